@@ -539,6 +539,10 @@ func (a *Agent) setupTask(agentCtx, setupCtx context.Context, initialTC *taskCon
 			Redacted:           tc.taskConfig.Redacted,
 			InternalRedactions: tc.taskConfig.InternalRedactions,
 		},
+		Comm: a.comm,
+		SendTestResults: func(ctx context.Context, format taskoutput.TestResultsFormat, files []string) error {
+			return command.SendTestResultsFiles(ctx, a.comm, tc.logger, tc.taskConfig, format, files)
+		},
 	}
 	tc.taskConfig.TaskOutputDir = taskoutput.NewDirectory(opts)
 	if err := tc.taskConfig.TaskOutputDir.Setup(); err != nil {
@@ -709,6 +713,7 @@ func (a *Agent) runTask(ctx context.Context, tcInput *taskContext, nt *apimodels
 	if shutdown != nil {
 		defer shutdown(ctx)
 	}
+	a.startResourceUsageSampling(tskCtx, tc)
 
 	tc.setHeartbeatTimeout(heartbeatTimeoutOptions{})
 	preAndMainCtx, preAndMainCancel := context.WithCancel(tskCtx)
@@ -1069,6 +1074,10 @@ func (a *Agent) finishTask(ctx context.Context, tc *taskContext, status string,
 		detail.FailureMetadataTags = utility.UniqueStrings(append(detail.FailureMetadataTags, addedMetadataTagResp.AddFailureMetadataTags...))
 	}
 
+	if tc.logger != nil {
+		tc.logger.Execution().Error(a.sendResourceUsage(ctx, tc))
+	}
+
 	// Attempt automatic task output ingestion if the task output directory
 	// was setup, regardless of the task status.
 	if tc.taskConfig != nil && tc.taskConfig.TaskOutputDir != nil {
@@ -1215,6 +1224,7 @@ func (a *Agent) endTaskResponse(ctx context.Context, tc *taskContext, status str
 	setEndTaskFailureDetails(tc, detail, status, highestPriorityDescription, userDefinedFailureType, userDefinedFailureMetadataTags)
 	if tc.taskConfig != nil {
 		detail.Modules.Prefixes = tc.taskConfig.ModulePaths
+		detail.CacheResults = tc.taskConfig.CacheResults
 	}
 	return detail
 }
@@ -102,6 +102,7 @@ func (a *Agent) runCommandsInBlock(ctx context.Context, tc *taskContext, cmdBloc
 		taskLogger.Infof("Finished running %s commands in %s.", legacyBlockName, time.Since(start).String())
 	}()
 
+	tc.setCurrentBlock(cmdBlock.block)
 	commands := cmdBlock.commands.List()
 	for i, commandInfo := range commands {
 		if err := blockCtx.Err(); err != nil {
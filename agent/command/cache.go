@@ -0,0 +1,262 @@
+package command
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/pail"
+	"github.com/mitchellh/mapstructure"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+const (
+	cacheAttribute = "evergreen.command.cache"
+
+	cacheArchiveExtension = ".tgz"
+)
+
+var (
+	cacheKeyAttribute         = fmt.Sprintf("%s.key", cacheAttribute)
+	cacheHitAttribute         = fmt.Sprintf("%s.hit", cacheAttribute)
+	cacheRestoredKeyAttribute = fmt.Sprintf("%s.restored_key", cacheAttribute)
+	cacheSkippedAttribute     = fmt.Sprintf("%s.skipped", cacheAttribute)
+)
+
+// cacheParams are the parameters shared by the cache.save and cache.restore
+// commands. A cache is a tarball stored in S3 whose name is the key followed
+// by a hash of the key files and key expansions, so the cache changes whenever
+// any of them change, and the time that it was saved.
+type cacheParams struct {
+	// Key is the name of the cache, which is the first part of the name of
+	// the stored archive.
+	Key string `mapstructure:"key" plugin:"expand"`
+
+	// KeyFiles are glob patterns, relative to the working directory, for
+	// files whose contents determine the cache, such as lock files.
+	KeyFiles []string `mapstructure:"key_files" plugin:"expand"`
+
+	// KeyExpansions are the names of expansions whose values determine the
+	// cache.
+	KeyExpansions []string `mapstructure:"key_expansions" plugin:"expand"`
+
+	// SourceDir is the directory that the cached files are saved from and
+	// restored to. It defaults to the working directory.
+	SourceDir string `mapstructure:"source_dir" plugin:"expand"`
+
+	// Bucket is the S3 bucket to store caches in.
+	Bucket string `mapstructure:"bucket" plugin:"expand"`
+
+	// Prefix is the path within the bucket to store caches in.
+	Prefix string `mapstructure:"prefix" plugin:"expand"`
+
+	// Region is the S3 region where the bucket is located. It defaults to
+	// "us-east-1".
+	Region string `mapstructure:"region" plugin:"expand"`
+
+	// RoleARN is an ARN that should be assumed to make the S3 requests.
+	RoleARN string `mapstructure:"role_arn" plugin:"expand"`
+
+	// AwsKey, AwsSecret, and AwsSessionToken are the user's credentials for
+	// authenticating interactions with S3, if not using a role ARN.
+	AwsKey          string `mapstructure:"aws_key" plugin:"expand"`
+	AwsSecret       string `mapstructure:"aws_secret" plugin:"expand"`
+	AwsSessionToken string `mapstructure:"aws_session_token" plugin:"expand"`
+
+	bucket   pail.Bucket
+	taskData client.TaskData
+}
+
+func (p *cacheParams) validate() error {
+	catcher := grip.NewSimpleCatcher()
+
+	catcher.NewWhen(p.Key == "", "key cannot be blank")
+	catcher.NewWhen(strings.ContainsAny(p.Key, "*?["), "key cannot contain glob characters")
+	catcher.Wrapf(validateS3BucketName(p.Bucket), "validating bucket name '%s'", p.Bucket)
+	if p.RoleARN != "" {
+		catcher.NewWhen(p.AwsKey != "", "AWS key must be empty when using role ARN")
+		catcher.NewWhen(p.AwsSecret != "", "AWS secret must be empty when using role ARN")
+		catcher.NewWhen(p.AwsSessionToken != "", "AWS session token must be empty when using role ARN")
+	} else {
+		catcher.NewWhen(p.AwsKey == "", "AWS key cannot be blank")
+		catcher.NewWhen(p.AwsSecret == "", "AWS secret cannot be blank")
+	}
+
+	return catcher.Resolve()
+}
+
+// decodeCacheParams decodes the parameters for a cache command into out,
+// which must embed cacheParams.
+func decodeCacheParams(params map[string]any, out any) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		Result:           out,
+	})
+	if err != nil {
+		return errors.Wrap(err, "initializing mapstructure decoder")
+	}
+	return errors.Wrap(decoder.Decode(params), "decoding mapstructure params")
+}
+
+// setDefaults sets the default values for the parameters after expansions
+// are applied.
+func (p *cacheParams) setDefaults(conf *internal.TaskConfig) {
+	if p.Region == "" {
+		p.Region = evergreen.DefaultEC2Region
+	}
+	p.SourceDir = GetWorkingDirectory(conf, p.SourceDir)
+	p.taskData = client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+}
+
+// keyHash returns a hash of the contents of the key files and the values of
+// the key expansions.
+func (p *cacheParams) keyHash(ctx context.Context, conf *internal.TaskConfig) (string, error) {
+	h := sha256.New()
+
+	if len(p.KeyFiles) > 0 {
+		files, _, err := findContentsToArchive(ctx, conf.WorkDir, p.KeyFiles, nil)
+		if err != nil {
+			return "", errors.Wrap(err, "finding key files")
+		}
+		var paths []string
+		for _, f := range files {
+			if f.info.Mode().IsRegular() {
+				paths = append(paths, f.path)
+			}
+		}
+		if len(paths) == 0 {
+			return "", errors.Errorf("no files matched key files %s", strings.Join(p.KeyFiles, ", "))
+		}
+		sort.Strings(paths)
+
+		for _, filePath := range paths {
+			relPath, err := filepath.Rel(conf.WorkDir, filePath)
+			if err != nil {
+				return "", errors.Wrapf(err, "getting relative path for key file '%s'", filePath)
+			}
+			fmt.Fprintf(h, "%s\x00", filepath.ToSlash(relPath))
+			if err := hashFile(h, filePath); err != nil {
+				return "", errors.Wrapf(err, "hashing key file '%s'", filePath)
+			}
+		}
+	}
+
+	for _, name := range p.KeyExpansions {
+		fmt.Fprintf(h, "%s=%s\x00", name, conf.Expansions.Get(name))
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashFile(w io.Writer, filePath string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// remoteKey returns the name of the stored archives for the exact key without
+// the time that they were saved.
+func (p *cacheParams) remoteKey(hash string) string {
+	return path.Join(p.Prefix, fmt.Sprintf("%s-%s", p.Key, hash))
+}
+
+// archiveName returns the name to store the archive for the remote key under
+// when it is saved at the given time.
+func archiveName(remoteKey string, savedAt time.Time) string {
+	return fmt.Sprintf("%s-%d%s", remoteKey, savedAt.UnixMilli(), cacheArchiveExtension)
+}
+
+// archiveSavedAt returns the time that the archive was saved, which is the
+// zero time if the name does not include it.
+func archiveSavedAt(name string) time.Time {
+	name = strings.TrimSuffix(name, cacheArchiveExtension)
+	millis, err := strconv.ParseInt(name[strings.LastIndex(name, "-")+1:], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis)
+}
+
+// latestArchive returns the most recently saved archive, or an empty string if
+// there are none.
+func latestArchive(names []string) string {
+	var latest string
+	var latestSavedAt time.Time
+	for _, name := range names {
+		savedAt := archiveSavedAt(name)
+		if latest == "" || savedAt.After(latestSavedAt) {
+			latest = name
+			latestSavedAt = savedAt
+		}
+	}
+	return latest
+}
+
+// displayKey returns the name of the stored archive relative to the prefix.
+func (p *cacheParams) displayKey(remoteKey string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(remoteKey, p.Prefix), "/")
+}
+
+func (p *cacheParams) createBucket(ctx context.Context, comm client.Communicator, httpClient *http.Client) error {
+	if p.bucket != nil {
+		return nil
+	}
+
+	opts := pail.S3Options{
+		Region: p.Region,
+		Name:   p.Bucket,
+	}
+	if p.AwsKey != "" {
+		opts.Credentials = pail.CreateAWSStaticCredentials(p.AwsKey, p.AwsSecret, p.AwsSessionToken)
+	} else if p.RoleARN != "" {
+		opts.Credentials = createEvergreenCredentials(comm, p.taskData, p.RoleARN)
+	}
+
+	bucket, err := pail.NewS3MultiPartBucketWithHTTPClient(ctx, httpClient, opts)
+	if err != nil {
+		return errors.Wrap(err, "creating S3 bucket")
+	}
+	p.bucket = bucket
+	return nil
+}
+
+// findArchives returns the names of the stored archives that begin with the
+// given key prefix, sorted by name.
+func (p *cacheParams) findArchives(ctx context.Context, keyPrefix string) ([]string, error) {
+	it, err := p.bucket.List(ctx, path.Join(p.Prefix, keyPrefix))
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing caches with prefix '%s'", keyPrefix)
+	}
+
+	var names []string
+	for it.Next(ctx) {
+		if name := it.Item().Name(); strings.HasSuffix(name, cacheArchiveExtension) {
+			names = append(names, name)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, errors.Wrapf(err, "listing caches with prefix '%s'", keyPrefix)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
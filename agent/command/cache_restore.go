@@ -0,0 +1,142 @@
+package command
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// cacheRestore is a command that restores files from a cache saved by
+// cache.save.
+type cacheRestore struct {
+	cacheParams `mapstructure:",squash" plugin:"expand"`
+
+	// RestoreKeys are key prefixes to fall back to, in order, if there's no
+	// cache for the exact key. If several caches match a prefix, the most
+	// recently saved one is restored.
+	RestoreKeys []string `mapstructure:"restore_keys" plugin:"expand"`
+
+	// HitExpansion is the name of an expansion to set to "true" if the cache
+	// for the exact key was restored and "false" otherwise.
+	HitExpansion string `mapstructure:"hit_expansion" plugin:"expand"`
+
+	base
+}
+
+func cacheRestoreFactory() Command   { return &cacheRestore{} }
+func (c *cacheRestore) Name() string { return "cache.restore" }
+
+func (c *cacheRestore) ParseParams(params map[string]any) error {
+	if err := decodeCacheParams(params, c); err != nil {
+		return err
+	}
+	return errors.Wrap(c.validate(), "validating params")
+}
+
+// Execute restores the cache for the exact key if it exists, or else the
+// cache for the first fallback key prefix that has one. A cache miss is not an
+// error. The result is recorded in the task's end details.
+func (c *cacheRestore) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+	c.setDefaults(conf)
+	if err := c.validate(); err != nil {
+		return errors.Wrap(err, "validating expanded params")
+	}
+
+	hash, err := c.keyHash(ctx, conf)
+	if err != nil {
+		return errors.Wrap(err, "computing cache key")
+	}
+	remoteKey := c.remoteKey(hash)
+
+	httpClient := utility.GetHTTPClient()
+	httpClient.Timeout = s3HTTPClientTimeout
+	defer utility.PutHTTPClient(httpClient)
+	if err := c.createBucket(ctx, comm, httpClient); err != nil {
+		return err
+	}
+
+	restoreKey, hit, err := c.findRestoreKey(ctx, remoteKey)
+	if err != nil {
+		return err
+	}
+
+	conf.AddCacheResult(apimodels.CacheResult{
+		Key:         c.displayKey(remoteKey),
+		Hit:         hit,
+		RestoredKey: c.displayKey(restoreKey),
+	})
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.String(cacheKeyAttribute, c.displayKey(remoteKey)),
+		attribute.Bool(cacheHitAttribute, hit),
+		attribute.String(cacheRestoredKeyAttribute, c.displayKey(restoreKey)),
+	)
+	if c.HitExpansion != "" {
+		if conf.DynamicExpansions == nil {
+			conf.DynamicExpansions = util.Expansions{}
+		}
+		conf.NewExpansions.Put(c.HitExpansion, strconv.FormatBool(hit))
+		conf.DynamicExpansions.Put(c.HitExpansion, strconv.FormatBool(hit))
+	}
+
+	if restoreKey == "" {
+		logger.Task().Infof("Cache miss for cache '%s'.", c.displayKey(remoteKey))
+		return nil
+	}
+	if hit {
+		logger.Task().Infof("Cache hit for cache '%s'.", c.displayKey(remoteKey))
+	} else {
+		logger.Task().Infof("Cache miss for cache '%s', restoring fallback cache '%s'.", c.displayKey(remoteKey), c.displayKey(restoreKey))
+	}
+
+	if err := os.MkdirAll(c.SourceDir, 0755); err != nil {
+		return errors.Wrapf(err, "creating source directory '%s'", c.SourceDir)
+	}
+	r, err := c.bucket.Get(ctx, restoreKey)
+	if err != nil {
+		return errors.Wrapf(err, "downloading cache '%s'", c.displayKey(restoreKey))
+	}
+	defer r.Close()
+	if err := extractTarball(ctx, r, c.SourceDir, nil); err != nil {
+		return errors.Wrapf(err, "extracting cache '%s' to '%s'", c.displayKey(restoreKey), c.SourceDir)
+	}
+	logger.Task().Infof("Restored cache '%s' to '%s'.", c.displayKey(restoreKey), c.SourceDir)
+
+	return nil
+}
+
+// findRestoreKey returns the name of the stored archive to restore, or an
+// empty string if there is none, and whether it is for the exact key. If
+// several archives match, the most recently saved one is returned.
+func (c *cacheRestore) findRestoreKey(ctx context.Context, remoteKey string) (string, bool, error) {
+	names, err := c.findArchives(ctx, c.displayKey(remoteKey)+"-")
+	if err != nil {
+		return "", false, err
+	}
+	if latest := latestArchive(names); latest != "" {
+		return latest, true, nil
+	}
+
+	for _, restoreKey := range c.RestoreKeys {
+		names, err := c.findArchives(ctx, restoreKey)
+		if err != nil {
+			return "", false, err
+		}
+		if latest := latestArchive(names); latest != "" {
+			return latest, false, nil
+		}
+	}
+
+	return "", false, nil
+}
@@ -0,0 +1,136 @@
+package command
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// cacheSave is a command that archives files and stores them in S3 as a cache
+// that cache.restore can restore in later tasks.
+type cacheSave struct {
+	cacheParams `mapstructure:",squash" plugin:"expand"`
+
+	// Include is a list of glob patterns, relative to the source directory,
+	// for the files to cache, e.g. "node_modules/**".
+	Include []string `mapstructure:"include" plugin:"expand"`
+
+	// ExcludeFiles is a list of glob patterns for files not to cache.
+	ExcludeFiles []string `mapstructure:"exclude_files" plugin:"expand"`
+
+	base
+}
+
+func cacheSaveFactory() Command   { return &cacheSave{} }
+func (c *cacheSave) Name() string { return "cache.save" }
+
+func (c *cacheSave) ParseParams(params map[string]any) error {
+	if err := decodeCacheParams(params, c); err != nil {
+		return err
+	}
+	return errors.Wrap(c.validate(), "validating params")
+}
+
+func (c *cacheSave) validate() error {
+	catcher := grip.NewSimpleCatcher()
+	catcher.Add(c.cacheParams.validate())
+	catcher.NewWhen(len(c.Include) == 0, "include cannot be empty")
+	return catcher.Resolve()
+}
+
+// Execute archives the files and uploads the archive, unless a cache with the
+// same key and hash already exists.
+func (c *cacheSave) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+	c.setDefaults(conf)
+	if err := c.validate(); err != nil {
+		return errors.Wrap(err, "validating expanded params")
+	}
+
+	hash, err := c.keyHash(ctx, conf)
+	if err != nil {
+		return errors.Wrap(err, "computing cache key")
+	}
+	remoteKey := c.remoteKey(hash)
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String(cacheKeyAttribute, c.displayKey(remoteKey)))
+
+	httpClient := utility.GetHTTPClient()
+	httpClient.Timeout = s3HTTPClientTimeout
+	defer utility.PutHTTPClient(httpClient)
+	if err := c.createBucket(ctx, comm, httpClient); err != nil {
+		return err
+	}
+
+	existing, err := c.findArchives(ctx, c.displayKey(remoteKey)+"-")
+	if err != nil {
+		return errors.Wrap(err, "checking for existing cache")
+	}
+	if len(existing) > 0 {
+		logger.Task().Infof("Cache '%s' already exists, not saving it again.", c.displayKey(remoteKey))
+		trace.SpanFromContext(ctx).SetAttributes(attribute.Bool(cacheSkippedAttribute, true))
+		return nil
+	}
+
+	archive, err := os.CreateTemp("", "cache-*"+cacheArchiveExtension)
+	if err != nil {
+		return errors.Wrap(err, "creating temporary archive file")
+	}
+	archivePath := archive.Name()
+	if err := archive.Close(); err != nil {
+		return errors.Wrapf(err, "closing temporary archive file '%s'", archivePath)
+	}
+	defer func() {
+		logger.Execution().Error(errors.Wrapf(os.RemoveAll(archivePath), "removing temporary archive file '%s'", archivePath))
+	}()
+
+	numFiles, err := c.makeArchive(ctx, archivePath, logger)
+	if err != nil {
+		return errors.Wrap(err, "creating cache archive")
+	}
+	if numFiles == 0 {
+		logger.Task().Warningf("No files matched for cache '%s', not saving it.", c.displayKey(remoteKey))
+		trace.SpanFromContext(ctx).SetAttributes(attribute.Bool(cacheSkippedAttribute, true))
+		return nil
+	}
+
+	name := archiveName(remoteKey, time.Now())
+	logger.Task().Infof("Saving %d files to cache '%s'.", numFiles, c.displayKey(name))
+	if err := c.bucket.Upload(ctx, name, archivePath); err != nil {
+		return errors.Wrapf(err, "uploading cache '%s'", c.displayKey(name))
+	}
+	logger.Task().Infof("Saved cache '%s'.", c.displayKey(name))
+
+	return nil
+}
+
+func (c *cacheSave) makeArchive(ctx context.Context, archivePath string, logger client.LoggerProducer) (int, error) {
+	pathsToAdd, totalSize, err := findContentsToArchive(ctx, c.SourceDir, c.Include, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "getting archive contents")
+	}
+
+	f, gz, tarWriter, err := tarGzWriter(archivePath, totalSize > thresholdSizeForParallelGzipCompression)
+	if err != nil {
+		return 0, errors.Wrapf(err, "opening archive file '%s'", archivePath)
+	}
+
+	numFiles, err := buildArchive(ctx, tarWriter, c.SourceDir, pathsToAdd, c.ExcludeFiles, logger.Execution())
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(err)
+	catcher.Wrap(tarWriter.Close(), "closing tar writer")
+	catcher.Wrap(gz.Close(), "closing gzip writer")
+	catcher.Wrap(f.Close(), "closing archive file")
+
+	return numFiles, catcher.Resolve()
+}
@@ -0,0 +1,165 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	agentutil "github.com/evergreen-ci/evergreen/agent/util"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/pail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheParseParams(t *testing.T) {
+	validParams := func() map[string]any {
+		return map[string]any{
+			"key":        "deps",
+			"key_files":  []string{"go.sum"},
+			"bucket":     "bucket",
+			"aws_key":    "key",
+			"aws_secret": "secret",
+			"include":    []string{"vendor/**"},
+		}
+	}
+
+	t.Run("SaveSucceedsWithValidParams", func(t *testing.T) {
+		c := &cacheSave{}
+		require.NoError(t, c.ParseParams(validParams()))
+		assert.Equal(t, "deps", c.Key)
+		assert.Equal(t, []string{"go.sum"}, c.KeyFiles)
+		assert.Equal(t, []string{"vendor/**"}, c.Include)
+	})
+	t.Run("SaveFailsWithoutInclude", func(t *testing.T) {
+		params := validParams()
+		delete(params, "include")
+		assert.Error(t, (&cacheSave{}).ParseParams(params))
+	})
+	t.Run("RestoreFailsWithoutKey", func(t *testing.T) {
+		params := validParams()
+		delete(params, "key")
+		assert.Error(t, (&cacheRestore{}).ParseParams(params))
+	})
+	t.Run("RestoreFailsWithCredentialsAndRoleARN", func(t *testing.T) {
+		params := validParams()
+		params["role_arn"] = "arn"
+		assert.Error(t, (&cacheRestore{}).ParseParams(params))
+	})
+}
+
+func TestCacheSaveAndRestore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	comm := client.NewMock("url")
+
+	setup := func(t *testing.T) (*internal.TaskConfig, client.LoggerProducer) {
+		workDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(workDir, "go.sum"), []byte("v1"), 0644))
+		conf := &internal.TaskConfig{
+			Task:              task.Task{Id: "task"},
+			WorkDir:           workDir,
+			Expansions:        util.Expansions{"os": "linux"},
+			NewExpansions:     agentutil.NewDynamicExpansions(util.Expansions{"os": "linux"}),
+			DynamicExpansions: util.Expansions{},
+		}
+		logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+		require.NoError(t, err)
+		return conf, logger
+	}
+	params := cacheParams{
+		Key:           "deps",
+		KeyFiles:      []string{"go.sum"},
+		KeyExpansions: []string{"os"},
+		Bucket:        "bucket",
+		AwsKey:        "key",
+		AwsSecret:     "secret",
+		Prefix:        "caches",
+	}
+
+	bucket, err := pail.NewLocalBucket(pail.LocalOptions{Path: t.TempDir(), UseSlash: true})
+	require.NoError(t, err)
+	params.bucket = bucket
+
+	saveConf, logger := setup(t)
+	require.NoError(t, os.MkdirAll(filepath.Join(saveConf.WorkDir, "vendor", "lib"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(saveConf.WorkDir, "vendor", "lib", "lib.go"), []byte("package lib"), 0644))
+	save := &cacheSave{cacheParams: params, Include: []string{"vendor/**"}}
+	require.NoError(t, save.Execute(ctx, comm, logger, saveConf))
+
+	t.Run("HitForSameKey", func(t *testing.T) {
+		conf, logger := setup(t)
+		restore := &cacheRestore{cacheParams: params, HitExpansion: "cache_hit"}
+		require.NoError(t, restore.Execute(ctx, comm, logger, conf))
+
+		contents, err := os.ReadFile(filepath.Join(conf.WorkDir, "vendor", "lib", "lib.go"))
+		require.NoError(t, err)
+		assert.Equal(t, "package lib", string(contents))
+		assert.Equal(t, "true", conf.NewExpansions.Get("cache_hit"))
+		require.Len(t, conf.CacheResults, 1)
+		assert.True(t, conf.CacheResults[0].Hit)
+		assert.True(t, strings.HasPrefix(conf.CacheResults[0].RestoredKey, conf.CacheResults[0].Key+"-"))
+	})
+	t.Run("MissForChangedKeyFile", func(t *testing.T) {
+		conf, logger := setup(t)
+		require.NoError(t, os.WriteFile(filepath.Join(conf.WorkDir, "go.sum"), []byte("v2"), 0644))
+		restore := &cacheRestore{cacheParams: params, HitExpansion: "cache_hit"}
+		require.NoError(t, restore.Execute(ctx, comm, logger, conf))
+
+		assert.NoFileExists(t, filepath.Join(conf.WorkDir, "vendor", "lib", "lib.go"))
+		assert.Equal(t, "false", conf.NewExpansions.Get("cache_hit"))
+		require.Len(t, conf.CacheResults, 1)
+		assert.False(t, conf.CacheResults[0].Hit)
+		assert.Empty(t, conf.CacheResults[0].RestoredKey)
+	})
+	t.Run("RestoresFallbackKey", func(t *testing.T) {
+		conf, logger := setup(t)
+		conf.Expansions.Put("os", "windows")
+		restore := &cacheRestore{cacheParams: params, RestoreKeys: []string{"deps-"}, HitExpansion: "cache_hit"}
+		require.NoError(t, restore.Execute(ctx, comm, logger, conf))
+
+		assert.FileExists(t, filepath.Join(conf.WorkDir, "vendor", "lib", "lib.go"))
+		assert.Equal(t, "false", conf.NewExpansions.Get("cache_hit"), "fallback should not count as a hit")
+		require.Len(t, conf.CacheResults, 1)
+		assert.False(t, conf.CacheResults[0].Hit)
+		assert.NotEmpty(t, conf.CacheResults[0].RestoredKey)
+	})
+	t.Run("SaveSkipsExistingCache", func(t *testing.T) {
+		conf, logger := setup(t)
+		save := &cacheSave{cacheParams: params, Include: []string{"vendor/**"}}
+		require.NoError(t, save.Execute(ctx, comm, logger, conf), "should not fail even though there are no files to archive")
+
+		names, err := save.findArchives(ctx, "deps")
+		require.NoError(t, err)
+		assert.Len(t, names, 1)
+	})
+	t.Run("FailsWithoutKeyFiles", func(t *testing.T) {
+		conf, logger := setup(t)
+		require.NoError(t, os.Remove(filepath.Join(conf.WorkDir, "go.sum")))
+		restore := &cacheRestore{cacheParams: params}
+		assert.Error(t, restore.Execute(ctx, comm, logger, conf))
+	})
+}
+
+func TestLatestArchive(t *testing.T) {
+	older := archiveName("caches/deps-ffff", time.Unix(1000, 0))
+	newer := archiveName("caches/deps-0000", time.Unix(2000, 0))
+
+	t.Run("ChoosesMostRecentlySaved", func(t *testing.T) {
+		assert.Equal(t, newer, latestArchive([]string{older, newer}))
+		assert.Equal(t, newer, latestArchive([]string{newer, older}), "should not depend on name order")
+	})
+	t.Run("PrefersArchivesWithSaveTime", func(t *testing.T) {
+		assert.Equal(t, older, latestArchive([]string{"caches/deps-abcd.tgz", older}))
+	})
+	t.Run("EmptyWithoutArchives", func(t *testing.T) {
+		assert.Empty(t, latestArchive(nil))
+	})
+}
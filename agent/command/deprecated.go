@@ -5,8 +5,22 @@ import (
 
 	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/utility"
 )
 
+// deprecatedCommands are the commands that no longer do anything. They are
+// still accepted so that existing project configurations remain valid, but
+// they can safely be removed.
+var deprecatedCommands = []string{
+	"git.apply_patch",
+	"manifest.load",
+}
+
+// IsDeprecated returns whether the command with the given name is deprecated.
+func IsDeprecated(name string) bool {
+	return utility.StringSliceContains(deprecatedCommands, name)
+}
+
 // gitApplyPatch is deprecated. Its functionality is now a part of GitGetProjectCommand.
 type gitApplyPatch struct{ base }
 
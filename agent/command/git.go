@@ -301,6 +301,11 @@ func (c *gitFetchProject) buildSourceCloneCommand(conf *internal.TaskConfig, opt
 			localBranchName = fmt.Sprintf("evg-mg-test-%s", utility.RandomString())
 			// HeadRef looks like "refs/heads/gh-readonly-queue/main/pr-515-9cd8a2532bcddf58369aa82eb66ba88e2323c056"
 			remoteBranchName = conf.GithubMergeData.HeadBranch
+			if remoteBranchName == "" {
+				// Patches that bisect a failed merge group have no branch
+				// because GitHub deletes it, so fetch the commit directly.
+				remoteBranchName = commitToTest
+			}
 		}
 		if commitToTest != "" {
 			gitCommands = append(gitCommands, []string{
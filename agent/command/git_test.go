@@ -614,6 +614,16 @@ func (s *GitGetProjectSuite) TestBuildSourceCommandForGitHubMergeQueue() {
 		"git reset --hard d2a90288ad96adca4a7d0122d8d4fd1deb24db11",
 		"git log --oneline -n 10",
 	}))
+
+	// Patches that bisect a failed merge group have no branch to fetch.
+	conf.GithubMergeData.HeadBranch = ""
+	cmds, err = c.buildSourceCloneCommand(conf, opts)
+	s.NoError(err)
+	s.True(utility.StringSliceContainsOrderedPrefixSubset(cmds, []string{
+		"git fetch origin \"d2a90288ad96adca4a7d0122d8d4fd1deb24db11:evg-mg-test-",
+		"git checkout \"evg-mg-test-",
+		"git reset --hard d2a90288ad96adca4a7d0122d8d4fd1deb24db11",
+	}))
 }
 
 func (s *GitGetProjectSuite) TestBuildModuleCommand() {
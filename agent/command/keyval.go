@@ -3,9 +3,11 @@ package command
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/util"
 	"github.com/mitchellh/mapstructure"
@@ -55,3 +57,263 @@ func (c *keyValInc) Execute(ctx context.Context,
 	conf.NewExpansions.Put(c.Destination, strconv.FormatInt(keyVal.Value, 10))
 	return nil
 }
+
+// keyValLockPollInterval is how often keyval.lock tries to acquire a lock that
+// another task holds.
+const keyValLockPollInterval = 10 * time.Second
+
+// keyValGet sets an expansion to the value of a key shared by the tasks in
+// the project.
+type keyValGet struct {
+	Key         string `mapstructure:"key" plugin:"expand"`
+	Destination string `mapstructure:"destination" plugin:"expand"`
+	// Default is the value to use if the key isn't set.
+	Default string `mapstructure:"default" plugin:"expand"`
+	// Required fails the command if the key isn't set.
+	Required bool `mapstructure:"required"`
+	base
+}
+
+func keyValGetFactory() Command   { return &keyValGet{} }
+func (c *keyValGet) Name() string { return "keyval.get" }
+
+func (c *keyValGet) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+	if c.Key == "" || c.Destination == "" {
+		return errors.New("both key and destination must be set")
+	}
+	return nil
+}
+
+func (c *keyValGet) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	resp, err := comm.ProjectKeyVal(ctx, td, apimodels.KeyValGet, apimodels.KeyValRequest{Key: c.Key})
+	if err != nil {
+		return errors.Wrapf(err, "getting key '%s'", c.Key)
+	}
+
+	value := resp.Value
+	if !resp.Found {
+		if c.Required {
+			return errors.Errorf("key '%s' is not set", c.Key)
+		}
+		logger.Task().Infof("Key '%s' is not set, using default value.", c.Key)
+		value = c.Default
+	}
+	conf.NewExpansions.Put(c.Destination, value)
+	return nil
+}
+
+// keyValSet sets a key shared by the tasks in the project.
+type keyValSet struct {
+	Key   string `mapstructure:"key" plugin:"expand"`
+	Value string `mapstructure:"value" plugin:"expand"`
+	// TTLSecs is how long the key lives.
+	TTLSecs int `mapstructure:"ttl_secs"`
+	base
+}
+
+func keyValSetFactory() Command   { return &keyValSet{} }
+func (c *keyValSet) Name() string { return "keyval.set" }
+
+func (c *keyValSet) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+	if c.Key == "" {
+		return errors.New("key must be set")
+	}
+	if c.TTLSecs < 0 {
+		return errors.New("TTL cannot be negative")
+	}
+	return nil
+}
+
+func (c *keyValSet) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	_, err := comm.ProjectKeyVal(ctx, td, apimodels.KeyValSet, apimodels.KeyValRequest{
+		Key:     c.Key,
+		Value:   c.Value,
+		TTLSecs: c.TTLSecs,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "setting key '%s'", c.Key)
+	}
+
+	logger.Task().Infof("Set key '%s'.", c.Key)
+	return nil
+}
+
+// keyValCAS sets a key shared by the tasks in the project only if it has the
+// expected value.
+type keyValCAS struct {
+	Key string `mapstructure:"key" plugin:"expand"`
+	// Expected is the value the key must have. If it's empty, the key must
+	// not be set.
+	Expected string `mapstructure:"expected" plugin:"expand"`
+	Value    string `mapstructure:"value" plugin:"expand"`
+	// Destination, if set, is the expansion that's set to whether the value
+	// was swapped. Otherwise, the command fails if it's not swapped.
+	Destination string `mapstructure:"destination" plugin:"expand"`
+	// TTLSecs is how long the key lives.
+	TTLSecs int `mapstructure:"ttl_secs"`
+	base
+}
+
+func keyValCASFactory() Command   { return &keyValCAS{} }
+func (c *keyValCAS) Name() string { return "keyval.cas" }
+
+func (c *keyValCAS) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+	if c.Key == "" {
+		return errors.New("key must be set")
+	}
+	if c.TTLSecs < 0 {
+		return errors.New("TTL cannot be negative")
+	}
+	return nil
+}
+
+func (c *keyValCAS) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	resp, err := comm.ProjectKeyVal(ctx, td, apimodels.KeyValCAS, apimodels.KeyValRequest{
+		Key:      c.Key,
+		Expected: c.Expected,
+		Value:    c.Value,
+		TTLSecs:  c.TTLSecs,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "swapping key '%s'", c.Key)
+	}
+
+	if c.Destination != "" {
+		conf.NewExpansions.Put(c.Destination, strconv.FormatBool(resp.Succeeded))
+	}
+	if !resp.Succeeded {
+		if c.Destination == "" {
+			return errors.Errorf("key '%s' does not have the expected value", c.Key)
+		}
+		logger.Task().Infof("Did not swap key '%s' because it does not have the expected value.", c.Key)
+		return nil
+	}
+
+	logger.Task().Infof("Swapped key '%s'.", c.Key)
+	return nil
+}
+
+// keyValLock acquires a lock shared by the tasks in the project, waiting for
+// another task to release it if necessary.
+type keyValLock struct {
+	Key string `mapstructure:"key" plugin:"expand"`
+	// TTLSecs is how long the lock is held before it's released
+	// automatically.
+	TTLSecs int `mapstructure:"ttl_secs"`
+	// TimeoutSecs is how long to wait for the lock. If it's zero, the command
+	// waits until the command times out.
+	TimeoutSecs int `mapstructure:"timeout_secs"`
+	base
+}
+
+func keyValLockFactory() Command   { return &keyValLock{} }
+func (c *keyValLock) Name() string { return "keyval.lock" }
+
+func (c *keyValLock) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+	if c.Key == "" {
+		return errors.New("key must be set")
+	}
+	if c.TTLSecs < 0 || c.TimeoutSecs < 0 {
+		return errors.New("TTL and timeout cannot be negative")
+	}
+	return nil
+}
+
+func (c *keyValLock) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+
+	if c.TimeoutSecs > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(c.TimeoutSecs)*time.Second)
+		defer cancel()
+	}
+
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	req := apimodels.KeyValRequest{Key: c.Key, TTLSecs: c.TTLSecs}
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "waiting for lock '%s'", c.Key)
+		case <-timer.C:
+			resp, err := comm.ProjectKeyVal(ctx, td, apimodels.KeyValLock, req)
+			if err != nil {
+				return errors.Wrapf(err, "locking key '%s'", c.Key)
+			}
+			if resp.Succeeded {
+				logger.Task().Infof("Acquired lock '%s'.", c.Key)
+				return nil
+			}
+			logger.Task().Infof("Lock '%s' is held by task '%s', waiting.", c.Key, resp.Value)
+			timer.Reset(keyValLockPollInterval)
+		}
+	}
+}
+
+// keyValUnlock releases a lock shared by the tasks in the project.
+type keyValUnlock struct {
+	Key string `mapstructure:"key" plugin:"expand"`
+	base
+}
+
+func keyValUnlockFactory() Command   { return &keyValUnlock{} }
+func (c *keyValUnlock) Name() string { return "keyval.unlock" }
+
+func (c *keyValUnlock) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+	if c.Key == "" {
+		return errors.New("key must be set")
+	}
+	return nil
+}
+
+func (c *keyValUnlock) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	resp, err := comm.ProjectKeyVal(ctx, td, apimodels.KeyValUnlock, apimodels.KeyValRequest{Key: c.Key})
+	if err != nil {
+		return errors.Wrapf(err, "unlocking key '%s'", c.Key)
+	}
+	if !resp.Succeeded {
+		logger.Task().Warningf("Lock '%s' is not held by this task.", c.Key)
+		return nil
+	}
+
+	logger.Task().Infof("Released lock '%s'.", c.Key)
+	return nil
+}
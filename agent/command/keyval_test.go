@@ -5,13 +5,17 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
 	agentutil "github.com/evergreen-ci/evergreen/agent/internal/testutil"
+	"github.com/evergreen-ci/evergreen/agent/util"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
 	modelutil "github.com/evergreen-ci/evergreen/model/testutil"
 	"github.com/evergreen-ci/evergreen/testutil"
 	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -61,3 +65,62 @@ func TestIncKey(t *testing.T) {
 		})
 	})
 }
+
+func TestProjectKeyValCommands(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	comm := client.NewMock("url")
+	setup := func(t *testing.T, taskID string) (*internal.TaskConfig, client.LoggerProducer) {
+		conf := &internal.TaskConfig{
+			Task:          task.Task{Id: taskID},
+			NewExpansions: util.NewDynamicExpansions(nil),
+		}
+		logger, err := comm.GetLoggerProducer(ctx, &conf.Task, nil)
+		require.NoError(t, err)
+		return conf, logger
+	}
+	run := func(t *testing.T, conf *internal.TaskConfig, logger client.LoggerProducer, name string, params map[string]any) error {
+		cmd, err := Render(model.PluginCommandConf{Command: name, Params: params}, &model.Project{}, BlockInfo{})
+		require.NoError(t, err)
+		require.Len(t, cmd, 1)
+		return cmd[0].Execute(ctx, comm, logger, conf)
+	}
+
+	t.Run("SetAndGet", func(t *testing.T) {
+		conf, logger := setup(t, "t1")
+		require.NoError(t, run(t, conf, logger, "keyval.set", map[string]any{"key": "build_id", "value": "1234"}))
+		require.NoError(t, run(t, conf, logger, "keyval.get", map[string]any{"key": "build_id", "destination": "id"}))
+		assert.Equal(t, "1234", conf.NewExpansions.Get("id"))
+
+		require.NoError(t, run(t, conf, logger, "keyval.get", map[string]any{"key": "missing", "destination": "missing", "default": "none"}))
+		assert.Equal(t, "none", conf.NewExpansions.Get("missing"))
+		assert.Error(t, run(t, conf, logger, "keyval.get", map[string]any{"key": "missing", "destination": "missing", "required": true}))
+	})
+	t.Run("CompareAndSwap", func(t *testing.T) {
+		conf, logger := setup(t, "t1")
+		require.NoError(t, run(t, conf, logger, "keyval.cas", map[string]any{"key": "published", "value": "t1"}))
+		assert.Error(t, run(t, conf, logger, "keyval.cas", map[string]any{"key": "published", "value": "t1"}), "key is already set")
+		require.NoError(t, run(t, conf, logger, "keyval.cas", map[string]any{"key": "published", "value": "t2", "destination": "swapped"}))
+		assert.Equal(t, "false", conf.NewExpansions.Get("swapped"))
+		require.NoError(t, run(t, conf, logger, "keyval.cas", map[string]any{"key": "published", "expected": "t1", "value": "t2", "destination": "swapped"}))
+		assert.Equal(t, "true", conf.NewExpansions.Get("swapped"))
+		assert.Equal(t, "t2", comm.ProjectKeyVals["published"])
+	})
+	t.Run("LockAndUnlock", func(t *testing.T) {
+		conf1, logger1 := setup(t, "t1")
+		conf2, logger2 := setup(t, "t2")
+		require.NoError(t, run(t, conf1, logger1, "keyval.lock", map[string]any{"key": "deploy"}))
+		assert.Error(t, run(t, conf2, logger2, "keyval.lock", map[string]any{"key": "deploy", "timeout_secs": 1}), "lock is held by another task")
+		require.NoError(t, run(t, conf2, logger2, "keyval.unlock", map[string]any{"key": "deploy"}))
+		assert.Equal(t, "t1", comm.ProjectKeyVals["deploy"], "only the owner should release the lock")
+		require.NoError(t, run(t, conf1, logger1, "keyval.unlock", map[string]any{"key": "deploy"}))
+		require.NoError(t, run(t, conf2, logger2, "keyval.lock", map[string]any{"key": "deploy"}))
+	})
+	t.Run("FailsWithoutKey", func(t *testing.T) {
+		for _, name := range []string{"keyval.get", "keyval.set", "keyval.cas", "keyval.lock", "keyval.unlock"} {
+			_, err := Render(model.PluginCommandConf{Command: name, Params: map[string]any{"destination": "d"}}, &model.Project{}, BlockInfo{})
+			assert.Error(t, err, name)
+		}
+	})
+}
@@ -24,6 +24,9 @@ func init() {
 		"archive.targz_extract":                 tarballExtractFactory,
 		"archive.zip_pack":                      zipArchiveCreateFactory,
 		"archive.zip_extract":                   zipExtractFactory,
+		"cache.restore":                         cacheRestoreFactory,
+		"cache.save":                            cacheSaveFactory,
+		"cucumber.parse_files":                  cucumberResultsFactory,
 		evergreen.AttachResultsCommandName:      attachResultsFactory,
 		evergreen.AttachXUnitResultsCommandName: xunitResultsFactory,
 		evergreen.AttachArtifactsCommandName:    attachArtifactsFactory,
@@ -38,7 +41,12 @@ func init() {
 		"git.push":                              gitPushFactory,
 		"github.generate_token":                 githubGenerateTokenFactory,
 		"gotest.parse_files":                    goTestFactory,
+		"keyval.cas":                            keyValCASFactory,
+		"keyval.get":                            keyValGetFactory,
 		"keyval.inc":                            keyValIncFactory,
+		"keyval.lock":                           keyValLockFactory,
+		"keyval.set":                            keyValSetFactory,
+		"keyval.unlock":                         keyValUnlockFactory,
 		"manifest.load":                         manifestLoadFactory,
 		"papertrail.trace":                      papertrailTraceFactory,
 		"perf.send":                             perfSendFactory,
@@ -48,6 +56,7 @@ func init() {
 		"s3Copy.copy":                           s3CopyFactory,
 		evergreen.ShellExecCommandName:          shellExecFactory,
 		"subprocess.exec":                       subprocessExecFactory,
+		"tap.parse_files":                       tapResultsFactory,
 		"setup.initial":                         initialSetupFactory,
 		"timeout.update":                        timeoutUpdateFactory,
 	}
@@ -73,6 +82,14 @@ func Render(c model.PluginCommandConf, project *model.Project, blockInfo BlockIn
 	return evgRegistry.renderCommands(c, project, blockInfo)
 }
 
+// Resolve takes a command specification and returns the specifications of the
+// commands to actually run, with their full display names, without parsing
+// their parameters. Like Render, a function resolves into the list of commands
+// it contains.
+func Resolve(c model.PluginCommandConf, project *model.Project, blockInfo BlockInfo) ([]model.PluginCommandConf, error) {
+	return resolveCommands(c, project, blockInfo)
+}
+
 func RegisteredCommandNames() []string { return evgRegistry.registeredCommandNames() }
 
 type CommandFactory func() Command
@@ -133,6 +150,44 @@ func (r *commandRegistry) getCommandFactory(name string) (CommandFactory, bool)
 func (r *commandRegistry) renderCommands(commandInfo model.PluginCommandConf,
 	project *model.Project, blockInfo BlockInfo) ([]Command, error) {
 
+	parsed, err := resolveCommands(commandInfo, project, blockInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	catcher := grip.NewBasicCatcher()
+	var out []Command
+	for _, c := range parsed {
+		factory, ok := r.getCommandFactory(c.Command)
+		if !ok {
+			catcher.Errorf("command '%s' is not registered", c.Command)
+			continue
+		}
+
+		cmd := factory()
+		// Note: this parses the parameters before expansions are applied.
+		// Expansions are only available when the command is executed.
+		if err := cmd.ParseParams(c.Params); err != nil {
+			catcher.Wrapf(err, "parsing parameters for command %s", c.DisplayName)
+			continue
+		}
+		cmd.SetType(c.GetType(project))
+		cmd.SetFullDisplayName(c.DisplayName)
+		cmd.SetIdleTimeout(time.Duration(c.TimeoutSecs) * time.Second)
+		cmd.SetRetryOnFailure(c.RetryOnFailure)
+		cmd.SetFailureMetadataTags(c.FailureMetadataTags)
+
+		out = append(out, cmd)
+	}
+
+	if catcher.HasErrors() {
+		return nil, catcher.Resolve()
+	}
+
+	return out, nil
+}
+
+func resolveCommands(commandInfo model.PluginCommandConf, project *model.Project, blockInfo BlockInfo) ([]model.PluginCommandConf, error) {
 	var parsed []model.PluginCommandConf
 
 	catcher := grip.NewBasicCatcher()
@@ -174,35 +229,11 @@ func (r *commandRegistry) renderCommands(commandInfo model.PluginCommandConf,
 		parsed = append(parsed, commandInfo)
 	}
 
-	var out []Command
-	for _, c := range parsed {
-		factory, ok := r.getCommandFactory(c.Command)
-		if !ok {
-			catcher.Errorf("command '%s' is not registered", c.Command)
-			continue
-		}
-
-		cmd := factory()
-		// Note: this parses the parameters before expansions are applied.
-		// Expansions are only available when the command is executed.
-		if err := cmd.ParseParams(c.Params); err != nil {
-			catcher.Wrapf(err, "parsing parameters for command %s", c.DisplayName)
-			continue
-		}
-		cmd.SetType(c.GetType(project))
-		cmd.SetFullDisplayName(c.DisplayName)
-		cmd.SetIdleTimeout(time.Duration(c.TimeoutSecs) * time.Second)
-		cmd.SetRetryOnFailure(c.RetryOnFailure)
-		cmd.SetFailureMetadataTags(c.FailureMetadataTags)
-
-		out = append(out, cmd)
-	}
-
 	if catcher.HasErrors() {
 		return nil, catcher.Resolve()
 	}
 
-	return out, nil
+	return parsed, nil
 }
 
 // BlockType is the name of the block that a command runs in.
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+	"io"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+)
+
+// cucumberResults parses Cucumber JSON reports and sends the scenario results
+// and logs found in them to the API server.
+type cucumberResults struct {
+	// File describes the relative path of the file to be parsed. Supports
+	// globbing.
+	File string `mapstructure:"file" plugin:"expand"`
+	// Files describes the relative paths of the files to be parsed.
+	// Supports globbing.
+	Files []string `mapstructure:"files" plugin:"expand"`
+
+	base
+}
+
+func cucumberResultsFactory() Command   { return &cucumberResults{} }
+func (c *cucumberResults) Name() string { return "cucumber.parse_files" }
+
+// ParseParams reads and validates the command parameters.
+func (c *cucumberResults) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+
+	if c.File == "" && len(c.Files) == 0 {
+		return errors.New("must specify at least one file")
+	}
+
+	return nil
+}
+
+// Execute parses the Cucumber JSON reports and sends the test results and
+// logs.
+func (c *cucumberResults) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+	if c.File != "" {
+		c.Files = append(c.Files, c.File)
+	}
+
+	return parseAndSendTestResultsFiles(ctx, comm, logger, conf, c.Files, parseCucumberFile)
+}
+
+// parseCucumberFile parses a single Cucumber JSON report.
+func parseCucumberFile(conf *internal.TaskConfig, r io.Reader, _ string) ([]testresult.TestResult, []testlog.TestLog, error) {
+	features, err := parseCucumberResults(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		results []testresult.TestResult
+		logs    []testlog.TestLog
+	)
+	for _, feature := range features {
+		featureResults, featureLogs := feature.toModelTestResultsAndLogs(conf)
+		results = append(results, featureResults...)
+		logs = append(logs, featureLogs...)
+	}
+
+	return results, logs, nil
+}
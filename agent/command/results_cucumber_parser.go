@@ -0,0 +1,162 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// Cucumber step result statuses.
+const (
+	cucumberStatusPassed    = "passed"
+	cucumberStatusFailed    = "failed"
+	cucumberStatusUndefined = "undefined"
+	cucumberStatusAmbiguous = "ambiguous"
+)
+
+// cucumberFeature is a feature in a Cucumber JSON report.
+type cucumberFeature struct {
+	Name     string            `json:"name"`
+	URI      string            `json:"uri"`
+	Elements []cucumberElement `json:"elements"`
+}
+
+// cucumberElement is a scenario or background in a Cucumber JSON report.
+type cucumberElement struct {
+	Name    string         `json:"name"`
+	Keyword string         `json:"keyword"`
+	Type    string         `json:"type"`
+	Line    int            `json:"line"`
+	Steps   []cucumberStep `json:"steps"`
+	Before  []cucumberStep `json:"before"`
+	After   []cucumberStep `json:"after"`
+}
+
+// cucumberStep is a step or hook in a Cucumber JSON report.
+type cucumberStep struct {
+	Keyword string             `json:"keyword"`
+	Name    string             `json:"name"`
+	Line    int                `json:"line"`
+	Result  cucumberStepResult `json:"result"`
+}
+
+// cucumberStepResult is the result of a single step or hook.
+type cucumberStepResult struct {
+	Status string `json:"status"`
+	// Duration is the step duration in nanoseconds.
+	Duration     int64  `json:"duration"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// parseCucumberResults parses a Cucumber JSON report.
+func parseCucumberResults(r io.Reader) ([]cucumberFeature, error) {
+	var features []cucumberFeature
+	if err := json.NewDecoder(r).Decode(&features); err != nil {
+		return nil, errors.Wrap(err, "decoding Cucumber JSON report")
+	}
+
+	return features, nil
+}
+
+// toModelTestResultsAndLogs converts each scenario of the feature into a test
+// result. Backgrounds are not reported as separate tests, their steps are
+// included in the scenario that follows them. Logs are only generated for
+// scenarios that did not succeed.
+func (f cucumberFeature) toModelTestResultsAndLogs(conf *internal.TaskConfig) ([]testresult.TestResult, []testlog.TestLog) {
+	var (
+		results    []testresult.TestResult
+		logs       []testlog.TestLog
+		background *cucumberElement
+	)
+	for i := range f.Elements {
+		element := f.Elements[i]
+		if element.Type == "background" {
+			background = &element
+			continue
+		}
+
+		var steps []cucumberStep
+		steps = append(steps, element.Before...)
+		if background != nil {
+			steps = append(steps, background.Steps...)
+		}
+		steps = append(steps, element.Steps...)
+		steps = append(steps, element.After...)
+
+		res, log := f.toModelTestResultAndLog(conf, element, steps)
+		if log != nil {
+			logs = append(logs, *log)
+		}
+		results = append(results, res)
+	}
+
+	return results, logs
+}
+
+func (f cucumberFeature) toModelTestResultAndLog(conf *internal.TaskConfig, scenario cucumberElement, steps []cucumberStep) (testresult.TestResult, *testlog.TestLog) {
+	name := fmt.Sprintf("%s.%s", f.Name, scenario.Name)
+	res := testresult.TestResult{
+		TestName:        util.CleanForPath(name),
+		DisplayTestName: name,
+		TestStartTime:   time.Now(),
+	}
+
+	var (
+		duration          time.Duration
+		failed, succeeded bool
+		lines             []string
+	)
+	for _, step := range steps {
+		duration += time.Duration(step.Result.Duration)
+
+		switch step.Result.Status {
+		case cucumberStatusFailed, cucumberStatusUndefined, cucumberStatusAmbiguous:
+			failed = true
+		case cucumberStatusPassed:
+			succeeded = true
+		}
+
+		if step.Name != "" || step.Keyword != "" {
+			lines = append(lines, fmt.Sprintf("%s%s (%s, %s)", step.Keyword, step.Name, step.Result.Status, time.Duration(step.Result.Duration)))
+		}
+		if step.Result.ErrorMessage != "" {
+			lines = append(lines, strings.Split(strings.TrimSpace(step.Result.ErrorMessage), "\n")...)
+		}
+	}
+	res.TestEndTime = res.TestStartTime.Add(duration)
+
+	switch {
+	case failed:
+		res.Status = evergreen.TestFailedStatus
+	case succeeded:
+		res.Status = evergreen.TestSucceededStatus
+	default:
+		// Scenarios in which every step was skipped or pending were
+		// not run.
+		res.Status = evergreen.TestSkippedStatus
+	}
+
+	if res.Status != evergreen.TestFailedStatus {
+		return res, nil
+	}
+
+	log := &testlog.TestLog{
+		Name:          utility.RandomString(),
+		Task:          conf.Task.Id,
+		TaskExecution: conf.Task.Execution,
+		Lines:         append([]string{fmt.Sprintf("FAILURE: %s: %s (%s:%d)", scenario.Keyword, scenario.Name, f.URI, scenario.Line)}, lines...),
+	}
+	res.LogInfo = &testresult.TestLogInfo{LogName: log.Name}
+
+	return res, log
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCucumberResults(t *testing.T) {
+	t.Run("ConvertsToTestResults", func(t *testing.T) {
+		f, err := os.Open(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "cucumber", "results.json"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		conf := &internal.TaskConfig{Task: task.Task{Id: "task", Execution: 1}}
+		results, logs, err := parseCucumberFile(conf, f, "results")
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		assert.Equal(t, "Login.Successful login", results[0].DisplayTestName)
+		assert.Equal(t, evergreen.TestSucceededStatus, results[0].Status)
+		// The background steps count towards the scenario duration.
+		assert.Equal(t, 6*time.Millisecond, results[0].TestEndTime.Sub(results[0].TestStartTime))
+		assert.Nil(t, results[0].LogInfo)
+
+		assert.Equal(t, evergreen.TestFailedStatus, results[1].Status)
+		require.NotNil(t, results[1].LogInfo)
+
+		assert.Equal(t, evergreen.TestSkippedStatus, results[2].Status)
+
+		require.Len(t, logs, 1)
+		assert.Equal(t, logs[0].Name, results[1].LogInfo.LogName)
+		assert.Equal(t, "FAILURE: Scenario: Wrong password (features/login.feature:10)", logs[0].Lines[0])
+		assert.Contains(t, logs[0].Lines, "expected error banner")
+		assert.Contains(t, logs[0].Lines, "but found nothing")
+	})
+	t.Run("InvalidJSON", func(t *testing.T) {
+		_, err := parseCucumberResults(strings.NewReader("{"))
+		assert.Error(t, err)
+	})
+}
@@ -105,6 +105,12 @@ func (c *attachResults) Execute(ctx context.Context, comm client.Communicator, l
 		reportFileLoc = GetWorkingDirectory(conf, c.FileLoc)
 	}
 
+	return sendNativeResultsFile(ctx, comm, logger, conf, reportFileLoc)
+}
+
+// sendNativeResultsFile parses the native JSON test results file at the given
+// path and sends the resulting test logs and test results.
+func sendNativeResultsFile(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig, reportFileLoc string) error {
 	reportFile, err := os.Open(reportFileLoc)
 	if err != nil {
 		return errors.Wrapf(err, "opening report file '%s'", reportFileLoc)
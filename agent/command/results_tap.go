@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"io"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+)
+
+// tapResults parses files containing TAP (Test Anything Protocol) output and
+// sends the test results and logs found in them to the API server.
+type tapResults struct {
+	// File describes the relative path of the file to be parsed. Supports
+	// globbing.
+	File string `mapstructure:"file" plugin:"expand"`
+	// Files describes the relative paths of the files to be parsed.
+	// Supports globbing.
+	Files []string `mapstructure:"files" plugin:"expand"`
+
+	base
+}
+
+func tapResultsFactory() Command   { return &tapResults{} }
+func (c *tapResults) Name() string { return "tap.parse_files" }
+
+// ParseParams reads and validates the command parameters.
+func (c *tapResults) ParseParams(params map[string]any) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrap(err, "decoding mapstructure params")
+	}
+
+	if c.File == "" && len(c.Files) == 0 {
+		return errors.New("must specify at least one file")
+	}
+
+	return nil
+}
+
+// Execute parses the TAP files and sends the test results and logs.
+func (c *tapResults) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := util.ExpandValues(c, &conf.Expansions); err != nil {
+		return errors.Wrap(err, "applying expansions")
+	}
+	if c.File != "" {
+		c.Files = append(c.Files, c.File)
+	}
+
+	return parseAndSendTestResultsFiles(ctx, comm, logger, conf, c.Files, parseTAPFile)
+}
+
+// parseTAPFile parses a single file of TAP output.
+func parseTAPFile(conf *internal.TaskConfig, r io.Reader, suiteName string) ([]testresult.TestResult, []testlog.TestLog, error) {
+	testCases, err := parseTAPResults(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		results []testresult.TestResult
+		logs    []testlog.TestLog
+	)
+	for _, tc := range testCases {
+		res, log := tc.toModelTestResultAndLog(conf, suiteName)
+		if log != nil {
+			logs = append(logs, *log)
+		}
+		results = append(results, res)
+	}
+
+	return results, logs, nil
+}
@@ -0,0 +1,251 @@
+package command
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// tapTestLineRegex matches a TAP test point, e.g.
+	//	not ok 3 - connects to the server # TODO not implemented
+	tapTestLineRegex = regexp.MustCompile(`^(not ok|ok)\b(?:\s+(\d+))?(?:\s*-)?\s*([^#]*?)\s*(?:#\s*(\S+)\s*(.*))?$`)
+	// tapPlanRegex matches a TAP plan, e.g. 1..10.
+	tapPlanRegex = regexp.MustCompile(`^\d+\.\.\d+`)
+)
+
+const (
+	tapBailOut         = "Bail out!"
+	tapYAMLBlockStart  = "---"
+	tapYAMLBlockEnd    = "..."
+	tapDirectiveSkip   = "SKIP"
+	tapDirectiveTODO   = "TODO"
+	tapVersionLineHead = "TAP version"
+)
+
+// tapTestCase is a single test point parsed from TAP output.
+type tapTestCase struct {
+	number      int
+	description string
+	ok          bool
+	directive   string
+	reason      string
+	duration    time.Duration
+	message     string
+	lines       []string
+}
+
+// tapDiagnostics are the recognized fields of a TAP YAML diagnostic block.
+type tapDiagnostics struct {
+	Message    string  `yaml:"message"`
+	DurationMS float64 `yaml:"duration_ms"`
+}
+
+// parseTAPResults parses TAP (Test Anything Protocol) output, versions 12
+// through 14, into test cases. Indented output, such as subtest output,
+// precedes the test point it belongs to, while top-level comments and YAML
+// diagnostic blocks follow the test point they belong to.
+func parseTAPResults(r io.Reader) ([]tapTestCase, error) {
+	var (
+		tests   []tapTestCase
+		pending []string
+		yamlBuf []string
+		inYAML  bool
+	)
+	appendToLast := func(line string) {
+		if len(tests) == 0 {
+			pending = append(pending, line)
+			return
+		}
+		tests[len(tests)-1].lines = append(tests[len(tests)-1].lines, line)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		trimmed := strings.TrimSpace(line)
+
+		if inYAML {
+			if trimmed == tapYAMLBlockEnd {
+				inYAML = false
+				if err := applyTAPDiagnostics(&tests[len(tests)-1], yamlBuf); err != nil {
+					return nil, errors.Wrapf(err, "parsing diagnostics of test %d", tests[len(tests)-1].number)
+				}
+				yamlBuf = nil
+				continue
+			}
+			yamlBuf = append(yamlBuf, line)
+			appendToLast(line)
+			continue
+		}
+
+		switch {
+		case trimmed == "":
+			continue
+		case trimmed == tapYAMLBlockStart && len(tests) > 0 && line != trimmed:
+			inYAML = true
+		case strings.HasPrefix(line, tapVersionLineHead), tapPlanRegex.MatchString(line):
+			continue
+		case strings.HasPrefix(line, tapBailOut):
+			tests = append(tests, tapTestCase{
+				number:      len(tests) + 1,
+				description: "bail out",
+				message:     strings.TrimSpace(strings.TrimPrefix(line, tapBailOut)),
+				lines:       append(pending, line),
+			})
+			pending = nil
+		case tapTestLineRegex.MatchString(line):
+			tests = append(tests, newTAPTestCase(tapTestLineRegex.FindStringSubmatch(line), len(tests)+1, pending))
+			pending = nil
+		case strings.HasPrefix(line, "#"):
+			appendToLast(line)
+		default:
+			pending = append(pending, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "reading TAP output")
+	}
+	if len(tests) > 0 && len(pending) > 0 {
+		tests[len(tests)-1].lines = append(tests[len(tests)-1].lines, pending...)
+	}
+
+	return tests, nil
+}
+
+// newTAPTestCase creates a TAP test case from the submatches of a test point
+// line.
+func newTAPTestCase(match []string, defaultNumber int, lines []string) tapTestCase {
+	tc := tapTestCase{
+		number:      defaultNumber,
+		ok:          match[1] == "ok",
+		description: match[3],
+		directive:   strings.ToUpper(match[4]),
+		reason:      match[5],
+		lines:       append(lines, match[0]),
+	}
+	if num, err := strconv.Atoi(match[2]); err == nil {
+		tc.number = num
+	}
+	// Directives may be abbreviated or have trailing characters, e.g.
+	// "# skipped".
+	switch {
+	case strings.HasPrefix(tc.directive, tapDirectiveSkip):
+		tc.directive = tapDirectiveSkip
+	case strings.HasPrefix(tc.directive, tapDirectiveTODO):
+		tc.directive = tapDirectiveTODO
+	default:
+		// An unrecognized directive is part of the description.
+		if tc.directive != "" {
+			tc.description = strings.TrimSpace(fmt.Sprintf("%s # %s %s", tc.description, match[4], tc.reason))
+		}
+		tc.directive = ""
+		tc.reason = ""
+	}
+
+	return tc
+}
+
+// applyTAPDiagnostics sets the recognized fields from a YAML diagnostic block
+// on the test case.
+func applyTAPDiagnostics(tc *tapTestCase, lines []string) error {
+	var diag tapDiagnostics
+	if err := yaml.Unmarshal([]byte(dedent(lines)), &diag); err != nil {
+		return err
+	}
+
+	tc.message = diag.Message
+	tc.duration = time.Duration(diag.DurationMS * float64(time.Millisecond))
+
+	return nil
+}
+
+// dedent removes the leading whitespace common to all non-empty lines.
+func dedent(lines []string) string {
+	indent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		n := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent < 0 || n < indent {
+			indent = n
+		}
+	}
+
+	var out strings.Builder
+	for _, line := range lines {
+		if len(line) >= indent && indent > 0 {
+			line = line[indent:]
+		}
+		out.WriteString(line)
+		out.WriteString("\n")
+	}
+
+	return out.String()
+}
+
+// toModelTestResultAndLog converts a TAP test case into a test result and
+// test log. Logs are only generated for test cases that did not succeed or
+// that have output.
+func (tc tapTestCase) toModelTestResultAndLog(conf *internal.TaskConfig, suiteName string) (testresult.TestResult, *testlog.TestLog) {
+	name := tc.description
+	if name == "" {
+		name = fmt.Sprintf("test %d", tc.number)
+	}
+
+	res := testresult.TestResult{
+		TestName:        util.CleanForPath(fmt.Sprintf("%s.%s", suiteName, name)),
+		DisplayTestName: name,
+		TestStartTime:   time.Now(),
+	}
+	res.TestEndTime = res.TestStartTime.Add(tc.duration)
+
+	switch {
+	case tc.directive == tapDirectiveSkip:
+		res.Status = evergreen.TestSkippedStatus
+	case tc.directive == tapDirectiveTODO && !tc.ok:
+		// Failing TODO tests are expected to fail and do not count as
+		// failures.
+		res.Status = evergreen.TestSkippedStatus
+	case tc.ok:
+		res.Status = evergreen.TestSucceededStatus
+	default:
+		res.Status = evergreen.TestFailedStatus
+	}
+
+	if res.Status != evergreen.TestFailedStatus && len(tc.lines) <= 1 {
+		return res, nil
+	}
+
+	log := &testlog.TestLog{
+		Name:          utility.RandomString(),
+		Task:          conf.Task.Id,
+		TaskExecution: conf.Task.Execution,
+	}
+	if res.Status == evergreen.TestFailedStatus {
+		message := tc.message
+		if message == "" {
+			message = name
+		}
+		log.Lines = append(log.Lines, fmt.Sprintf("FAILURE: %s", message))
+	}
+	log.Lines = append(log.Lines, tc.lines...)
+	res.LogInfo = &testresult.TestLogInfo{LogName: log.Name}
+
+	return res, log
+}
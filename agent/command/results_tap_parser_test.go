@@ -0,0 +1,92 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTAPResults(t *testing.T) {
+	t.Run("File", func(t *testing.T) {
+		f, err := os.Open(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "tap", "results.tap"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		tests, err := parseTAPResults(f)
+		require.NoError(t, err)
+		require.Len(t, tests, 6)
+
+		assert.Equal(t, "parses the config file", tests[0].description)
+		assert.True(t, tests[0].ok)
+
+		assert.Equal(t, "connects to the server", tests[1].description)
+		assert.False(t, tests[1].ok)
+		assert.Equal(t, "connection refused", tests[1].message)
+		assert.Equal(t, 1250500*time.Microsecond, tests[1].duration)
+		assert.Contains(t, tests[1].lines, "# at t/server.t line 12.")
+
+		assert.Equal(t, tapDirectiveSkip, tests[2].directive)
+		assert.Equal(t, "no network", tests[2].reason)
+
+		assert.Equal(t, tapDirectiveTODO, tests[3].directive)
+		assert.False(t, tests[3].ok)
+
+		assert.Equal(t, "nested suite", tests[4].description)
+		assert.Equal(t, 30*time.Millisecond, tests[4].duration)
+		assert.Contains(t, tests[4].lines, "    ok 1 - first")
+
+		assert.Equal(t, 6, tests[5].number)
+		assert.Empty(t, tests[5].description)
+	})
+	t.Run("BailOut", func(t *testing.T) {
+		tests, err := parseTAPResults(strings.NewReader("1..2\nok 1 - first\nBail out! database is down\n"))
+		require.NoError(t, err)
+		require.Len(t, tests, 2)
+		assert.False(t, tests[1].ok)
+		assert.Equal(t, "database is down", tests[1].message)
+	})
+	t.Run("ConvertsToTestResults", func(t *testing.T) {
+		f, err := os.Open(filepath.Join(testutil.GetDirectoryOfFile(), "testdata", "tap", "results.tap"))
+		require.NoError(t, err)
+		defer f.Close()
+
+		conf := &internal.TaskConfig{Task: task.Task{Id: "task", Execution: 1}}
+		results, logs, err := parseTAPFile(conf, f, "results")
+		require.NoError(t, err)
+		require.Len(t, results, 6)
+
+		expectedStatuses := []string{
+			evergreen.TestSucceededStatus,
+			evergreen.TestFailedStatus,
+			evergreen.TestSkippedStatus,
+			evergreen.TestSkippedStatus,
+			evergreen.TestSucceededStatus,
+			evergreen.TestSucceededStatus,
+		}
+		for i, res := range results {
+			assert.Equal(t, expectedStatuses[i], res.Status, res.DisplayTestName)
+		}
+		assert.Equal(t, "test 6", results[5].DisplayTestName)
+		assert.Equal(t, 1250500*time.Microsecond, results[1].TestEndTime.Sub(results[1].TestStartTime))
+
+		// Only the failing test and the test with subtest output have
+		// logs.
+		require.Len(t, logs, 2)
+		require.NotNil(t, results[1].LogInfo)
+		assert.Equal(t, logs[0].Name, results[1].LogInfo.LogName)
+		assert.Equal(t, "FAILURE: connection refused", logs[0].Lines[0])
+		assert.Equal(t, "task", logs[0].Task)
+		assert.Equal(t, 1, logs[0].TaskExecution)
+		require.NotNil(t, results[4].LogInfo)
+		assert.Equal(t, logs[1].Name, results[4].LogInfo.LogName)
+	})
+}
@@ -2,6 +2,10 @@ package command
 
 import (
 	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/agent/internal"
@@ -14,6 +18,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/timber/testresults"
 	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
 )
 
@@ -155,3 +160,102 @@ func makeCedarTestResults(id string, t *task.Task, results []testresult.TestResu
 
 	return rs, failed
 }
+
+// testResultsFileParser parses a single test results file into test results
+// and the logs of the tests that have log output.
+type testResultsFileParser func(conf *internal.TaskConfig, r io.Reader, suiteName string) ([]testresult.TestResult, []testlog.TestLog, error)
+
+// parseAndSendTestResultsFiles parses each file matching the given file
+// patterns, which are relative to the task's working directory, and sends the
+// resulting test logs and test results. As with attach.xunit_results, paths
+// that do not exist or are directories are skipped, but it is an error if
+// none of the matched paths are files. Files that contain no test results are
+// not an error.
+func parseAndSendTestResultsFiles(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig, files []string, parse testResultsFileParser) error {
+	filePaths, err := getFilePaths(conf.WorkDir, files)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var (
+		allResults []testresult.TestResult
+		allLogs    []testlog.TestLog
+		numInvalid int
+	)
+	for _, filePath := range filePaths {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrapf(err, "canceled while parsing results file '%s'", filePath)
+		}
+
+		stat, err := os.Stat(filePath)
+		if os.IsNotExist(err) {
+			numInvalid++
+			logger.Task().Infof("Result file '%s' does not exist.", filePath)
+			continue
+		}
+		if stat.IsDir() {
+			numInvalid++
+			logger.Task().Infof("Result file '%s' is a directory, not a file.", filePath)
+			continue
+		}
+
+		f, err := os.Open(filePath)
+		if err != nil {
+			return errors.Wrapf(err, "opening results file '%s'", filePath)
+		}
+		suiteName := strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+		results, logs, err := parse(conf, f, suiteName)
+		if err != nil {
+			catcher := grip.NewBasicCatcher()
+			catcher.Wrapf(err, "parsing results file '%s'", filePath)
+			catcher.Wrapf(f.Close(), "closing results file '%s'", filePath)
+			return catcher.Resolve()
+		}
+		if err = f.Close(); err != nil {
+			return errors.Wrapf(err, "closing results file '%s'", filePath)
+		}
+
+		allResults = append(allResults, results...)
+		allLogs = append(allLogs, logs...)
+	}
+	if len(filePaths) == numInvalid {
+		return errors.New("all given file paths do not exist or are directories")
+	}
+
+	if len(allResults) == 0 {
+		logger.Task().Info("No test results found in the given files.")
+		return nil
+	}
+
+	return sendTestLogsAndResults(ctx, comm, logger, conf, allLogs, allResults)
+}
+
+// SendTestResultsFiles parses the given test results files, which must all be
+// of the given format, and sends the resulting test logs and test results. It
+// is used to ingest the files written to the task output directory, so each
+// format is parsed the same way as by its corresponding command.
+func SendTestResultsFiles(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig, format taskoutput.TestResultsFormat, files []string) error {
+	switch format {
+	case taskoutput.TestResultsFormatXUnit:
+		c := &xunitResults{Files: files}
+		return c.parseAndUploadResults(ctx, conf, logger, comm)
+	case taskoutput.TestResultsFormatGoTest:
+		logs, results, err := parseTestOutputFiles(ctx, logger, conf, files)
+		if err != nil {
+			return errors.Wrap(err, "parsing go test output files")
+		}
+		return sendTestLogsAndResults(ctx, comm, logger, conf, logs, results)
+	case taskoutput.TestResultsFormatEvergreen:
+		catcher := grip.NewBasicCatcher()
+		for _, file := range files {
+			catcher.Add(sendNativeResultsFile(ctx, comm, logger, conf, file))
+		}
+		return catcher.Resolve()
+	case taskoutput.TestResultsFormatTAP:
+		return parseAndSendTestResultsFiles(ctx, comm, logger, conf, files, parseTAPFile)
+	case taskoutput.TestResultsFormatCucumber:
+		return parseAndSendTestResultsFiles(ctx, comm, logger, conf, files, parseCucumberFile)
+	default:
+		return errors.Errorf("unrecognized test results format '%s'", format)
+	}
+}
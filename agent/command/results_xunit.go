@@ -117,7 +117,7 @@ func getFilePaths(workDir string, files []string) ([]string, error) {
 	}
 	// Only error for no files if the user provided files.
 	if len(out) == 0 && len(files) > 0 {
-		return nil, errors.New("Files parameter was provided but no XML files matched")
+		return nil, errors.New("Files parameter was provided but no files matched")
 	}
 
 	return out, nil
@@ -18,6 +18,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/artifact"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	patchmodel "github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testlog"
 	"github.com/evergreen-ci/evergreen/model/testresult"
@@ -613,6 +614,22 @@ func (c *baseCommunicator) SetResultsInfo(ctx context.Context, taskData TaskData
 	return nil
 }
 
+// SendResourceUsage sends the resource usage sampled while the task ran.
+func (c *baseCommunicator) SendResourceUsage(ctx context.Context, taskData TaskData, usage *resourceusage.TaskResourceUsage) error {
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &taskData,
+	}
+	info.setTaskPathSuffix("resource_usage")
+	resp, err := c.retryRequest(ctx, info, usage)
+	if err != nil {
+		return util.RespError(resp, errors.Wrap(err, "sending resource usage").Error())
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
 func (c *baseCommunicator) NewPush(ctx context.Context, taskData TaskData, req *apimodels.S3CopyRequest) (*model.PushLog, error) {
 	newPushLog := model.PushLog{}
 	info := requestInfo{
@@ -726,6 +743,27 @@ func (c *baseCommunicator) KeyValInc(ctx context.Context, taskData TaskData, kv
 	return nil
 }
 
+// ProjectKeyVal gets, sets, swaps, locks or unlocks a key shared by the tasks
+// in the task's project.
+func (c *baseCommunicator) ProjectKeyVal(ctx context.Context, td TaskData, op string, req apimodels.KeyValRequest) (*apimodels.KeyValResponse, error) {
+	info := requestInfo{
+		method:   http.MethodPost,
+		taskData: &td,
+	}
+	info.setTaskPathSuffix("keyval/" + op)
+	resp, err := c.retryRequest(ctx, info, req)
+	if err != nil {
+		return nil, util.RespError(resp, errors.Wrapf(err, "running key-value operation '%s' on key '%s'", op, req.Key).Error())
+	}
+
+	kvResp := &apimodels.KeyValResponse{}
+	if err = utility.ReadJSON(resp.Body, kvResp); err != nil {
+		return nil, errors.Wrap(err, "reading key-value reply from response")
+	}
+
+	return kvResp, nil
+}
+
 // GenerateTasks posts new tasks for the `generate.tasks` command.
 func (c *baseCommunicator) GenerateTasks(ctx context.Context, td TaskData, jsonBytes []json.RawMessage) error {
 	info := requestInfo{
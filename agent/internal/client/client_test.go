@@ -10,9 +10,13 @@ import (
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/agent/internal/redactor"
 	agentutil "github.com/evergreen-ci/evergreen/agent/util"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/taskoutput"
 	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/grip/send"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -145,3 +149,38 @@ func TestLoggerClose(t *testing.T) {
 		assert.NoError(t, logger.Close())
 	})
 }
+
+func TestLocalCommunicator(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	comm := NewLocalCommunicator(send.MakePlainLogger())
+	c, ok := comm.(*localCommunicator)
+	require.True(t, ok)
+	td := TaskData{ID: "local_task"}
+
+	t.Run("KeepsSentDataInMemory", func(t *testing.T) {
+		require.NoError(t, comm.AttachFiles(ctx, td, []*artifact.File{{Name: "file"}}))
+		require.Len(t, c.attachedFiles, 1)
+		assert.Equal(t, "file", c.attachedFiles[0].Name)
+
+		kv := &model.KeyVal{Key: "key"}
+		require.NoError(t, comm.KeyValInc(ctx, td, kv))
+		assert.EqualValues(t, 1, kv.Value)
+		kv = &model.KeyVal{Key: "key"}
+		require.NoError(t, comm.KeyValInc(ctx, td, kv))
+		assert.EqualValues(t, 2, kv.Value)
+	})
+	t.Run("HeartbeatSucceeds", func(t *testing.T) {
+		status, err := comm.Heartbeat(ctx, td)
+		require.NoError(t, err)
+		assert.Empty(t, status)
+	})
+	t.Run("AppServerOperationsAreNotSupported", func(t *testing.T) {
+		_, err := comm.GetTaskVersion(ctx, td)
+		assert.ErrorContains(t, err, "not supported when running a task locally")
+		_, err = comm.AssumeRole(ctx, td, apimodels.AssumeRoleRequest{RoleARN: "role"})
+		assert.ErrorContains(t, err, "not supported when running a task locally")
+		assert.ErrorContains(t, comm.GenerateTasks(ctx, td, nil), "not supported when running a task locally")
+	})
+}
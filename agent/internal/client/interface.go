@@ -12,6 +12,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/artifact"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	patchmodel "github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testlog"
 	"github.com/evergreen-ci/evergreen/model/testresult"
@@ -80,6 +81,8 @@ type SharedCommunicator interface {
 	GetCedarGRPCConn(context.Context) (*grpc.ClientConn, error)
 	// SetResultsInfo sets the test results information in the task.
 	SetResultsInfo(context.Context, TaskData, string, bool) error
+	// SendResourceUsage sends the resource usage sampled while the task ran.
+	SendResourceUsage(context.Context, TaskData, *resourceusage.TaskResourceUsage) error
 
 	// DisableHost signals to the app server that the host should be disabled.
 	DisableHost(ctx context.Context, hostID string, info apimodels.DisableInfo) error
@@ -99,6 +102,9 @@ type SharedCommunicator interface {
 	AttachFiles(context.Context, TaskData, []*artifact.File) error
 	GetManifest(context.Context, TaskData) (*manifest.Manifest, error)
 	KeyValInc(context.Context, TaskData, *model.KeyVal) error
+	// ProjectKeyVal gets, sets, swaps, locks or unlocks a key shared by the
+	// tasks in the task's project.
+	ProjectKeyVal(context.Context, TaskData, string, apimodels.KeyValRequest) (*apimodels.KeyValResponse, error)
 
 	// GenerateTasks posts new tasks for the `generate.tasks` command.
 	GenerateTasks(context.Context, TaskData, []json.RawMessage) error
@@ -0,0 +1,260 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal/redactor"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/manifest"
+	patchmodel "github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testlog"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/google/go-github/v70/github"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// localCommunicator implements Communicator for running a task's commands
+// locally without an app server. Data that commands send to the app server,
+// such as attached files and test results, is kept in memory and discarded.
+// Operations that need the app server return an error.
+type localCommunicator struct {
+	sender send.Sender
+
+	mu            sync.Mutex
+	lastMessageAt time.Time
+	attachedFiles []*artifact.File
+	testResults   []testresult.TestResult
+	keyVals       map[string]int64
+}
+
+// NewLocalCommunicator returns a Communicator that does not make any requests
+// to an app server and writes all task logs to the given sender.
+func NewLocalCommunicator(sender send.Sender) Communicator {
+	return &localCommunicator{
+		sender:  sender,
+		keyVals: map[string]int64{},
+	}
+}
+
+// errNotSupportedLocally returns the error for an operation that requires an
+// app server.
+func errNotSupportedLocally(op string) error {
+	return errors.Errorf("%s is not supported when running a task locally", op)
+}
+
+func (c *localCommunicator) Close() {}
+
+func (c *localCommunicator) UpdateLastMessageTime() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastMessageAt = time.Now()
+}
+
+func (c *localCommunicator) LastMessageAt() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lastMessageAt
+}
+
+func (c *localCommunicator) GetLoggerProducer(ctx context.Context, tsk *task.Task, config *LoggerConfig) (LoggerProducer, error) {
+	sender := c.sender
+	if config != nil {
+		sender = redactor.NewRedactingSender(sender, config.RedactorOpts)
+	}
+	return NewSingleChannelLogHarness(tsk.Id, sender), nil
+}
+
+// Heartbeat always succeeds since there is no app server to abort the task.
+func (c *localCommunicator) Heartbeat(context.Context, TaskData) (string, error) {
+	return "", nil
+}
+
+func (c *localCommunicator) AttachFiles(ctx context.Context, td TaskData, files []*artifact.File) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.attachedFiles = append(c.attachedFiles, files...)
+	return nil
+}
+
+func (c *localCommunicator) SendTestResults(ctx context.Context, td TaskData, results []testresult.TestResult) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.testResults = append(c.testResults, results...)
+	return nil
+}
+
+func (c *localCommunicator) SendTestLog(context.Context, TaskData, *testlog.TestLog) (string, error) {
+	return "", nil
+}
+
+func (c *localCommunicator) SetResultsInfo(context.Context, TaskData, string, bool) error {
+	return nil
+}
+
+func (c *localCommunicator) SendResourceUsage(context.Context, TaskData, *resourceusage.TaskResourceUsage) error {
+	return nil
+}
+
+func (c *localCommunicator) SetDownstreamParams(context.Context, []patchmodel.Parameter, TaskData) error {
+	return nil
+}
+
+// KeyValInc increments the key in memory, so the values only last for the
+// local run.
+func (c *localCommunicator) KeyValInc(ctx context.Context, td TaskData, kv *model.KeyVal) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keyVals[kv.Key]++
+	kv.Value = c.keyVals[kv.Key]
+	return nil
+}
+
+func (c *localCommunicator) EndTask(context.Context, *apimodels.TaskEndDetail, TaskData) (*apimodels.EndTaskResponse, error) {
+	return nil, errNotSupportedLocally("ending the task")
+}
+
+func (c *localCommunicator) GetNextTask(context.Context, *apimodels.GetNextTaskDetails) (*apimodels.NextTaskResponse, error) {
+	return nil, errNotSupportedLocally("getting the next task")
+}
+
+func (c *localCommunicator) GetAgentSetupData(context.Context) (*apimodels.AgentSetupData, error) {
+	return nil, errNotSupportedLocally("getting agent setup data")
+}
+
+func (c *localCommunicator) StartTask(context.Context, TaskData) error {
+	return errNotSupportedLocally("starting the task")
+}
+
+func (c *localCommunicator) GetTask(context.Context, TaskData) (*task.Task, error) {
+	return nil, errNotSupportedLocally("getting the task")
+}
+
+func (c *localCommunicator) GetDisplayTaskInfoFromExecution(context.Context, TaskData) (*apimodels.DisplayTaskInfo, error) {
+	return nil, errNotSupportedLocally("getting display task info")
+}
+
+func (c *localCommunicator) GetProjectRef(context.Context, TaskData) (*model.ProjectRef, error) {
+	return nil, errNotSupportedLocally("getting the project ref")
+}
+
+func (c *localCommunicator) GetDistroView(context.Context, TaskData) (*apimodels.DistroView, error) {
+	return nil, errNotSupportedLocally("getting the distro")
+}
+
+func (c *localCommunicator) GetHostView(context.Context, TaskData) (*apimodels.HostView, error) {
+	return nil, errNotSupportedLocally("getting the host")
+}
+
+func (c *localCommunicator) GetDistroAMI(context.Context, string, string, TaskData) (string, error) {
+	return "", errNotSupportedLocally("getting the distro AMI")
+}
+
+func (c *localCommunicator) GetProject(context.Context, TaskData) (*model.Project, error) {
+	return nil, errNotSupportedLocally("getting the project")
+}
+
+func (c *localCommunicator) GetExpansionsAndVars(context.Context, TaskData) (*apimodels.ExpansionsAndVars, error) {
+	return nil, errNotSupportedLocally("getting expansions and project variables")
+}
+
+func (c *localCommunicator) GetCedarConfig(context.Context) (*apimodels.CedarConfig, error) {
+	return nil, errNotSupportedLocally("getting the Cedar config")
+}
+
+func (c *localCommunicator) GetCedarGRPCConn(context.Context) (*grpc.ClientConn, error) {
+	return nil, errNotSupportedLocally("connecting to Cedar")
+}
+
+func (c *localCommunicator) DisableHost(context.Context, string, apimodels.DisableInfo) error {
+	return errNotSupportedLocally("disabling the host")
+}
+
+func (c *localCommunicator) GetTaskPatch(context.Context, TaskData, string) (*patchmodel.Patch, error) {
+	return nil, errNotSupportedLocally("getting the task's patch")
+}
+
+func (c *localCommunicator) GetTaskVersion(context.Context, TaskData) (*model.Version, error) {
+	return nil, errNotSupportedLocally("getting the task's version")
+}
+
+func (c *localCommunicator) GetPatchFile(context.Context, TaskData, string) (string, error) {
+	return "", errNotSupportedLocally("getting patch files")
+}
+
+func (c *localCommunicator) NewPush(context.Context, TaskData, *apimodels.S3CopyRequest) (*model.PushLog, error) {
+	return nil, errNotSupportedLocally("pushing files")
+}
+
+func (c *localCommunicator) UpdatePushStatus(context.Context, TaskData, *model.PushLog) error {
+	return errNotSupportedLocally("updating push status")
+}
+
+func (c *localCommunicator) GetManifest(context.Context, TaskData) (*manifest.Manifest, error) {
+	return nil, errNotSupportedLocally("getting the manifest")
+}
+
+func (c *localCommunicator) ProjectKeyVal(context.Context, TaskData, string, apimodels.KeyValRequest) (*apimodels.KeyValResponse, error) {
+	return nil, errNotSupportedLocally("using project keys")
+}
+
+func (c *localCommunicator) GenerateTasks(context.Context, TaskData, []json.RawMessage) error {
+	return errNotSupportedLocally("generating tasks")
+}
+
+func (c *localCommunicator) GenerateTasksPoll(context.Context, TaskData) (*apimodels.GeneratePollResponse, error) {
+	return nil, errNotSupportedLocally("polling generated tasks")
+}
+
+func (c *localCommunicator) CreateHost(context.Context, TaskData, apimodels.CreateHost) ([]string, error) {
+	return nil, errNotSupportedLocally("creating hosts")
+}
+
+func (c *localCommunicator) ListHosts(context.Context, TaskData) (restmodel.HostListResults, error) {
+	return restmodel.HostListResults{}, errNotSupportedLocally("listing hosts")
+}
+
+func (c *localCommunicator) ConcludeMerge(context.Context, string, string, TaskData) error {
+	return errNotSupportedLocally("concluding merges")
+}
+
+func (c *localCommunicator) GetAdditionalPatches(context.Context, string, TaskData) ([]string, error) {
+	return nil, errNotSupportedLocally("getting additional patches")
+}
+
+func (c *localCommunicator) CreateInstallationTokenForClone(context.Context, TaskData, string, string) (string, error) {
+	return "", errNotSupportedLocally("creating GitHub installation tokens")
+}
+
+func (c *localCommunicator) CreateGitHubDynamicAccessToken(context.Context, TaskData, string, string, *github.InstallationPermissions) (string, *github.InstallationPermissions, error) {
+	return "", nil, errNotSupportedLocally("creating GitHub access tokens")
+}
+
+func (c *localCommunicator) RevokeGitHubDynamicAccessToken(context.Context, TaskData, string) error {
+	return errNotSupportedLocally("revoking GitHub access tokens")
+}
+
+func (c *localCommunicator) MarkFailedTaskToRestart(context.Context, TaskData) error {
+	return errNotSupportedLocally("restarting the task")
+}
+
+func (c *localCommunicator) UpsertCheckRun(context.Context, TaskData, apimodels.CheckRunOutput) error {
+	return errNotSupportedLocally("upserting check runs")
+}
+
+func (c *localCommunicator) AssumeRole(context.Context, TaskData, apimodels.AssumeRoleRequest) (*apimodels.AWSCredentials, error) {
+	return nil, errNotSupportedLocally("assuming AWS roles")
+}
+
+func (c *localCommunicator) S3Credentials(context.Context, TaskData, string) (*apimodels.AWSCredentials, error) {
+	return nil, errNotSupportedLocally("getting S3 credentials")
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/log"
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	patchModel "github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testlog"
 	"github.com/evergreen-ci/evergreen/model/testresult"
@@ -80,12 +81,15 @@ type Mock struct {
 	LocalTestResults []testresult.TestResult
 	ResultsService   string
 	ResultsFailed    bool
+	ResourceUsage    *resourceusage.TaskResourceUsage
 	TestLogs         []*testlog.TestLog
 	TestLogCount     int
 
 	taskLogs   map[string][]log.LogLine
 	PatchFiles map[string]string
 	keyVal     map[string]*serviceModel.KeyVal
+	// ProjectKeyVals are the keys shared by tasks in the project.
+	ProjectKeyVals map[string]string
 
 	// Mock data returned from methods
 	LastMessageSent  time.Time
@@ -102,14 +106,15 @@ type EndTaskResult struct {
 // NewMock returns a Communicator for testing.
 func NewMock(serverURL string) *Mock {
 	return &Mock{
-		maxAttempts:   defaultMaxAttempts,
-		timeoutStart:  defaultTimeoutStart,
-		timeoutMax:    defaultTimeoutMax,
-		taskLogs:      make(map[string][]log.LogLine),
-		PatchFiles:    make(map[string]string),
-		keyVal:        make(map[string]*serviceModel.KeyVal),
-		AttachedFiles: make(map[string][]*artifact.File),
-		serverURL:     serverURL,
+		maxAttempts:    defaultMaxAttempts,
+		timeoutStart:   defaultTimeoutStart,
+		timeoutMax:     defaultTimeoutMax,
+		taskLogs:       make(map[string][]log.LogLine),
+		PatchFiles:     make(map[string]string),
+		keyVal:         make(map[string]*serviceModel.KeyVal),
+		ProjectKeyVals: make(map[string]string),
+		AttachedFiles:  make(map[string][]*artifact.File),
+		serverURL:      serverURL,
 	}
 }
 
@@ -446,6 +451,15 @@ func (c *Mock) SetResultsInfo(ctx context.Context, _ TaskData, service string, f
 	return nil
 }
 
+// SendResourceUsage stores the resource usage in the mock.
+func (c *Mock) SendResourceUsage(ctx context.Context, _ TaskData, usage *resourceusage.TaskResourceUsage) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ResourceUsage = usage
+	return nil
+}
+
 // DisableHost signals to the app server that the host should be disabled.
 func (c *Mock) DisableHost(ctx context.Context, hostID string, info apimodels.DisableInfo) error {
 	return nil
@@ -504,6 +518,42 @@ func (c *Mock) KeyValInc(ctx context.Context, td TaskData, kv *serviceModel.KeyV
 	return nil
 }
 
+// ProjectKeyVal runs the key-value operation against the mock's keys. Keys
+// never expire.
+func (c *Mock) ProjectKeyVal(ctx context.Context, td TaskData, op string, req apimodels.KeyValRequest) (*apimodels.KeyValResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	resp := &apimodels.KeyValResponse{}
+	current, ok := c.ProjectKeyVals[req.Key]
+	switch op {
+	case apimodels.KeyValGet:
+	case apimodels.KeyValSet:
+		c.ProjectKeyVals[req.Key] = req.Value
+		resp.Succeeded = true
+	case apimodels.KeyValCAS:
+		if (req.Expected == "" && !ok) || (ok && req.Expected != "" && current == req.Expected) {
+			c.ProjectKeyVals[req.Key] = req.Value
+			resp.Succeeded = true
+		}
+	case apimodels.KeyValLock:
+		if !ok || current == td.ID {
+			c.ProjectKeyVals[req.Key] = td.ID
+			resp.Succeeded = true
+		}
+	case apimodels.KeyValUnlock:
+		if ok && current == td.ID {
+			delete(c.ProjectKeyVals, req.Key)
+			resp.Succeeded = true
+		}
+	default:
+		return nil, errors.Errorf("unrecognized key-value operation '%s'", op)
+	}
+
+	resp.Value, resp.Found = c.ProjectKeyVals[req.Key]
+	return resp, nil
+}
+
 // GenerateTasks posts new tasks for the `generate.tasks` command.
 func (c *Mock) GenerateTasks(ctx context.Context, td TaskData, jsonBytes []json.RawMessage) error {
 	if td.ID != "mock_id" {
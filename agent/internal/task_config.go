@@ -60,6 +60,7 @@ type TaskConfig struct {
 	Timeout            Timeout
 	TaskOutput         evergreen.S3Credentials
 	ModulePaths        map[string]string
+	CacheResults       []apimodels.CacheResult
 	CedarTestResultsID string
 	TaskGroup          *model.TaskGroup
 	CommandCleanups    []CommandCleanup
@@ -106,6 +107,14 @@ func (t *TaskConfig) GetAndClearCommandCleanups() []CommandCleanup {
 	return cleanups
 }
 
+// AddCacheResult records the result of restoring a cache.
+func (t *TaskConfig) AddCacheResult(result apimodels.CacheResult) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.CacheResults = append(t.CacheResults, result)
+}
+
 // Timeout records dynamic timeout information that has been explicitly set by
 // the user during task runtime.
 type Timeout struct {
@@ -0,0 +1,287 @@
+package taskoutput
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	agentutil "github.com/evergreen-ci/evergreen/agent/util"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/evergreen-ci/pail"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"gopkg.in/yaml.v2"
+)
+
+// artifactsDirectoryHandler implements automatic task output handling for the
+// reserved artifacts directory. Files written to the directory are uploaded to
+// the S3 bucket named in the artifacts spec file and attached to the task.
+type artifactsDirectoryHandler struct {
+	dir          string
+	logger       client.LoggerProducer
+	comm         client.Communicator
+	taskData     client.TaskData
+	taskOpts     taskoutput.TaskOptions
+	spec         artifactsSpec
+	createBucket func(ctx context.Context, contentType string) (pail.Bucket, error)
+}
+
+// newArtifactsDirectoryHandler returns a new artifacts directory handler for
+// the specified task.
+func newArtifactsDirectoryHandler(dir string, logger client.LoggerProducer, handlerOpts directoryHandlerOpts) directoryHandler {
+	h := &artifactsDirectoryHandler{
+		dir:      dir,
+		logger:   logger,
+		comm:     handlerOpts.comm,
+		taskData: handlerOpts.taskData,
+		taskOpts: handlerOpts.taskOpts,
+	}
+	h.createBucket = h.createS3Bucket
+
+	return h
+}
+
+func (h *artifactsDirectoryHandler) run(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "artifacts-ingestion")
+	defer span.End()
+
+	files, err := h.getArtifactFiles(ctx)
+	if err != nil {
+		return errors.Wrap(err, "finding artifact files")
+	}
+	span.SetAttributes(attribute.KeyValue{Key: "artifact_file_count", Value: attribute.IntValue(len(files))})
+	if len(files) == 0 {
+		return nil
+	}
+
+	if err = h.getSpecFile(); err != nil {
+		return errors.Wrap(err, "getting artifacts spec")
+	}
+	if h.comm == nil {
+		return errors.New("artifact files were found but the task output directory cannot attach artifacts")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	buckets := map[string]pail.Bucket{}
+	var attached []*artifact.File
+	for _, relPath := range files {
+		if err := ctx.Err(); err != nil {
+			catcher.Wrap(err, "canceled while uploading artifacts")
+			break
+		}
+
+		fileSpec := h.spec.getFileSpec(relPath)
+		bucket, ok := buckets[fileSpec.ContentType]
+		if !ok {
+			bucket, err = h.createBucket(ctx, fileSpec.ContentType)
+			if err != nil {
+				catcher.Wrapf(err, "creating bucket for artifact '%s'", relPath)
+				continue
+			}
+			buckets[fileSpec.ContentType] = bucket
+		}
+
+		key := h.spec.remoteKey(h.taskOpts, relPath)
+		h.logger.Task().Infof("Uploading artifact '%s' to '%s'.", relPath, agentutil.S3DefaultURL(h.spec.Bucket, key))
+		if err = bucket.Upload(ctx, key, filepath.Join(h.dir, filepath.FromSlash(relPath))); err != nil {
+			catcher.Wrapf(err, "uploading artifact '%s'", relPath)
+			continue
+		}
+
+		file := &artifact.File{
+			Name:        fileSpec.DisplayName,
+			Link:        agentutil.S3DefaultURL(h.spec.Bucket, key),
+			Visibility:  fileSpec.Visibility,
+			AWSRoleARN:  h.spec.RoleARN,
+			ContentType: fileSpec.ContentType,
+		}
+		if file.Visibility == artifact.Signed {
+			file.Bucket = h.spec.Bucket
+			file.FileKey = key
+		}
+		attached = append(attached, file)
+	}
+
+	if len(attached) > 0 {
+		if err := h.comm.AttachFiles(ctx, h.taskData, attached); err != nil {
+			catcher.Wrap(err, "attaching artifacts")
+		} else {
+			h.logger.Task().Infof("Attached %d artifact(s) from the task output directory.", len(attached))
+		}
+	}
+
+	return catcher.Resolve()
+}
+
+// getArtifactFiles returns the paths of the files in the artifacts directory,
+// relative to the directory and with slash ('/') separators, sorted by path.
+// The spec file is not an artifact.
+func (h *artifactsDirectoryHandler) getArtifactFiles(ctx context.Context) ([]string, error) {
+	var files []string
+	ignore := filepath.Join(h.dir, artifactsSpecFilename)
+	err := filepath.WalkDir(h.dir, func(path string, info fs.DirEntry, err error) error {
+		if err != nil {
+			h.logger.Execution().Warning(errors.Wrap(err, "walking artifacts directory"))
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if info.IsDir() || path == ignore {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(h.dir, path)
+		if err != nil {
+			h.logger.Task().Error(errors.Wrapf(err, "getting relative path for artifact file '%s'", path))
+			return nil
+		}
+		files = append(files, filepath.ToSlash(relPath))
+
+		return nil
+	})
+	sort.Strings(files)
+
+	return files, err
+}
+
+// getSpecFile reads and validates the artifacts specification file in the top
+// level of the reserved artifacts directory. Unlike other task output
+// directories, the spec file is required because there is no default bucket
+// to upload artifacts to.
+func (h *artifactsDirectoryHandler) getSpecFile() error {
+	data, err := os.ReadFile(filepath.Join(h.dir, artifactsSpecFilename))
+	if err != nil {
+		return errors.Wrapf(err, "reading artifacts spec file '%s'", artifactsSpecFilename)
+	}
+	if err = yaml.Unmarshal(data, &h.spec); err != nil {
+		return errors.Wrap(err, "unmarshalling artifacts spec")
+	}
+
+	return h.spec.validate()
+}
+
+// createS3Bucket returns an S3 bucket for uploading artifacts with the given
+// content type. If the spec has a role ARN, the role is assumed; otherwise,
+// the credentials that the task's project has been granted for the bucket are
+// used.
+func (h *artifactsDirectoryHandler) createS3Bucket(ctx context.Context, contentType string) (pail.Bucket, error) {
+	var (
+		creds *apimodels.AWSCredentials
+		err   error
+	)
+	if h.spec.RoleARN != "" {
+		creds, err = h.comm.AssumeRole(ctx, h.taskData, apimodels.AssumeRoleRequest{RoleARN: h.spec.RoleARN})
+	} else {
+		creds, err = h.comm.S3Credentials(ctx, h.taskData, h.spec.Bucket)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting credentials for bucket '%s'", h.spec.Bucket)
+	}
+	if creds == nil {
+		return nil, errors.Errorf("no credentials returned for bucket '%s'", h.spec.Bucket)
+	}
+
+	return pail.NewS3MultiPartBucket(ctx, pail.S3Options{
+		Name:        h.spec.Bucket,
+		Region:      h.spec.Region,
+		Credentials: pail.CreateAWSStaticCredentials(creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken),
+		Permissions: pail.S3Permissions(h.spec.Permissions),
+		ContentType: contentType,
+	})
+}
+
+// artifactsSpec represents the artifacts specification file written at the
+// top level of the reserved artifacts directory.
+type artifactsSpec struct {
+	SchemaVersion string `yaml:"schema_version"`
+	// Bucket is the S3 bucket to upload artifacts to.
+	Bucket string `yaml:"bucket"`
+	// Region is the region of the bucket. Defaults to us-east-1.
+	Region string `yaml:"region"`
+	// Prefix is prepended to the remote path of every artifact. Artifacts
+	// are always stored under the task ID and execution within the prefix.
+	Prefix string `yaml:"prefix"`
+	// RoleARN, if set, is the role assumed to upload the artifacts and to
+	// sign links to them.
+	RoleARN string `yaml:"role_arn"`
+	// Permissions is the canned S3 ACL applied to the uploaded artifacts.
+	// Defaults to private.
+	Permissions string `yaml:"permissions"`
+	// Visibility is the default visibility of the attached artifacts.
+	// Defaults to private. Signed visibility requires a role ARN, since the
+	// links are signed with the role rather than stored credentials.
+	Visibility string `yaml:"visibility"`
+	// Files customizes individual artifacts by their path relative to the
+	// artifacts directory.
+	Files []artifactFileSpec `yaml:"files"`
+}
+
+// artifactFileSpec customizes how a single artifact is uploaded and attached.
+type artifactFileSpec struct {
+	Path        string `yaml:"path"`
+	DisplayName string `yaml:"display_name"`
+	Visibility  string `yaml:"visibility"`
+	ContentType string `yaml:"content_type"`
+}
+
+const artifactsSpecFilename = "artifacts_spec.yaml"
+
+func (s *artifactsSpec) validate() error {
+	if s.Region == "" {
+		s.Region = evergreen.DefaultEC2Region
+	}
+	if s.Permissions == "" {
+		s.Permissions = string(pail.S3PermissionsPrivate)
+	}
+	if s.Visibility == "" {
+		s.Visibility = artifact.Private
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(s.Bucket == "", "must specify a bucket")
+	catcher.ErrorfWhen(!utility.StringSliceContains(artifact.ValidVisibilities, s.Visibility), "invalid visibility '%s'", s.Visibility)
+	catcher.NewWhen(s.Visibility == artifact.Signed && s.RoleARN == "", "signed visibility requires a role ARN")
+	for _, f := range s.Files {
+		catcher.NewWhen(f.Path == "", "must specify a path for each file")
+		catcher.ErrorfWhen(!utility.StringSliceContains(artifact.ValidVisibilities, f.Visibility), "invalid visibility '%s' for file '%s'", f.Visibility, f.Path)
+		catcher.ErrorfWhen(f.Visibility == artifact.Signed && s.RoleARN == "", "signed visibility for file '%s' requires a role ARN", f.Path)
+	}
+
+	return catcher.Resolve()
+}
+
+// getFileSpec returns the spec for the artifact at the given relative path,
+// with defaults filled in.
+func (s *artifactsSpec) getFileSpec(relPath string) artifactFileSpec {
+	fileSpec := artifactFileSpec{Path: relPath}
+	for _, f := range s.Files {
+		if path.Clean(f.Path) == relPath {
+			fileSpec = f
+			break
+		}
+	}
+	if fileSpec.DisplayName == "" {
+		fileSpec.DisplayName = relPath
+	}
+	if fileSpec.Visibility == "" {
+		fileSpec.Visibility = s.Visibility
+	}
+
+	return fileSpec
+}
+
+// remoteKey returns the S3 key for the artifact at the given relative path.
+func (s *artifactsSpec) remoteKey(taskOpts taskoutput.TaskOptions, relPath string) string {
+	return path.Join(s.Prefix, taskOpts.TaskID, strconv.Itoa(taskOpts.Execution), relPath)
+}
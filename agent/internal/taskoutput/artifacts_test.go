@@ -0,0 +1,127 @@
+package taskoutput
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/artifact"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/evergreen-ci/pail"
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactsDirectoryHandlerRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tsk := &task.Task{Id: utility.RandomString(), Secret: "secret", Execution: 1}
+
+	setup := func(t *testing.T, files map[string]string) (*artifactsDirectoryHandler, *client.Mock, pail.Bucket) {
+		comm := client.NewMock("url")
+		logger, err := comm.GetLoggerProducer(ctx, tsk, nil)
+		require.NoError(t, err)
+		bucket, err := pail.NewLocalBucket(pail.LocalOptions{Path: t.TempDir(), UseSlash: true})
+		require.NoError(t, err)
+
+		dir := t.TempDir()
+		for name, contents := range files {
+			path := filepath.Join(dir, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0777))
+			require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+		}
+		h := newArtifactsDirectoryHandler(dir, logger, directoryHandlerOpts{
+			comm:     comm,
+			taskData: client.TaskData{ID: tsk.Id, Secret: tsk.Secret},
+			taskOpts: taskoutput.TaskOptions{TaskID: tsk.Id, Execution: tsk.Execution},
+		}).(*artifactsDirectoryHandler)
+		h.createBucket = func(context.Context, string) (pail.Bucket, error) { return bucket, nil }
+
+		return h, comm, bucket
+	}
+
+	t.Run("UploadsAndAttachesFiles", func(t *testing.T) {
+		h, comm, bucket := setup(t, map[string]string{
+			artifactsSpecFilename: `
+bucket: artifacts-bucket
+prefix: my-project
+role_arn: arn:aws:iam::123456789012:role/artifacts
+visibility: signed
+files:
+  - path: coverage/index.html
+    display_name: Coverage Report
+    visibility: private
+    content_type: text/html
+`,
+			"coverage/index.html": "coverage",
+			"binary.tgz":          "binary",
+		})
+		require.NoError(t, h.run(ctx))
+
+		files := comm.AttachedFiles[tsk.Id]
+		require.Len(t, files, 2)
+
+		assert.Equal(t, "binary.tgz", files[0].Name)
+		assert.Equal(t, artifact.Signed, files[0].Visibility)
+		assert.Equal(t, "arn:aws:iam::123456789012:role/artifacts", files[0].AWSRoleARN)
+		assert.Equal(t, "artifacts-bucket", files[0].Bucket)
+		assert.Equal(t, "my-project/"+tsk.Id+"/1/binary.tgz", files[0].FileKey)
+		assert.Contains(t, files[0].Link, "my-project/"+tsk.Id+"/1/binary.tgz")
+
+		assert.Equal(t, "Coverage Report", files[1].Name)
+		assert.Equal(t, artifact.Private, files[1].Visibility)
+		assert.Equal(t, "text/html", files[1].ContentType)
+		assert.Empty(t, files[1].Bucket)
+		assert.Contains(t, files[1].Link, "my-project/"+tsk.Id+"/1/coverage/index.html")
+
+		r, err := bucket.Get(ctx, "my-project/"+tsk.Id+"/1/coverage/index.html")
+		require.NoError(t, err)
+		defer r.Close()
+		_, err = bucket.Get(ctx, "my-project/"+tsk.Id+"/1/"+artifactsSpecFilename)
+		assert.Error(t, err, "spec file should not be uploaded")
+	})
+	t.Run("DefaultsToPrivate", func(t *testing.T) {
+		h, comm, _ := setup(t, map[string]string{
+			artifactsSpecFilename: "bucket: artifacts-bucket",
+			"binary.tgz":          "binary",
+		})
+		require.NoError(t, h.run(ctx))
+
+		files := comm.AttachedFiles[tsk.Id]
+		require.Len(t, files, 1)
+		assert.Equal(t, artifact.Private, files[0].Visibility)
+		assert.Empty(t, files[0].Bucket)
+		assert.Empty(t, files[0].FileKey)
+	})
+	t.Run("SignedWithoutRoleARNError", func(t *testing.T) {
+		h, comm, _ := setup(t, map[string]string{
+			artifactsSpecFilename: "bucket: artifacts-bucket\nvisibility: signed",
+			"binary.tgz":          "binary",
+		})
+		assert.Error(t, h.run(ctx))
+		assert.Empty(t, comm.AttachedFiles[tsk.Id])
+	})
+	t.Run("NoFilesWithoutSpec", func(t *testing.T) {
+		h, comm, _ := setup(t, nil)
+		require.NoError(t, h.run(ctx))
+		assert.Empty(t, comm.AttachedFiles[tsk.Id])
+	})
+	t.Run("FilesWithoutSpecError", func(t *testing.T) {
+		h, comm, _ := setup(t, map[string]string{"binary.tgz": "binary"})
+		assert.Error(t, h.run(ctx))
+		assert.Empty(t, comm.AttachedFiles[tsk.Id])
+	})
+	t.Run("InvalidSpecError", func(t *testing.T) {
+		h, comm, _ := setup(t, map[string]string{
+			artifactsSpecFilename: "visibility: everyone",
+			"binary.tgz":          "binary",
+		})
+		assert.Error(t, h.run(ctx))
+		assert.Empty(t, comm.AttachedFiles[tsk.Id])
+	})
+}
@@ -32,8 +32,10 @@ import (
 )
 
 var directoryHandlerFactories = map[string]directoryHandlerFactory{
-	"TestLogs":   newTestLogDirectoryHandler,
-	"OTelTraces": newOtelTraceDirectoryHandler,
+	"TestLogs":    newTestLogDirectoryHandler,
+	"OTelTraces":  newOtelTraceDirectoryHandler,
+	"TestResults": newTestResultsDirectoryHandler,
+	"Artifacts":   newArtifactsDirectoryHandler,
 }
 
 // Directory is the application representation of a task's reserved output
@@ -51,6 +53,11 @@ type DirectoryOpts struct {
 	RedactorOpts redactor.RedactionOptions
 	Logger       client.LoggerProducer
 	TraceClient  otlptrace.Client
+	// Comm is used to attach the task's artifacts.
+	Comm client.Communicator
+	// SendTestResults parses and sends the files written to the test
+	// results directory.
+	SendTestResults TestResultsSender
 }
 
 // NewDirectory returns a new task output directory with the specified root for
@@ -62,9 +69,12 @@ func NewDirectory(opts DirectoryOpts) *Directory {
 			TaskID:    opts.Tsk.Id,
 			Execution: opts.Tsk.Execution,
 		},
-		redactorOpts: opts.RedactorOpts,
-		output:       opts.Tsk.TaskOutputInfo,
-		traceClient:  opts.TraceClient,
+		redactorOpts:    opts.RedactorOpts,
+		output:          opts.Tsk.TaskOutputInfo,
+		traceClient:     opts.TraceClient,
+		comm:            opts.Comm,
+		taskData:        client.TaskData{ID: opts.Tsk.Id, Secret: opts.Tsk.Secret},
+		sendTestResults: opts.SendTestResults,
 	}
 	root := filepath.Join(opts.Root, "build")
 	handlers := map[string]directoryHandler{}
@@ -122,10 +132,13 @@ type directoryHandler interface {
 
 // directoryHandlerOpts contains options to be passed into each directory handler implementation initialization.
 type directoryHandlerOpts struct {
-	output       *taskoutput.TaskOutput
-	taskOpts     taskoutput.TaskOptions
-	redactorOpts redactor.RedactionOptions
-	traceClient  otlptrace.Client
+	output          *taskoutput.TaskOutput
+	taskOpts        taskoutput.TaskOptions
+	redactorOpts    redactor.RedactionOptions
+	traceClient     otlptrace.Client
+	comm            client.Communicator
+	taskData        client.TaskData
+	sendTestResults TestResultsSender
 }
 
 // directoryHandlerFactory abstracts the creation of a directory handler.
@@ -0,0 +1,182 @@
+package taskoutput
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"gopkg.in/yaml.v2"
+)
+
+// TestResultsFormat specifies the format of the test results files written to
+// the reserved test results directory.
+type TestResultsFormat string
+
+const (
+	// TestResultsFormatXUnit is xunit XML, as parsed by the
+	// attach.xunit_results command.
+	TestResultsFormatXUnit TestResultsFormat = "xunit"
+	// TestResultsFormatGoTest is the verbose output of go test, as parsed by
+	// the gotest.parse_files command.
+	TestResultsFormatGoTest TestResultsFormat = "gotest"
+	// TestResultsFormatEvergreen is Evergreen's native JSON test results
+	// format, as parsed by the attach.results command.
+	TestResultsFormatEvergreen TestResultsFormat = "evergreen"
+	// TestResultsFormatTAP is Test Anything Protocol output, as parsed by the
+	// tap.parse_files command.
+	TestResultsFormatTAP TestResultsFormat = "tap"
+	// TestResultsFormatCucumber is Cucumber JSON, as parsed by the
+	// cucumber.parse_files command.
+	TestResultsFormatCucumber TestResultsFormat = "cucumber"
+)
+
+func (f TestResultsFormat) validate() error {
+	switch f {
+	case TestResultsFormatXUnit, TestResultsFormatGoTest, TestResultsFormatEvergreen, TestResultsFormatTAP, TestResultsFormatCucumber:
+		return nil
+	default:
+		return errors.Errorf("unrecognized test results format '%s'", f)
+	}
+}
+
+// testResultsFormatsByExtension maps file extensions to the test results
+// format used when the spec file does not specify one.
+var testResultsFormatsByExtension = map[string]TestResultsFormat{
+	".xml":   TestResultsFormatXUnit,
+	".suite": TestResultsFormatGoTest,
+	".json":  TestResultsFormatEvergreen,
+	".tap":   TestResultsFormatTAP,
+}
+
+// TestResultsSender parses the given test results files, which are all of the
+// same format, and sends the test results and logs found in them.
+type TestResultsSender func(ctx context.Context, format TestResultsFormat, files []string) error
+
+// testResultsDirectoryHandler implements automatic task output handling for
+// the reserved test results directory.
+type testResultsDirectoryHandler struct {
+	dir    string
+	logger client.LoggerProducer
+	spec   testResultsSpec
+	send   TestResultsSender
+}
+
+// newTestResultsDirectoryHandler returns a new test results directory handler
+// for the specified task.
+func newTestResultsDirectoryHandler(dir string, logger client.LoggerProducer, handlerOpts directoryHandlerOpts) directoryHandler {
+	return &testResultsDirectoryHandler{
+		dir:    dir,
+		logger: logger,
+		send:   handlerOpts.sendTestResults,
+	}
+}
+
+func (h *testResultsDirectoryHandler) run(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "test-results-ingestion")
+	defer span.End()
+
+	h.getSpecFile()
+
+	filesByFormat := map[TestResultsFormat][]string{}
+	var fileCount int
+	ignore := filepath.Join(h.dir, testResultsSpecFilename)
+	err := filepath.WalkDir(h.dir, func(path string, info fs.DirEntry, err error) error {
+		if err != nil {
+			h.logger.Execution().Warning(errors.Wrap(err, "walking test results directory"))
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if info.IsDir() || path == ignore {
+			return nil
+		}
+
+		format := h.spec.Format
+		if format == "" {
+			format = testResultsFormatsByExtension[strings.ToLower(filepath.Ext(path))]
+		}
+		if format == "" {
+			h.logger.Task().Warningf("skipping test results file '%s' because its format cannot be determined from its extension and no format is set in the test results spec", path)
+			return nil
+		}
+
+		fileCount++
+		filesByFormat[format] = append(filesByFormat[format], path)
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "finding test results files")
+	}
+
+	span.SetAttributes(attribute.KeyValue{Key: "test_results_file_count", Value: attribute.IntValue(fileCount)})
+
+	if fileCount == 0 {
+		return nil
+	}
+	if h.send == nil {
+		return errors.New("test results files were found but the task output directory cannot send test results")
+	}
+
+	formats := make([]string, 0, len(filesByFormat))
+	for format := range filesByFormat {
+		formats = append(formats, string(format))
+	}
+	sort.Strings(formats)
+
+	catcher := grip.NewBasicCatcher()
+	for _, format := range formats {
+		files := filesByFormat[TestResultsFormat(format)]
+		sort.Strings(files)
+		h.logger.Task().Infof("Found %d test results file(s) in format '%s', initiating automated ingestion.", len(files), format)
+		catcher.Wrapf(h.send(ctx, TestResultsFormat(format), files), "sending '%s' test results", format)
+	}
+
+	return catcher.Resolve()
+}
+
+// getSpecFile looks for the test results specification file in the top level
+// of the reserved test results directory. The spec file is optional; if it
+// does not exist, the format of each file is determined by its extension.
+func (h *testResultsDirectoryHandler) getSpecFile() {
+	data, err := os.ReadFile(filepath.Join(h.dir, testResultsSpecFilename))
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		h.logger.Task().Warning(errors.Wrap(err, "reading test results spec; falling back to file extensions"))
+		return
+	}
+
+	var spec testResultsSpec
+	if err = yaml.Unmarshal(data, &spec); err != nil {
+		h.logger.Task().Warning(errors.Wrap(err, "unmarshalling test results spec; falling back to file extensions"))
+		return
+	}
+	if spec.Format != "" {
+		if err = spec.Format.validate(); err != nil {
+			h.logger.Task().Warning(errors.Wrap(err, "invalid test results format specified; falling back to file extensions"))
+			return
+		}
+	}
+
+	h.spec = spec
+}
+
+// testResultsSpec represents the test results specification file written at
+// the top level of the reserved test results directory.
+type testResultsSpec struct {
+	SchemaVersion string `yaml:"schema_version"`
+	// Format, if set, is the format of every file in the directory.
+	Format TestResultsFormat `yaml:"format"`
+}
+
+const testResultsSpecFilename = "results_spec.yaml"
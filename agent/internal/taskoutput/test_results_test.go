@@ -0,0 +1,105 @@
+package taskoutput
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestResultsDirectoryHandlerRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	comm := client.NewMock("url")
+	logger, err := comm.GetLoggerProducer(ctx, &task.Task{Id: utility.RandomString()}, nil)
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, files map[string]string) (*testResultsDirectoryHandler, map[TestResultsFormat][]string) {
+		sent := map[TestResultsFormat][]string{}
+		dir := t.TempDir()
+		for name, contents := range files {
+			path := filepath.Join(dir, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0777))
+			require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+		}
+		h := newTestResultsDirectoryHandler(dir, logger, directoryHandlerOpts{
+			sendTestResults: func(_ context.Context, format TestResultsFormat, files []string) error {
+				for _, file := range files {
+					rel, err := filepath.Rel(dir, file)
+					require.NoError(t, err)
+					sent[format] = append(sent[format], filepath.ToSlash(rel))
+				}
+				return nil
+			},
+		}).(*testResultsDirectoryHandler)
+
+		return h, sent
+	}
+
+	t.Run("FormatsFromExtensions", func(t *testing.T) {
+		h, sent := setup(t, map[string]string{
+			"junit.xml":          "",
+			"nested/report.XML":  "",
+			"unit.suite":         "",
+			"results.json":       "",
+			"output.tap":         "",
+			"notes.txt":          "",
+			"nested/deeper.json": "",
+		})
+		require.NoError(t, h.run(ctx))
+		assert.Equal(t, map[TestResultsFormat][]string{
+			TestResultsFormatXUnit:     {"junit.xml", "nested/report.XML"},
+			TestResultsFormatGoTest:    {"unit.suite"},
+			TestResultsFormatEvergreen: {"nested/deeper.json", "results.json"},
+			TestResultsFormatTAP:       {"output.tap"},
+		}, sent)
+	})
+	t.Run("FormatFromSpecFile", func(t *testing.T) {
+		h, sent := setup(t, map[string]string{
+			testResultsSpecFilename: "format: cucumber",
+			"features.json":         "",
+			"more_features.txt":     "",
+		})
+		require.NoError(t, h.run(ctx))
+		assert.Equal(t, map[TestResultsFormat][]string{
+			TestResultsFormatCucumber: {"features.json", "more_features.txt"},
+		}, sent)
+	})
+	t.Run("InvalidSpecFileFallsBackToExtensions", func(t *testing.T) {
+		h, sent := setup(t, map[string]string{
+			testResultsSpecFilename: "format: invalid",
+			"junit.xml":             "",
+		})
+		require.NoError(t, h.run(ctx))
+		assert.Equal(t, map[TestResultsFormat][]string{
+			TestResultsFormatXUnit: {"junit.xml"},
+		}, sent)
+	})
+	t.Run("EmptyDirectory", func(t *testing.T) {
+		h, sent := setup(t, nil)
+		h.send = nil
+		require.NoError(t, h.run(ctx))
+		assert.Empty(t, sent)
+	})
+	t.Run("SendError", func(t *testing.T) {
+		h, _ := setup(t, map[string]string{
+			"junit.xml":  "",
+			"unit.suite": "",
+		})
+		var formats []TestResultsFormat
+		h.send = func(_ context.Context, format TestResultsFormat, _ []string) error {
+			formats = append(formats, format)
+			return errors.New("send error")
+		}
+		assert.Error(t, h.run(ctx))
+		assert.ElementsMatch(t, []TestResultsFormat{TestResultsFormatXUnit, TestResultsFormatGoTest}, formats, "every format should be sent even if one fails")
+	})
+}
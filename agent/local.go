@@ -0,0 +1,329 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/command"
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/send"
+	"github.com/mongodb/jasper"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"gopkg.in/yaml.v3"
+)
+
+// LocalTaskOptions are the options to run a task's commands locally, without
+// an app server or host.
+type LocalTaskOptions struct {
+	// Project is the project configuration that defines the task.
+	Project *model.Project
+	// ProjectID is the identifier of the project.
+	ProjectID string
+	// BuildVariant is the name of the build variant to run the task on.
+	BuildVariant string
+	// TaskName is the name of the task to run.
+	TaskName string
+	// WorkDir is the directory to run the commands in.
+	WorkDir string
+	// Expansions are additional expansions for the task. They take precedence
+	// over the default expansions, build variant expansions and project
+	// parameters.
+	Expansions map[string]string
+	// Logger is where the task logs, including command output, are sent. If
+	// not set, they're written to standard output.
+	Logger send.Sender
+}
+
+// LocalCommand is a command that would run for a task, with its parameters
+// expanded.
+type LocalCommand struct {
+	Block       string         `yaml:"block"`
+	Command     string         `yaml:"command"`
+	DisplayName string         `yaml:"display_name"`
+	Params      map[string]any `yaml:"params,omitempty"`
+}
+
+// RunTaskLocally runs the commands for a task in the same order as the agent,
+// including its pre and post blocks or its task group's setup and teardown
+// blocks. Data that commands would send to the app server is discarded.
+func RunTaskLocally(ctx context.Context, opts LocalTaskOptions) error {
+	tc, err := newLocalTaskContext(opts)
+	if err != nil {
+		return err
+	}
+
+	sender := opts.Logger
+	if sender == nil {
+		sender = send.MakePlainLogger()
+	}
+	jpm, err := jasper.NewSynchronizedManager(false)
+	if err != nil {
+		return errors.Wrap(err, "creating process manager")
+	}
+	a := &Agent{
+		comm:               client.NewLocalCommunicator(sender),
+		jasper:             jpm,
+		setEndTaskResp:     tc.setUserEndTaskResponse,
+		addMetadataTagResp: tc.setAddMetadataTagResponse,
+		tracer:             otel.GetTracerProvider().Tracer("noop_tracer"),
+	}
+
+	tc.logger, err = a.comm.GetLoggerProducer(ctx, &tc.taskConfig.Task, nil)
+	if err != nil {
+		return errors.Wrap(err, "getting logger")
+	}
+	defer func() {
+		grip.Error(errors.Wrap(tc.logger.Close(), "closing logger"))
+	}()
+	tc.logger.Task().Infof("Running task '%s' on build variant '%s' locally in directory '%s'.", opts.TaskName, opts.BuildVariant, tc.taskConfig.WorkDir)
+
+	taskErr := a.runPreTaskCommands(ctx, tc)
+	if taskErr == nil {
+		taskErr = a.runTaskCommands(ctx, tc)
+	}
+	postErr := a.runPostOrTeardownTaskCommands(ctx, tc)
+
+	// This does not use runTeardownGroupCommands because that removes the
+	// task directory, which is the user's local directory.
+	teardownGroup, err := tc.getTeardownGroup()
+	if err != nil {
+		return errors.Wrap(err, "getting teardown-group commands")
+	}
+	if teardownGroup.commands != nil {
+		_ = a.runCommandsInBlock(ctx, tc, *teardownGroup)
+	}
+	tc.runTaskCommandCleanups(ctx, tc.logger, a.tracer)
+	tc.runSetupGroupCommandCleanups(ctx, tc.logger, a.tracer)
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrap(taskErr, "running task commands")
+	catcher.Wrap(postErr, "running post-task commands")
+	if catcher.HasErrors() {
+		tc.logger.Task().Errorf("Task '%s' failed.", opts.TaskName)
+	} else {
+		tc.logger.Task().Infof("Task '%s' succeeded.", opts.TaskName)
+	}
+	return catcher.Resolve()
+}
+
+// GetLocalTaskCommands returns the commands that would run for a task, in the
+// order that they would run, with functions resolved and expansions applied.
+// Expansions that are set while the task runs (e.g. by expansions.update) are
+// not available, so they are left unexpanded.
+func GetLocalTaskCommands(opts LocalTaskOptions) ([]LocalCommand, error) {
+	tc, err := newLocalTaskContext(opts)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := tc.getLocalCommandBlocks()
+	if err != nil {
+		return nil, err
+	}
+
+	var cmds []LocalCommand
+	for _, b := range blocks {
+		if b.commands == nil {
+			continue
+		}
+		commands := b.commands.List()
+		for i, commandInfo := range commands {
+			if !commandInfo.RunOnVariant(opts.BuildVariant) {
+				continue
+			}
+			blockInfo := command.BlockInfo{
+				Block:     b.block,
+				CmdNum:    i + 1,
+				TotalCmds: len(commands),
+			}
+			resolved, err := command.Resolve(commandInfo, &tc.taskConfig.Project, blockInfo)
+			if err != nil {
+				return nil, errors.Wrapf(err, "resolving command '%s'", commandInfo.Command)
+			}
+
+			// Function variables are only set for the commands in the
+			// function, the same as when running the task.
+			exp := util.NewExpansions(tc.taskConfig.Expansions.Map())
+			for key, val := range commandInfo.Vars {
+				expanded, err := tc.taskConfig.Expansions.ExpandString(val)
+				if err != nil {
+					return nil, errors.Wrapf(err, "expanding function variable '%s'", key)
+				}
+				exp.Put(key, expanded)
+			}
+
+			for _, c := range resolved {
+				params, err := expandLocalParams(c.Params, exp)
+				if err != nil {
+					return nil, errors.Wrapf(err, "expanding parameters for command %s", c.DisplayName)
+				}
+				cmds = append(cmds, LocalCommand{
+					Block:       resourceUsageBlockName(b.block),
+					Command:     c.Command,
+					DisplayName: c.DisplayName,
+					Params:      params.(map[string]any),
+				})
+			}
+		}
+	}
+
+	return cmds, nil
+}
+
+// PrintLocalTaskCommands writes the commands that would run for a task as
+// YAML.
+func PrintLocalTaskCommands(w io.Writer, opts LocalTaskOptions) error {
+	cmds, err := GetLocalTaskCommands(opts)
+	if err != nil {
+		return err
+	}
+	out, err := yaml.Marshal(cmds)
+	if err != nil {
+		return errors.Wrap(err, "marshalling commands to YAML")
+	}
+	_, err = fmt.Fprint(w, string(out))
+	return errors.Wrap(err, "writing commands")
+}
+
+// getLocalCommandBlocks returns all the blocks of commands that run for a
+// task, in the order that they run.
+func (tc *taskContext) getLocalCommandBlocks() ([]commandBlock, error) {
+	projectTask := tc.taskConfig.Project.FindProjectTask(tc.taskConfig.Task.DisplayName)
+	if projectTask == nil {
+		return nil, errors.Errorf("task '%s' not found in project", tc.taskConfig.Task.DisplayName)
+	}
+
+	setupGroup, err := tc.getSetupGroup()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting setup-group commands")
+	}
+	pre, err := tc.getPre()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting pre-task commands")
+	}
+	post, err := tc.getPost()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting post-task commands")
+	}
+	teardownGroup, err := tc.getTeardownGroup()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting teardown-group commands")
+	}
+	main := commandBlock{
+		block:    command.MainTaskBlock,
+		commands: &model.YAMLCommandSet{MultiCommand: projectTask.Commands},
+	}
+
+	return []commandBlock{*setupGroup, *pre, main, *post, *teardownGroup}, nil
+}
+
+// newLocalTaskContext creates the task context to run a task locally, with
+// the expansions that the app server would normally provide.
+func newLocalTaskContext(opts LocalTaskOptions) (*taskContext, error) {
+	if opts.Project == nil {
+		return nil, errors.New("project must be specified")
+	}
+	if opts.Project.FindTaskForVariant(opts.TaskName, opts.BuildVariant) == nil {
+		return nil, errors.Errorf("task '%s' does not run on build variant '%s'", opts.TaskName, opts.BuildVariant)
+	}
+	if opts.Project.FindProjectTask(opts.TaskName) == nil {
+		return nil, errors.Errorf("'%s' is not a task", opts.TaskName)
+	}
+	workDir, err := filepath.Abs(opts.WorkDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting absolute path for working directory '%s'", opts.WorkDir)
+	}
+
+	tsk := &task.Task{
+		Id:           fmt.Sprintf("local_%s_%s", opts.BuildVariant, opts.TaskName),
+		DisplayName:  opts.TaskName,
+		BuildVariant: opts.BuildVariant,
+		Project:      opts.ProjectID,
+		Requester:    evergreen.RepotrackerVersionRequester,
+	}
+	if tg := opts.Project.FindTaskGroupForTask(opts.BuildVariant, opts.TaskName); tg != nil {
+		tsk.TaskGroup = tg.Name
+	}
+
+	expansions := util.NewExpansions(map[string]string{
+		"execution":          strconv.Itoa(tsk.Execution),
+		"task_id":            tsk.Id,
+		"task_name":          tsk.DisplayName,
+		"build_variant":      tsk.BuildVariant,
+		"project":            opts.ProjectID,
+		"project_identifier": opts.ProjectID,
+		"project_id":         opts.ProjectID,
+		"workdir":            workDir,
+	})
+	if bv := opts.Project.FindBuildVariant(opts.BuildVariant); bv != nil {
+		expansions.Update(bv.Expansions)
+	}
+	for _, param := range opts.Project.Parameters {
+		if param.Value != "" {
+			expansions.Put(param.Key, param.Value)
+		}
+	}
+	expansions.Update(opts.Expansions)
+
+	taskConfig, err := internal.NewTaskConfig(internal.TaskConfigOptions{
+		WorkDir:    workDir,
+		Distro:     &apimodels.DistroView{},
+		Host:       &apimodels.HostView{},
+		Project:    opts.Project,
+		Task:       tsk,
+		ProjectRef: &model.ProjectRef{Id: opts.ProjectID, Identifier: opts.ProjectID},
+		ExpansionsAndVars: &apimodels.ExpansionsAndVars{
+			Expansions:  *expansions,
+			PrivateVars: map[string]bool{},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating task config")
+	}
+
+	return &taskContext{
+		task:       client.TaskData{ID: tsk.Id},
+		taskConfig: taskConfig,
+	}, nil
+}
+
+// expandLocalParams returns a copy of the command parameters with expansions
+// applied to all strings.
+func expandLocalParams(params any, exp *util.Expansions) (any, error) {
+	switch v := params.(type) {
+	case string:
+		return exp.ExpandString(v)
+	case map[string]any:
+		expanded := make(map[string]any, len(v))
+		for key, val := range v {
+			expandedVal, err := expandLocalParams(val, exp)
+			if err != nil {
+				return nil, errors.Wrapf(err, "expanding '%s'", key)
+			}
+			expanded[key] = expandedVal
+		}
+		return expanded, nil
+	case []any:
+		expanded := make([]any, 0, len(v))
+		for _, val := range v {
+			expandedVal, err := expandLocalParams(val, exp)
+			if err != nil {
+				return nil, err
+			}
+			expanded = append(expanded, expandedVal)
+		}
+		return expanded, nil
+	default:
+		return v, nil
+	}
+}
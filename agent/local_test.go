@@ -0,0 +1,122 @@
+package agent
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/mongodb/grip/send"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const localTaskProjectYAML = `
+pre:
+  - command: shell.exec
+    params:
+      script: echo pre
+post:
+  - command: shell.exec
+    params:
+      script: echo post > ${workdir}/post.txt
+functions:
+  write:
+    - command: shell.exec
+      params:
+        script: echo ${message} > ${file}
+tasks:
+  - name: write_file
+    commands:
+      - func: write
+        vars:
+          file: out.txt
+      - command: shell.exec
+        variants: ["other"]
+        params:
+          script: echo skipped
+  - name: fail
+    commands:
+      - command: shell.exec
+        params:
+          script: exit 1
+buildvariants:
+  - name: bv
+    expansions:
+      message: hello
+    run_on: [d]
+    tasks:
+      - name: write_file
+      - name: fail
+`
+
+func loadLocalTaskProject(t *testing.T) *model.Project {
+	p := &model.Project{}
+	_, err := model.LoadProjectInto(context.Background(), []byte(localTaskProjectYAML), nil, "project", p)
+	require.NoError(t, err)
+	return p
+}
+
+func TestGetLocalTaskCommands(t *testing.T) {
+	opts := LocalTaskOptions{
+		Project:      loadLocalTaskProject(t),
+		ProjectID:    "project",
+		BuildVariant: "bv",
+		TaskName:     "write_file",
+		WorkDir:      t.TempDir(),
+		Expansions:   map[string]string{"message": "overridden"},
+	}
+
+	t.Run("ResolvesAndExpandsCommands", func(t *testing.T) {
+		cmds, err := GetLocalTaskCommands(opts)
+		require.NoError(t, err)
+		require.Len(t, cmds, 3)
+
+		assert.Equal(t, "pre", cmds[0].Block)
+		assert.Equal(t, "task", cmds[1].Block)
+		assert.Equal(t, "shell.exec", cmds[1].Command)
+		assert.Contains(t, cmds[1].DisplayName, "in function 'write'")
+		assert.Equal(t, "echo overridden > out.txt", cmds[1].Params["script"], "should apply function vars and expansions")
+		assert.Equal(t, "post", cmds[2].Block)
+		assert.Equal(t, "echo post > "+opts.WorkDir+"/post.txt", cmds[2].Params["script"])
+	})
+	t.Run("ErrorsForTaskNotOnVariant", func(t *testing.T) {
+		opts := opts
+		opts.BuildVariant = "other"
+		_, err := GetLocalTaskCommands(opts)
+		assert.Error(t, err)
+	})
+}
+
+func TestRunTaskLocally(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	opts := LocalTaskOptions{
+		Project:      loadLocalTaskProject(t),
+		ProjectID:    "project",
+		BuildVariant: "bv",
+	}
+
+	t.Run("RunsAllBlocks", func(t *testing.T) {
+		opts := opts
+		opts.TaskName = "write_file"
+		opts.WorkDir = t.TempDir()
+		opts.Logger = send.MakeInternalLogger()
+		require.NoError(t, RunTaskLocally(ctx, opts))
+
+		out, err := os.ReadFile(filepath.Join(opts.WorkDir, "out.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "hello\n", string(out))
+		assert.FileExists(t, filepath.Join(opts.WorkDir, "post.txt"))
+	})
+	t.Run("ErrorsForFailingTask", func(t *testing.T) {
+		opts := opts
+		opts.TaskName = "fail"
+		opts.WorkDir = t.TempDir()
+		opts.Logger = send.MakeInternalLogger()
+		assert.Error(t, RunTaskLocally(ctx, opts))
+		assert.FileExists(t, filepath.Join(opts.WorkDir, "post.txt"), "post should run after the task fails")
+	})
+}
@@ -0,0 +1,236 @@
+package agent
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/command"
+	agentutil "github.com/evergreen-ci/evergreen/agent/util"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/recovery"
+	"github.com/pkg/errors"
+	"github.com/shirou/gopsutil/v3/net"
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// resourceUsageSampleInterval is how often the resource usage of a task's
+// processes is sampled.
+const resourceUsageSampleInterval = 10 * time.Second
+
+// processCounters are the cumulative resource counters of a single process.
+type processCounters struct {
+	cpuSecs    float64
+	readBytes  uint64
+	writeBytes uint64
+}
+
+// processUsage is the resource usage of a task's processes at a point in
+// time, before it has been converted into a sample.
+type processUsage struct {
+	counters    map[int32]processCounters
+	memoryBytes uint64
+	netSent     uint64
+	netRecv     uint64
+}
+
+// resourceUsageSampler samples the resource usage of a task's process tree at
+// regular intervals, attributing each sample to the command that was running.
+type resourceUsageSampler struct {
+	tc       *taskContext
+	logger   grip.Journaler
+	interval time.Duration
+	collect  func(context.Context) (*processUsage, error)
+
+	mu       sync.Mutex
+	samples  []resourceusage.Sample
+	prev     *processUsage
+	prevTime time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newResourceUsageSampler(tc *taskContext, logger grip.Journaler) *resourceUsageSampler {
+	s := &resourceUsageSampler{
+		tc:       tc,
+		logger:   logger,
+		interval: resourceUsageSampleInterval,
+	}
+	s.collect = func(ctx context.Context) (*processUsage, error) {
+		pids, err := agentutil.TaskProcessPIDs(ctx, tc.task.ID, tc.taskConfig.WorkDir, logger)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting task processes")
+		}
+		return collectProcessUsage(ctx, pids)
+	}
+	return s
+}
+
+// start begins sampling in the background until the context is done or stop
+// is called.
+func (s *resourceUsageSampler) start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+	s.prevTime = time.Now()
+
+	go func() {
+		defer close(s.done)
+		defer recovery.LogStackTraceAndContinue("resource usage sampler")
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				usage, err := s.collect(ctx)
+				if err != nil {
+					if ctx.Err() == nil {
+						s.logger.Debug(errors.Wrap(err, "sampling task resource usage"))
+					}
+					continue
+				}
+				s.record(time.Now(), usage)
+			}
+		}
+	}()
+}
+
+// stop stops sampling and returns the samples collected so far.
+func (s *resourceUsageSampler) stop() []resourceusage.Sample {
+	if s.cancel != nil {
+		s.cancel()
+		<-s.done
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.samples
+}
+
+// record converts the process usage into a sample relative to the previous
+// usage and adds it to the time series.
+func (s *resourceUsageSampler) record(now time.Time, usage *processUsage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sample := resourceusage.Sample{
+		Time:        now,
+		Block:       resourceUsageBlockName(s.tc.getCurrentBlock()),
+		MemoryBytes: usage.memoryBytes,
+		Processes:   len(usage.counters),
+	}
+	if cmd := s.tc.getCurrentCommand(); cmd != nil {
+		sample.Command = cmd.FullDisplayName()
+	}
+
+	var cpuSecs float64
+	for pid, cur := range usage.counters {
+		var prev processCounters
+		if s.prev != nil {
+			prev = s.prev.counters[pid]
+		}
+		// New processes have no previous counters, so all of their usage so
+		// far is attributed to this sample.
+		cpuSecs += max(cur.cpuSecs-prev.cpuSecs, 0)
+		sample.DiskReadBytes += counterDelta(cur.readBytes, prev.readBytes)
+		sample.DiskWriteBytes += counterDelta(cur.writeBytes, prev.writeBytes)
+	}
+	if elapsed := now.Sub(s.prevTime).Seconds(); elapsed > 0 {
+		sample.CPUPercent = cpuSecs / elapsed * 100
+	}
+	if s.prev != nil {
+		sample.NetSentBytes = counterDelta(usage.netSent, s.prev.netSent)
+		sample.NetRecvBytes = counterDelta(usage.netRecv, s.prev.netRecv)
+	}
+
+	s.samples = append(s.samples, sample)
+	s.prev = usage
+	s.prevTime = now
+}
+
+// counterDelta returns the increase in a counter, or zero if the counter was
+// reset.
+func counterDelta(cur, prev uint64) uint64 {
+	if cur < prev {
+		return 0
+	}
+	return cur - prev
+}
+
+// resourceUsageBlockName returns the name of the block for resource usage
+// samples.
+func resourceUsageBlockName(block command.BlockType) string {
+	if block == command.MainTaskBlock {
+		return "task"
+	}
+	return string(block)
+}
+
+// collectProcessUsage returns the current resource usage of the given
+// processes. Processes that exit while being inspected are skipped. Network
+// usage is for the whole host.
+func collectProcessUsage(ctx context.Context, pids []int) (*processUsage, error) {
+	usage := &processUsage{counters: map[int32]processCounters{}}
+	for _, pid := range pids {
+		p, err := process.NewProcessWithContext(ctx, int32(pid))
+		if err != nil {
+			continue
+		}
+		times, err := p.TimesWithContext(ctx)
+		if err != nil {
+			continue
+		}
+		counters := processCounters{cpuSecs: times.User + times.System}
+		// I/O counters are not available on all platforms.
+		if io, err := p.IOCountersWithContext(ctx); err == nil {
+			counters.readBytes = io.ReadBytes
+			counters.writeBytes = io.WriteBytes
+		}
+		if mem, err := p.MemoryInfoWithContext(ctx); err == nil {
+			usage.memoryBytes += mem.RSS
+		}
+		usage.counters[p.Pid] = counters
+	}
+
+	netCounters, err := net.IOCountersWithContext(ctx, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting network counters")
+	}
+	if len(netCounters) > 0 {
+		usage.netSent = netCounters[0].BytesSent
+		usage.netRecv = netCounters[0].BytesRecv
+	}
+
+	return usage, nil
+}
+
+// startResourceUsageSampling starts sampling the resource usage of the task's
+// processes.
+func (a *Agent) startResourceUsageSampling(ctx context.Context, tc *taskContext) {
+	tc.resourceUsage = newResourceUsageSampler(tc, tc.logger.Execution())
+	tc.resourceUsage.start(ctx)
+}
+
+// sendResourceUsage stops sampling the resource usage of the task's processes
+// and sends the samples to the app server.
+func (a *Agent) sendResourceUsage(ctx context.Context, tc *taskContext) error {
+	if tc.resourceUsage == nil {
+		return nil
+	}
+	samples := tc.resourceUsage.stop()
+	tc.resourceUsage = nil
+	if len(samples) == 0 {
+		return nil
+	}
+
+	return errors.Wrap(a.comm.SendResourceUsage(ctx, tc.task, &resourceusage.TaskResourceUsage{
+		TaskID:             tc.taskConfig.Task.Id,
+		Execution:          tc.taskConfig.Task.Execution,
+		SampleIntervalSecs: resourceUsageSampleInterval.Seconds(),
+		Samples:            samples,
+	}), "sending resource usage")
+}
@@ -0,0 +1,68 @@
+package agent
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/command"
+	"github.com/mongodb/grip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceUsageSamplerRecord(t *testing.T) {
+	tc := &taskContext{}
+	s := newResourceUsageSampler(tc, grip.GetDefaultJournaler())
+	start := time.Now()
+	s.prevTime = start
+
+	tc.setCurrentBlock(command.PreBlock)
+	s.record(start.Add(10*time.Second), &processUsage{
+		counters: map[int32]processCounters{
+			1: {cpuSecs: 5, readBytes: 100, writeBytes: 10},
+		},
+		memoryBytes: 1000,
+		netSent:     50,
+		netRecv:     70,
+	})
+
+	tc.setCurrentBlock(command.MainTaskBlock)
+	s.record(start.Add(20*time.Second), &processUsage{
+		counters: map[int32]processCounters{
+			1: {cpuSecs: 10, readBytes: 150, writeBytes: 10},
+			2: {cpuSecs: 5, writeBytes: 20},
+		},
+		memoryBytes: 3000,
+		netSent:     80,
+		netRecv:     60,
+	})
+
+	samples := s.stop()
+	require.Len(t, samples, 2)
+
+	assert.Equal(t, "pre", samples[0].Block)
+	assert.InDelta(t, 50, samples[0].CPUPercent, 0.001)
+	assert.EqualValues(t, 1000, samples[0].MemoryBytes)
+	assert.EqualValues(t, 100, samples[0].DiskReadBytes)
+	assert.EqualValues(t, 10, samples[0].DiskWriteBytes)
+	assert.Zero(t, samples[0].NetSentBytes, "the first sample has no network baseline")
+	assert.Equal(t, 1, samples[0].Processes)
+
+	assert.Equal(t, "task", samples[1].Block)
+	assert.InDelta(t, 100, samples[1].CPUPercent, 0.001)
+	assert.EqualValues(t, 3000, samples[1].MemoryBytes)
+	assert.EqualValues(t, 50, samples[1].DiskReadBytes)
+	assert.EqualValues(t, 20, samples[1].DiskWriteBytes)
+	assert.EqualValues(t, 30, samples[1].NetSentBytes)
+	assert.Zero(t, samples[1].NetRecvBytes, "a reset counter should not underflow")
+	assert.Equal(t, 2, samples[1].Processes)
+}
+
+func TestCollectProcessUsage(t *testing.T) {
+	usage, err := collectProcessUsage(context.Background(), []int{os.Getpid(), -1})
+	require.NoError(t, err)
+	require.Len(t, usage.counters, 1, "nonexistent processes should be skipped")
+	assert.NotZero(t, usage.memoryBytes)
+}
@@ -26,6 +26,8 @@ import (
 
 type taskContext struct {
 	currentCommand command.Command
+	// currentBlock is the block of commands that is currently running.
+	currentBlock command.BlockType
 	// failingCommand keeps track of the command that caused the task to fail,
 	// if any.
 	failingCommand command.Command
@@ -57,6 +59,8 @@ type taskContext struct {
 	// metadata tag payload, which can be appended to the final list of failure
 	// metadata tags in the end task response.
 	addMetadataTagResp *triggerAddMetadataTagResp
+	// resourceUsage samples the resource usage of the task's processes.
+	resourceUsage *resourceUsageSampler
 	sync.RWMutex
 }
 
@@ -182,6 +186,18 @@ func (tc *taskContext) getCurrentCommand() command.Command {
 	return tc.currentCommand
 }
 
+func (tc *taskContext) setCurrentBlock(block command.BlockType) {
+	tc.Lock()
+	defer tc.Unlock()
+	tc.currentBlock = block
+}
+
+func (tc *taskContext) getCurrentBlock() command.BlockType {
+	tc.RLock()
+	defer tc.RUnlock()
+	return tc.currentBlock
+}
+
 // setCurrentIdleTimeout sets the idle timeout for the current running command.
 // This timeout only applies to commands running in specific blocks where idle
 // timeout is allowed.
@@ -65,6 +65,13 @@ func KillSpawnedProcs(ctx context.Context, key, workingDir, execUser string, log
 
 }
 
+// TaskProcessPIDs returns the PIDs of the processes started by the task with
+// the given key. These are the processes that KillSpawnedProcs kills when the
+// task does not run as a separate user.
+func TaskProcessPIDs(ctx context.Context, key, workingDir string, logger grip.Journaler) ([]int, error) {
+	return getPIDsToKill(ctx, key, workingDir, logger)
+}
+
 func killUserProcesses(ctx context.Context, execUser string) error {
 	if execUser == "" {
 		return errors.New("execUser cannot be empty")
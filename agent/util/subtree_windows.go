@@ -3,12 +3,14 @@ package util
 import (
 	"context"
 	"fmt"
+	"os"
 	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
+	"github.com/shirou/gopsutil/v3/process"
 )
 
 const (
@@ -170,6 +172,32 @@ func KillSpawnedProcs(ctx context.Context, key, workingDir, _ string, logger gri
 	return errors.Wrapf(processMapping.removeJob(key), "removing job object '%s' from internal Evergreen tracking mechanism", key)
 }
 
+// TaskProcessPIDs returns the PIDs of the processes started by the task with
+// the given key. Job objects don't expose the processes assigned to them, so
+// this returns every process that descends from the agent instead.
+func TaskProcessPIDs(ctx context.Context, key, workingDir string, logger grip.Journaler) ([]int, error) {
+	agent, err := process.NewProcessWithContext(ctx, int32(os.Getpid()))
+	if err != nil {
+		return nil, errors.Wrap(err, "getting agent process")
+	}
+
+	var pids []int
+	var addChildren func(p *process.Process)
+	addChildren = func(p *process.Process) {
+		children, err := p.ChildrenWithContext(ctx)
+		if err != nil {
+			return
+		}
+		for _, child := range children {
+			pids = append(pids, int(child.Pid))
+			addChildren(child)
+		}
+	}
+	addChildren(agent)
+
+	return pids, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////
 //
 // All the methods below are boilerplate functions for accessing the Windows syscalls for
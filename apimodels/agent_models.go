@@ -70,6 +70,20 @@ type TaskEndDetail struct {
 	Modules              ModuleCloneInfo  `bson:"modules,omitempty" json:"modules,omitempty"`
 	TraceID              string           `bson:"trace_id,omitempty" json:"trace_id,omitempty"`
 	DiskDevices          []string         `bson:"disk_devices,omitempty" json:"disk_devices,omitempty"`
+	// CacheResults are the results of the cache.restore commands that ran.
+	CacheResults []CacheResult `bson:"cache_results,omitempty" json:"cache_results,omitempty"`
+}
+
+// CacheResult is the result of restoring a cache in a task.
+type CacheResult struct {
+	// Key is the name of the cache for the exact key.
+	Key string `bson:"key" json:"key"`
+	// Hit is whether the cache for the exact key was restored.
+	Hit bool `bson:"hit" json:"hit"`
+	// RestoredKey is the name of the cache that was restored, which is a
+	// fallback cache if it differs from Key. It is empty if no cache was
+	// restored.
+	RestoredKey string `bson:"restored_key,omitempty" json:"restored_key,omitempty"`
 }
 
 // FailingCommand represents a command that failed in a task.
@@ -225,6 +239,50 @@ func (ar *S3CredentialsRequest) Validate() error {
 	return catcher.Resolve()
 }
 
+// Operations on keys shared by the tasks in a project.
+const (
+	KeyValGet    = "get"
+	KeyValSet    = "set"
+	KeyValCAS    = "cas"
+	KeyValLock   = "lock"
+	KeyValUnlock = "unlock"
+)
+
+// KeyValRequest is a request to get, set, swap, lock or unlock a key shared by
+// the tasks in a project.
+type KeyValRequest struct {
+	Key string `json:"key"`
+	// Value is the value to set the key to.
+	Value string `json:"value,omitempty"`
+	// Expected is the value that the key must have for a compare-and-swap to
+	// succeed. If it's empty, the key must not be set.
+	Expected string `json:"expected,omitempty"`
+	// TTLSecs is how long the key lives, or how long the lock is held. It
+	// defaults to the server's default if it's zero.
+	TTLSecs int `json:"ttl_secs,omitempty"`
+}
+
+// Validate checks that the request has valid values.
+func (r *KeyValRequest) Validate() error {
+	catcher := grip.NewBasicCatcher()
+
+	catcher.NewWhen(r.Key == "", "must specify key")
+	catcher.NewWhen(r.TTLSecs < 0, "cannot specify a negative TTL")
+
+	return catcher.Resolve()
+}
+
+// KeyValResponse is the result of a request to get, set, swap, lock or unlock
+// a key shared by the tasks in a project.
+type KeyValResponse struct {
+	// Value is the key's value after the request.
+	Value string `json:"value"`
+	// Found is whether the key is set after the request.
+	Found bool `json:"found"`
+	// Succeeded is whether the set, swap, lock or unlock took effect.
+	Succeeded bool `json:"succeeded"`
+}
+
 func (ted *TaskEndDetail) IsEmpty() bool {
 	return ted == nil || ted.Status == ""
 }
@@ -36,6 +36,9 @@ func LoadUserManager(settings *evergreen.Settings) (gimlet.UserManager, evergree
 	if authConfig.Okta != nil {
 		return makeOktaManager(settings, authConfig.Okta)
 	}
+	if authConfig.OIDC != nil {
+		return makeOIDCManager(settings, authConfig.OIDC)
+	}
 	if authConfig.Naive != nil {
 		return makeNaiveManager(authConfig.Naive)
 	}
@@ -62,6 +65,17 @@ func makeOktaManager(settings *evergreen.Settings, config *evergreen.OktaConfig)
 	}, nil
 }
 
+func makeOIDCManager(settings *evergreen.Settings, config *evergreen.OIDCAuthConfig) (gimlet.UserManager, evergreen.UserManagerInfo, error) {
+	manager, err := NewOIDCUserManager(config, settings.Ui.Url, settings.Ui.LoginDomain)
+	if err != nil {
+		return nil, evergreen.UserManagerInfo{}, errors.Wrap(err, "problem setting up OIDC authentication")
+	}
+	return manager, evergreen.UserManagerInfo{
+		CanClearTokens: true,
+		CanReauthorize: true,
+	}, nil
+}
+
 func makeNaiveManager(config *evergreen.NaiveAuthConfig) (gimlet.UserManager, evergreen.UserManagerInfo, error) {
 	manager, err := NewNaiveUserManager(config)
 	if err != nil {
@@ -140,6 +154,10 @@ func makeSingleManager(kind string, settings *evergreen.Settings, config evergre
 		if config.Okta != nil {
 			return makeOktaManager(settings, config.Okta)
 		}
+	case evergreen.AuthOIDCKey:
+		if config.OIDC != nil {
+			return makeOIDCManager(settings, config.OIDC)
+		}
 	case evergreen.AuthGithubKey:
 		if config.Github != nil {
 			return makeGithubManager(settings, config.Github)
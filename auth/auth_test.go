@@ -22,6 +22,11 @@ func TestLoadUserManager(t *testing.T) {
 		Issuer:       "issuer",
 		UserGroup:    "user_group",
 	}
+	oidc := evergreen.OIDCAuthConfig{
+		Issuer:       "https://idp.example.com",
+		ClientID:     "client_id",
+		ClientSecret: "client_secret",
+	}
 	multi := evergreen.MultiAuthConfig{
 		ReadWrite: []string{evergreen.AuthOktaKey},
 		ReadOnly:  []string{evergreen.AuthNaiveKey},
@@ -56,6 +61,13 @@ func TestLoadUserManager(t *testing.T) {
 	assert.True(t, info.CanReauthorize)
 	assert.NotNil(t, um, "a UserManager should be created if one AuthConfig type is Okta")
 
+	a = evergreen.AuthConfig{OIDC: &oidc}
+	um, info, err = LoadUserManager(&evergreen.Settings{AuthConfig: a})
+	assert.NoError(t, err, "a UserManager should be created if one AuthConfig type is OIDC")
+	assert.True(t, info.CanClearTokens)
+	assert.True(t, info.CanReauthorize)
+	assert.NotNil(t, um, "a UserManager should be created if one AuthConfig type is OIDC")
+
 	a = evergreen.AuthConfig{Naive: &naive}
 	um, info, err = LoadUserManager(&evergreen.Settings{AuthConfig: a})
 	assert.NoError(t, err, "a UserManager should be created if one AuthConfig type is Naive")
@@ -125,6 +137,15 @@ func TestLoadUserManager(t *testing.T) {
 	_, ok = um.(*NaiveUserManager)
 	assert.True(t, ok)
 
+	a = evergreen.AuthConfig{PreferredType: evergreen.AuthOIDCKey, OIDC: &oidc, Naive: &naive}
+	um, info, err = LoadUserManager(&evergreen.Settings{AuthConfig: a})
+	assert.NoError(t, err)
+	assert.True(t, info.CanClearTokens)
+	assert.True(t, info.CanReauthorize)
+	assert.NotNil(t, um)
+	_, ok = um.(*OIDCUserManager)
+	assert.True(t, ok)
+
 	a = evergreen.AuthConfig{PreferredType: evergreen.AuthKanopyKey, Kanopy: &kanopy}
 	um, info, err = LoadUserManager(&evergreen.Settings{AuthConfig: a})
 	assert.NoError(t, err)
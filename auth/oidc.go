@@ -0,0 +1,813 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/gimlet/usercache"
+	"github.com/evergreen-ci/utility"
+	"github.com/golang-jwt/jwt"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	oidcStateCookieName        = "oidc-state"
+	oidcNonceCookieName        = "oidc-nonce"
+	oidcCodeVerifierCookieName = "oidc-code-verifier"
+	oidcRequestURICookieName   = "oidc-original-request-uri"
+
+	// oidcTemporaryCookieTTL is how long the user has to finish logging in
+	// with the identity provider.
+	oidcTemporaryCookieTTL = 10 * time.Minute
+	oidcRequestTimeout     = 10 * time.Second
+)
+
+// oidcSigningMethods are the ID token signing algorithms that are accepted.
+var oidcSigningMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+
+// OIDCUserManager implements the UserManager with a generic OpenID Connect
+// identity provider.
+// The provider's endpoints are loaded from its discovery document. Login uses
+// the authorization code flow with PKCE: the user is redirected to the
+// provider's authorization endpoint with an unguessable state, a nonce and a
+// code challenge, all of which are also stored in temporary cookies. When the
+// provider redirects the user back, the code is exchanged for tokens using
+// the code verifier, and the ID token's signature and claims are validated
+// against the provider's keyset. The user's Evergreen roles are synced from
+// the configured role mappings every time the ID token is validated.
+// Expired logins are reauthorized using the refresh token.
+type OIDCUserManager struct {
+	conf        evergreen.OIDCAuthConfig
+	redirectURI string
+	loginDomain string
+	cache       usercache.Cache
+
+	mu       sync.RWMutex
+	metadata *oidcProviderMetadata
+	keys     map[string]crypto.PublicKey
+}
+
+// oidcProviderMetadata is the subset of the identity provider's discovery
+// document that the OIDC user manager uses.
+type oidcProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	RefreshToken     string `json:"refresh_token"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type oidcKeyset struct {
+	Keys []oidcJWK `json:"keys"`
+}
+
+// oidcJWK is a JSON web key from the identity provider's keyset.
+type oidcJWK struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	// N and E are the modulus and exponent of RSA keys.
+	N string `json:"n"`
+	E string `json:"e"`
+	// Crv, X and Y are the curve and coordinates of EC keys.
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// NewOIDCUserManager initializes an OIDCUserManager. The identity provider is
+// not contacted until the first login, so an unavailable provider does not
+// prevent Evergreen from starting.
+func NewOIDCUserManager(conf *evergreen.OIDCAuthConfig, evgURL, loginDomain string) (gimlet.UserManager, error) {
+	if conf == nil {
+		return nil, errors.New("OIDC config cannot be nil")
+	}
+	c := *conf
+	if err := c.ValidateAndDefault(); err != nil {
+		return nil, errors.Wrap(err, "invalid OIDC config")
+	}
+
+	expireAfter := time.Duration(c.ExpireAfterMinutes) * time.Minute
+	cache, err := usercache.NewExternal(usercache.ExternalOptions{
+		PutUserGetToken: user.PutLoginCache,
+		GetUserByToken:  func(token string) (gimlet.User, bool, error) { return user.GetLoginCache(token, expireAfter) },
+		ClearUserToken: func(u gimlet.User, all bool) error {
+			if all {
+				return user.ClearAllLoginCaches()
+			}
+			return user.ClearLoginCache(u)
+		},
+		GetUserByID:     func(id string) (gimlet.User, bool, error) { return getUserByIdWithExpiration(id, expireAfter) },
+		GetOrCreateUser: getOrCreateUser,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating user cache")
+	}
+
+	return &OIDCUserManager{
+		conf:        c,
+		redirectURI: strings.TrimRight(evgURL, "/") + "/login/redirect/callback",
+		loginDomain: loginDomain,
+		cache:       cache,
+	}, nil
+}
+
+// GetUserByToken returns the user logged in with the given login token. If the
+// login has expired, the user is reauthorized with the identity provider.
+func (m *OIDCUserManager) GetUserByToken(_ context.Context, token string) (gimlet.User, error) {
+	u, valid, err := m.cache.Get(token)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting cached user")
+	}
+	if u == nil {
+		return nil, errors.New("user not found in cache")
+	}
+	if !valid {
+		if err = m.ReauthorizeUser(u); err != nil {
+			grip.Debug(message.WrapError(err, message.Fields{
+				"message": "could not reauthorize OIDC user",
+				"user":    u.Username(),
+			}))
+			return u, gimlet.ErrNeedsReauthentication
+		}
+	}
+	return u, nil
+}
+
+// CreateUserToken is not implemented in OIDCUserManager.
+func (*OIDCUserManager) CreateUserToken(string, string) (string, error) {
+	return "", errors.New("OIDCUserManager does not create tokens via username/password")
+}
+
+// GetLoginHandler returns the function that starts the login by redirecting
+// the user to the identity provider's authorization endpoint.
+func (m *OIDCUserManager) GetLoginHandler(_ string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), oidcRequestTimeout)
+		defer cancel()
+
+		authURL, err := m.getAuthorizationURL(ctx)
+		if err != nil {
+			m.writeLoginError(w, r, http.StatusInternalServerError, errors.Wrap(err, "getting authorization URL"))
+			return
+		}
+		state, nonce, codeVerifier, err := newOIDCLoginValues()
+		if err != nil {
+			m.writeLoginError(w, r, http.StatusInternalServerError, err)
+			return
+		}
+
+		m.setTemporaryCookie(w, oidcStateCookieName, state)
+		m.setTemporaryCookie(w, oidcNonceCookieName, nonce)
+		m.setTemporaryCookie(w, oidcCodeVerifierCookieName, codeVerifier)
+		m.setTemporaryCookie(w, oidcRequestURICookieName, url.QueryEscape(getOIDCRedirect(r.URL.Query().Get("redirect"))))
+
+		challenge := sha256.Sum256([]byte(codeVerifier))
+		q := authURL.Query()
+		q.Set("client_id", m.conf.ClientID)
+		q.Set("response_type", "code")
+		q.Set("scope", strings.Join(m.conf.Scopes, " "))
+		q.Set("redirect_uri", m.redirectURI)
+		q.Set("state", state)
+		q.Set("nonce", nonce)
+		q.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+		q.Set("code_challenge_method", "S256")
+		authURL.RawQuery = q.Encode()
+
+		w.Header().Set("Cache-Control", "no-cache,no-store")
+		http.Redirect(w, r, authURL.String(), http.StatusFound)
+	}
+}
+
+// GetLoginCallbackHandler returns the function that is called when the
+// identity provider redirects the user back to Evergreen.
+func (m *OIDCUserManager) GetLoginCallbackHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if errCode := q.Get("error"); errCode != "" {
+			m.writeLoginError(w, r, http.StatusUnauthorized, errors.Errorf("identity provider returned error '%s': %s", errCode, q.Get("error_description")))
+			return
+		}
+
+		catcher := grip.NewBasicCatcher()
+		state, err := getOIDCCookie(r, oidcStateCookieName)
+		catcher.Add(err)
+		nonce, err := getOIDCCookie(r, oidcNonceCookieName)
+		catcher.Add(err)
+		codeVerifier, err := getOIDCCookie(r, oidcCodeVerifierCookieName)
+		catcher.Add(err)
+		if catcher.HasErrors() {
+			m.writeLoginError(w, r, http.StatusBadRequest, errors.Wrap(catcher.Resolve(), "getting login cookies"))
+			return
+		}
+		if q.Get("state") != state {
+			m.writeLoginError(w, r, http.StatusBadRequest, errors.New("state received from identity provider did not match expected state"))
+			return
+		}
+		requestURI := "/"
+		if encoded, err := getOIDCCookie(r, oidcRequestURICookieName); err == nil {
+			if decoded, err := url.QueryUnescape(encoded); err == nil {
+				requestURI = getOIDCRedirect(decoded)
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), oidcRequestTimeout)
+		defer cancel()
+
+		loginToken, err := m.login(ctx, q.Get("code"), codeVerifier, nonce)
+		if err != nil {
+			m.writeLoginError(w, r, http.StatusUnauthorized, errors.Wrap(err, "logging in"))
+			return
+		}
+
+		for _, name := range []string{oidcStateCookieName, oidcNonceCookieName, oidcCodeVerifierCookieName, oidcRequestURICookieName} {
+			m.unsetTemporaryCookie(w, name)
+		}
+		SetLoginToken(loginToken, m.loginDomain, w)
+		http.Redirect(w, r, requestURI, http.StatusFound)
+	}
+}
+
+// IsRedirect returns true because OIDCUserManager redirects the user to the
+// identity provider to log in.
+func (*OIDCUserManager) IsRedirect() bool { return true }
+
+// ReauthorizeUser refreshes the user's tokens with the identity provider. If
+// the provider issues a new ID token, the user's roles are synced from it.
+func (m *OIDCUserManager) ReauthorizeUser(u gimlet.User) error {
+	refreshToken := u.GetRefreshToken()
+	if refreshToken == "" {
+		return errors.Errorf("user '%s' cannot refresh tokens because refresh token is missing", u.Username())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), oidcRequestTimeout)
+	defer cancel()
+
+	tokens, err := m.requestTokens(ctx, url.Values{
+		"grant_type":    []string{"refresh_token"},
+		"refresh_token": []string{refreshToken},
+		"scope":         []string{strings.Join(m.conf.Scopes, " ")},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "refreshing tokens for user '%s'", u.Username())
+	}
+	if tokens.RefreshToken == "" {
+		// Providers that do not rotate refresh tokens return no new one.
+		tokens.RefreshToken = refreshToken
+	}
+
+	if tokens.IDToken == "" {
+		// Providers are not required to issue a new ID token when refreshing,
+		// in which case the user's claims are unchanged.
+		opts, err := gimlet.NewBasicUserOptions(u.Username())
+		if err != nil {
+			return errors.Wrap(err, "creating user options")
+		}
+		refreshed := gimlet.NewBasicUser(opts.Name(u.DisplayName()).Email(u.Email()).AccessToken(tokens.AccessToken).RefreshToken(tokens.RefreshToken))
+		_, err = m.cache.Put(refreshed)
+		return errors.Wrapf(err, "updating reauthorized user '%s' in cache", u.Username())
+	}
+
+	claims, err := m.verifyIDToken(ctx, tokens.IDToken, "")
+	if err != nil {
+		return errors.Wrap(err, "invalid ID token")
+	}
+	refreshed, err := m.makeUser(claims, tokens)
+	if err != nil {
+		return errors.Wrap(err, "making user from ID token")
+	}
+	if refreshed.Username() != u.Username() {
+		return errors.Errorf("user name '%s' from ID token did not match user name '%s' to reauthorize", refreshed.Username(), u.Username())
+	}
+	if _, err = m.cache.Put(refreshed); err != nil {
+		return errors.Wrapf(err, "updating reauthorized user '%s' in cache", u.Username())
+	}
+	return errors.Wrapf(m.syncRoles(ctx, refreshed), "syncing roles for user '%s'", u.Username())
+}
+
+// GetUserByID gets a user from persistent storage.
+func (m *OIDCUserManager) GetUserByID(id string) (gimlet.User, error) {
+	u, _, err := m.cache.Find(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding user")
+	}
+	if u == nil {
+		return nil, errors.Errorf("user '%s' not found", id)
+	}
+	return u, nil
+}
+
+// GetOrCreateUser gets the user from persistent storage or creates it if it
+// does not exist.
+func (m *OIDCUserManager) GetOrCreateUser(u gimlet.User) (gimlet.User, error) {
+	return m.cache.GetOrCreate(u)
+}
+
+// ClearUser logs out the user, or all users if all is true.
+func (m *OIDCUserManager) ClearUser(u gimlet.User, all bool) error {
+	return m.cache.Clear(u, all)
+}
+
+// GetGroupsForUser is not implemented in OIDCUserManager.
+func (*OIDCUserManager) GetGroupsForUser(string) ([]string, error) {
+	return nil, errors.New("not implemented")
+}
+
+// login exchanges the authorization code for tokens, validates the ID token
+// and logs in the user it identifies. It returns the user's login token.
+func (m *OIDCUserManager) login(ctx context.Context, code, codeVerifier, nonce string) (string, error) {
+	if code == "" {
+		return "", errors.New("authorization code is missing")
+	}
+	tokens, err := m.requestTokens(ctx, url.Values{
+		"grant_type":    []string{"authorization_code"},
+		"code":          []string{code},
+		"redirect_uri":  []string{m.redirectURI},
+		"code_verifier": []string{codeVerifier},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "redeeming authorization code for tokens")
+	}
+	if tokens.IDToken == "" {
+		return "", errors.New("token response is missing ID token")
+	}
+
+	claims, err := m.verifyIDToken(ctx, tokens.IDToken, nonce)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid ID token")
+	}
+	u, err := m.makeUser(claims, tokens)
+	if err != nil {
+		return "", errors.Wrap(err, "making user from ID token")
+	}
+	if _, err = m.cache.GetOrCreate(u); err != nil {
+		return "", errors.Wrapf(err, "getting or creating user '%s'", u.Username())
+	}
+	if err = m.syncRoles(ctx, u); err != nil {
+		return "", errors.Wrapf(err, "syncing roles for user '%s'", u.Username())
+	}
+	loginToken, err := m.cache.Put(u)
+	if err != nil {
+		return "", errors.Wrapf(err, "caching user '%s'", u.Username())
+	}
+	return loginToken, nil
+}
+
+// makeUser creates a user from the ID token's claims. The user's roles are
+// the ones granted by the role mappings. If the username claim is an email
+// address, the email must be verified and in one of the allowed domains, and
+// the domain is removed from the username only if it's the primary (first)
+// allowed domain.
+func (m *OIDCUserManager) makeUser(claims jwt.MapClaims, tokens *oidcTokenResponse) (gimlet.User, error) {
+	username, _ := claims[m.conf.UsernameClaim].(string)
+	if username == "" {
+		return nil, errors.Errorf("ID token is missing username claim '%s'", m.conf.UsernameClaim)
+	}
+	if emailDomainStart := strings.LastIndex(username, "@"); emailDomainStart != -1 {
+		if !isOIDCEmailVerified(claims) {
+			return nil, errors.Errorf("email '%s' is not verified by the identity provider", username)
+		}
+		domain := username[emailDomainStart+1:]
+		if !m.isAllowedDomain(domain) {
+			return nil, errors.Errorf("email domain '%s' is not allowed", domain)
+		}
+		// Only the primary domain is removed so that users with the same
+		// name in different domains remain different users.
+		if strings.EqualFold(domain, m.conf.AllowedDomains[0]) {
+			username = username[:emailDomainStart]
+		}
+	}
+	name, _ := claims["name"].(string)
+	if name == "" {
+		name = username
+	}
+	email, _ := claims["email"].(string)
+
+	opts, err := gimlet.NewBasicUserOptions(username)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating user options")
+	}
+	return gimlet.NewBasicUser(opts.
+		Name(name).
+		Email(email).
+		AccessToken(tokens.AccessToken).
+		RefreshToken(tokens.RefreshToken).
+		Roles(m.mappedRoles(claims)...),
+	), nil
+}
+
+// isAllowedDomain returns whether users with emails in the domain can log in.
+func (m *OIDCUserManager) isAllowedDomain(domain string) bool {
+	for _, allowed := range m.conf.AllowedDomains {
+		if strings.EqualFold(domain, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// isOIDCEmailVerified returns whether the ID token's email_verified claim is
+// true. Some providers send the claim as a string rather than a boolean.
+func isOIDCEmailVerified(claims jwt.MapClaims) bool {
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		return v
+	case string:
+		return strings.EqualFold(v, "true")
+	default:
+		return false
+	}
+}
+
+// mappedRoles returns the Evergreen roles that the role mappings grant for the
+// values of the roles claim.
+func (m *OIDCUserManager) mappedRoles(claims jwt.MapClaims) []string {
+	var values []string
+	switch v := claims[m.conf.RolesClaim].(type) {
+	case string:
+		values = []string{v}
+	case []any:
+		for _, value := range v {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+
+	roles := []string{}
+	for _, mapping := range m.conf.RoleMappings {
+		if utility.StringSliceContains(values, mapping.ClaimValue) {
+			roles = append(roles, mapping.Roles...)
+		}
+	}
+	return utility.UniqueStrings(roles)
+}
+
+// syncRoles updates the roles of the user in persistent storage to match the
+// roles granted by the role mappings. Roles that are not in any role mapping
+// are left alone, so roles granted within Evergreen are not removed.
+func (m *OIDCUserManager) syncRoles(ctx context.Context, u gimlet.User) error {
+	if len(m.conf.RoleMappings) == 0 {
+		return nil
+	}
+	dbUser, err := user.FindOneByIdContext(ctx, u.Username())
+	if err != nil {
+		return errors.Wrapf(err, "finding user '%s'", u.Username())
+	}
+	if dbUser == nil {
+		return errors.Errorf("user '%s' not found", u.Username())
+	}
+
+	managed := map[string]bool{}
+	for _, mapping := range m.conf.RoleMappings {
+		for _, role := range mapping.Roles {
+			managed[role] = true
+		}
+	}
+	catcher := grip.NewBasicCatcher()
+	for _, role := range append([]string{}, dbUser.Roles()...) {
+		if managed[role] && !utility.StringSliceContains(u.Roles(), role) {
+			catcher.Wrapf(dbUser.RemoveRole(ctx, role), "removing role '%s'", role)
+		}
+	}
+	for _, role := range u.Roles() {
+		catcher.Wrapf(dbUser.AddRole(ctx, role), "adding role '%s'", role)
+	}
+	return catcher.Resolve()
+}
+
+// verifyIDToken validates the ID token's signature against the identity
+// provider's keyset, checks its issuer, audience and expiration and returns
+// its claims. If nonce is set, the ID token's nonce must match it.
+func (m *OIDCUserManager) verifyIDToken(ctx context.Context, rawIDToken, nonce string) (jwt.MapClaims, error) {
+	metadata, err := m.getMetadata(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting provider metadata")
+	}
+
+	claims := jwt.MapClaims{}
+	parser := &jwt.Parser{ValidMethods: oidcSigningMethods}
+	if _, err = parser.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return m.getKey(ctx, kid)
+	}); err != nil {
+		return nil, errors.Wrap(err, "parsing ID token")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.ErrorfWhen(!claims.VerifyIssuer(metadata.Issuer, true), "ID token issuer does not match expected issuer '%s'", metadata.Issuer)
+	catcher.ErrorfWhen(!claims.VerifyAudience(m.conf.ClientID, true), "ID token audience does not include client ID '%s'", m.conf.ClientID)
+	catcher.NewWhen(!claims.VerifyExpiresAt(time.Now().Unix(), true), "ID token is expired or has no expiration")
+	if nonce != "" {
+		tokenNonce, _ := claims["nonce"].(string)
+		catcher.NewWhen(tokenNonce != nonce, "ID token nonce does not match expected nonce")
+	}
+	if catcher.HasErrors() {
+		return nil, catcher.Resolve()
+	}
+	return claims, nil
+}
+
+// getMetadata returns the identity provider's metadata, which is loaded from
+// its discovery document the first time it is needed.
+func (m *OIDCUserManager) getMetadata(ctx context.Context) (*oidcProviderMetadata, error) {
+	m.mu.RLock()
+	metadata := m.metadata
+	m.mu.RUnlock()
+	if metadata != nil {
+		return metadata, nil
+	}
+
+	metadata = &oidcProviderMetadata{}
+	discoveryURL := strings.TrimRight(m.conf.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := m.getJSON(ctx, discoveryURL, metadata); err != nil {
+		return nil, errors.Wrap(err, "getting discovery document")
+	}
+	catcher := grip.NewBasicCatcher()
+	catcher.ErrorfWhen(strings.TrimRight(metadata.Issuer, "/") != strings.TrimRight(m.conf.Issuer, "/"), "discovery document issuer '%s' does not match configured issuer '%s'", metadata.Issuer, m.conf.Issuer)
+	catcher.NewWhen(metadata.AuthorizationEndpoint == "", "discovery document is missing authorization endpoint")
+	catcher.NewWhen(metadata.TokenEndpoint == "", "discovery document is missing token endpoint")
+	catcher.NewWhen(metadata.JWKSURI == "", "discovery document is missing JWKS URI")
+	if catcher.HasErrors() {
+		return nil, errors.Wrap(catcher.Resolve(), "invalid discovery document")
+	}
+
+	m.mu.Lock()
+	m.metadata = metadata
+	m.mu.Unlock()
+	return metadata, nil
+}
+
+func (m *OIDCUserManager) getAuthorizationURL(ctx context.Context) (*url.URL, error) {
+	metadata, err := m.getMetadata(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting provider metadata")
+	}
+	authURL, err := url.Parse(metadata.AuthorizationEndpoint)
+	return authURL, errors.Wrapf(err, "parsing authorization endpoint '%s'", metadata.AuthorizationEndpoint)
+}
+
+// getKey returns the identity provider's public key with the given key ID. If
+// the key is not known, the keyset is reloaded in case the provider has
+// rotated its keys.
+func (m *OIDCUserManager) getKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	m.mu.RLock()
+	key, ok := lookupOIDCKey(m.keys, kid)
+	m.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	metadata, err := m.getMetadata(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting provider metadata")
+	}
+	keyset := oidcKeyset{}
+	if err = m.getJSON(ctx, metadata.JWKSURI, &keyset); err != nil {
+		return nil, errors.Wrap(err, "getting keyset")
+	}
+	keys := map[string]crypto.PublicKey{}
+	for _, jwk := range keyset.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		publicKey, err := jwk.publicKey()
+		if err != nil {
+			grip.Warning(message.WrapError(err, message.Fields{
+				"message": "skipping invalid key in OIDC keyset",
+				"kid":     jwk.Kid,
+				"issuer":  m.conf.Issuer,
+			}))
+			continue
+		}
+		keys[jwk.Kid] = publicKey
+	}
+
+	m.mu.Lock()
+	m.keys = keys
+	m.mu.Unlock()
+
+	key, ok = lookupOIDCKey(keys, kid)
+	if !ok {
+		return nil, errors.Errorf("signing key '%s' not found in keyset", kid)
+	}
+	return key, nil
+}
+
+// lookupOIDCKey returns the key with the given key ID. Tokens without a key ID
+// can only be verified if the keyset has exactly one key.
+func lookupOIDCKey(keys map[string]crypto.PublicKey, kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+	key, ok := keys[kid]
+	return key, ok
+}
+
+// publicKey returns the RSA or EC public key that the JWK represents.
+func (k *oidcJWK) publicKey() (crypto.PublicKey, error) {
+	decode := func(name, value string) (*big.Int, error) {
+		b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+		if err != nil {
+			return nil, errors.Wrapf(err, "decoding parameter '%s'", name)
+		}
+		if len(b) == 0 {
+			return nil, errors.Errorf("parameter '%s' is missing", name)
+		}
+		return new(big.Int).SetBytes(b), nil
+	}
+
+	switch k.Kty {
+	case "RSA":
+		n, err := decode("n", k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode("e", k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > int64(^uint32(0)>>1) {
+			return nil, errors.New("RSA exponent is too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve '%s'", k.Crv)
+		}
+		x, err := decode("x", k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode("y", k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type '%s'", k.Kty)
+	}
+}
+
+// requestTokens makes a request to the identity provider's token endpoint,
+// authenticating with the client credentials.
+func (m *OIDCUserManager) requestTokens(ctx context.Context, params url.Values) (*oidcTokenResponse, error) {
+	metadata, err := m.getMetadata(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting provider metadata")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating token request")
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(m.conf.ClientID), url.QueryEscape(m.conf.ClientSecret))
+
+	client := utility.GetHTTPClient()
+	defer utility.PutHTTPClient(client)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "making token request")
+	}
+	defer resp.Body.Close()
+
+	tokens := &oidcTokenResponse{}
+	if err = json.NewDecoder(resp.Body).Decode(tokens); err != nil {
+		return nil, errors.Wrapf(err, "decoding token response with status code %d", resp.StatusCode)
+	}
+	if tokens.Error != "" {
+		return nil, errors.Errorf("token request failed with status code %d: %s: %s", resp.StatusCode, tokens.Error, tokens.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("token request failed with status code %d", resp.StatusCode)
+	}
+	return tokens, nil
+}
+
+func (m *OIDCUserManager) getJSON(ctx context.Context, u string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	client := utility.GetHTTPClient()
+	defer utility.PutHTTPClient(client)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "making request to '%s'", u)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("request to '%s' failed with status code %d", u, resp.StatusCode)
+	}
+	return errors.Wrapf(json.NewDecoder(resp.Body).Decode(out), "decoding response from '%s'", u)
+}
+
+func (m *OIDCUserManager) setTemporaryCookie(w http.ResponseWriter, name, value string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		Domain:   m.loginDomain,
+		Expires:  time.Now().Add(oidcTemporaryCookieTTL),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (m *OIDCUserManager) unsetTemporaryCookie(w http.ResponseWriter, name string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:   name,
+		Value:  "",
+		Path:   "/",
+		Domain: m.loginDomain,
+		MaxAge: -1,
+	})
+}
+
+func (m *OIDCUserManager) writeLoginError(w http.ResponseWriter, r *http.Request, status int, err error) {
+	grip.Error(message.WrapError(err, message.Fields{
+		"message": "OIDC login failed",
+		"issuer":  m.conf.Issuer,
+		"request": gimlet.GetRequestID(r.Context()),
+	}))
+	gimlet.WriteResponse(w, gimlet.MakeTextErrorResponder(gimlet.ErrorResponse{
+		StatusCode: status,
+		Message:    err.Error(),
+	}))
+}
+
+func getOIDCCookie(r *http.Request, name string) (string, error) {
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return "", errors.Wrapf(err, "getting cookie '%s'", name)
+	}
+	if cookie.Value == "" {
+		return "", errors.Errorf("cookie '%s' is empty", name)
+	}
+	return cookie.Value, nil
+}
+
+// getOIDCRedirect returns the page to redirect the user to after logging in.
+// Only paths within Evergreen are allowed so the login can't be used as an
+// open redirect.
+func getOIDCRedirect(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.HasPrefix(redirect, "/\\") {
+		return "/"
+	}
+	return redirect
+}
+
+// newOIDCLoginValues generates the state, nonce and PKCE code verifier for a
+// login.
+func newOIDCLoginValues() (state, nonce, codeVerifier string, err error) {
+	values := make([]string, 3)
+	for i := range values {
+		b := make([]byte, 32)
+		if _, err = rand.Read(b); err != nil {
+			return "", "", "", errors.Wrap(err, "generating random login values")
+		}
+		values[i] = base64.RawURLEncoding.EncodeToString(b)
+	}
+	return values[0], values[1], values[2], nil
+}
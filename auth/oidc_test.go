@@ -0,0 +1,471 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/utility"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOIDCProvider is a stand-in identity provider that serves a discovery
+// document, a keyset and a token endpoint that enforces PKCE.
+type fakeOIDCProvider struct {
+	server       *httptest.Server
+	key          *rsa.PrivateKey
+	clientID     string
+	clientSecret string
+
+	mu sync.Mutex
+	// codes maps authorization codes to the code challenge and nonce of the
+	// login they were issued for.
+	codes  map[string][2]string
+	claims jwt.MapClaims
+}
+
+func newFakeOIDCProvider(t *testing.T) *fakeOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p := &fakeOIDCProvider{
+		key:          key,
+		clientID:     "evergreen",
+		clientSecret: "secret",
+		codes:        map[string][2]string{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{
+			"issuer":                 p.server.URL,
+			"authorization_endpoint": p.server.URL + "/authorize",
+			"token_endpoint":         p.server.URL + "/token",
+			"jwks_uri":               p.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"keys": []map[string]string{{
+				"kid": "key",
+				"kty": "RSA",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", p.handleToken)
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+
+	return p
+}
+
+func (p *fakeOIDCProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	id, secret, ok := r.BasicAuth()
+	if !ok || id != p.clientID || secret != p.clientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var nonce string
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		login, ok := p.codes[r.PostForm.Get("code")]
+		delete(p.codes, r.PostForm.Get("code"))
+		challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if !ok || login[0] != base64.RawURLEncoding.EncodeToString(challenge[:]) {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "PKCE verification failed"})
+			return
+		}
+		nonce = login[1]
+	case "refresh_token":
+		if r.PostForm.Get("refresh_token") != "refresh_token" {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+			return
+		}
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	claims := jwt.MapClaims{
+		"iss": p.server.URL,
+		"aud": p.clientID,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range p.claims {
+		claims[k] = v
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+	writeJSON(w, http.StatusOK, map[string]string{
+		"access_token":  "access_token",
+		"refresh_token": "refresh_token",
+		"id_token":      p.sign(claims, p.key),
+	})
+}
+
+// authorize simulates the user logging in at the authorization endpoint and
+// returns the authorization code.
+func (p *fakeOIDCProvider) authorize(challenge, nonce string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	code := utility.RandomString()
+	p.codes[code] = [2]string{challenge, nonce}
+	return code
+}
+
+func (p *fakeOIDCProvider) setClaims(claims jwt.MapClaims) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.claims = claims
+}
+
+func (p *fakeOIDCProvider) sign(claims jwt.MapClaims, key *rsa.PrivateKey) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "key"
+	signed, err := token.SignedString(key)
+	if err != nil {
+		panic(err)
+	}
+	return signed
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newTestOIDCUserManager(t *testing.T, p *fakeOIDCProvider) *OIDCUserManager {
+	um, err := NewOIDCUserManager(&evergreen.OIDCAuthConfig{
+		Issuer:         p.server.URL,
+		ClientID:       p.clientID,
+		ClientSecret:   p.clientSecret,
+		AllowedDomains: []string{"example.com"},
+		RolesClaim:     "groups",
+		RoleMappings: []evergreen.OIDCRoleMapping{
+			{ClaimValue: "admins", Roles: []string{"superuser"}},
+			{ClaimValue: "developers", Roles: []string{"project_admin", "basic_access"}},
+		},
+	}, "https://evergreen.example.com/", "example.com")
+	require.NoError(t, err)
+	m, ok := um.(*OIDCUserManager)
+	require.True(t, ok)
+	return m
+}
+
+func TestOIDCUserManager(t *testing.T) {
+	require.NoError(t, db.ClearCollections(user.Collection, event.EventCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(user.Collection, event.EventCollection))
+	}()
+
+	p := newFakeOIDCProvider(t)
+	um := newTestOIDCUserManager(t, p)
+
+	// startLogin runs the login handler and simulates the user logging in
+	// with the provider. It returns the callback request that the provider
+	// redirects the user to.
+	startLogin := func(t *testing.T, redirect string) *http.Request {
+		rw := httptest.NewRecorder()
+		um.GetLoginHandler("")(rw, httptest.NewRequest(http.MethodGet, "/login/redirect?redirect="+url.QueryEscape(redirect), nil))
+		resp := rw.Result()
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+
+		location, err := url.Parse(resp.Header.Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, p.server.URL+"/authorize", location.Scheme+"://"+location.Host+location.Path)
+		q := location.Query()
+		assert.Equal(t, p.clientID, q.Get("client_id"))
+		assert.Equal(t, "code", q.Get("response_type"))
+		assert.Equal(t, "openid profile email offline_access", q.Get("scope"))
+		assert.Equal(t, "https://evergreen.example.com/login/redirect/callback", q.Get("redirect_uri"))
+		assert.Equal(t, "S256", q.Get("code_challenge_method"))
+		require.NotEmpty(t, q.Get("code_challenge"))
+		require.NotEmpty(t, q.Get("nonce"))
+
+		code := p.authorize(q.Get("code_challenge"), q.Get("nonce"))
+		callback := httptest.NewRequest(http.MethodGet, "/login/redirect/callback?"+url.Values{"code": {code}, "state": {q.Get("state")}}.Encode(), nil)
+		for _, cookie := range resp.Cookies() {
+			callback.AddCookie(cookie)
+		}
+		return callback
+	}
+
+	t.Run("LoginMapsClaimsToUserAndRoles", func(t *testing.T) {
+		p.setClaims(jwt.MapClaims{"email_verified": true, "email": "annie.black@example.com", "name": "Annie Black", "groups": []string{"developers", "testers"}})
+
+		rw := httptest.NewRecorder()
+		um.GetLoginCallbackHandler()(rw, startLogin(t, "/waterfall/mci"))
+		resp := rw.Result()
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/waterfall/mci", resp.Header.Get("Location"))
+
+		var loginToken string
+		for _, cookie := range resp.Cookies() {
+			if cookie.Name == evergreen.AuthTokenCookie {
+				loginToken = cookie.Value
+			}
+		}
+		require.NotEmpty(t, loginToken)
+
+		u, err := um.GetUserByToken(t.Context(), loginToken)
+		require.NoError(t, err)
+		assert.Equal(t, "annie.black", u.Username())
+		assert.Equal(t, "Annie Black", u.DisplayName())
+		assert.Equal(t, "annie.black@example.com", u.Email())
+		assert.Equal(t, "refresh_token", u.GetRefreshToken())
+		assert.ElementsMatch(t, []string{"project_admin", "basic_access"}, u.Roles())
+	})
+
+	t.Run("ReauthorizeUserSyncsMappedRoles", func(t *testing.T) {
+		dbUser, err := user.FindOneById("annie.black")
+		require.NoError(t, err)
+		require.NotNil(t, dbUser)
+		require.NoError(t, dbUser.AddRole(t.Context(), "manually_granted"))
+
+		p.setClaims(jwt.MapClaims{"email_verified": true, "email": "annie.black@example.com", "name": "Annie Black", "groups": []string{"admins"}})
+		require.NoError(t, um.ReauthorizeUser(dbUser))
+
+		dbUser, err = user.FindOneById("annie.black")
+		require.NoError(t, err)
+		require.NotNil(t, dbUser)
+		assert.ElementsMatch(t, []string{"superuser", "manually_granted"}, dbUser.Roles(), "unmapped roles should not be removed")
+	})
+
+	t.Run("ReauthorizeUserRejectsDifferentUser", func(t *testing.T) {
+		dbUser, err := user.FindOneById("annie.black")
+		require.NoError(t, err)
+		require.NotNil(t, dbUser)
+
+		p.setClaims(jwt.MapClaims{"email_verified": true, "email": "someone.else@example.com"})
+		assert.Error(t, um.ReauthorizeUser(dbUser))
+	})
+
+	t.Run("CallbackRejectsUnverifiedEmail", func(t *testing.T) {
+		p.setClaims(jwt.MapClaims{"email_verified": false, "email": "annie.black@example.com"})
+		rw := httptest.NewRecorder()
+		um.GetLoginCallbackHandler()(rw, startLogin(t, "/"))
+		assert.Equal(t, http.StatusUnauthorized, rw.Code)
+	})
+
+	t.Run("CallbackRejectsDisallowedDomain", func(t *testing.T) {
+		p.setClaims(jwt.MapClaims{"email_verified": true, "email": "annie.black@attacker.example.org"})
+		rw := httptest.NewRecorder()
+		um.GetLoginCallbackHandler()(rw, startLogin(t, "/"))
+		assert.Equal(t, http.StatusUnauthorized, rw.Code)
+	})
+
+	t.Run("CallbackRejectsMismatchedState", func(t *testing.T) {
+		p.setClaims(jwt.MapClaims{"email_verified": true, "email": "annie.black@example.com"})
+		callback := startLogin(t, "/")
+		q := callback.URL.Query()
+		q.Set("state", "wrong")
+		callback.URL.RawQuery = q.Encode()
+
+		rw := httptest.NewRecorder()
+		um.GetLoginCallbackHandler()(rw, callback)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	t.Run("CallbackRejectsWrongCodeVerifier", func(t *testing.T) {
+		p.setClaims(jwt.MapClaims{"email_verified": true, "email": "annie.black@example.com"})
+		callback := startLogin(t, "/")
+		var cookies []*http.Cookie
+		for _, cookie := range callback.Cookies() {
+			if cookie.Name == oidcCodeVerifierCookieName {
+				cookie.Value = "wrong"
+			}
+			cookies = append(cookies, cookie)
+		}
+		callback.Header.Del("Cookie")
+		for _, cookie := range cookies {
+			callback.AddCookie(cookie)
+		}
+
+		rw := httptest.NewRecorder()
+		um.GetLoginCallbackHandler()(rw, callback)
+		assert.Equal(t, http.StatusUnauthorized, rw.Code)
+	})
+
+	t.Run("CallbackOnlyRedirectsWithinEvergreen", func(t *testing.T) {
+		p.setClaims(jwt.MapClaims{"email_verified": true, "email": "annie.black@example.com"})
+		rw := httptest.NewRecorder()
+		um.GetLoginCallbackHandler()(rw, startLogin(t, "//attacker.example.com"))
+		require.Equal(t, http.StatusFound, rw.Code)
+		assert.Equal(t, "/", rw.Header().Get("Location"))
+	})
+}
+
+func TestOIDCMakeUser(t *testing.T) {
+	p := newFakeOIDCProvider(t)
+	um := newTestOIDCUserManager(t, p)
+	tokens := &oidcTokenResponse{AccessToken: "access_token", RefreshToken: "refresh_token"}
+
+	for tName, tCase := range map[string]struct {
+		claims           jwt.MapClaims
+		expectedUsername string
+		errorContains    string
+	}{
+		"VerifiedEmailInAllowedDomain": {
+			claims:           jwt.MapClaims{"email": "annie.black@example.com", "email_verified": true},
+			expectedUsername: "annie.black",
+		},
+		"VerifiedAsStringAndDomainCaseInsensitive": {
+			claims:           jwt.MapClaims{"email": "annie.black@EXAMPLE.com", "email_verified": "true"},
+			expectedUsername: "annie.black",
+		},
+		"UnverifiedEmail": {
+			claims:        jwt.MapClaims{"email": "annie.black@example.com", "email_verified": false},
+			errorContains: "is not verified",
+		},
+		"MissingEmailVerified": {
+			claims:        jwt.MapClaims{"email": "annie.black@example.com"},
+			errorContains: "is not verified",
+		},
+		"DomainNotAllowed": {
+			claims:        jwt.MapClaims{"email": "annie.black@attacker.example.org", "email_verified": true},
+			errorContains: "email domain 'attacker.example.org' is not allowed",
+		},
+		"SubdomainNotAllowed": {
+			claims:        jwt.MapClaims{"email": "annie.black@evil.example.com", "email_verified": true},
+			errorContains: "is not allowed",
+		},
+		"MissingUsernameClaim": {
+			claims:        jwt.MapClaims{"name": "Annie Black"},
+			errorContains: "missing username claim",
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			u, err := um.makeUser(tCase.claims, tokens)
+			if tCase.errorContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tCase.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tCase.expectedUsername, u.Username())
+		})
+	}
+
+	t.Run("UsernameWithoutDomainDoesNotRequireVerifiedEmail", func(t *testing.T) {
+		m := &OIDCUserManager{conf: evergreen.OIDCAuthConfig{UsernameClaim: "preferred_username"}}
+		u, err := m.makeUser(jwt.MapClaims{"preferred_username": "annie.black"}, tokens)
+		require.NoError(t, err)
+		assert.Equal(t, "annie.black", u.Username())
+	})
+
+	t.Run("OnlyPrimaryDomainIsRemoved", func(t *testing.T) {
+		m := &OIDCUserManager{conf: evergreen.OIDCAuthConfig{
+			UsernameClaim:  "email",
+			AllowedDomains: []string{"example.com", "example.org"},
+		}}
+		primary, err := m.makeUser(jwt.MapClaims{"email": "annie.black@example.com", "email_verified": true}, tokens)
+		require.NoError(t, err)
+		assert.Equal(t, "annie.black", primary.Username())
+
+		secondary, err := m.makeUser(jwt.MapClaims{"email": "annie.black@example.org", "email_verified": true}, tokens)
+		require.NoError(t, err)
+		assert.Equal(t, "annie.black@example.org", secondary.Username())
+		assert.NotEqual(t, primary.Username(), secondary.Username(), "users in different domains should not have the same username")
+	})
+
+	t.Run("NoAllowedDomainsRejectsEmails", func(t *testing.T) {
+		m := &OIDCUserManager{conf: evergreen.OIDCAuthConfig{UsernameClaim: "email"}}
+		_, err := m.makeUser(jwt.MapClaims{"email": "annie.black@example.com", "email_verified": true}, tokens)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not allowed")
+	})
+}
+
+func TestOIDCVerifyIDToken(t *testing.T) {
+	p := newFakeOIDCProvider(t)
+	um := newTestOIDCUserManager(t, p)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":   p.server.URL,
+			"aud":   p.clientID,
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"nonce": "nonce",
+			"email": "annie.black@example.com",
+		}
+	}
+	for tName, tCase := range map[string]struct {
+		modify func(jwt.MapClaims)
+		key    *rsa.PrivateKey
+		valid  bool
+	}{
+		"Valid": {
+			valid: true,
+		},
+		"ValidWithMultipleAudiences": {
+			modify: func(c jwt.MapClaims) { c["aud"] = []string{"other", p.clientID} },
+			valid:  true,
+		},
+		"WrongIssuer": {
+			modify: func(c jwt.MapClaims) { c["iss"] = "https://other.example.com" },
+		},
+		"WrongAudience": {
+			modify: func(c jwt.MapClaims) { c["aud"] = "other" },
+		},
+		"Expired": {
+			modify: func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+		},
+		"MissingExpiration": {
+			modify: func(c jwt.MapClaims) { delete(c, "exp") },
+		},
+		"WrongNonce": {
+			modify: func(c jwt.MapClaims) { c["nonce"] = "other" },
+		},
+		"SignedByUnknownKey": {
+			key: otherKey,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			claims := validClaims()
+			if tCase.modify != nil {
+				tCase.modify(claims)
+			}
+			key := p.key
+			if tCase.key != nil {
+				key = tCase.key
+			}
+
+			verified, err := um.verifyIDToken(t.Context(), p.sign(claims, key), "nonce")
+			if !tCase.valid {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "annie.black@example.com", verified["email"])
+		})
+	}
+}
@@ -129,6 +129,8 @@ func GetSettings(provider string) (ProviderSettings, error) {
 		return &MockProviderSettings{}, nil
 	case evergreen.ProviderNameDocker, evergreen.ProviderNameDockerMock:
 		return &dockerSettings{}, nil
+	case evergreen.ProviderNameKubernetes:
+		return &KubernetesSettings{}, nil
 	}
 	return nil, errors.Errorf("invalid provider name '%s'", provider)
 }
@@ -165,6 +167,8 @@ func GetManager(ctx context.Context, env evergreen.Environment, mgrOpts ManagerO
 		provider = &dockerManager{env: env}
 	case evergreen.ProviderNameDockerMock:
 		provider = &dockerManager{env: env, client: &dockerClientMock{}}
+	case evergreen.ProviderNameKubernetes:
+		provider = &kubernetesManager{env: env}
 	default:
 		return nil, errors.Errorf("no known provider '%s'", mgrOpts.Provider)
 	}
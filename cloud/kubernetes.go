@@ -0,0 +1,426 @@
+package cloud
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// kubernetesDefaultNamespace is the namespace used for pods if neither
+	// the distro nor the admin settings specify one.
+	kubernetesDefaultNamespace = "default"
+	// kubernetesAgentContainerName is the name of the container in the pod
+	// that runs the agent.
+	kubernetesAgentContainerName = "evergreen-agent"
+	// kubernetesManagedByLabel is the label set on every pod that Evergreen
+	// creates so that they can be listed in batches.
+	kubernetesManagedByLabel = "app.kubernetes.io/managed-by"
+	kubernetesManagedByValue = "evergreen"
+	// kubernetesMaxNameLength is the maximum length of a pod name that can
+	// also be used as a DNS label.
+	kubernetesMaxNameLength = 63
+)
+
+var (
+	kubernetesInvalidNameChars = regexp.MustCompile("[^a-z0-9-]+")
+	kubernetesNameRegexp       = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+)
+
+// KubernetesSettings are the distro-level settings for hosts that run as
+// Kubernetes pods.
+type KubernetesSettings struct {
+	// Image is the container image that runs the agent. It must have bash and
+	// curl available.
+	Image string `bson:"image" json:"image" mapstructure:"image"`
+	// Namespace is the namespace to create pods in. If empty, the namespace
+	// from the admin settings is used.
+	Namespace string `bson:"namespace,omitempty" json:"namespace,omitempty" mapstructure:"namespace,omitempty"`
+	// ServiceAccount is the name of the service account the pod runs as.
+	ServiceAccount string `bson:"service_account,omitempty" json:"service_account,omitempty" mapstructure:"service_account,omitempty"`
+	// CPU is the amount of CPU to request for the container in Kubernetes
+	// quantity notation (e.g. "2" or "500m").
+	CPU string `bson:"cpu,omitempty" json:"cpu,omitempty" mapstructure:"cpu,omitempty"`
+	// Memory is the amount of memory to request for the container in
+	// Kubernetes quantity notation (e.g. "4Gi").
+	Memory string `bson:"memory,omitempty" json:"memory,omitempty" mapstructure:"memory,omitempty"`
+	// NodeSelector constrains the nodes that pods can be scheduled on.
+	NodeSelector map[string]string `bson:"node_selector,omitempty" json:"node_selector,omitempty" mapstructure:"node_selector,omitempty"`
+	// ImagePullSecrets are the names of secrets used to pull the image.
+	ImagePullSecrets []string `bson:"image_pull_secrets,omitempty" json:"image_pull_secrets,omitempty" mapstructure:"image_pull_secrets,omitempty"`
+}
+
+// Validate checks that the settings from the distro are valid.
+func (s *KubernetesSettings) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(s.Image == "", "image must not be empty")
+	catcher.ErrorfWhen(s.Namespace != "" && !kubernetesNameRegexp.MatchString(s.Namespace), "namespace '%s' is not a valid Kubernetes name", s.Namespace)
+	catcher.ErrorfWhen(s.ServiceAccount != "" && !kubernetesNameRegexp.MatchString(s.ServiceAccount), "service account '%s' is not a valid Kubernetes name", s.ServiceAccount)
+	for _, secret := range s.ImagePullSecrets {
+		catcher.NewWhen(secret == "", "image pull secret name must not be empty")
+	}
+	return catcher.Resolve()
+}
+
+// FromDistroSettings loads the Kubernetes settings from the distro's provider
+// settings.
+func (s *KubernetesSettings) FromDistroSettings(d distro.Distro, _ string) error {
+	if len(d.ProviderSettingsList) != 0 {
+		bytes, err := d.ProviderSettingsList[0].MarshalBSON()
+		if err != nil {
+			return errors.Wrap(err, "marshalling provider setting into BSON")
+		}
+		if err := bson.Unmarshal(bytes, s); err != nil {
+			return errors.Wrap(err, "unmarshalling BSON into provider settings")
+		}
+	}
+	return nil
+}
+
+// kubernetesManager implements the Manager interface for hosts that run as
+// pods in a Kubernetes cluster.
+type kubernetesManager struct {
+	client           kubernetesClient
+	env              evergreen.Environment
+	defaultNamespace string
+}
+
+// Configure populates a kubernetesManager by reading relevant settings from
+// the config object.
+func (m *kubernetesManager) Configure(ctx context.Context, s *evergreen.Settings) error {
+	if m.env == nil {
+		return errors.New("Kubernetes manager requires a non-nil Evergreen environment")
+	}
+
+	config := s.Providers.Kubernetes
+	if m.client == nil {
+		client, err := newKubernetesClient(config)
+		if err != nil {
+			return errors.Wrap(err, "initializing Kubernetes client")
+		}
+		m.client = client
+	}
+
+	m.defaultNamespace = config.Namespace
+	if m.defaultNamespace == "" {
+		m.defaultNamespace = kubernetesDefaultNamespace
+	}
+
+	return nil
+}
+
+// getSettings returns the validated Kubernetes settings for the host's
+// distro with the namespace resolved.
+func (m *kubernetesManager) getSettings(h *host.Host) (*KubernetesSettings, error) {
+	s := &KubernetesSettings{}
+	if err := s.FromDistroSettings(h.Distro, ""); err != nil {
+		return nil, errors.Wrapf(err, "getting Kubernetes settings for distro '%s'", h.Distro.Id)
+	}
+	if err := s.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid Kubernetes settings in distro '%s'", h.Distro.Id)
+	}
+	if s.Namespace == "" {
+		s.Namespace = m.defaultNamespace
+	}
+	return s, nil
+}
+
+// SpawnHost creates a pod that downloads and runs the agent. The pod is not
+// yet running when this returns; its state is polled like any other cloud
+// host.
+func (m *kubernetesManager) SpawnHost(ctx context.Context, h *host.Host) (*host.Host, error) {
+	if h.Distro.Provider != evergreen.ProviderNameKubernetes {
+		return nil, errors.Errorf("can't spawn pod for distro '%s': distro provider is '%s'", h.Distro.Id, h.Distro.Provider)
+	}
+
+	settings, err := m.getSettings(h)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// The pod name becomes the host ID, so the intent host's ID has to be
+	// converted into a valid Kubernetes name before it's used by the agent.
+	h.Id = kubernetesPodName(h.Id)
+	if h.Secret == "" {
+		h.Secret = utility.RandomString()
+	}
+
+	pod, err := m.makePod(h, settings)
+	if err != nil {
+		return nil, errors.Wrapf(err, "making pod for host '%s'", h.Id)
+	}
+	if _, err = m.client.CreatePod(ctx, pod); err != nil {
+		grip.Info(message.WrapError(err, message.Fields{
+			"message":   "spawn pod host failed",
+			"host_id":   h.Id,
+			"distro":    h.Distro.Id,
+			"namespace": settings.Namespace,
+		}))
+		return nil, errors.Wrapf(err, "creating pod for host '%s'", h.Id)
+	}
+
+	// The pod starts the agent itself, so it's always running the current
+	// agent revision.
+	h.AgentRevision = evergreen.AgentVersion
+
+	grip.Info(message.Fields{
+		"message":   "created Kubernetes pod",
+		"host_id":   h.Id,
+		"distro":    h.Distro.Id,
+		"namespace": settings.Namespace,
+	})
+
+	return h, nil
+}
+
+// makePod returns the pod definition for a host. The pod's only container
+// downloads the Evergreen binary and starts the agent.
+func (m *kubernetesManager) makePod(h *host.Host, settings *KubernetesSettings) (*kubernetesPod, error) {
+	fetchClient, err := h.CurlCommandWithDefaultRetry(m.env)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting command to fetch the Evergreen binary")
+	}
+	agentCmd := strings.Join(h.AgentCommand(m.env.Settings(), ""), " ")
+
+	agentEnv := h.AgentEnv()
+	env := make([]kubernetesEnvVar, 0, len(agentEnv))
+	for k, v := range agentEnv {
+		env = append(env, kubernetesEnvVar{Name: k, Value: v})
+	}
+	sort.Slice(env, func(i, j int) bool { return env[i].Name < env[j].Name })
+
+	container := kubernetesContainer{
+		Name:    kubernetesAgentContainerName,
+		Image:   settings.Image,
+		Command: []string{"bash", "-c", fetchClient + " && " + agentCmd},
+		Env:     env,
+	}
+	if settings.CPU != "" || settings.Memory != "" {
+		resources := map[string]string{}
+		if settings.CPU != "" {
+			resources["cpu"] = settings.CPU
+		}
+		if settings.Memory != "" {
+			resources["memory"] = settings.Memory
+		}
+		container.Resources = kubernetesResourceRequirement{Requests: resources, Limits: resources}
+	}
+
+	pod := &kubernetesPod{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Metadata: kubernetesObjectMeta{
+			Name:      h.Id,
+			Namespace: settings.Namespace,
+			Labels:    map[string]string{kubernetesManagedByLabel: kubernetesManagedByValue},
+		},
+		Spec: kubernetesPodSpec{
+			Containers: []kubernetesContainer{container},
+			// The agent exits when the host should be torn down, so the pod
+			// must not restart it.
+			RestartPolicy:      "Never",
+			ServiceAccountName: settings.ServiceAccount,
+			NodeSelector:       settings.NodeSelector,
+		},
+	}
+	for _, secret := range settings.ImagePullSecrets {
+		pod.Spec.ImagePullSecrets = append(pod.Spec.ImagePullSecrets, kubernetesLocalObjectName{Name: secret})
+	}
+
+	return pod, nil
+}
+
+// kubernetesPodName converts a host ID into a valid pod name. Pod names must
+// be lowercase alphanumeric DNS labels, so invalid characters are replaced
+// and the end of the ID, which holds its unique suffix, is kept if the ID is
+// too long.
+func kubernetesPodName(id string) string {
+	name := kubernetesInvalidNameChars.ReplaceAllString(strings.ToLower(id), "-")
+	if len(name) > kubernetesMaxNameLength {
+		name = name[len(name)-kubernetesMaxNameLength:]
+	}
+	return strings.Trim(name, "-")
+}
+
+// podStatusToCloudStatus converts the state of a pod into a cloud status.
+func podStatusToCloudStatus(pod *kubernetesPod) CloudStatus {
+	if pod.Metadata.DeletionTimestamp != nil {
+		return StatusTerminated
+	}
+	switch pod.Status.Phase {
+	case "Pending":
+		return StatusInitializing
+	case "Running":
+		return StatusRunning
+	case "Succeeded":
+		return StatusTerminated
+	case "Failed":
+		return StatusFailed
+	default:
+		return StatusUnknown
+	}
+}
+
+// GetInstanceState returns the state of the host's pod.
+func (m *kubernetesManager) GetInstanceState(ctx context.Context, h *host.Host) (CloudInstanceState, error) {
+	info := CloudInstanceState{Status: StatusUnknown}
+	settings, err := m.getSettings(h)
+	if err != nil {
+		return info, errors.WithStack(err)
+	}
+
+	pod, err := m.client.GetPod(ctx, settings.Namespace, h.Id)
+	if err != nil {
+		if isKubernetesNotFound(err) {
+			info.Status = StatusNonExistent
+			return info, nil
+		}
+		return info, errors.Wrapf(err, "getting pod for host '%s'", h.Id)
+	}
+
+	info.Status = podStatusToCloudStatus(pod)
+	if pod.Status.Message != "" {
+		info.StateReason = pod.Status.Message
+	} else {
+		info.StateReason = pod.Status.Reason
+	}
+	return info, nil
+}
+
+// GetInstanceStatuses returns the states of the hosts' pods, listing all the
+// pods managed by Evergreen once per namespace.
+func (m *kubernetesManager) GetInstanceStatuses(ctx context.Context, hosts []host.Host) (map[string]CloudStatus, error) {
+	hostIDsByNamespace := map[string][]string{}
+	for i := range hosts {
+		settings, err := m.getSettings(&hosts[i])
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		hostIDsByNamespace[settings.Namespace] = append(hostIDsByNamespace[settings.Namespace], hosts[i].Id)
+	}
+
+	statuses := make(map[string]CloudStatus, len(hosts))
+	for namespace, hostIDs := range hostIDsByNamespace {
+		pods, err := m.client.ListPods(ctx, namespace, kubernetesManagedByLabel+"="+kubernetesManagedByValue)
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing pods in namespace '%s'", namespace)
+		}
+		podsByName := make(map[string]*kubernetesPod, len(pods))
+		for i := range pods {
+			podsByName[pods[i].Metadata.Name] = &pods[i]
+		}
+		for _, id := range hostIDs {
+			pod, ok := podsByName[id]
+			if !ok {
+				statuses[id] = StatusNonExistent
+				continue
+			}
+			statuses[id] = podStatusToCloudStatus(pod)
+		}
+	}
+
+	return statuses, nil
+}
+
+// GetDNSName returns the pod's IP address, which is only available once the
+// pod has been scheduled.
+func (m *kubernetesManager) GetDNSName(ctx context.Context, h *host.Host) (string, error) {
+	settings, err := m.getSettings(h)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	pod, err := m.client.GetPod(ctx, settings.Namespace, h.Id)
+	if err != nil {
+		return "", errors.Wrapf(err, "getting pod for host '%s'", h.Id)
+	}
+	return pod.Status.PodIP, nil
+}
+
+// TerminateInstance deletes the host's pod.
+func (m *kubernetesManager) TerminateInstance(ctx context.Context, h *host.Host, user, reason string) error {
+	if h.Status == evergreen.HostTerminated {
+		return errors.Errorf("cannot terminate host '%s' because it's already marked as terminated", h.Id)
+	}
+
+	settings, err := m.getSettings(h)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err = m.client.DeletePod(ctx, settings.Namespace, h.Id); err != nil && !isKubernetesNotFound(err) {
+		return errors.Wrapf(err, "deleting pod for host '%s'", h.Id)
+	}
+
+	grip.Info(message.Fields{
+		"message":   "terminated Kubernetes pod",
+		"host_id":   h.Id,
+		"namespace": settings.Namespace,
+	})
+
+	return h.Terminate(ctx, user, reason)
+}
+
+func (m *kubernetesManager) ModifyHost(context.Context, *host.Host, host.HostModifyOptions) error {
+	return errors.New("can't modify instances with Kubernetes provider")
+}
+
+func (m *kubernetesManager) SetPortMappings(context.Context, *host.Host, *host.Host) error {
+	return errors.New("can't set port mappings with Kubernetes provider")
+}
+
+func (m *kubernetesManager) StopInstance(context.Context, *host.Host, bool, string) error {
+	return errors.New("StopInstance is not supported for Kubernetes provider")
+}
+
+func (m *kubernetesManager) StartInstance(context.Context, *host.Host, string) error {
+	return errors.New("StartInstance is not supported for Kubernetes provider")
+}
+
+func (m *kubernetesManager) AttachVolume(context.Context, *host.Host, *host.VolumeAttachment) error {
+	return errors.New("can't attach volume with Kubernetes provider")
+}
+
+func (m *kubernetesManager) DetachVolume(context.Context, *host.Host, string) error {
+	return errors.New("can't detach volume with Kubernetes provider")
+}
+
+func (m *kubernetesManager) CreateVolume(context.Context, *host.Volume) (*host.Volume, error) {
+	return nil, errors.New("can't create volume with Kubernetes provider")
+}
+
+func (m *kubernetesManager) DeleteVolume(context.Context, *host.Volume) error {
+	return errors.New("can't delete volume with Kubernetes provider")
+}
+
+func (m *kubernetesManager) ModifyVolume(context.Context, *host.Volume, *model.VolumeModifyOptions) error {
+	return errors.New("can't modify volume with Kubernetes provider")
+}
+
+func (m *kubernetesManager) GetVolumeAttachment(context.Context, string) (*VolumeAttachment, error) {
+	return nil, errors.New("can't get volume attachment with Kubernetes provider")
+}
+
+func (m *kubernetesManager) CheckInstanceType(context.Context, string) error {
+	return errors.New("can't specify instance type with Kubernetes provider")
+}
+
+// TimeTilNextPayment returns zero because pods are not billed by the hour.
+func (m *kubernetesManager) TimeTilNextPayment(*host.Host) time.Duration {
+	return 0
+}
+
+// Cleanup is a noop for the Kubernetes provider.
+func (m *kubernetesManager) Cleanup(context.Context) error {
+	return nil
+}
@@ -0,0 +1,230 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/pkg/errors"
+)
+
+// kubernetesClient wraps the subset of the Kubernetes API used to manage
+// pods for Kubernetes hosts.
+type kubernetesClient interface {
+	// CreatePod creates the pod and returns the pod as stored by the API
+	// server.
+	CreatePod(ctx context.Context, pod *kubernetesPod) (*kubernetesPod, error)
+	// GetPod returns the pod with the given name.
+	GetPod(ctx context.Context, namespace, name string) (*kubernetesPod, error)
+	// DeletePod deletes the pod with the given name.
+	DeletePod(ctx context.Context, namespace, name string) error
+	// ListPods returns all pods in the namespace matching the label selector.
+	ListPods(ctx context.Context, namespace, labelSelector string) ([]kubernetesPod, error)
+}
+
+// kubernetesPod is the subset of the Kubernetes pod resource that Evergreen
+// reads and writes.
+type kubernetesPod struct {
+	APIVersion string               `json:"apiVersion,omitempty"`
+	Kind       string               `json:"kind,omitempty"`
+	Metadata   kubernetesObjectMeta `json:"metadata"`
+	Spec       kubernetesPodSpec    `json:"spec"`
+	Status     kubernetesPodStatus  `json:"status"`
+}
+
+type kubernetesObjectMeta struct {
+	Name              string            `json:"name"`
+	Namespace         string            `json:"namespace,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
+	CreationTimestamp *time.Time        `json:"creationTimestamp,omitempty"`
+	DeletionTimestamp *time.Time        `json:"deletionTimestamp,omitempty"`
+}
+
+type kubernetesPodSpec struct {
+	Containers         []kubernetesContainer       `json:"containers"`
+	RestartPolicy      string                      `json:"restartPolicy,omitempty"`
+	ServiceAccountName string                      `json:"serviceAccountName,omitempty"`
+	NodeSelector       map[string]string           `json:"nodeSelector,omitempty"`
+	ImagePullSecrets   []kubernetesLocalObjectName `json:"imagePullSecrets,omitempty"`
+}
+
+type kubernetesLocalObjectName struct {
+	Name string `json:"name"`
+}
+
+type kubernetesContainer struct {
+	Name       string                        `json:"name"`
+	Image      string                        `json:"image"`
+	Command    []string                      `json:"command,omitempty"`
+	Env        []kubernetesEnvVar            `json:"env,omitempty"`
+	WorkingDir string                        `json:"workingDir,omitempty"`
+	Resources  kubernetesResourceRequirement `json:"resources"`
+}
+
+type kubernetesEnvVar struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type kubernetesResourceRequirement struct {
+	Requests map[string]string `json:"requests,omitempty"`
+	Limits   map[string]string `json:"limits,omitempty"`
+}
+
+type kubernetesPodStatus struct {
+	Phase   string `json:"phase,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+	PodIP   string `json:"podIP,omitempty"`
+}
+
+type kubernetesPodList struct {
+	Items []kubernetesPod `json:"items"`
+}
+
+// kubernetesStatus is the error body returned by the Kubernetes API.
+type kubernetesStatus struct {
+	Message string `json:"message"`
+	Reason  string `json:"reason"`
+	Code    int    `json:"code"`
+}
+
+// kubernetesAPIError is returned when the Kubernetes API responds with an
+// unsuccessful status code.
+type kubernetesAPIError struct {
+	StatusCode int
+	Reason     string
+	Message    string
+}
+
+func (e *kubernetesAPIError) Error() string {
+	return fmt.Sprintf("Kubernetes API returned status %d (%s): %s", e.StatusCode, e.Reason, e.Message)
+}
+
+// isKubernetesNotFound returns whether the error indicates that the requested
+// Kubernetes resource does not exist.
+func isKubernetesNotFound(err error) bool {
+	apiErr, ok := errors.Cause(err).(*kubernetesAPIError)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+type kubernetesClientImpl struct {
+	baseURL     string
+	bearerToken string
+	httpClient  *http.Client
+}
+
+// newKubernetesClient returns a client that talks to the API server in the
+// given config.
+func newKubernetesClient(config evergreen.KubernetesConfig) (*kubernetesClientImpl, error) {
+	if config.APIServerURL == "" {
+		return nil, errors.New("Kubernetes API server URL must be configured")
+	}
+	if _, err := url.Parse(config.APIServerURL); err != nil {
+		return nil, errors.Wrap(err, "parsing Kubernetes API server URL")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.CACert)) {
+			return nil, errors.New("Kubernetes CA certificate is not valid PEM")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	return &kubernetesClientImpl{
+		baseURL:     strings.TrimSuffix(config.APIServerURL, "/"),
+		bearerToken: config.BearerToken,
+		httpClient:  &http.Client{Transport: transport, Timeout: time.Minute},
+	}, nil
+}
+
+func (c *kubernetesClientImpl) podsURL(namespace string) string {
+	return fmt.Sprintf("%s/api/v1/namespaces/%s/pods", c.baseURL, url.PathEscape(namespace))
+}
+
+func (c *kubernetesClientImpl) CreatePod(ctx context.Context, pod *kubernetesPod) (*kubernetesPod, error) {
+	created := &kubernetesPod{}
+	if err := c.do(ctx, http.MethodPost, c.podsURL(pod.Metadata.Namespace), pod, created); err != nil {
+		return nil, errors.Wrapf(err, "creating pod '%s'", pod.Metadata.Name)
+	}
+	return created, nil
+}
+
+func (c *kubernetesClientImpl) GetPod(ctx context.Context, namespace, name string) (*kubernetesPod, error) {
+	pod := &kubernetesPod{}
+	if err := c.do(ctx, http.MethodGet, c.podsURL(namespace)+"/"+url.PathEscape(name), nil, pod); err != nil {
+		return nil, errors.Wrapf(err, "getting pod '%s'", name)
+	}
+	return pod, nil
+}
+
+func (c *kubernetesClientImpl) DeletePod(ctx context.Context, namespace, name string) error {
+	return errors.Wrapf(c.do(ctx, http.MethodDelete, c.podsURL(namespace)+"/"+url.PathEscape(name), nil, nil), "deleting pod '%s'", name)
+}
+
+func (c *kubernetesClientImpl) ListPods(ctx context.Context, namespace, labelSelector string) ([]kubernetesPod, error) {
+	list := &kubernetesPodList{}
+	listURL := c.podsURL(namespace) + "?" + url.Values{"labelSelector": []string{labelSelector}}.Encode()
+	if err := c.do(ctx, http.MethodGet, listURL, nil, list); err != nil {
+		return nil, errors.Wrapf(err, "listing pods in namespace '%s'", namespace)
+	}
+	return list.Items, nil
+}
+
+// do makes a request to the Kubernetes API, encoding the input as the JSON
+// request body and decoding the JSON response body into out if they are
+// non-nil.
+func (c *kubernetesClientImpl) do(ctx context.Context, method, requestURL string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return errors.Wrap(err, "marshalling request body")
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, body)
+	if err != nil {
+		return errors.Wrap(err, "creating request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.bearerToken)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "making request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &kubernetesAPIError{StatusCode: resp.StatusCode}
+		status := kubernetesStatus{}
+		if err = json.NewDecoder(resp.Body).Decode(&status); err == nil {
+			apiErr.Reason = status.Reason
+			apiErr.Message = status.Message
+		}
+		return apiErr
+	}
+
+	if out == nil {
+		return nil
+	}
+	return errors.Wrap(json.NewDecoder(resp.Body).Decode(out), "decoding response body")
+}
@@ -0,0 +1,337 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/birch"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/mock"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeKubernetesAPI is a minimal in-memory Kubernetes API server that
+// supports creating, getting, listing, and deleting pods.
+type fakeKubernetesAPI struct {
+	mu    sync.Mutex
+	pods  map[string]kubernetesPod
+	token string
+}
+
+func newFakeKubernetesAPI(token string) *fakeKubernetesAPI {
+	return &fakeKubernetesAPI{pods: map[string]kubernetesPod{}, token: token}
+}
+
+func (f *fakeKubernetesAPI) setPhase(namespace, name, phase string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	pod := f.pods[namespace+"/"+name]
+	pod.Status.Phase = phase
+	f.pods[namespace+"/"+name] = pod
+}
+
+func (f *fakeKubernetesAPI) writeStatus(w http.ResponseWriter, code int, reason string) {
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(kubernetesStatus{Code: code, Reason: reason, Message: reason})
+}
+
+func (f *fakeKubernetesAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+f.token {
+		f.writeStatus(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	// Paths have the form /api/v1/namespaces/{namespace}/pods[/{name}].
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/namespaces/"), "/")
+	if len(parts) < 2 || parts[1] != "pods" {
+		f.writeStatus(w, http.StatusNotFound, "NotFound")
+		return
+	}
+	namespace := parts[0]
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(parts) == 2 {
+		switch r.Method {
+		case http.MethodPost:
+			pod := kubernetesPod{}
+			if err := json.NewDecoder(r.Body).Decode(&pod); err != nil {
+				f.writeStatus(w, http.StatusBadRequest, "BadRequest")
+				return
+			}
+			key := namespace + "/" + pod.Metadata.Name
+			if _, ok := f.pods[key]; ok {
+				f.writeStatus(w, http.StatusConflict, "AlreadyExists")
+				return
+			}
+			pod.Metadata.Namespace = namespace
+			pod.Status.Phase = "Pending"
+			f.pods[key] = pod
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(pod)
+		case http.MethodGet:
+			label := strings.SplitN(r.URL.Query().Get("labelSelector"), "=", 2)
+			list := kubernetesPodList{}
+			for _, pod := range f.pods {
+				if pod.Metadata.Namespace == namespace && len(label) == 2 && pod.Metadata.Labels[label[0]] == label[1] {
+					list.Items = append(list.Items, pod)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(list)
+		default:
+			f.writeStatus(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+		}
+		return
+	}
+
+	key := namespace + "/" + parts[2]
+	pod, ok := f.pods[key]
+	if !ok {
+		f.writeStatus(w, http.StatusNotFound, "NotFound")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		_ = json.NewEncoder(w).Encode(pod)
+	case http.MethodDelete:
+		delete(f.pods, key)
+		_ = json.NewEncoder(w).Encode(pod)
+	default:
+		f.writeStatus(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func TestKubernetesManager(t *testing.T) {
+	const token = "token"
+
+	makeHost := func(id string, settings *birch.Document) *host.Host {
+		return &host.Host{
+			Id:     id,
+			Status: evergreen.HostUninitialized,
+			Distro: distro.Distro{
+				Id:                   "k8s_distro",
+				Provider:             evergreen.ProviderNameKubernetes,
+				Arch:                 evergreen.ArchLinuxAmd64,
+				User:                 "root",
+				WorkDir:              "/data/mci",
+				ProviderSettingsList: []*birch.Document{settings},
+			},
+		}
+	}
+	defaultSettings := func() *birch.Document {
+		return birch.NewDocument(
+			birch.EC.String("image", "evergreen/agent:latest"),
+			birch.EC.String("cpu", "2"),
+			birch.EC.String("memory", "4Gi"),
+		)
+	}
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, m *kubernetesManager, api *fakeKubernetesAPI){
+		"SpawnHostCreatesPod": func(ctx context.Context, t *testing.T, m *kubernetesManager, api *fakeKubernetesAPI) {
+			h := makeHost("evg-k8s_distro-20240101000000-1234", defaultSettings())
+			_, err := m.SpawnHost(ctx, h)
+			require.NoError(t, err)
+
+			assert.Equal(t, "evg-k8s-distro-20240101000000-1234", h.Id)
+			assert.NotEmpty(t, h.Secret)
+			assert.Equal(t, evergreen.AgentVersion, h.AgentRevision)
+
+			require.Contains(t, api.pods, "evergreen/"+h.Id)
+			pod := api.pods["evergreen/"+h.Id]
+			assert.Equal(t, kubernetesManagedByValue, pod.Metadata.Labels[kubernetesManagedByLabel])
+			assert.Equal(t, "Never", pod.Spec.RestartPolicy)
+			require.Len(t, pod.Spec.Containers, 1)
+			container := pod.Spec.Containers[0]
+			assert.Equal(t, "evergreen/agent:latest", container.Image)
+			assert.Equal(t, "2", container.Resources.Requests["cpu"])
+			assert.Equal(t, "4Gi", container.Resources.Limits["memory"])
+			require.Len(t, container.Command, 3)
+			assert.Contains(t, container.Command[2], "curl")
+			assert.Contains(t, container.Command[2], "agent")
+			assert.Contains(t, container.Env, kubernetesEnvVar{Name: evergreen.HostIDEnvVar, Value: h.Id})
+			assert.Contains(t, container.Env, kubernetesEnvVar{Name: evergreen.HostSecretEnvVar, Value: h.Secret})
+		},
+		"SpawnHostUsesDistroNamespace": func(ctx context.Context, t *testing.T, m *kubernetesManager, api *fakeKubernetesAPI) {
+			settings := defaultSettings()
+			settings.Set(birch.EC.String("namespace", "other"))
+			h := makeHost("pod", settings)
+			_, err := m.SpawnHost(ctx, h)
+			require.NoError(t, err)
+			assert.Contains(t, api.pods, "other/pod")
+		},
+		"SpawnHostFailsWithoutImage": func(ctx context.Context, t *testing.T, m *kubernetesManager, api *fakeKubernetesAPI) {
+			h := makeHost("pod", birch.NewDocument())
+			_, err := m.SpawnHost(ctx, h)
+			assert.Error(t, err)
+			assert.Empty(t, api.pods)
+		},
+		"SpawnHostFailsForOtherProvider": func(ctx context.Context, t *testing.T, m *kubernetesManager, api *fakeKubernetesAPI) {
+			h := makeHost("pod", defaultSettings())
+			h.Distro.Provider = evergreen.ProviderNameDocker
+			_, err := m.SpawnHost(ctx, h)
+			assert.Error(t, err)
+		},
+		"GetInstanceStateFollowsPodPhase": func(ctx context.Context, t *testing.T, m *kubernetesManager, api *fakeKubernetesAPI) {
+			h := makeHost("pod", defaultSettings())
+			_, err := m.SpawnHost(ctx, h)
+			require.NoError(t, err)
+
+			state, err := m.GetInstanceState(ctx, h)
+			require.NoError(t, err)
+			assert.Equal(t, StatusInitializing, state.Status)
+
+			api.setPhase("evergreen", h.Id, "Running")
+			state, err = m.GetInstanceState(ctx, h)
+			require.NoError(t, err)
+			assert.Equal(t, StatusRunning, state.Status)
+
+			api.setPhase("evergreen", h.Id, "Failed")
+			state, err = m.GetInstanceState(ctx, h)
+			require.NoError(t, err)
+			assert.Equal(t, StatusFailed, state.Status)
+		},
+		"GetInstanceStateReturnsNonExistentForMissingPod": func(ctx context.Context, t *testing.T, m *kubernetesManager, api *fakeKubernetesAPI) {
+			state, err := m.GetInstanceState(ctx, makeHost("missing", defaultSettings()))
+			require.NoError(t, err)
+			assert.Equal(t, StatusNonExistent, state.Status)
+		},
+		"GetInstanceStatusesBatchesByNamespace": func(ctx context.Context, t *testing.T, m *kubernetesManager, api *fakeKubernetesAPI) {
+			running := makeHost("running", defaultSettings())
+			_, err := m.SpawnHost(ctx, running)
+			require.NoError(t, err)
+			api.setPhase("evergreen", running.Id, "Running")
+
+			otherSettings := defaultSettings()
+			otherSettings.Set(birch.EC.String("namespace", "other"))
+			pending := makeHost("pending", otherSettings)
+			_, err = m.SpawnHost(ctx, pending)
+			require.NoError(t, err)
+
+			missing := makeHost("missing", defaultSettings())
+
+			statuses, err := m.GetInstanceStatuses(ctx, []host.Host{*running, *pending, *missing})
+			require.NoError(t, err)
+			assert.Equal(t, map[string]CloudStatus{
+				"running": StatusRunning,
+				"pending": StatusInitializing,
+				"missing": StatusNonExistent,
+			}, statuses)
+		},
+		"GetDNSNameReturnsPodIP": func(ctx context.Context, t *testing.T, m *kubernetesManager, api *fakeKubernetesAPI) {
+			h := makeHost("pod", defaultSettings())
+			_, err := m.SpawnHost(ctx, h)
+			require.NoError(t, err)
+			pod := api.pods["evergreen/pod"]
+			pod.Status.PodIP = "10.0.0.1"
+			api.pods["evergreen/pod"] = pod
+
+			dnsName, err := m.GetDNSName(ctx, h)
+			require.NoError(t, err)
+			assert.Equal(t, "10.0.0.1", dnsName)
+		},
+		"TerminateInstanceDeletesPod": func(ctx context.Context, t *testing.T, m *kubernetesManager, api *fakeKubernetesAPI) {
+			h := makeHost("pod", defaultSettings())
+			_, err := m.SpawnHost(ctx, h)
+			require.NoError(t, err)
+			h.Status = evergreen.HostRunning
+			require.NoError(t, h.Insert(ctx))
+
+			require.NoError(t, m.TerminateInstance(ctx, h, evergreen.User, ""))
+			assert.Empty(t, api.pods)
+
+			dbHost, err := host.FindOneId(ctx, h.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbHost)
+			assert.Equal(t, evergreen.HostTerminated, dbHost.Status)
+		},
+		"TerminateInstanceSucceedsForMissingPod": func(ctx context.Context, t *testing.T, m *kubernetesManager, api *fakeKubernetesAPI) {
+			h := makeHost("missing", defaultSettings())
+			h.Status = evergreen.HostRunning
+			require.NoError(t, h.Insert(ctx))
+
+			assert.NoError(t, m.TerminateInstance(ctx, h, evergreen.User, ""))
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			require.NoError(t, db.ClearCollections(host.Collection))
+			defer func() {
+				assert.NoError(t, db.ClearCollections(host.Collection))
+			}()
+
+			env := &mock.Environment{}
+			require.NoError(t, env.Configure(ctx))
+			env.Clients.S3URLPrefix = "https://foo.com"
+
+			api := newFakeKubernetesAPI(token)
+			srv := httptest.NewServer(api)
+			defer srv.Close()
+
+			env.EvergreenSettings.Providers.Kubernetes = evergreen.KubernetesConfig{
+				APIServerURL: srv.URL,
+				BearerToken:  token,
+				Namespace:    "evergreen",
+			}
+			m := &kubernetesManager{env: env}
+			require.NoError(t, m.Configure(ctx, env.Settings()))
+
+			tCase(ctx, t, m, api)
+		})
+	}
+}
+
+func TestKubernetesSettings(t *testing.T) {
+	t.Run("FromDistroSettings", func(t *testing.T) {
+		d := distro.Distro{
+			Provider: evergreen.ProviderNameKubernetes,
+			ProviderSettingsList: []*birch.Document{birch.NewDocument(
+				birch.EC.String("image", "image"),
+				birch.EC.String("namespace", "namespace"),
+				birch.EC.String("service_account", "builder"),
+				birch.EC.SubDocument("node_selector", birch.NewDocument(birch.EC.String("pool", "build"))),
+				birch.EC.SliceString("image_pull_secrets", []string{"registry"}),
+			)},
+		}
+		s := &KubernetesSettings{}
+		require.NoError(t, s.FromDistroSettings(d, ""))
+		assert.NoError(t, s.Validate())
+		assert.Equal(t, "image", s.Image)
+		assert.Equal(t, "namespace", s.Namespace)
+		assert.Equal(t, "builder", s.ServiceAccount)
+		assert.Equal(t, map[string]string{"pool": "build"}, s.NodeSelector)
+		assert.Equal(t, []string{"registry"}, s.ImagePullSecrets)
+	})
+	t.Run("ValidateFailsWithoutImage", func(t *testing.T) {
+		s := &KubernetesSettings{}
+		assert.Error(t, s.Validate())
+	})
+	t.Run("ValidateFailsWithInvalidNamespace", func(t *testing.T) {
+		s := &KubernetesSettings{Image: "image", Namespace: "Not_Valid"}
+		assert.Error(t, s.Validate())
+	})
+	t.Run("ManagerRequiresAPIServer", func(t *testing.T) {
+		m := &kubernetesManager{env: &mock.Environment{}}
+		assert.Error(t, m.Configure(context.Background(), &evergreen.Settings{}))
+	})
+}
+
+func TestKubernetesPodName(t *testing.T) {
+	assert.Equal(t, "evg-my-distro-1", kubernetesPodName("evg-My_Distro.1"))
+	long := kubernetesPodName("evg-" + strings.Repeat("a", 100) + "-123")
+	assert.Len(t, long, kubernetesMaxNameLength)
+	assert.True(t, strings.HasSuffix(long, "-123"))
+	assert.True(t, kubernetesNameRegexp.MatchString(kubernetesPodName("-_leading-and-trailing_-")))
+}
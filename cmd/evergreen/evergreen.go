@@ -50,9 +50,12 @@ func buildApp() *cli.App {
 
 		// Top-level commands.
 		operations.Keys(),
+		operations.Tokens(),
 		operations.Fetch(),
 		operations.Evaluate(),
+		operations.RunTask(),
 		operations.Validate(),
+		operations.Lint(),
 		operations.List(),
 		operations.LastGreen(),
 		operations.Subscriptions(),
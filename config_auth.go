@@ -24,6 +24,7 @@ var (
 	AuthNaiveKey                   = bsonutil.MustHaveTag(AuthConfig{}, "Naive")
 	AuthMultiKey                   = bsonutil.MustHaveTag(AuthConfig{}, "Multi")
 	AuthKanopyKey                  = bsonutil.MustHaveTag(AuthConfig{}, "Kanopy")
+	AuthOIDCKey                    = bsonutil.MustHaveTag(AuthConfig{}, "OIDC")
 	authPreferredTypeKey           = bsonutil.MustHaveTag(AuthConfig{}, "PreferredType")
 	authBackgroundReauthMinutesKey = bsonutil.MustHaveTag(AuthConfig{}, "BackgroundReauthMinutes")
 	AuthAllowServiceUsersKey       = bsonutil.MustHaveTag(AuthConfig{}, "AllowServiceUsers")
@@ -81,6 +82,79 @@ type KanopyAuthConfig struct {
 	KeysetURL string `bson:"keyset_url" json:"keyset_url" yaml:"keyset_url"`
 }
 
+// OIDCAuthConfig configures authentication with a generic OpenID Connect
+// identity provider. The provider's endpoints and signing keys are loaded from
+// its discovery document, so only the issuer and client credentials need to be
+// configured.
+type OIDCAuthConfig struct {
+	// Issuer is the issuer URL of the identity provider. The discovery
+	// document must be served from <issuer>/.well-known/openid-configuration.
+	Issuer       string `bson:"issuer" json:"issuer" yaml:"issuer"`
+	ClientID     string `bson:"client_id" json:"client_id" yaml:"client_id"`
+	ClientSecret string `bson:"client_secret" json:"client_secret" yaml:"client_secret"`
+	// Scopes are the scopes requested during login. The offline_access scope
+	// is required for Evergreen to refresh tokens without the user logging in
+	// again.
+	Scopes []string `bson:"scopes" json:"scopes" yaml:"scopes"`
+	// UsernameClaim is the ID token claim used as the Evergreen username. If
+	// the claim is an email address in the primary allowed domain, the domain
+	// is removed.
+	UsernameClaim string `bson:"username_claim" json:"username_claim" yaml:"username_claim"`
+	// AllowedDomains are the email domains of users who can log in if the
+	// username claim is an email address. The first domain is the primary
+	// domain, whose users' usernames don't include the domain. Users in the
+	// other domains keep their full email as their username, and users in
+	// domains that aren't listed are rejected, so that no one can log in as
+	// a user with the same name in another domain.
+	AllowedDomains []string `bson:"allowed_domains" json:"allowed_domains" yaml:"allowed_domains"`
+	// RolesClaim is the ID token claim, such as groups, that is matched
+	// against the role mappings.
+	RolesClaim string `bson:"roles_claim" json:"roles_claim" yaml:"roles_claim"`
+	// RoleMappings grant Evergreen roles to users based on the values of their
+	// roles claim.
+	RoleMappings       []OIDCRoleMapping `bson:"role_mappings" json:"role_mappings" yaml:"role_mappings"`
+	ExpireAfterMinutes int               `bson:"expire_after_minutes" json:"expire_after_minutes" yaml:"expire_after_minutes"`
+}
+
+// OIDCRoleMapping grants Evergreen roles to users whose roles claim contains
+// the claim value.
+type OIDCRoleMapping struct {
+	ClaimValue string   `bson:"claim_value" json:"claim_value" yaml:"claim_value"`
+	Roles      []string `bson:"roles" json:"roles" yaml:"roles"`
+}
+
+const (
+	defaultOIDCUsernameClaim      = "email"
+	defaultOIDCExpireAfterMinutes = 60
+)
+
+var defaultOIDCScopes = []string{"openid", "profile", "email", "offline_access"}
+
+// ValidateAndDefault checks that the OIDC settings are valid and sets defaults
+// for unset optional fields.
+func (c *OIDCAuthConfig) ValidateAndDefault() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(c.Issuer == "", "issuer cannot be empty if using OIDC auth")
+	catcher.NewWhen(c.ClientID == "", "client ID cannot be empty if using OIDC auth")
+	catcher.NewWhen(c.ClientSecret == "", "client secret cannot be empty if using OIDC auth")
+	if len(c.Scopes) == 0 {
+		c.Scopes = append([]string{}, defaultOIDCScopes...)
+	}
+	catcher.NewWhen(!utility.StringSliceContains(c.Scopes, "openid"), "OIDC scopes must include 'openid'")
+	if c.UsernameClaim == "" {
+		c.UsernameClaim = defaultOIDCUsernameClaim
+	}
+	catcher.NewWhen(len(c.RoleMappings) > 0 && c.RolesClaim == "", "roles claim must be set if using OIDC role mappings")
+	for _, mapping := range c.RoleMappings {
+		catcher.NewWhen(mapping.ClaimValue == "", "OIDC role mapping must specify a claim value")
+		catcher.ErrorfWhen(len(mapping.Roles) == 0, "OIDC role mapping for claim value '%s' must specify at least one role", mapping.ClaimValue)
+	}
+	if c.ExpireAfterMinutes <= 0 {
+		c.ExpireAfterMinutes = defaultOIDCExpireAfterMinutes
+	}
+	return catcher.Resolve()
+}
+
 // AuthConfig contains the settings for the various auth managers.
 type AuthConfig struct {
 	Okta                    *OktaConfig       `bson:"okta,omitempty" json:"okta" yaml:"okta"`
@@ -88,6 +162,7 @@ type AuthConfig struct {
 	Github                  *GithubAuthConfig `bson:"github,omitempty" json:"github" yaml:"github"`
 	Multi                   *MultiAuthConfig  `bson:"multi" json:"multi" yaml:"multi"`
 	Kanopy                  *KanopyAuthConfig `bson:"kanopy" json:"kanopy" yaml:"kanopy"`
+	OIDC                    *OIDCAuthConfig   `bson:"oidc,omitempty" json:"oidc" yaml:"oidc"`
 	AllowServiceUsers       bool              `bson:"allow_service_users" json:"allow_service_users" yaml:"allow_service_users"`
 	PreferredType           string            `bson:"preferred_type,omitempty" json:"preferred_type" yaml:"preferred_type"`
 	BackgroundReauthMinutes int               `bson:"background_reauth_minutes" json:"background_reauth_minutes" yaml:"background_reauth_minutes"`
@@ -107,6 +182,7 @@ func (c *AuthConfig) Set(ctx context.Context) error {
 			AuthGithubKey:                  c.Github,
 			AuthMultiKey:                   c.Multi,
 			AuthKanopyKey:                  c.Kanopy,
+			AuthOIDCKey:                    c.OIDC,
 			authPreferredTypeKey:           c.PreferredType,
 			authBackgroundReauthMinutesKey: c.BackgroundReauthMinutes,
 			AuthAllowServiceUsersKey:       c.AllowServiceUsers,
@@ -139,9 +215,10 @@ func (c *AuthConfig) ValidateAndDefault() error {
 		AuthGithubKey,
 		AuthMultiKey,
 		AuthKanopyKey,
+		AuthOIDCKey,
 	}, c.PreferredType), "invalid auth type '%s'", c.PreferredType)
 
-	if c.Naive == nil && c.Github == nil && c.Okta == nil && c.Multi == nil && c.Kanopy == nil {
+	if c.Naive == nil && c.Github == nil && c.Okta == nil && c.Multi == nil && c.Kanopy == nil && c.OIDC == nil {
 		catcher.Add(errors.New("must specify one form of authentication"))
 	}
 
@@ -160,6 +237,8 @@ func (c *AuthConfig) ValidateAndDefault() error {
 				catcher.NewWhen(c.Github == nil, "GitHub settings cannot be empty if using in multi auth")
 			case AuthNaiveKey:
 				catcher.NewWhen(c.Naive == nil, "Naive settings cannot be empty if using in multi auth")
+			case AuthOIDCKey:
+				catcher.NewWhen(c.OIDC == nil, "OIDC settings cannot be empty if using in multi auth")
 			default:
 				catcher.Errorf("unrecognized auth mechanism '%s'", kind)
 			}
@@ -175,5 +254,9 @@ func (c *AuthConfig) ValidateAndDefault() error {
 		catcher.NewWhen(c.Kanopy.KeysetURL == "", "keyset URL cannot be empty if using Kanopy auth")
 	}
 
+	if c.OIDC != nil {
+		catcher.Add(c.OIDC.ValidateAndDefault())
+	}
+
 	return catcher.Resolve()
 }
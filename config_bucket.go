@@ -51,11 +51,15 @@ type BucketsConfig struct {
 	LogBucket BucketConfig `bson:"log_bucket" json:"log_bucket" yaml:"log_bucket"`
 	// Credentials for accessing the LogBucket.
 	Credentials S3Credentials `bson:"credentials" json:"credentials" yaml:"credentials"`
+	// CompressedLogs indicates whether new task runs should write their
+	// task and test logs in the compressed, indexed log format.
+	CompressedLogs bool `bson:"compressed_logs" json:"compressed_logs" yaml:"compressed_logs"`
 }
 
 var (
-	bucketsConfigLogBucketKey   = bsonutil.MustHaveTag(BucketsConfig{}, "LogBucket")
-	bucketsConfigCredentialsKey = bsonutil.MustHaveTag(BucketsConfig{}, "Credentials")
+	bucketsConfigLogBucketKey      = bsonutil.MustHaveTag(BucketsConfig{}, "LogBucket")
+	bucketsConfigCredentialsKey    = bsonutil.MustHaveTag(BucketsConfig{}, "Credentials")
+	bucketsConfigCompressedLogsKey = bsonutil.MustHaveTag(BucketsConfig{}, "CompressedLogs")
 )
 
 // BucketConfig represents the admin config for an individual bucket.
@@ -86,8 +90,9 @@ func (c *BucketsConfig) Get(ctx context.Context) error {
 func (c *BucketsConfig) Set(ctx context.Context) error {
 	return errors.Wrapf(setConfigSection(ctx, c.SectionId(), bson.M{
 		"$set": bson.M{
-			bucketsConfigLogBucketKey:   c.LogBucket,
-			bucketsConfigCredentialsKey: c.Credentials,
+			bucketsConfigLogBucketKey:      c.LogBucket,
+			bucketsConfigCredentialsKey:    c.Credentials,
+			bucketsConfigCompressedLogsKey: c.CompressedLogs,
 		}}), "updating config section '%s'", c.SectionId(),
 	)
 }
@@ -2,6 +2,9 @@ package evergreen
 
 import (
 	"context"
+	"crypto/x509"
+	"net/url"
+	"regexp"
 
 	"github.com/mongodb/anser/bsonutil"
 	"github.com/mongodb/grip"
@@ -10,14 +13,16 @@ import (
 )
 
 var (
-	cloudProvidersAWSKey    = bsonutil.MustHaveTag(CloudProviders{}, "AWS")
-	cloudProvidersDockerKey = bsonutil.MustHaveTag(CloudProviders{}, "Docker")
+	cloudProvidersAWSKey        = bsonutil.MustHaveTag(CloudProviders{}, "AWS")
+	cloudProvidersDockerKey     = bsonutil.MustHaveTag(CloudProviders{}, "Docker")
+	cloudProvidersKubernetesKey = bsonutil.MustHaveTag(CloudProviders{}, "Kubernetes")
 )
 
 // CloudProviders stores configuration settings for the supported cloud host providers.
 type CloudProviders struct {
-	AWS    AWSConfig    `bson:"aws" json:"aws" yaml:"aws"`
-	Docker DockerConfig `bson:"docker" json:"docker" yaml:"docker"`
+	AWS        AWSConfig        `bson:"aws" json:"aws" yaml:"aws"`
+	Docker     DockerConfig     `bson:"docker" json:"docker" yaml:"docker"`
+	Kubernetes KubernetesConfig `bson:"kubernetes" json:"kubernetes" yaml:"kubernetes"`
 }
 
 func (c *CloudProviders) SectionId() string { return "providers" }
@@ -29,8 +34,9 @@ func (c *CloudProviders) Get(ctx context.Context) error {
 func (c *CloudProviders) Set(ctx context.Context) error {
 	return errors.Wrapf(setConfigSection(ctx, c.SectionId(), bson.M{
 		"$set": bson.M{
-			cloudProvidersAWSKey:    c.AWS,
-			cloudProvidersDockerKey: c.Docker,
+			cloudProvidersAWSKey:        c.AWS,
+			cloudProvidersDockerKey:     c.Docker,
+			cloudProvidersKubernetesKey: c.Kubernetes,
 		}}), "updating config section '%s'", c.SectionId(),
 	)
 }
@@ -41,6 +47,7 @@ func (c *CloudProviders) ValidateAndDefault() error {
 	for i, m := range c.AWS.AccountRoles {
 		catcher.Wrapf(m.Validate(), "invalid account role mapping at index %d", i)
 	}
+	catcher.Wrap(c.Kubernetes.Validate(), "invalid Kubernetes config")
 	return catcher.Resolve()
 }
 
@@ -347,3 +354,43 @@ const (
 type DockerConfig struct {
 	APIVersion string `bson:"api_version" json:"api_version" yaml:"api_version"`
 }
+
+// KubernetesConfig stores the connection settings for the Kubernetes cluster
+// where Kubernetes hosts run as pods.
+type KubernetesConfig struct {
+	// APIServerURL is the base URL of the cluster's API server.
+	APIServerURL string `bson:"api_server_url" json:"api_server_url" yaml:"api_server_url"`
+	// BearerToken is the token used to authenticate to the API server.
+	BearerToken string `bson:"bearer_token" json:"bearer_token" yaml:"bearer_token"`
+	// CACert is the PEM-encoded certificate authority used to verify the API
+	// server. If empty, the system certificate pool is used.
+	CACert string `bson:"ca_cert" json:"ca_cert" yaml:"ca_cert"`
+	// Namespace is the default namespace for pods. Distros may override it.
+	Namespace string `bson:"namespace" json:"namespace" yaml:"namespace"`
+}
+
+// Validate checks that the Kubernetes config is valid if it is set.
+func (c *KubernetesConfig) Validate() error {
+	if c.APIServerURL == "" {
+		if c.BearerToken != "" || c.CACert != "" || c.Namespace != "" {
+			return errors.New("API server URL must be set if any other Kubernetes settings are set")
+		}
+		return nil
+	}
+
+	catcher := grip.NewBasicCatcher()
+	u, err := url.Parse(c.APIServerURL)
+	if err != nil {
+		catcher.Wrap(err, "invalid API server URL")
+	} else {
+		catcher.ErrorfWhen(u.Scheme != "https" && u.Scheme != "http", "API server URL scheme must be 'http' or 'https', not '%s'", u.Scheme)
+		catcher.NewWhen(u.Host == "", "API server URL must include a host")
+	}
+	catcher.NewWhen(c.BearerToken == "", "bearer token must be set")
+	catcher.NewWhen(c.CACert != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(c.CACert)), "CA certificate must be a valid PEM-encoded certificate")
+	catcher.ErrorfWhen(c.Namespace != "" && !kubernetesNamespaceRegexp.MatchString(c.Namespace), "namespace '%s' is not a valid Kubernetes name", c.Namespace)
+	return catcher.Resolve()
+}
+
+// kubernetesNamespaceRegexp matches valid Kubernetes namespace names.
+var kubernetesNamespaceRegexp = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
@@ -0,0 +1,100 @@
+package evergreen
+
+import (
+	"context"
+
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CostConfig represents the hourly rates used to estimate the cost of the
+// hosts that run tasks.
+type CostConfig struct {
+	// DefaultHourlyCost is the hourly cost of a host whose distro and
+	// instance type have no rate configured.
+	DefaultHourlyCost float64 `bson:"default_hourly_cost" json:"default_hourly_cost" yaml:"default_hourly_cost"`
+	// InstanceTypes are the hourly costs of hosts by instance type.
+	InstanceTypes []InstanceTypeCost `bson:"instance_types" json:"instance_types" yaml:"instance_types"`
+	// Distros are the hourly costs of hosts by distro. These take precedence
+	// over the instance type rates.
+	Distros []DistroCost `bson:"distros" json:"distros" yaml:"distros"`
+}
+
+// InstanceTypeCost is the hourly cost of a host with a particular instance
+// type.
+type InstanceTypeCost struct {
+	InstanceType string  `bson:"instance_type" json:"instance_type" yaml:"instance_type"`
+	HourlyCost   float64 `bson:"hourly_cost" json:"hourly_cost" yaml:"hourly_cost"`
+}
+
+// DistroCost is the hourly cost of a host in a particular distro.
+type DistroCost struct {
+	Distro     string  `bson:"distro" json:"distro" yaml:"distro"`
+	HourlyCost float64 `bson:"hourly_cost" json:"hourly_cost" yaml:"hourly_cost"`
+}
+
+var (
+	costDefaultHourlyCostKey = bsonutil.MustHaveTag(CostConfig{}, "DefaultHourlyCost")
+	costInstanceTypesKey     = bsonutil.MustHaveTag(CostConfig{}, "InstanceTypes")
+	costDistrosKey           = bsonutil.MustHaveTag(CostConfig{}, "Distros")
+)
+
+func (c *CostConfig) SectionId() string { return "cost" }
+
+func (c *CostConfig) Get(ctx context.Context) error {
+	return getConfigSection(ctx, c)
+}
+
+func (c *CostConfig) Set(ctx context.Context) error {
+	return errors.Wrapf(setConfigSection(ctx, c.SectionId(), bson.M{
+		"$set": bson.M{
+			costDefaultHourlyCostKey: c.DefaultHourlyCost,
+			costInstanceTypesKey:     c.InstanceTypes,
+			costDistrosKey:           c.Distros,
+		}}), "updating config section '%s'", c.SectionId(),
+	)
+}
+
+func (c *CostConfig) ValidateAndDefault() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(c.DefaultHourlyCost < 0, "default hourly cost cannot be negative")
+
+	instanceTypes := map[string]bool{}
+	for _, it := range c.InstanceTypes {
+		catcher.NewWhen(it.InstanceType == "", "instance type cannot be empty")
+		catcher.ErrorfWhen(it.HourlyCost < 0, "hourly cost for instance type '%s' cannot be negative", it.InstanceType)
+		catcher.ErrorfWhen(instanceTypes[it.InstanceType], "instance type '%s' has more than one hourly cost", it.InstanceType)
+		instanceTypes[it.InstanceType] = true
+	}
+
+	distros := map[string]bool{}
+	for _, d := range c.Distros {
+		catcher.NewWhen(d.Distro == "", "distro cannot be empty")
+		catcher.ErrorfWhen(d.HourlyCost < 0, "hourly cost for distro '%s' cannot be negative", d.Distro)
+		catcher.ErrorfWhen(distros[d.Distro], "distro '%s' has more than one hourly cost", d.Distro)
+		distros[d.Distro] = true
+	}
+
+	return catcher.Resolve()
+}
+
+// HourlyCost returns the hourly cost of a host in the given distro with the
+// given instance type. Distro rates take precedence over instance type rates,
+// which take precedence over the default rate.
+func (c *CostConfig) HourlyCost(distroID, instanceType string) float64 {
+	for _, d := range c.Distros {
+		if d.Distro == distroID {
+			return d.HourlyCost
+		}
+	}
+	if instanceType != "" {
+		for _, it := range c.InstanceTypes {
+			if it.InstanceType == instanceType {
+				return it.HourlyCost
+			}
+		}
+	}
+	return c.DefaultHourlyCost
+}
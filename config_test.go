@@ -284,6 +284,19 @@ func (s *AdminSuite) TestAuthConfig() {
 		Kanopy: &KanopyAuthConfig{
 			HeaderName: "internal_header",
 		},
+		OIDC: &OIDCAuthConfig{
+			Issuer:         "https://idp.example.com",
+			ClientID:       "oidc_client",
+			ClientSecret:   "oidc_secret",
+			Scopes:         []string{"openid", "email"},
+			UsernameClaim:  "preferred_username",
+			AllowedDomains: []string{"example.com"},
+			RolesClaim:     "groups",
+			RoleMappings: []OIDCRoleMapping{
+				{ClaimValue: "admins", Roles: []string{"superuser"}},
+			},
+			ExpireAfterMinutes: 30,
+		},
 		BackgroundReauthMinutes: 60,
 	}
 
@@ -397,6 +410,32 @@ func (s *AdminSuite) TestProvidersConfig() {
 	s.Equal(config, settings.Providers)
 }
 
+func (s *AdminSuite) TestKubernetesConfig() {
+	emptyConfig := KubernetesConfig{}
+	s.NoError(emptyConfig.Validate())
+
+	validConfig := KubernetesConfig{
+		APIServerURL: "https://kubernetes.example.com:6443",
+		BearerToken:  "token",
+		Namespace:    "evergreen",
+	}
+	s.NoError(validConfig.Validate())
+
+	for name, config := range map[string]KubernetesConfig{
+		"MissingURL":       {BearerToken: "token"},
+		"MissingScheme":    {APIServerURL: "kubernetes.example.com", BearerToken: "token"},
+		"InvalidScheme":    {APIServerURL: "ftp://kubernetes.example.com", BearerToken: "token"},
+		"MissingHost":      {APIServerURL: "https://", BearerToken: "token"},
+		"MissingToken":     {APIServerURL: "https://kubernetes.example.com"},
+		"InvalidCACert":    {APIServerURL: "https://kubernetes.example.com", BearerToken: "token", CACert: "not a cert"},
+		"InvalidNamespace": {APIServerURL: "https://kubernetes.example.com", BearerToken: "token", Namespace: "Not_Valid"},
+	} {
+		s.Run(name, func() {
+			s.Error(config.Validate())
+		})
+	}
+}
+
 func (s *AdminSuite) TestRepotrackerConfig() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -1228,6 +1228,10 @@ type BuildBaronSettings struct {
 	BFSuggestionPassword    string `mapstructure:"bf_suggestion_password" bson:"bf_suggestion_password" json:"bf_suggestion_password" yaml:"bf_suggestion_password"`
 	BFSuggestionTimeoutSecs int    `mapstructure:"bf_suggestion_timeout_secs" bson:"bf_suggestion_timeout_secs" json:"bf_suggestion_timeout_secs" yaml:"bf_suggestion_timeout_secs"`
 	BFSuggestionFeaturesURL string `mapstructure:"bf_suggestion_features_url" bson:"bf_suggestion_features_url" json:"bf_suggestion_features_url" yaml:"bf_suggestion_features_url"`
+
+	// FingerprintSuggestionsEnabled suggests the issues linked to past failures in the project that
+	// failed the same way, which does not require a Jira search to be configured.
+	FingerprintSuggestionsEnabled bool `mapstructure:"fingerprint_suggestions_enabled" bson:"fingerprint_suggestions_enabled" json:"fingerprint_suggestions_enabled" yaml:"fingerprint_suggestions_enabled"`
 }
 
 type AnnotationsSettings struct {
@@ -276,6 +276,22 @@ const (
 
 	PlannerVersionTunable = "tunable"
 
+	// PlannerStrategyDefault orders a distro's queue by a weighted rank
+	// of patches, mainline, stepback, generate.tasks, dependents, and
+	// expected duration.
+	PlannerStrategyDefault = "default"
+	// PlannerStrategyFairShare interleaves the queue so that each project
+	// receives a share of the distro's expected runtime, weighted by the
+	// priority of its tasks.
+	PlannerStrategyFairShare = "fair-share"
+	// PlannerStrategyShortestFirst orders the queue with the tasks that
+	// are expected to take the least time first.
+	PlannerStrategyShortestFirst = "shortest-first"
+	// PlannerStrategyDeadline orders the queue with the tasks that have
+	// the least time left before they miss their deadline first. A task's
+	// deadline is the distro's target time after it was activated.
+	PlannerStrategyDeadline = "deadline"
+
 	DispatcherVersionRevisedWithDependencies = "revised-with-dependencies"
 
 	// maximum turnaround we want to maintain for all hosts for a given distro
@@ -582,6 +598,7 @@ const (
 	ProviderNameDockerMock  = "docker-mock"
 	ProviderNameStatic      = "static"
 	ProviderNameMock        = "mock"
+	ProviderNameKubernetes  = "kubernetes"
 
 	// DefaultEC2Region is the default region where hosts should be spawned and
 	// general Evergreen operations occur in AWS if no particular region is
@@ -631,6 +648,7 @@ var (
 		ProviderNameEc2Fleet,
 		ProviderNameMock,
 		ProviderNameDocker,
+		ProviderNameKubernetes,
 	}
 
 	// ProviderUserSpawnable includes all cloud provider types where a user can
@@ -992,6 +1010,14 @@ var (
 		PlannerVersionTunable,
 	}
 
+	// Set of valid PlannerSettings.Strategy strings that can be user set via the API
+	ValidTaskPlannerStrategies = []string{
+		PlannerStrategyDefault,
+		PlannerStrategyFairShare,
+		PlannerStrategyShortestFirst,
+		PlannerStrategyDeadline,
+	}
+
 	// Set of valid DispatchSettings.Version strings that can be user set via the API
 	ValidTaskDispatcherVersions = []string{
 		DispatcherVersionRevisedWithDependencies,
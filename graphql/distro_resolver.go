@@ -102,6 +102,8 @@ func (r *distroResolver) Provider(ctx context.Context, obj *model.APIDistro) (Pr
 		return ProviderEc2Fleet, nil
 	case evergreen.ProviderNameEc2OnDemand:
 		return ProviderEc2OnDemand, nil
+	case evergreen.ProviderNameKubernetes:
+		return ProviderKubernetes, nil
 	case evergreen.ProviderNameStatic:
 		return ProviderStatic, nil
 	default:
@@ -207,6 +209,26 @@ func (r *hostAllocatorSettingsResolver) Version(ctx context.Context, obj *model.
 	}
 }
 
+// Strategy is the resolver for the strategy field.
+func (r *plannerSettingsResolver) Strategy(ctx context.Context, obj *model.APIPlannerSettings) (PlannerStrategy, error) {
+	if obj == nil {
+		return "", InternalServerError.Send(ctx, "distro undefined when attempting to resolve planner strategy")
+	}
+
+	switch utility.FromStringPtr(obj.Strategy) {
+	case "", evergreen.PlannerStrategyDefault:
+		return PlannerStrategyDefault, nil
+	case evergreen.PlannerStrategyFairShare:
+		return PlannerStrategyFairShare, nil
+	case evergreen.PlannerStrategyShortestFirst:
+		return PlannerStrategyShortestFirst, nil
+	case evergreen.PlannerStrategyDeadline:
+		return PlannerStrategyDeadline, nil
+	default:
+		return "", InputValidationError.Send(ctx, fmt.Sprintf("planner strategy '%s' is invalid", utility.FromStringPtr(obj.Strategy)))
+	}
+}
+
 // Version is the resolver for the version field.
 func (r *plannerSettingsResolver) Version(ctx context.Context, obj *model.APIPlannerSettings) (PlannerVersion, error) {
 	if obj == nil {
@@ -294,6 +316,8 @@ func (r *distroInputResolver) Provider(ctx context.Context, obj *model.APIDistro
 		obj.Provider = utility.ToStringPtr(evergreen.ProviderNameEc2Fleet)
 	case ProviderEc2OnDemand:
 		obj.Provider = utility.ToStringPtr(evergreen.ProviderNameEc2OnDemand)
+	case ProviderKubernetes:
+		obj.Provider = utility.ToStringPtr(evergreen.ProviderNameKubernetes)
 	case ProviderStatic:
 		obj.Provider = utility.ToStringPtr(evergreen.ProviderNameStatic)
 	default:
@@ -399,6 +423,26 @@ func (r *hostAllocatorSettingsInputResolver) Version(ctx context.Context, obj *m
 	return nil
 }
 
+// Strategy is the resolver for the strategy field.
+func (r *plannerSettingsInputResolver) Strategy(ctx context.Context, obj *model.APIPlannerSettings, data *PlannerStrategy) error {
+	if data == nil {
+		return nil
+	}
+	switch *data {
+	case PlannerStrategyDefault:
+		obj.Strategy = utility.ToStringPtr(evergreen.PlannerStrategyDefault)
+	case PlannerStrategyFairShare:
+		obj.Strategy = utility.ToStringPtr(evergreen.PlannerStrategyFairShare)
+	case PlannerStrategyShortestFirst:
+		obj.Strategy = utility.ToStringPtr(evergreen.PlannerStrategyShortestFirst)
+	case PlannerStrategyDeadline:
+		obj.Strategy = utility.ToStringPtr(evergreen.PlannerStrategyDeadline)
+	default:
+		return InputValidationError.Send(ctx, fmt.Sprintf("planner strategy '%s' is invalid", *data))
+	}
+	return nil
+}
+
 // TargetTime is the resolver for the targetTime field.
 func (r *plannerSettingsInputResolver) TargetTime(ctx context.Context, obj *model.APIPlannerSettings, data int) error {
 	obj.TargetTime = model.NewAPIDuration(time.Duration(data) * time.Millisecond)
@@ -20,6 +20,7 @@ import (
 	model1 "github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/thirdparty"
@@ -620,6 +621,16 @@ type ComplexityRoot struct {
 		Version   func(childComplexity int) int
 	}
 
+	LogSearchResult struct {
+		Data       func(childComplexity int) int
+		Execution  func(childComplexity int) int
+		IsContext  func(childComplexity int) int
+		LineNumber func(childComplexity int) int
+		LogName    func(childComplexity int) int
+		Priority   func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+	}
+
 	LogkeeperBuild struct {
 		BuildNum      func(childComplexity int) int
 		Builder       func(childComplexity int) int
@@ -885,6 +896,7 @@ type ComplexityRoot struct {
 		NumDependentsFactor       func(childComplexity int) int
 		PatchFactor               func(childComplexity int) int
 		PatchTimeInQueueFactor    func(childComplexity int) int
+		Strategy                  func(childComplexity int) int
 		TargetTime                func(childComplexity int) int
 		Version                   func(childComplexity int) int
 	}
@@ -1187,6 +1199,35 @@ type ComplexityRoot struct {
 		VirtualMemoryKB func(childComplexity int) int
 	}
 
+	ResourceUsageSample struct {
+		Block          func(childComplexity int) int
+		CPUPercent     func(childComplexity int) int
+		Command        func(childComplexity int) int
+		DiskReadBytes  func(childComplexity int) int
+		DiskWriteBytes func(childComplexity int) int
+		MemoryBytes    func(childComplexity int) int
+		NetRecvBytes   func(childComplexity int) int
+		NetSentBytes   func(childComplexity int) int
+		Processes      func(childComplexity int) int
+		Time           func(childComplexity int) int
+	}
+
+	ResourceUsageSummary struct {
+		AvgCPUPercent   func(childComplexity int) int
+		AvgMemoryBytes  func(childComplexity int) int
+		Block           func(childComplexity int) int
+		Command         func(childComplexity int) int
+		DiskReadBytes   func(childComplexity int) int
+		DiskWriteBytes  func(childComplexity int) int
+		DurationSecs    func(childComplexity int) int
+		NetRecvBytes    func(childComplexity int) int
+		NetSentBytes    func(childComplexity int) int
+		NumSamples      func(childComplexity int) int
+		PeakCPUPercent  func(childComplexity int) int
+		PeakMemoryBytes func(childComplexity int) int
+		PeakProcesses   func(childComplexity int) int
+	}
+
 	SaveDistroPayload struct {
 		Distro    func(childComplexity int) int
 		HostCount func(childComplexity int) int
@@ -1343,6 +1384,7 @@ type ComplexityRoot struct {
 		ProjectIdentifier       func(childComplexity int) int
 		Requester               func(childComplexity int) int
 		ResetWhenFinished       func(childComplexity int) int
+		ResourceUsage           func(childComplexity int) int
 		Revision                func(childComplexity int) int
 		ScheduledTime           func(childComplexity int) int
 		SpawnHostLink           func(childComplexity int) int
@@ -1353,6 +1395,7 @@ type ComplexityRoot struct {
 		TaskGroup               func(childComplexity int) int
 		TaskGroupMaxHosts       func(childComplexity int) int
 		TaskLogs                func(childComplexity int) int
+		TestFlakiness           func(childComplexity int) int
 		Tests                   func(childComplexity int, opts *TestFilterOptions) int
 		TimeTaken               func(childComplexity int) int
 		TotalTestCount          func(childComplexity int) int
@@ -1439,6 +1482,7 @@ type ComplexityRoot struct {
 		AllLogs    func(childComplexity int) int
 		EventLogs  func(childComplexity int) int
 		Execution  func(childComplexity int) int
+		SearchLogs func(childComplexity int, options LogSearchOptions) int
 		SystemLogs func(childComplexity int) int
 		TaskID     func(childComplexity int) int
 		TaskLogs   func(childComplexity int) int
@@ -1464,6 +1508,15 @@ type ComplexityRoot struct {
 		Version           func(childComplexity int) int
 	}
 
+	TaskResourceUsage struct {
+		Commands           func(childComplexity int) int
+		Execution          func(childComplexity int) int
+		SampleIntervalSecs func(childComplexity int) int
+		Samples            func(childComplexity int) int
+		TaskID             func(childComplexity int) int
+		Total              func(childComplexity int) int
+	}
+
 	TaskSpecifier struct {
 		PatchAlias   func(childComplexity int) int
 		TaskRegex    func(childComplexity int) int
@@ -1488,6 +1541,20 @@ type ComplexityRoot struct {
 		TotalTestCount          func(childComplexity int) int
 	}
 
+	TestFlakiness struct {
+		BuildVariant     func(childComplexity int) int
+		NumFlips         func(childComplexity int) int
+		NumRuns          func(childComplexity int) int
+		QuarantineReason func(childComplexity int) int
+		Quarantined      func(childComplexity int) int
+		QuarantinedAt    func(childComplexity int) int
+		QuarantinedBy    func(childComplexity int) int
+		Score            func(childComplexity int) int
+		ScoredAt         func(childComplexity int) int
+		TaskName         func(childComplexity int) int
+		TestName         func(childComplexity int) int
+	}
+
 	TestLog struct {
 		LineNum       func(childComplexity int) int
 		RenderingType func(childComplexity int) int
@@ -1905,6 +1972,8 @@ type PermissionsResolver interface {
 	RepoPermissions(ctx context.Context, obj *Permissions, options RepoPermissionsOptions) (*RepoPermissions, error)
 }
 type PlannerSettingsResolver interface {
+	Strategy(ctx context.Context, obj *model.APIPlannerSettings) (PlannerStrategy, error)
+
 	Version(ctx context.Context, obj *model.APIPlannerSettings) (PlannerVersion, error)
 }
 type PodResolver interface {
@@ -2045,9 +2114,12 @@ type TaskResolver interface {
 
 	ProjectIdentifier(ctx context.Context, obj *model.APITask) (*string, error)
 
+	ResourceUsage(ctx context.Context, obj *model.APITask) (*model.APITaskResourceUsage, error)
+
 	SpawnHostLink(ctx context.Context, obj *model.APITask) (*string, error)
 
 	TaskLogs(ctx context.Context, obj *model.APITask) (*TaskLogs, error)
+	TestFlakiness(ctx context.Context, obj *model.APITask) ([]*model.APITestFlakiness, error)
 	Tests(ctx context.Context, obj *model.APITask, opts *TestFilterOptions) (*TaskTestResult, error)
 
 	TotalTestCount(ctx context.Context, obj *model.APITask) (int, error)
@@ -2062,6 +2134,7 @@ type TaskLogsResolver interface {
 	AllLogs(ctx context.Context, obj *TaskLogs) ([]*apimodels.LogMessage, error)
 	EventLogs(ctx context.Context, obj *TaskLogs) ([]*model.TaskAPIEventLogEntry, error)
 
+	SearchLogs(ctx context.Context, obj *TaskLogs, options LogSearchOptions) ([]*model.APILogSearchResult, error)
 	SystemLogs(ctx context.Context, obj *TaskLogs) ([]*apimodels.LogMessage, error)
 
 	TaskLogs(ctx context.Context, obj *TaskLogs) ([]*apimodels.LogMessage, error)
@@ -2142,6 +2215,7 @@ type HostAllocatorSettingsInputResolver interface {
 	Version(ctx context.Context, obj *model.APIHostAllocatorSettings, data HostAllocatorVersion) error
 }
 type PlannerSettingsInputResolver interface {
+	Strategy(ctx context.Context, obj *model.APIPlannerSettings, data *PlannerStrategy) error
 	TargetTime(ctx context.Context, obj *model.APIPlannerSettings, data int) error
 	Version(ctx context.Context, obj *model.APIPlannerSettings, data PlannerVersion) error
 }
@@ -4358,6 +4432,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LogkeeperBuild.Builder(childComplexity), true
 
+	case "LogSearchResult.data":
+		if e.complexity.LogSearchResult.Data == nil {
+			break
+		}
+
+		return e.complexity.LogSearchResult.Data(childComplexity), true
+
+	case "LogSearchResult.execution":
+		if e.complexity.LogSearchResult.Execution == nil {
+			break
+		}
+
+		return e.complexity.LogSearchResult.Execution(childComplexity), true
+
+	case "LogSearchResult.isContext":
+		if e.complexity.LogSearchResult.IsContext == nil {
+			break
+		}
+
+		return e.complexity.LogSearchResult.IsContext(childComplexity), true
+
+	case "LogSearchResult.lineNumber":
+		if e.complexity.LogSearchResult.LineNumber == nil {
+			break
+		}
+
+		return e.complexity.LogSearchResult.LineNumber(childComplexity), true
+
+	case "LogSearchResult.logName":
+		if e.complexity.LogSearchResult.LogName == nil {
+			break
+		}
+
+		return e.complexity.LogSearchResult.LogName(childComplexity), true
+
+	case "LogSearchResult.priority":
+		if e.complexity.LogSearchResult.Priority == nil {
+			break
+		}
+
+		return e.complexity.LogSearchResult.Priority(childComplexity), true
+
+	case "LogSearchResult.timestamp":
+		if e.complexity.LogSearchResult.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.LogSearchResult.Timestamp(childComplexity), true
+
 	case "LogkeeperBuild.id":
 		if e.complexity.LogkeeperBuild.ID == nil {
 			break
@@ -5972,6 +6095,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PlannerSettings.PatchTimeInQueueFactor(childComplexity), true
 
+	case "PlannerSettings.strategy":
+		if e.complexity.PlannerSettings.Strategy == nil {
+			break
+		}
+
+		return e.complexity.PlannerSettings.Strategy(childComplexity), true
+
 	case "PlannerSettings.targetTime":
 		if e.complexity.PlannerSettings.TargetTime == nil {
 			break
@@ -7712,6 +7842,167 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ResourceLimits.VirtualMemoryKB(childComplexity), true
 
+	case "ResourceUsageSample.block":
+		if e.complexity.ResourceUsageSample.Block == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.Block(childComplexity), true
+
+	case "ResourceUsageSample.command":
+		if e.complexity.ResourceUsageSample.Command == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.Command(childComplexity), true
+
+	case "ResourceUsageSample.cpuPercent":
+		if e.complexity.ResourceUsageSample.CPUPercent == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.CPUPercent(childComplexity), true
+
+	case "ResourceUsageSample.diskReadBytes":
+		if e.complexity.ResourceUsageSample.DiskReadBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.DiskReadBytes(childComplexity), true
+
+	case "ResourceUsageSample.diskWriteBytes":
+		if e.complexity.ResourceUsageSample.DiskWriteBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.DiskWriteBytes(childComplexity), true
+
+	case "ResourceUsageSample.memoryBytes":
+		if e.complexity.ResourceUsageSample.MemoryBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.MemoryBytes(childComplexity), true
+
+	case "ResourceUsageSample.netRecvBytes":
+		if e.complexity.ResourceUsageSample.NetRecvBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.NetRecvBytes(childComplexity), true
+
+	case "ResourceUsageSample.netSentBytes":
+		if e.complexity.ResourceUsageSample.NetSentBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.NetSentBytes(childComplexity), true
+
+	case "ResourceUsageSample.processes":
+		if e.complexity.ResourceUsageSample.Processes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.Processes(childComplexity), true
+
+	case "ResourceUsageSample.time":
+		if e.complexity.ResourceUsageSample.Time == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSample.Time(childComplexity), true
+
+	case "ResourceUsageSummary.avgCpuPercent":
+		if e.complexity.ResourceUsageSummary.AvgCPUPercent == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.AvgCPUPercent(childComplexity), true
+
+	case "ResourceUsageSummary.avgMemoryBytes":
+		if e.complexity.ResourceUsageSummary.AvgMemoryBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.AvgMemoryBytes(childComplexity), true
+
+	case "ResourceUsageSummary.block":
+		if e.complexity.ResourceUsageSummary.Block == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.Block(childComplexity), true
+
+	case "ResourceUsageSummary.command":
+		if e.complexity.ResourceUsageSummary.Command == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.Command(childComplexity), true
+
+	case "ResourceUsageSummary.diskReadBytes":
+		if e.complexity.ResourceUsageSummary.DiskReadBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.DiskReadBytes(childComplexity), true
+
+	case "ResourceUsageSummary.diskWriteBytes":
+		if e.complexity.ResourceUsageSummary.DiskWriteBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.DiskWriteBytes(childComplexity), true
+
+	case "ResourceUsageSummary.durationSecs":
+		if e.complexity.ResourceUsageSummary.DurationSecs == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.DurationSecs(childComplexity), true
+
+	case "ResourceUsageSummary.netRecvBytes":
+		if e.complexity.ResourceUsageSummary.NetRecvBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.NetRecvBytes(childComplexity), true
+
+	case "ResourceUsageSummary.netSentBytes":
+		if e.complexity.ResourceUsageSummary.NetSentBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.NetSentBytes(childComplexity), true
+
+	case "ResourceUsageSummary.numSamples":
+		if e.complexity.ResourceUsageSummary.NumSamples == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.NumSamples(childComplexity), true
+
+	case "ResourceUsageSummary.peakCpuPercent":
+		if e.complexity.ResourceUsageSummary.PeakCPUPercent == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.PeakCPUPercent(childComplexity), true
+
+	case "ResourceUsageSummary.peakMemoryBytes":
+		if e.complexity.ResourceUsageSummary.PeakMemoryBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.PeakMemoryBytes(childComplexity), true
+
+	case "ResourceUsageSummary.peakProcesses":
+		if e.complexity.ResourceUsageSummary.PeakProcesses == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsageSummary.PeakProcesses(childComplexity), true
+
 	case "SaveDistroPayload.distro":
 		if e.complexity.SaveDistroPayload.Distro == nil {
 			break
@@ -8503,6 +8794,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Task.ResetWhenFinished(childComplexity), true
 
+	case "Task.resourceUsage":
+		if e.complexity.Task.ResourceUsage == nil {
+			break
+		}
+
+		return e.complexity.Task.ResourceUsage(childComplexity), true
+
 	case "Task.revision":
 		if e.complexity.Task.Revision == nil {
 			break
@@ -8573,6 +8871,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Task.TaskLogs(childComplexity), true
 
+	case "Task.testFlakiness":
+		if e.complexity.Task.TestFlakiness == nil {
+			break
+		}
+
+		return e.complexity.Task.TestFlakiness(childComplexity), true
+
 	case "Task.tests":
 		if e.complexity.Task.Tests == nil {
 			break
@@ -8949,6 +9254,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TaskLogs.Execution(childComplexity), true
 
+	case "TaskLogs.searchLogs":
+		if e.complexity.TaskLogs.SearchLogs == nil {
+			break
+		}
+
+		args, err := ec.field_TaskLogs_searchLogs_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.TaskLogs.SearchLogs(childComplexity, args["options"].(LogSearchOptions)), true
+
 	case "TaskLogs.systemLogs":
 		if e.complexity.TaskLogs.SystemLogs == nil {
 			break
@@ -9068,6 +9385,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TaskQueueItem.Version(childComplexity), true
 
+	case "TaskResourceUsage.commands":
+		if e.complexity.TaskResourceUsage.Commands == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.Commands(childComplexity), true
+
+	case "TaskResourceUsage.execution":
+		if e.complexity.TaskResourceUsage.Execution == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.Execution(childComplexity), true
+
+	case "TaskResourceUsage.sampleIntervalSecs":
+		if e.complexity.TaskResourceUsage.SampleIntervalSecs == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.SampleIntervalSecs(childComplexity), true
+
+	case "TaskResourceUsage.samples":
+		if e.complexity.TaskResourceUsage.Samples == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.Samples(childComplexity), true
+
+	case "TaskResourceUsage.taskId":
+		if e.complexity.TaskResourceUsage.TaskID == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.TaskID(childComplexity), true
+
+	case "TaskResourceUsage.total":
+		if e.complexity.TaskResourceUsage.Total == nil {
+			break
+		}
+
+		return e.complexity.TaskResourceUsage.Total(childComplexity), true
+
 	case "TaskSpecifier.patchAlias":
 		if e.complexity.TaskSpecifier.PatchAlias == nil {
 			break
@@ -9152,6 +9511,83 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TaskTestResultSample.TotalTestCount(childComplexity), true
 
+	case "TestFlakiness.buildVariant":
+		if e.complexity.TestFlakiness.BuildVariant == nil {
+			break
+		}
+
+		return e.complexity.TestFlakiness.BuildVariant(childComplexity), true
+
+	case "TestFlakiness.numFlips":
+		if e.complexity.TestFlakiness.NumFlips == nil {
+			break
+		}
+
+		return e.complexity.TestFlakiness.NumFlips(childComplexity), true
+
+	case "TestFlakiness.numRuns":
+		if e.complexity.TestFlakiness.NumRuns == nil {
+			break
+		}
+
+		return e.complexity.TestFlakiness.NumRuns(childComplexity), true
+
+	case "TestFlakiness.quarantineReason":
+		if e.complexity.TestFlakiness.QuarantineReason == nil {
+			break
+		}
+
+		return e.complexity.TestFlakiness.QuarantineReason(childComplexity), true
+
+	case "TestFlakiness.quarantined":
+		if e.complexity.TestFlakiness.Quarantined == nil {
+			break
+		}
+
+		return e.complexity.TestFlakiness.Quarantined(childComplexity), true
+
+	case "TestFlakiness.quarantinedAt":
+		if e.complexity.TestFlakiness.QuarantinedAt == nil {
+			break
+		}
+
+		return e.complexity.TestFlakiness.QuarantinedAt(childComplexity), true
+
+	case "TestFlakiness.quarantinedBy":
+		if e.complexity.TestFlakiness.QuarantinedBy == nil {
+			break
+		}
+
+		return e.complexity.TestFlakiness.QuarantinedBy(childComplexity), true
+
+	case "TestFlakiness.score":
+		if e.complexity.TestFlakiness.Score == nil {
+			break
+		}
+
+		return e.complexity.TestFlakiness.Score(childComplexity), true
+
+	case "TestFlakiness.scoredAt":
+		if e.complexity.TestFlakiness.ScoredAt == nil {
+			break
+		}
+
+		return e.complexity.TestFlakiness.ScoredAt(childComplexity), true
+
+	case "TestFlakiness.taskName":
+		if e.complexity.TestFlakiness.TaskName == nil {
+			break
+		}
+
+		return e.complexity.TestFlakiness.TaskName(childComplexity), true
+
+	case "TestFlakiness.testName":
+		if e.complexity.TestFlakiness.TestName == nil {
+			break
+		}
+
+		return e.complexity.TestFlakiness.TestName(childComplexity), true
+
 	case "TestLog.lineNum":
 		if e.complexity.TestLog.LineNum == nil {
 			break
@@ -10453,6 +10889,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputInstanceTagInput,
 		ec.unmarshalInputIssueLinkInput,
 		ec.unmarshalInputJiraIssueSubscriberInput,
+		ec.unmarshalInputLogSearchOptions,
 		ec.unmarshalInputMainlineCommitsOptions,
 		ec.unmarshalInputMetadataLinkInput,
 		ec.unmarshalInputMoveProjectInput,
@@ -16150,6 +16587,34 @@ func (ec *executionContext) field_Task_tests_argsOpts(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_TaskLogs_searchLogs_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_TaskLogs_searchLogs_argsOptions(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["options"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_TaskLogs_searchLogs_argsOptions(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (LogSearchOptions, error) {
+	if _, ok := rawArgs["options"]; !ok {
+		var zeroVal LogSearchOptions
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("options"))
+	if tmp, ok := rawArgs["options"]; ok {
+		return ec.unmarshalNLogSearchOptions2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐLogSearchOptions(ctx, tmp)
+	}
+
+	var zeroVal LogSearchOptions
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_User_patches_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -20850,6 +21315,8 @@ func (ec *executionContext) fieldContext_Distro_plannerSettings(_ context.Contex
 				return ec.fieldContext_PlannerSettings_patchFactor(ctx, field)
 			case "patchTimeInQueueFactor":
 				return ec.fieldContext_PlannerSettings_patchTimeInQueueFactor(ctx, field)
+			case "strategy":
+				return ec.fieldContext_PlannerSettings_strategy(ctx, field)
 			case "targetTime":
 				return ec.fieldContext_PlannerSettings_targetTime(ctx, field)
 			case "version":
@@ -24456,6 +24923,8 @@ func (ec *executionContext) fieldContext_GroupedBuildVariant_tasks(_ context.Con
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -24474,6 +24943,8 @@ func (ec *executionContext) fieldContext_GroupedBuildVariant_tasks(_ context.Con
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -28755,6 +29226,8 @@ func (ec *executionContext) fieldContext_Image_latestTask(_ context.Context, fie
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -28773,6 +29246,8 @@ func (ec *executionContext) fieldContext_Image_latestTask(_ context.Context, fie
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -30818,8 +31293,8 @@ func (ec *executionContext) fieldContext_LogMessage_version(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _LogkeeperBuild_id(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogkeeperBuild_id(ctx, field)
+func (ec *executionContext) _LogSearchResult_data(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchResult_data(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30832,26 +31307,23 @@ func (ec *executionContext) _LogkeeperBuild_id(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Data, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogkeeperBuild_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchResult_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogkeeperBuild",
+		Object:     "LogSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -30862,8 +31334,8 @@ func (ec *executionContext) fieldContext_LogkeeperBuild_id(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _LogkeeperBuild_builder(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogkeeperBuild_builder(ctx, field)
+func (ec *executionContext) _LogSearchResult_execution(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchResult_execution(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30876,7 +31348,7 @@ func (ec *executionContext) _LogkeeperBuild_builder(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Builder, nil
+		return obj.Execution, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -30888,26 +31360,26 @@ func (ec *executionContext) _LogkeeperBuild_builder(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogkeeperBuild_builder(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchResult_execution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogkeeperBuild",
+		Object:     "LogSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogkeeperBuild_buildNum(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogkeeperBuild_buildNum(ctx, field)
+func (ec *executionContext) _LogSearchResult_isContext(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchResult_isContext(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30920,7 +31392,7 @@ func (ec *executionContext) _LogkeeperBuild_buildNum(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BuildNum, nil
+		return obj.IsContext, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -30932,26 +31404,26 @@ func (ec *executionContext) _LogkeeperBuild_buildNum(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogkeeperBuild_buildNum(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchResult_isContext(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogkeeperBuild",
+		Object:     "LogSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogkeeperBuild_taskId(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogkeeperBuild_taskId(ctx, field)
+func (ec *executionContext) _LogSearchResult_lineNumber(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchResult_lineNumber(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -30964,7 +31436,7 @@ func (ec *executionContext) _LogkeeperBuild_taskId(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TaskID, nil
+		return obj.LineNumber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -30976,26 +31448,26 @@ func (ec *executionContext) _LogkeeperBuild_taskId(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogkeeperBuild_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchResult_lineNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogkeeperBuild",
+		Object:     "LogSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogkeeperBuild_taskExecution(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogkeeperBuild_taskExecution(ctx, field)
+func (ec *executionContext) _LogSearchResult_logName(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchResult_logName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -31008,38 +31480,35 @@ func (ec *executionContext) _LogkeeperBuild_taskExecution(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TaskExecution, nil
+		return obj.LogName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogkeeperBuild_taskExecution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchResult_logName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogkeeperBuild",
+		Object:     "LogSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogkeeperBuild_tests(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogkeeperBuild_tests(ctx, field)
+func (ec *executionContext) _LogSearchResult_priority(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchResult_priority(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -31052,7 +31521,7 @@ func (ec *executionContext) _LogkeeperBuild_tests(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Tests, nil
+		return obj.Priority, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -31064,42 +31533,26 @@ func (ec *executionContext) _LogkeeperBuild_tests(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]plank.Test)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNLogkeeperTest2ᚕgithubᚗcomᚋevergreenᚑciᚋplankᚐTestᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogkeeperBuild_tests(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchResult_priority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogkeeperBuild",
+		Object:     "LogSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_LogkeeperTest_id(ctx, field)
-			case "name":
-				return ec.fieldContext_LogkeeperTest_name(ctx, field)
-			case "buildId":
-				return ec.fieldContext_LogkeeperTest_buildId(ctx, field)
-			case "taskId":
-				return ec.fieldContext_LogkeeperTest_taskId(ctx, field)
-			case "taskExecution":
-				return ec.fieldContext_LogkeeperTest_taskExecution(ctx, field)
-			case "phase":
-				return ec.fieldContext_LogkeeperTest_phase(ctx, field)
-			case "command":
-				return ec.fieldContext_LogkeeperTest_command(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LogkeeperTest", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogkeeperBuild_task(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogkeeperBuild_task(ctx, field)
+func (ec *executionContext) _LogSearchResult_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.APILogSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSearchResult_timestamp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -31112,190 +31565,515 @@ func (ec *executionContext) _LogkeeperBuild_task(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LogkeeperBuild().Task(rctx, obj)
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.APITask)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNTask2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITask(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogkeeperBuild_task(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSearchResult_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogkeeperBuild",
+		Object:     "LogSearchResult",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Task_id(ctx, field)
-			case "aborted":
-				return ec.fieldContext_Task_aborted(ctx, field)
-			case "abortInfo":
-				return ec.fieldContext_Task_abortInfo(ctx, field)
-			case "activated":
-				return ec.fieldContext_Task_activated(ctx, field)
-			case "activatedBy":
-				return ec.fieldContext_Task_activatedBy(ctx, field)
-			case "activatedTime":
-				return ec.fieldContext_Task_activatedTime(ctx, field)
-			case "ami":
-				return ec.fieldContext_Task_ami(ctx, field)
-			case "annotation":
-				return ec.fieldContext_Task_annotation(ctx, field)
-			case "baseStatus":
-				return ec.fieldContext_Task_baseStatus(ctx, field)
-			case "baseTask":
-				return ec.fieldContext_Task_baseTask(ctx, field)
-			case "blocked":
-				return ec.fieldContext_Task_blocked(ctx, field)
-			case "buildId":
-				return ec.fieldContext_Task_buildId(ctx, field)
-			case "buildVariant":
-				return ec.fieldContext_Task_buildVariant(ctx, field)
-			case "buildVariantDisplayName":
-				return ec.fieldContext_Task_buildVariantDisplayName(ctx, field)
-			case "canAbort":
-				return ec.fieldContext_Task_canAbort(ctx, field)
-			case "canDisable":
-				return ec.fieldContext_Task_canDisable(ctx, field)
-			case "canModifyAnnotation":
-				return ec.fieldContext_Task_canModifyAnnotation(ctx, field)
-			case "canOverrideDependencies":
-				return ec.fieldContext_Task_canOverrideDependencies(ctx, field)
-			case "canRestart":
-				return ec.fieldContext_Task_canRestart(ctx, field)
-			case "canSchedule":
-				return ec.fieldContext_Task_canSchedule(ctx, field)
-			case "canSetPriority":
-				return ec.fieldContext_Task_canSetPriority(ctx, field)
-			case "canUnschedule":
-				return ec.fieldContext_Task_canUnschedule(ctx, field)
-			case "containerAllocatedTime":
-				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
-			case "createTime":
-				return ec.fieldContext_Task_createTime(ctx, field)
-			case "dependsOn":
-				return ec.fieldContext_Task_dependsOn(ctx, field)
-			case "details":
-				return ec.fieldContext_Task_details(ctx, field)
-			case "dispatchTime":
-				return ec.fieldContext_Task_dispatchTime(ctx, field)
-			case "displayName":
-				return ec.fieldContext_Task_displayName(ctx, field)
-			case "displayStatus":
-				return ec.fieldContext_Task_displayStatus(ctx, field)
-			case "displayOnly":
-				return ec.fieldContext_Task_displayOnly(ctx, field)
-			case "displayTask":
-				return ec.fieldContext_Task_displayTask(ctx, field)
-			case "distroId":
-				return ec.fieldContext_Task_distroId(ctx, field)
-			case "estimatedStart":
-				return ec.fieldContext_Task_estimatedStart(ctx, field)
-			case "execution":
-				return ec.fieldContext_Task_execution(ctx, field)
-			case "executionTasks":
-				return ec.fieldContext_Task_executionTasks(ctx, field)
-			case "executionTasksFull":
-				return ec.fieldContext_Task_executionTasksFull(ctx, field)
-			case "expectedDuration":
-				return ec.fieldContext_Task_expectedDuration(ctx, field)
-			case "failedTestCount":
-				return ec.fieldContext_Task_failedTestCount(ctx, field)
-			case "finishTime":
-				return ec.fieldContext_Task_finishTime(ctx, field)
-			case "files":
-				return ec.fieldContext_Task_files(ctx, field)
-			case "generatedBy":
-				return ec.fieldContext_Task_generatedBy(ctx, field)
-			case "generatedByName":
-				return ec.fieldContext_Task_generatedByName(ctx, field)
-			case "generateTask":
-				return ec.fieldContext_Task_generateTask(ctx, field)
-			case "hasCedarResults":
-				return ec.fieldContext_Task_hasCedarResults(ctx, field)
-			case "hostId":
-				return ec.fieldContext_Task_hostId(ctx, field)
-			case "imageId":
-				return ec.fieldContext_Task_imageId(ctx, field)
-			case "ingestTime":
-				return ec.fieldContext_Task_ingestTime(ctx, field)
-			case "isPerfPluginEnabled":
-				return ec.fieldContext_Task_isPerfPluginEnabled(ctx, field)
-			case "latestExecution":
-				return ec.fieldContext_Task_latestExecution(ctx, field)
-			case "logs":
-				return ec.fieldContext_Task_logs(ctx, field)
-			case "minQueuePosition":
-				return ec.fieldContext_Task_minQueuePosition(ctx, field)
-			case "order":
-				return ec.fieldContext_Task_order(ctx, field)
-			case "patch":
-				return ec.fieldContext_Task_patch(ctx, field)
-			case "patchNumber":
-				return ec.fieldContext_Task_patchNumber(ctx, field)
-			case "pod":
-				return ec.fieldContext_Task_pod(ctx, field)
-			case "priority":
-				return ec.fieldContext_Task_priority(ctx, field)
-			case "project":
-				return ec.fieldContext_Task_project(ctx, field)
-			case "projectId":
-				return ec.fieldContext_Task_projectId(ctx, field)
-			case "projectIdentifier":
-				return ec.fieldContext_Task_projectIdentifier(ctx, field)
-			case "requester":
-				return ec.fieldContext_Task_requester(ctx, field)
-			case "resetWhenFinished":
-				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
-			case "revision":
-				return ec.fieldContext_Task_revision(ctx, field)
-			case "scheduledTime":
-				return ec.fieldContext_Task_scheduledTime(ctx, field)
-			case "spawnHostLink":
-				return ec.fieldContext_Task_spawnHostLink(ctx, field)
-			case "startTime":
-				return ec.fieldContext_Task_startTime(ctx, field)
-			case "status":
-				return ec.fieldContext_Task_status(ctx, field)
-			case "tags":
-				return ec.fieldContext_Task_tags(ctx, field)
-			case "taskGroup":
-				return ec.fieldContext_Task_taskGroup(ctx, field)
-			case "taskGroupMaxHosts":
-				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
-			case "taskLogs":
-				return ec.fieldContext_Task_taskLogs(ctx, field)
-			case "tests":
-				return ec.fieldContext_Task_tests(ctx, field)
-			case "timeTaken":
-				return ec.fieldContext_Task_timeTaken(ctx, field)
-			case "totalTestCount":
-				return ec.fieldContext_Task_totalTestCount(ctx, field)
-			case "versionMetadata":
-				return ec.fieldContext_Task_versionMetadata(ctx, field)
-			case "stepbackInfo":
-				return ec.fieldContext_Task_stepbackInfo(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Task", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogkeeperTest_id(ctx context.Context, field graphql.CollectedField, obj *plank.Test) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogkeeperTest_id(ctx, field)
+func (ec *executionContext) _LogkeeperBuild_id(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogkeeperBuild_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogkeeperBuild_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogkeeperBuild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogkeeperBuild_builder(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogkeeperBuild_builder(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Builder, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogkeeperBuild_builder(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogkeeperBuild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogkeeperBuild_buildNum(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogkeeperBuild_buildNum(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BuildNum, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogkeeperBuild_buildNum(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogkeeperBuild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogkeeperBuild_taskId(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogkeeperBuild_taskId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TaskID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogkeeperBuild_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogkeeperBuild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogkeeperBuild_taskExecution(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogkeeperBuild_taskExecution(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TaskExecution, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogkeeperBuild_taskExecution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogkeeperBuild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogkeeperBuild_tests(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogkeeperBuild_tests(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tests, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]plank.Test)
+	fc.Result = res
+	return ec.marshalNLogkeeperTest2ᚕgithubᚗcomᚋevergreenᚑciᚋplankᚐTestᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogkeeperBuild_tests(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogkeeperBuild",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LogkeeperTest_id(ctx, field)
+			case "name":
+				return ec.fieldContext_LogkeeperTest_name(ctx, field)
+			case "buildId":
+				return ec.fieldContext_LogkeeperTest_buildId(ctx, field)
+			case "taskId":
+				return ec.fieldContext_LogkeeperTest_taskId(ctx, field)
+			case "taskExecution":
+				return ec.fieldContext_LogkeeperTest_taskExecution(ctx, field)
+			case "phase":
+				return ec.fieldContext_LogkeeperTest_phase(ctx, field)
+			case "command":
+				return ec.fieldContext_LogkeeperTest_command(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LogkeeperTest", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogkeeperBuild_task(ctx context.Context, field graphql.CollectedField, obj *plank.Build) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogkeeperBuild_task(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.LogkeeperBuild().Task(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.APITask)
+	fc.Result = res
+	return ec.marshalNTask2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITask(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogkeeperBuild_task(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogkeeperBuild",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Task_id(ctx, field)
+			case "aborted":
+				return ec.fieldContext_Task_aborted(ctx, field)
+			case "abortInfo":
+				return ec.fieldContext_Task_abortInfo(ctx, field)
+			case "activated":
+				return ec.fieldContext_Task_activated(ctx, field)
+			case "activatedBy":
+				return ec.fieldContext_Task_activatedBy(ctx, field)
+			case "activatedTime":
+				return ec.fieldContext_Task_activatedTime(ctx, field)
+			case "ami":
+				return ec.fieldContext_Task_ami(ctx, field)
+			case "annotation":
+				return ec.fieldContext_Task_annotation(ctx, field)
+			case "baseStatus":
+				return ec.fieldContext_Task_baseStatus(ctx, field)
+			case "baseTask":
+				return ec.fieldContext_Task_baseTask(ctx, field)
+			case "blocked":
+				return ec.fieldContext_Task_blocked(ctx, field)
+			case "buildId":
+				return ec.fieldContext_Task_buildId(ctx, field)
+			case "buildVariant":
+				return ec.fieldContext_Task_buildVariant(ctx, field)
+			case "buildVariantDisplayName":
+				return ec.fieldContext_Task_buildVariantDisplayName(ctx, field)
+			case "canAbort":
+				return ec.fieldContext_Task_canAbort(ctx, field)
+			case "canDisable":
+				return ec.fieldContext_Task_canDisable(ctx, field)
+			case "canModifyAnnotation":
+				return ec.fieldContext_Task_canModifyAnnotation(ctx, field)
+			case "canOverrideDependencies":
+				return ec.fieldContext_Task_canOverrideDependencies(ctx, field)
+			case "canRestart":
+				return ec.fieldContext_Task_canRestart(ctx, field)
+			case "canSchedule":
+				return ec.fieldContext_Task_canSchedule(ctx, field)
+			case "canSetPriority":
+				return ec.fieldContext_Task_canSetPriority(ctx, field)
+			case "canUnschedule":
+				return ec.fieldContext_Task_canUnschedule(ctx, field)
+			case "containerAllocatedTime":
+				return ec.fieldContext_Task_containerAllocatedTime(ctx, field)
+			case "createTime":
+				return ec.fieldContext_Task_createTime(ctx, field)
+			case "dependsOn":
+				return ec.fieldContext_Task_dependsOn(ctx, field)
+			case "details":
+				return ec.fieldContext_Task_details(ctx, field)
+			case "dispatchTime":
+				return ec.fieldContext_Task_dispatchTime(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Task_displayName(ctx, field)
+			case "displayStatus":
+				return ec.fieldContext_Task_displayStatus(ctx, field)
+			case "displayOnly":
+				return ec.fieldContext_Task_displayOnly(ctx, field)
+			case "displayTask":
+				return ec.fieldContext_Task_displayTask(ctx, field)
+			case "distroId":
+				return ec.fieldContext_Task_distroId(ctx, field)
+			case "estimatedStart":
+				return ec.fieldContext_Task_estimatedStart(ctx, field)
+			case "execution":
+				return ec.fieldContext_Task_execution(ctx, field)
+			case "executionTasks":
+				return ec.fieldContext_Task_executionTasks(ctx, field)
+			case "executionTasksFull":
+				return ec.fieldContext_Task_executionTasksFull(ctx, field)
+			case "expectedDuration":
+				return ec.fieldContext_Task_expectedDuration(ctx, field)
+			case "failedTestCount":
+				return ec.fieldContext_Task_failedTestCount(ctx, field)
+			case "finishTime":
+				return ec.fieldContext_Task_finishTime(ctx, field)
+			case "files":
+				return ec.fieldContext_Task_files(ctx, field)
+			case "generatedBy":
+				return ec.fieldContext_Task_generatedBy(ctx, field)
+			case "generatedByName":
+				return ec.fieldContext_Task_generatedByName(ctx, field)
+			case "generateTask":
+				return ec.fieldContext_Task_generateTask(ctx, field)
+			case "hasCedarResults":
+				return ec.fieldContext_Task_hasCedarResults(ctx, field)
+			case "hostId":
+				return ec.fieldContext_Task_hostId(ctx, field)
+			case "imageId":
+				return ec.fieldContext_Task_imageId(ctx, field)
+			case "ingestTime":
+				return ec.fieldContext_Task_ingestTime(ctx, field)
+			case "isPerfPluginEnabled":
+				return ec.fieldContext_Task_isPerfPluginEnabled(ctx, field)
+			case "latestExecution":
+				return ec.fieldContext_Task_latestExecution(ctx, field)
+			case "logs":
+				return ec.fieldContext_Task_logs(ctx, field)
+			case "minQueuePosition":
+				return ec.fieldContext_Task_minQueuePosition(ctx, field)
+			case "order":
+				return ec.fieldContext_Task_order(ctx, field)
+			case "patch":
+				return ec.fieldContext_Task_patch(ctx, field)
+			case "patchNumber":
+				return ec.fieldContext_Task_patchNumber(ctx, field)
+			case "pod":
+				return ec.fieldContext_Task_pod(ctx, field)
+			case "priority":
+				return ec.fieldContext_Task_priority(ctx, field)
+			case "project":
+				return ec.fieldContext_Task_project(ctx, field)
+			case "projectId":
+				return ec.fieldContext_Task_projectId(ctx, field)
+			case "projectIdentifier":
+				return ec.fieldContext_Task_projectIdentifier(ctx, field)
+			case "requester":
+				return ec.fieldContext_Task_requester(ctx, field)
+			case "resetWhenFinished":
+				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
+			case "revision":
+				return ec.fieldContext_Task_revision(ctx, field)
+			case "scheduledTime":
+				return ec.fieldContext_Task_scheduledTime(ctx, field)
+			case "spawnHostLink":
+				return ec.fieldContext_Task_spawnHostLink(ctx, field)
+			case "startTime":
+				return ec.fieldContext_Task_startTime(ctx, field)
+			case "status":
+				return ec.fieldContext_Task_status(ctx, field)
+			case "tags":
+				return ec.fieldContext_Task_tags(ctx, field)
+			case "taskGroup":
+				return ec.fieldContext_Task_taskGroup(ctx, field)
+			case "taskGroupMaxHosts":
+				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
+			case "taskLogs":
+				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
+			case "tests":
+				return ec.fieldContext_Task_tests(ctx, field)
+			case "timeTaken":
+				return ec.fieldContext_Task_timeTaken(ctx, field)
+			case "totalTestCount":
+				return ec.fieldContext_Task_totalTestCount(ctx, field)
+			case "versionMetadata":
+				return ec.fieldContext_Task_versionMetadata(ctx, field)
+			case "stepbackInfo":
+				return ec.fieldContext_Task_stepbackInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Task", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogkeeperTest_id(ctx context.Context, field graphql.CollectedField, obj *plank.Test) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogkeeperTest_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35718,6 +36496,8 @@ func (ec *executionContext) fieldContext_Mutation_abortTask(ctx context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -35736,6 +36516,8 @@ func (ec *executionContext) fieldContext_Mutation_abortTask(ctx context.Context,
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -35925,6 +36707,8 @@ func (ec *executionContext) fieldContext_Mutation_overrideTaskDependencies(ctx c
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -35943,6 +36727,8 @@ func (ec *executionContext) fieldContext_Mutation_overrideTaskDependencies(ctx c
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -36132,6 +36918,8 @@ func (ec *executionContext) fieldContext_Mutation_restartTask(ctx context.Contex
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -36150,6 +36938,8 @@ func (ec *executionContext) fieldContext_Mutation_restartTask(ctx context.Contex
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -36339,6 +37129,8 @@ func (ec *executionContext) fieldContext_Mutation_scheduleTasks(ctx context.Cont
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -36357,6 +37149,8 @@ func (ec *executionContext) fieldContext_Mutation_scheduleTasks(ctx context.Cont
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -36546,6 +37340,8 @@ func (ec *executionContext) fieldContext_Mutation_setTaskPriority(ctx context.Co
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -36564,6 +37360,8 @@ func (ec *executionContext) fieldContext_Mutation_setTaskPriority(ctx context.Co
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -36753,6 +37551,8 @@ func (ec *executionContext) fieldContext_Mutation_unscheduleTask(ctx context.Con
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -36771,6 +37571,8 @@ func (ec *executionContext) fieldContext_Mutation_unscheduleTask(ctx context.Con
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -37909,6 +38711,8 @@ func (ec *executionContext) fieldContext_Mutation_scheduleUndispatchedBaseTasks(
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -37927,6 +38731,8 @@ func (ec *executionContext) fieldContext_Mutation_scheduleUndispatchedBaseTasks(
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -42725,6 +43531,50 @@ func (ec *executionContext) fieldContext_PlannerSettings_patchTimeInQueueFactor(
 	return fc, nil
 }
 
+func (ec *executionContext) _PlannerSettings_strategy(ctx context.Context, field graphql.CollectedField, obj *model.APIPlannerSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PlannerSettings_strategy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.PlannerSettings().Strategy(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(PlannerStrategy)
+	fc.Result = res
+	return ec.marshalNPlannerStrategy2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐPlannerStrategy(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PlannerSettings_strategy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlannerSettings",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PlannerStrategy does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _PlannerSettings_targetTime(ctx context.Context, field graphql.CollectedField, obj *model.APIPlannerSettings) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_PlannerSettings_targetTime(ctx, field)
 	if err != nil {
@@ -43120,6 +43970,8 @@ func (ec *executionContext) fieldContext_Pod_task(_ context.Context, field graph
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -43138,6 +43990,8 @@ func (ec *executionContext) fieldContext_Pod_task(_ context.Context, field graph
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -43661,6 +44515,8 @@ func (ec *executionContext) fieldContext_PodEventLogData_task(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -43679,6 +44535,8 @@ func (ec *executionContext) fieldContext_PodEventLogData_task(_ context.Context,
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -50958,6 +51816,8 @@ func (ec *executionContext) fieldContext_Query_task(ctx context.Context, field g
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -50976,6 +51836,8 @@ func (ec *executionContext) fieldContext_Query_task(ctx context.Context, field g
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -51165,6 +52027,8 @@ func (ec *executionContext) fieldContext_Query_taskAllExecutions(ctx context.Con
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -51183,6 +52047,8 @@ func (ec *executionContext) fieldContext_Query_taskAllExecutions(ctx context.Con
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -55148,8 +56014,8 @@ func (ec *executionContext) fieldContext_ResourceLimits_virtualMemoryKb(_ contex
 	return fc, nil
 }
 
-func (ec *executionContext) _SaveDistroPayload_distro(ctx context.Context, field graphql.CollectedField, obj *SaveDistroPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SaveDistroPayload_distro(ctx, field)
+func (ec *executionContext) _ResourceUsageSample_block(ctx context.Context, field graphql.CollectedField, obj *resourceusage.Sample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSample_block(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55162,106 +56028,35 @@ func (ec *executionContext) _SaveDistroPayload_distro(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Distro, nil
+		return obj.Block, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.APIDistro)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNDistro2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDistro(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SaveDistroPayload_distro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSample_block(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SaveDistroPayload",
+		Object:     "ResourceUsageSample",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "adminOnly":
-				return ec.fieldContext_Distro_adminOnly(ctx, field)
-			case "aliases":
-				return ec.fieldContext_Distro_aliases(ctx, field)
-			case "arch":
-				return ec.fieldContext_Distro_arch(ctx, field)
-			case "authorizedKeysFile":
-				return ec.fieldContext_Distro_authorizedKeysFile(ctx, field)
-			case "bootstrapSettings":
-				return ec.fieldContext_Distro_bootstrapSettings(ctx, field)
-			case "containerPool":
-				return ec.fieldContext_Distro_containerPool(ctx, field)
-			case "disabled":
-				return ec.fieldContext_Distro_disabled(ctx, field)
-			case "disableShallowClone":
-				return ec.fieldContext_Distro_disableShallowClone(ctx, field)
-			case "dispatcherSettings":
-				return ec.fieldContext_Distro_dispatcherSettings(ctx, field)
-			case "execUser":
-				return ec.fieldContext_Distro_execUser(ctx, field)
-			case "expansions":
-				return ec.fieldContext_Distro_expansions(ctx, field)
-			case "finderSettings":
-				return ec.fieldContext_Distro_finderSettings(ctx, field)
-			case "homeVolumeSettings":
-				return ec.fieldContext_Distro_homeVolumeSettings(ctx, field)
-			case "hostAllocatorSettings":
-				return ec.fieldContext_Distro_hostAllocatorSettings(ctx, field)
-			case "iceCreamSettings":
-				return ec.fieldContext_Distro_iceCreamSettings(ctx, field)
-			case "imageId":
-				return ec.fieldContext_Distro_imageId(ctx, field)
-			case "isCluster":
-				return ec.fieldContext_Distro_isCluster(ctx, field)
-			case "isVirtualWorkStation":
-				return ec.fieldContext_Distro_isVirtualWorkStation(ctx, field)
-			case "mountpoints":
-				return ec.fieldContext_Distro_mountpoints(ctx, field)
-			case "name":
-				return ec.fieldContext_Distro_name(ctx, field)
-			case "note":
-				return ec.fieldContext_Distro_note(ctx, field)
-			case "plannerSettings":
-				return ec.fieldContext_Distro_plannerSettings(ctx, field)
-			case "provider":
-				return ec.fieldContext_Distro_provider(ctx, field)
-			case "providerSettingsList":
-				return ec.fieldContext_Distro_providerSettingsList(ctx, field)
-			case "setup":
-				return ec.fieldContext_Distro_setup(ctx, field)
-			case "setupAsSudo":
-				return ec.fieldContext_Distro_setupAsSudo(ctx, field)
-			case "singleTaskDistro":
-				return ec.fieldContext_Distro_singleTaskDistro(ctx, field)
-			case "sshOptions":
-				return ec.fieldContext_Distro_sshOptions(ctx, field)
-			case "user":
-				return ec.fieldContext_Distro_user(ctx, field)
-			case "userSpawnAllowed":
-				return ec.fieldContext_Distro_userSpawnAllowed(ctx, field)
-			case "validProjects":
-				return ec.fieldContext_Distro_validProjects(ctx, field)
-			case "warningNote":
-				return ec.fieldContext_Distro_warningNote(ctx, field)
-			case "workDir":
-				return ec.fieldContext_Distro_workDir(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Distro", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SaveDistroPayload_hostCount(ctx context.Context, field graphql.CollectedField, obj *SaveDistroPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SaveDistroPayload_hostCount(ctx, field)
+func (ec *executionContext) _ResourceUsageSample_command(ctx context.Context, field graphql.CollectedField, obj *resourceusage.Sample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSample_command(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55274,38 +56069,35 @@ func (ec *executionContext) _SaveDistroPayload_hostCount(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HostCount, nil
+		return obj.Command, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SaveDistroPayload_hostCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSample_command(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SaveDistroPayload",
+		Object:     "ResourceUsageSample",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SearchReturnInfo_featuresURL(ctx context.Context, field graphql.CollectedField, obj *thirdparty.SearchReturnInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SearchReturnInfo_featuresURL(ctx, field)
+func (ec *executionContext) _ResourceUsageSample_cpuPercent(ctx context.Context, field graphql.CollectedField, obj *resourceusage.Sample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSample_cpuPercent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55318,7 +56110,7 @@ func (ec *executionContext) _SearchReturnInfo_featuresURL(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FeaturesURL, nil
+		return obj.CPUPercent, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55330,26 +56122,26 @@ func (ec *executionContext) _SearchReturnInfo_featuresURL(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SearchReturnInfo_featuresURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSample_cpuPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SearchReturnInfo",
+		Object:     "ResourceUsageSample",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SearchReturnInfo_issues(ctx context.Context, field graphql.CollectedField, obj *thirdparty.SearchReturnInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SearchReturnInfo_issues(ctx, field)
+func (ec *executionContext) _ResourceUsageSample_diskReadBytes(ctx context.Context, field graphql.CollectedField, obj *resourceusage.Sample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSample_diskReadBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55362,7 +56154,7 @@ func (ec *executionContext) _SearchReturnInfo_issues(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Issues, nil
+		return obj.DiskReadBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55374,32 +56166,26 @@ func (ec *executionContext) _SearchReturnInfo_issues(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]thirdparty.JiraTicket)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNJiraTicket2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋthirdpartyᚐJiraTicketᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SearchReturnInfo_issues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSample_diskReadBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SearchReturnInfo",
+		Object:     "ResourceUsageSample",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "fields":
-				return ec.fieldContext_JiraTicket_fields(ctx, field)
-			case "key":
-				return ec.fieldContext_JiraTicket_key(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type JiraTicket", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SearchReturnInfo_search(ctx context.Context, field graphql.CollectedField, obj *thirdparty.SearchReturnInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SearchReturnInfo_search(ctx, field)
+func (ec *executionContext) _ResourceUsageSample_diskWriteBytes(ctx context.Context, field graphql.CollectedField, obj *resourceusage.Sample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSample_diskWriteBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55412,7 +56198,7 @@ func (ec *executionContext) _SearchReturnInfo_search(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Search, nil
+		return obj.DiskWriteBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55424,26 +56210,26 @@ func (ec *executionContext) _SearchReturnInfo_search(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SearchReturnInfo_search(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSample_diskWriteBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SearchReturnInfo",
+		Object:     "ResourceUsageSample",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SearchReturnInfo_source(ctx context.Context, field graphql.CollectedField, obj *thirdparty.SearchReturnInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SearchReturnInfo_source(ctx, field)
+func (ec *executionContext) _ResourceUsageSample_memoryBytes(ctx context.Context, field graphql.CollectedField, obj *resourceusage.Sample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSample_memoryBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55456,7 +56242,7 @@ func (ec *executionContext) _SearchReturnInfo_source(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Source, nil
+		return obj.MemoryBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55468,26 +56254,26 @@ func (ec *executionContext) _SearchReturnInfo_source(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SearchReturnInfo_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSample_memoryBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SearchReturnInfo",
+		Object:     "ResourceUsageSample",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Selector_data(ctx context.Context, field graphql.CollectedField, obj *model.APISelector) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Selector_data(ctx, field)
+func (ec *executionContext) _ResourceUsageSample_netRecvBytes(ctx context.Context, field graphql.CollectedField, obj *resourceusage.Sample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSample_netRecvBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55500,7 +56286,7 @@ func (ec *executionContext) _Selector_data(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Data, nil
+		return obj.NetRecvBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55512,26 +56298,26 @@ func (ec *executionContext) _Selector_data(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Selector_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSample_netRecvBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Selector",
+		Object:     "ResourceUsageSample",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Selector_type(ctx context.Context, field graphql.CollectedField, obj *model.APISelector) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Selector_type(ctx, field)
+func (ec *executionContext) _ResourceUsageSample_netSentBytes(ctx context.Context, field graphql.CollectedField, obj *resourceusage.Sample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSample_netSentBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55544,7 +56330,7 @@ func (ec *executionContext) _Selector_type(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.NetSentBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55556,26 +56342,26 @@ func (ec *executionContext) _Selector_type(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Selector_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSample_netSentBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Selector",
+		Object:     "ResourceUsageSample",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SetLastRevisionPayload_mergeBaseRevision(ctx context.Context, field graphql.CollectedField, obj *SetLastRevisionPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SetLastRevisionPayload_mergeBaseRevision(ctx, field)
+func (ec *executionContext) _ResourceUsageSample_processes(ctx context.Context, field graphql.CollectedField, obj *resourceusage.Sample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSample_processes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55588,7 +56374,7 @@ func (ec *executionContext) _SetLastRevisionPayload_mergeBaseRevision(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MergeBaseRevision, nil
+		return obj.Processes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55600,26 +56386,26 @@ func (ec *executionContext) _SetLastRevisionPayload_mergeBaseRevision(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SetLastRevisionPayload_mergeBaseRevision(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSample_processes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SetLastRevisionPayload",
+		Object:     "ResourceUsageSample",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SingleTaskDistroConfig_projectTasksPairs(ctx context.Context, field graphql.CollectedField, obj *model.APISingleTaskDistroConfig) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SingleTaskDistroConfig_projectTasksPairs(ctx, field)
+func (ec *executionContext) _ResourceUsageSample_time(ctx context.Context, field graphql.CollectedField, obj *resourceusage.Sample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSample_time(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55632,7 +56418,7 @@ func (ec *executionContext) _SingleTaskDistroConfig_projectTasksPairs(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ProjectTasksPairs, nil
+		return obj.Time, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55644,34 +56430,26 @@ func (ec *executionContext) _SingleTaskDistroConfig_projectTasksPairs(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.APIProjectTasksPair)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNProjectTasksPair2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectTasksPairᚄ(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SingleTaskDistroConfig_projectTasksPairs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSample_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SingleTaskDistroConfig",
+		Object:     "ResourceUsageSample",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "projectId":
-				return ec.fieldContext_ProjectTasksPair_projectId(ctx, field)
-			case "allowedTasks":
-				return ec.fieldContext_ProjectTasksPair_allowedTasks(ctx, field)
-			case "allowedBVs":
-				return ec.fieldContext_ProjectTasksPair_allowedBVs(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ProjectTasksPair", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SlackConfig_name(ctx context.Context, field graphql.CollectedField, obj *model.APISlackConfig) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SlackConfig_name(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_avgCpuPercent(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_avgCpuPercent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55684,35 +56462,38 @@ func (ec *executionContext) _SlackConfig_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.AvgCPUPercent, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SlackConfig_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_avgCpuPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SlackConfig",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SleepSchedule_dailyStartTime(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SleepSchedule_dailyStartTime(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_avgMemoryBytes(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_avgMemoryBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55725,7 +56506,7 @@ func (ec *executionContext) _SleepSchedule_dailyStartTime(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DailyStartTime, nil
+		return obj.AvgMemoryBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55737,26 +56518,26 @@ func (ec *executionContext) _SleepSchedule_dailyStartTime(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SleepSchedule_dailyStartTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_avgMemoryBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SleepSchedule",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SleepSchedule_dailyStopTime(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SleepSchedule_dailyStopTime(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_block(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_block(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55769,26 +56550,23 @@ func (ec *executionContext) _SleepSchedule_dailyStopTime(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DailyStopTime, nil
+		return obj.Block, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SleepSchedule_dailyStopTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_block(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SleepSchedule",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -55799,8 +56577,8 @@ func (ec *executionContext) fieldContext_SleepSchedule_dailyStopTime(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _SleepSchedule_nextStartTime(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SleepSchedule_nextStartTime(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_command(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_command(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55813,7 +56591,7 @@ func (ec *executionContext) _SleepSchedule_nextStartTime(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NextStartTime, nil
+		return obj.Command, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55822,26 +56600,26 @@ func (ec *executionContext) _SleepSchedule_nextStartTime(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SleepSchedule_nextStartTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_command(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SleepSchedule",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SleepSchedule_nextStopTime(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SleepSchedule_nextStopTime(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_diskReadBytes(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_diskReadBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55854,35 +56632,38 @@ func (ec *executionContext) _SleepSchedule_nextStopTime(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NextStopTime, nil
+		return obj.DiskReadBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SleepSchedule_nextStopTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_diskReadBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SleepSchedule",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SleepSchedule_permanentlyExempt(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SleepSchedule_permanentlyExempt(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_diskWriteBytes(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_diskWriteBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55895,7 +56676,7 @@ func (ec *executionContext) _SleepSchedule_permanentlyExempt(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PermanentlyExempt, nil
+		return obj.DiskWriteBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55907,26 +56688,26 @@ func (ec *executionContext) _SleepSchedule_permanentlyExempt(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SleepSchedule_permanentlyExempt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_diskWriteBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SleepSchedule",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SleepSchedule_shouldKeepOff(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SleepSchedule_shouldKeepOff(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_durationSecs(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_durationSecs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55939,7 +56720,7 @@ func (ec *executionContext) _SleepSchedule_shouldKeepOff(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ShouldKeepOff, nil
+		return obj.DurationSecs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55951,26 +56732,26 @@ func (ec *executionContext) _SleepSchedule_shouldKeepOff(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SleepSchedule_shouldKeepOff(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_durationSecs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SleepSchedule",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SleepSchedule_timeZone(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SleepSchedule_timeZone(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_netRecvBytes(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_netRecvBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -55983,7 +56764,7 @@ func (ec *executionContext) _SleepSchedule_timeZone(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TimeZone, nil
+		return obj.NetRecvBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -55995,26 +56776,26 @@ func (ec *executionContext) _SleepSchedule_timeZone(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SleepSchedule_timeZone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_netRecvBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SleepSchedule",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SleepSchedule_temporarilyExemptUntil(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SleepSchedule_temporarilyExemptUntil(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_netSentBytes(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_netSentBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -56027,35 +56808,38 @@ func (ec *executionContext) _SleepSchedule_temporarilyExemptUntil(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TemporarilyExemptUntil, nil
+		return obj.NetSentBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SleepSchedule_temporarilyExemptUntil(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_netSentBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SleepSchedule",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SleepSchedule_wholeWeekdaysOff(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SleepSchedule_wholeWeekdaysOff(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_numSamples(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_numSamples(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -56068,7 +56852,7 @@ func (ec *executionContext) _SleepSchedule_wholeWeekdaysOff(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.SleepSchedule().WholeWeekdaysOff(rctx, obj)
+		return obj.NumSamples, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -56080,17 +56864,17 @@ func (ec *executionContext) _SleepSchedule_wholeWeekdaysOff(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]int)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNInt2ᚕintᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SleepSchedule_wholeWeekdaysOff(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_numSamples(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SleepSchedule",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type Int does not have child fields")
 		},
@@ -56098,8 +56882,8 @@ func (ec *executionContext) fieldContext_SleepSchedule_wholeWeekdaysOff(_ contex
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_author(ctx context.Context, field graphql.CollectedField, obj *model.APISource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_author(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_peakCpuPercent(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_peakCpuPercent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -56112,7 +56896,7 @@ func (ec *executionContext) _Source_author(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Author, nil
+		return obj.PeakCPUPercent, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -56124,26 +56908,26 @@ func (ec *executionContext) _Source_author(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_author(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_peakCpuPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_requester(ctx context.Context, field graphql.CollectedField, obj *model.APISource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_requester(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_peakMemoryBytes(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_peakMemoryBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -56156,7 +56940,7 @@ func (ec *executionContext) _Source_requester(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Requester, nil
+		return obj.PeakMemoryBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -56168,26 +56952,26 @@ func (ec *executionContext) _Source_requester(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_requester(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_peakMemoryBytes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_time(ctx context.Context, field graphql.CollectedField, obj *model.APISource) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_time(ctx, field)
+func (ec *executionContext) _ResourceUsageSummary_peakProcesses(ctx context.Context, field graphql.CollectedField, obj *model.APIResourceUsageSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsageSummary_peakProcesses(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -56200,7 +56984,7 @@ func (ec *executionContext) _Source_time(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Time, nil
+		return obj.PeakProcesses, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -56212,26 +56996,26 @@ func (ec *executionContext) _Source_time(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsageSummary_peakProcesses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "ResourceUsageSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SpawnHostConfig_spawnHostsPerUser(ctx context.Context, field graphql.CollectedField, obj *model.APISpawnHostConfig) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SpawnHostConfig_spawnHostsPerUser(ctx, field)
+func (ec *executionContext) _SaveDistroPayload_distro(ctx context.Context, field graphql.CollectedField, obj *SaveDistroPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SaveDistroPayload_distro(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -56244,7 +57028,7 @@ func (ec *executionContext) _SpawnHostConfig_spawnHostsPerUser(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpawnHostsPerUser, nil
+		return obj.Distro, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -56256,26 +57040,94 @@ func (ec *executionContext) _SpawnHostConfig_spawnHostsPerUser(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*model.APIDistro)
 	fc.Result = res
-	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNDistro2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIDistro(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SpawnHostConfig_spawnHostsPerUser(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SaveDistroPayload_distro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SpawnHostConfig",
+		Object:     "SaveDistroPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "adminOnly":
+				return ec.fieldContext_Distro_adminOnly(ctx, field)
+			case "aliases":
+				return ec.fieldContext_Distro_aliases(ctx, field)
+			case "arch":
+				return ec.fieldContext_Distro_arch(ctx, field)
+			case "authorizedKeysFile":
+				return ec.fieldContext_Distro_authorizedKeysFile(ctx, field)
+			case "bootstrapSettings":
+				return ec.fieldContext_Distro_bootstrapSettings(ctx, field)
+			case "containerPool":
+				return ec.fieldContext_Distro_containerPool(ctx, field)
+			case "disabled":
+				return ec.fieldContext_Distro_disabled(ctx, field)
+			case "disableShallowClone":
+				return ec.fieldContext_Distro_disableShallowClone(ctx, field)
+			case "dispatcherSettings":
+				return ec.fieldContext_Distro_dispatcherSettings(ctx, field)
+			case "execUser":
+				return ec.fieldContext_Distro_execUser(ctx, field)
+			case "expansions":
+				return ec.fieldContext_Distro_expansions(ctx, field)
+			case "finderSettings":
+				return ec.fieldContext_Distro_finderSettings(ctx, field)
+			case "homeVolumeSettings":
+				return ec.fieldContext_Distro_homeVolumeSettings(ctx, field)
+			case "hostAllocatorSettings":
+				return ec.fieldContext_Distro_hostAllocatorSettings(ctx, field)
+			case "iceCreamSettings":
+				return ec.fieldContext_Distro_iceCreamSettings(ctx, field)
+			case "imageId":
+				return ec.fieldContext_Distro_imageId(ctx, field)
+			case "isCluster":
+				return ec.fieldContext_Distro_isCluster(ctx, field)
+			case "isVirtualWorkStation":
+				return ec.fieldContext_Distro_isVirtualWorkStation(ctx, field)
+			case "mountpoints":
+				return ec.fieldContext_Distro_mountpoints(ctx, field)
+			case "name":
+				return ec.fieldContext_Distro_name(ctx, field)
+			case "note":
+				return ec.fieldContext_Distro_note(ctx, field)
+			case "plannerSettings":
+				return ec.fieldContext_Distro_plannerSettings(ctx, field)
+			case "provider":
+				return ec.fieldContext_Distro_provider(ctx, field)
+			case "providerSettingsList":
+				return ec.fieldContext_Distro_providerSettingsList(ctx, field)
+			case "setup":
+				return ec.fieldContext_Distro_setup(ctx, field)
+			case "setupAsSudo":
+				return ec.fieldContext_Distro_setupAsSudo(ctx, field)
+			case "singleTaskDistro":
+				return ec.fieldContext_Distro_singleTaskDistro(ctx, field)
+			case "sshOptions":
+				return ec.fieldContext_Distro_sshOptions(ctx, field)
+			case "user":
+				return ec.fieldContext_Distro_user(ctx, field)
+			case "userSpawnAllowed":
+				return ec.fieldContext_Distro_userSpawnAllowed(ctx, field)
+			case "validProjects":
+				return ec.fieldContext_Distro_validProjects(ctx, field)
+			case "warningNote":
+				return ec.fieldContext_Distro_warningNote(ctx, field)
+			case "workDir":
+				return ec.fieldContext_Distro_workDir(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Distro", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SpawnHostConfig_unexpirableHostsPerUser(ctx context.Context, field graphql.CollectedField, obj *model.APISpawnHostConfig) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SpawnHostConfig_unexpirableHostsPerUser(ctx, field)
+func (ec *executionContext) _SaveDistroPayload_hostCount(ctx context.Context, field graphql.CollectedField, obj *SaveDistroPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SaveDistroPayload_hostCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -56288,7 +57140,7 @@ func (ec *executionContext) _SpawnHostConfig_unexpirableHostsPerUser(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UnexpirableHostsPerUser, nil
+		return obj.HostCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -56300,14 +57152,14 @@ func (ec *executionContext) _SpawnHostConfig_unexpirableHostsPerUser(ctx context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SpawnHostConfig_unexpirableHostsPerUser(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SaveDistroPayload_hostCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SpawnHostConfig",
+		Object:     "SaveDistroPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -56318,8 +57170,8 @@ func (ec *executionContext) fieldContext_SpawnHostConfig_unexpirableHostsPerUser
 	return fc, nil
 }
 
-func (ec *executionContext) _SpawnHostConfig_unexpirableVolumesPerUser(ctx context.Context, field graphql.CollectedField, obj *model.APISpawnHostConfig) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SpawnHostConfig_unexpirableVolumesPerUser(ctx, field)
+func (ec *executionContext) _SearchReturnInfo_featuresURL(ctx context.Context, field graphql.CollectedField, obj *thirdparty.SearchReturnInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SearchReturnInfo_featuresURL(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -56332,7 +57184,7 @@ func (ec *executionContext) _SpawnHostConfig_unexpirableVolumesPerUser(ctx conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UnexpirableVolumesPerUser, nil
+		return obj.FeaturesURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -56344,26 +57196,26 @@ func (ec *executionContext) _SpawnHostConfig_unexpirableVolumesPerUser(ctx conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SpawnHostConfig_unexpirableVolumesPerUser(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SearchReturnInfo_featuresURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SpawnHostConfig",
+		Object:     "SearchReturnInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SpruceConfig_banner(ctx context.Context, field graphql.CollectedField, obj *model.APIAdminSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SpruceConfig_banner(ctx, field)
+func (ec *executionContext) _SearchReturnInfo_issues(ctx context.Context, field graphql.CollectedField, obj *thirdparty.SearchReturnInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SearchReturnInfo_issues(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -56376,35 +57228,1049 @@ func (ec *executionContext) _SpruceConfig_banner(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Banner, nil
+		return obj.Issues, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]thirdparty.JiraTicket)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNJiraTicket2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋthirdpartyᚐJiraTicketᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SpruceConfig_banner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SearchReturnInfo_issues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SpruceConfig",
+		Object:     "SearchReturnInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "fields":
+				return ec.fieldContext_JiraTicket_fields(ctx, field)
+			case "key":
+				return ec.fieldContext_JiraTicket_key(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type JiraTicket", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SpruceConfig_bannerTheme(ctx context.Context, field graphql.CollectedField, obj *model.APIAdminSettings) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SpruceConfig_bannerTheme(ctx, field)
+func (ec *executionContext) _SearchReturnInfo_search(ctx context.Context, field graphql.CollectedField, obj *thirdparty.SearchReturnInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SearchReturnInfo_search(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Search, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SearchReturnInfo_search(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchReturnInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SearchReturnInfo_source(ctx context.Context, field graphql.CollectedField, obj *thirdparty.SearchReturnInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SearchReturnInfo_source(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Source, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SearchReturnInfo_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SearchReturnInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Selector_data(ctx context.Context, field graphql.CollectedField, obj *model.APISelector) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Selector_data(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Data, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Selector_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Selector",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Selector_type(ctx context.Context, field graphql.CollectedField, obj *model.APISelector) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Selector_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Selector_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Selector",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SetLastRevisionPayload_mergeBaseRevision(ctx context.Context, field graphql.CollectedField, obj *SetLastRevisionPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SetLastRevisionPayload_mergeBaseRevision(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MergeBaseRevision, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SetLastRevisionPayload_mergeBaseRevision(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SetLastRevisionPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SingleTaskDistroConfig_projectTasksPairs(ctx context.Context, field graphql.CollectedField, obj *model.APISingleTaskDistroConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SingleTaskDistroConfig_projectTasksPairs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ProjectTasksPairs, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.APIProjectTasksPair)
+	fc.Result = res
+	return ec.marshalNProjectTasksPair2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIProjectTasksPairᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SingleTaskDistroConfig_projectTasksPairs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SingleTaskDistroConfig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "projectId":
+				return ec.fieldContext_ProjectTasksPair_projectId(ctx, field)
+			case "allowedTasks":
+				return ec.fieldContext_ProjectTasksPair_allowedTasks(ctx, field)
+			case "allowedBVs":
+				return ec.fieldContext_ProjectTasksPair_allowedBVs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProjectTasksPair", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SlackConfig_name(ctx context.Context, field graphql.CollectedField, obj *model.APISlackConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SlackConfig_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SlackConfig_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SlackConfig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_dailyStartTime(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_dailyStartTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DailyStartTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_dailyStartTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_dailyStopTime(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_dailyStopTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DailyStopTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_dailyStopTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_nextStartTime(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_nextStartTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NextStartTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_nextStartTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_nextStopTime(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_nextStopTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NextStopTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_nextStopTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_permanentlyExempt(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_permanentlyExempt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PermanentlyExempt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_permanentlyExempt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_shouldKeepOff(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_shouldKeepOff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ShouldKeepOff, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_shouldKeepOff(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_timeZone(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_timeZone(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TimeZone, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_timeZone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_temporarilyExemptUntil(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_temporarilyExemptUntil(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TemporarilyExemptUntil, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_temporarilyExemptUntil(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SleepSchedule_wholeWeekdaysOff(ctx context.Context, field graphql.CollectedField, obj *host.SleepScheduleInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SleepSchedule_wholeWeekdaysOff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SleepSchedule().WholeWeekdaysOff(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]int)
+	fc.Result = res
+	return ec.marshalNInt2ᚕintᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SleepSchedule_wholeWeekdaysOff(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SleepSchedule",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Source_author(ctx context.Context, field graphql.CollectedField, obj *model.APISource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_author(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Author, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Source_author(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Source_requester(ctx context.Context, field graphql.CollectedField, obj *model.APISource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_requester(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Requester, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Source_requester(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Source_time(ctx context.Context, field graphql.CollectedField, obj *model.APISource) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_time(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalNTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Source_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SpawnHostConfig_spawnHostsPerUser(ctx context.Context, field graphql.CollectedField, obj *model.APISpawnHostConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SpawnHostConfig_spawnHostsPerUser(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SpawnHostsPerUser, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SpawnHostConfig_spawnHostsPerUser(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SpawnHostConfig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SpawnHostConfig_unexpirableHostsPerUser(ctx context.Context, field graphql.CollectedField, obj *model.APISpawnHostConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SpawnHostConfig_unexpirableHostsPerUser(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UnexpirableHostsPerUser, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SpawnHostConfig_unexpirableHostsPerUser(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SpawnHostConfig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SpawnHostConfig_unexpirableVolumesPerUser(ctx context.Context, field graphql.CollectedField, obj *model.APISpawnHostConfig) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SpawnHostConfig_unexpirableVolumesPerUser(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UnexpirableVolumesPerUser, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SpawnHostConfig_unexpirableVolumesPerUser(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SpawnHostConfig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SpruceConfig_banner(ctx context.Context, field graphql.CollectedField, obj *model.APIAdminSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SpruceConfig_banner(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Banner, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SpruceConfig_banner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SpruceConfig",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SpruceConfig_bannerTheme(ctx context.Context, field graphql.CollectedField, obj *model.APIAdminSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SpruceConfig_bannerTheme(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -58114,6 +59980,8 @@ func (ec *executionContext) fieldContext_Task_baseTask(_ context.Context, field
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -58132,6 +60000,8 @@ func (ec *executionContext) fieldContext_Task_baseTask(_ context.Context, field
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -59198,6 +61068,8 @@ func (ec *executionContext) fieldContext_Task_displayTask(_ context.Context, fie
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -59216,6 +61088,8 @@ func (ec *executionContext) fieldContext_Task_displayTask(_ context.Context, fie
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -59561,6 +61435,8 @@ func (ec *executionContext) fieldContext_Task_executionTasksFull(_ context.Conte
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -59579,6 +61455,8 @@ func (ec *executionContext) fieldContext_Task_executionTasksFull(_ context.Conte
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -60855,6 +62733,61 @@ func (ec *executionContext) fieldContext_Task_resetWhenFinished(_ context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _Task_resourceUsage(ctx context.Context, field graphql.CollectedField, obj *model.APITask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Task_resourceUsage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Task().ResourceUsage(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.APITaskResourceUsage)
+	fc.Result = res
+	return ec.marshalOTaskResourceUsage2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskResourceUsage(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Task_resourceUsage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Task",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "commands":
+				return ec.fieldContext_TaskResourceUsage_commands(ctx, field)
+			case "execution":
+				return ec.fieldContext_TaskResourceUsage_execution(ctx, field)
+			case "sampleIntervalSecs":
+				return ec.fieldContext_TaskResourceUsage_sampleIntervalSecs(ctx, field)
+			case "samples":
+				return ec.fieldContext_TaskResourceUsage_samples(ctx, field)
+			case "taskId":
+				return ec.fieldContext_TaskResourceUsage_taskId(ctx, field)
+			case "total":
+				return ec.fieldContext_TaskResourceUsage_total(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TaskResourceUsage", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Task_revision(ctx context.Context, field graphql.CollectedField, obj *model.APITask) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Task_revision(ctx, field)
 	if err != nil {
@@ -61236,6 +63169,8 @@ func (ec *executionContext) fieldContext_Task_taskLogs(_ context.Context, field
 				return ec.fieldContext_TaskLogs_eventLogs(ctx, field)
 			case "execution":
 				return ec.fieldContext_TaskLogs_execution(ctx, field)
+			case "searchLogs":
+				return ec.fieldContext_TaskLogs_searchLogs(ctx, field)
 			case "systemLogs":
 				return ec.fieldContext_TaskLogs_systemLogs(ctx, field)
 			case "taskId":
@@ -61249,6 +63184,74 @@ func (ec *executionContext) fieldContext_Task_taskLogs(_ context.Context, field
 	return fc, nil
 }
 
+func (ec *executionContext) _Task_testFlakiness(ctx context.Context, field graphql.CollectedField, obj *model.APITask) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Task_testFlakiness(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Task().TestFlakiness(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.APITestFlakiness)
+	fc.Result = res
+	return ec.marshalNTestFlakiness2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITestFlakinessᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Task_testFlakiness(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Task",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "buildVariant":
+				return ec.fieldContext_TestFlakiness_buildVariant(ctx, field)
+			case "numFlips":
+				return ec.fieldContext_TestFlakiness_numFlips(ctx, field)
+			case "numRuns":
+				return ec.fieldContext_TestFlakiness_numRuns(ctx, field)
+			case "quarantineReason":
+				return ec.fieldContext_TestFlakiness_quarantineReason(ctx, field)
+			case "quarantined":
+				return ec.fieldContext_TestFlakiness_quarantined(ctx, field)
+			case "quarantinedAt":
+				return ec.fieldContext_TestFlakiness_quarantinedAt(ctx, field)
+			case "quarantinedBy":
+				return ec.fieldContext_TestFlakiness_quarantinedBy(ctx, field)
+			case "score":
+				return ec.fieldContext_TestFlakiness_score(ctx, field)
+			case "scoredAt":
+				return ec.fieldContext_TestFlakiness_scoredAt(ctx, field)
+			case "taskName":
+				return ec.fieldContext_TestFlakiness_taskName(ctx, field)
+			case "testName":
+				return ec.fieldContext_TestFlakiness_testName(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TestFlakiness", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Task_tests(ctx context.Context, field graphql.CollectedField, obj *model.APITask) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Task_tests(ctx, field)
 	if err != nil {
@@ -63225,6 +65228,8 @@ func (ec *executionContext) fieldContext_TaskHistory_tasks(_ context.Context, fi
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -63243,6 +65248,8 @@ func (ec *executionContext) fieldContext_TaskHistory_tasks(_ context.Context, fi
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -63901,6 +65908,77 @@ func (ec *executionContext) fieldContext_TaskLogs_execution(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _TaskLogs_searchLogs(ctx context.Context, field graphql.CollectedField, obj *TaskLogs) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskLogs_searchLogs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.TaskLogs().SearchLogs(rctx, obj, fc.Args["options"].(LogSearchOptions))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.APILogSearchResult)
+	fc.Result = res
+	return ec.marshalNLogSearchResult2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSearchResultᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskLogs_searchLogs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskLogs",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "data":
+				return ec.fieldContext_LogSearchResult_data(ctx, field)
+			case "execution":
+				return ec.fieldContext_LogSearchResult_execution(ctx, field)
+			case "isContext":
+				return ec.fieldContext_LogSearchResult_isContext(ctx, field)
+			case "lineNumber":
+				return ec.fieldContext_LogSearchResult_lineNumber(ctx, field)
+			case "logName":
+				return ec.fieldContext_LogSearchResult_logName(ctx, field)
+			case "priority":
+				return ec.fieldContext_LogSearchResult_priority(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_LogSearchResult_timestamp(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LogSearchResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_TaskLogs_searchLogs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TaskLogs_systemLogs(ctx context.Context, field graphql.CollectedField, obj *TaskLogs) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TaskLogs_systemLogs(ctx, field)
 	if err != nil {
@@ -64670,6 +66748,348 @@ func (ec *executionContext) fieldContext_TaskQueueItem_version(_ context.Context
 	return fc, nil
 }
 
+func (ec *executionContext) _TaskResourceUsage_commands(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_commands(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Commands, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.APIResourceUsageSummary)
+	fc.Result = res
+	return ec.marshalNResourceUsageSummary2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsageSummaryᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_commands(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "avgCpuPercent":
+				return ec.fieldContext_ResourceUsageSummary_avgCpuPercent(ctx, field)
+			case "avgMemoryBytes":
+				return ec.fieldContext_ResourceUsageSummary_avgMemoryBytes(ctx, field)
+			case "block":
+				return ec.fieldContext_ResourceUsageSummary_block(ctx, field)
+			case "command":
+				return ec.fieldContext_ResourceUsageSummary_command(ctx, field)
+			case "diskReadBytes":
+				return ec.fieldContext_ResourceUsageSummary_diskReadBytes(ctx, field)
+			case "diskWriteBytes":
+				return ec.fieldContext_ResourceUsageSummary_diskWriteBytes(ctx, field)
+			case "durationSecs":
+				return ec.fieldContext_ResourceUsageSummary_durationSecs(ctx, field)
+			case "netRecvBytes":
+				return ec.fieldContext_ResourceUsageSummary_netRecvBytes(ctx, field)
+			case "netSentBytes":
+				return ec.fieldContext_ResourceUsageSummary_netSentBytes(ctx, field)
+			case "numSamples":
+				return ec.fieldContext_ResourceUsageSummary_numSamples(ctx, field)
+			case "peakCpuPercent":
+				return ec.fieldContext_ResourceUsageSummary_peakCpuPercent(ctx, field)
+			case "peakMemoryBytes":
+				return ec.fieldContext_ResourceUsageSummary_peakMemoryBytes(ctx, field)
+			case "peakProcesses":
+				return ec.fieldContext_ResourceUsageSummary_peakProcesses(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceUsageSummary", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_execution(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_execution(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Execution, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_execution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_sampleIntervalSecs(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_sampleIntervalSecs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SampleIntervalSecs, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_sampleIntervalSecs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_samples(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_samples(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Samples, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]resourceusage.Sample)
+	fc.Result = res
+	return ec.marshalNResourceUsageSample2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚋresourceusageᚐSampleᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_samples(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "block":
+				return ec.fieldContext_ResourceUsageSample_block(ctx, field)
+			case "command":
+				return ec.fieldContext_ResourceUsageSample_command(ctx, field)
+			case "cpuPercent":
+				return ec.fieldContext_ResourceUsageSample_cpuPercent(ctx, field)
+			case "diskReadBytes":
+				return ec.fieldContext_ResourceUsageSample_diskReadBytes(ctx, field)
+			case "diskWriteBytes":
+				return ec.fieldContext_ResourceUsageSample_diskWriteBytes(ctx, field)
+			case "memoryBytes":
+				return ec.fieldContext_ResourceUsageSample_memoryBytes(ctx, field)
+			case "netRecvBytes":
+				return ec.fieldContext_ResourceUsageSample_netRecvBytes(ctx, field)
+			case "netSentBytes":
+				return ec.fieldContext_ResourceUsageSample_netSentBytes(ctx, field)
+			case "processes":
+				return ec.fieldContext_ResourceUsageSample_processes(ctx, field)
+			case "time":
+				return ec.fieldContext_ResourceUsageSample_time(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceUsageSample", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_taskId(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_taskId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TaskID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskResourceUsage_total(ctx context.Context, field graphql.CollectedField, obj *model.APITaskResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskResourceUsage_total(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Total, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.APIResourceUsageSummary)
+	fc.Result = res
+	return ec.marshalNResourceUsageSummary2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsageSummary(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskResourceUsage_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskResourceUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "avgCpuPercent":
+				return ec.fieldContext_ResourceUsageSummary_avgCpuPercent(ctx, field)
+			case "avgMemoryBytes":
+				return ec.fieldContext_ResourceUsageSummary_avgMemoryBytes(ctx, field)
+			case "block":
+				return ec.fieldContext_ResourceUsageSummary_block(ctx, field)
+			case "command":
+				return ec.fieldContext_ResourceUsageSummary_command(ctx, field)
+			case "diskReadBytes":
+				return ec.fieldContext_ResourceUsageSummary_diskReadBytes(ctx, field)
+			case "diskWriteBytes":
+				return ec.fieldContext_ResourceUsageSummary_diskWriteBytes(ctx, field)
+			case "durationSecs":
+				return ec.fieldContext_ResourceUsageSummary_durationSecs(ctx, field)
+			case "netRecvBytes":
+				return ec.fieldContext_ResourceUsageSummary_netRecvBytes(ctx, field)
+			case "netSentBytes":
+				return ec.fieldContext_ResourceUsageSummary_netSentBytes(ctx, field)
+			case "numSamples":
+				return ec.fieldContext_ResourceUsageSummary_numSamples(ctx, field)
+			case "peakCpuPercent":
+				return ec.fieldContext_ResourceUsageSummary_peakCpuPercent(ctx, field)
+			case "peakMemoryBytes":
+				return ec.fieldContext_ResourceUsageSummary_peakMemoryBytes(ctx, field)
+			case "peakProcesses":
+				return ec.fieldContext_ResourceUsageSummary_peakProcesses(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceUsageSummary", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _TaskSpecifier_patchAlias(ctx context.Context, field graphql.CollectedField, obj *model.APITaskSpecifier) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TaskSpecifier_patchAlias(ctx, field)
 	if err != nil {
@@ -64991,9 +67411,229 @@ func (ec *executionContext) _TaskTestResult_totalTestCount(ctx context.Context,
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TaskTestResult_totalTestCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TaskTestResult_totalTestCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskTestResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskTestResult_filteredTestCount(ctx context.Context, field graphql.CollectedField, obj *TaskTestResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskTestResult_filteredTestCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FilteredTestCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskTestResult_filteredTestCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskTestResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskTestResultSample_execution(ctx context.Context, field graphql.CollectedField, obj *TaskTestResultSample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskTestResultSample_execution(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Execution, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskTestResultSample_execution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskTestResultSample",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskTestResultSample_matchingFailedTestNames(ctx context.Context, field graphql.CollectedField, obj *TaskTestResultSample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskTestResultSample_matchingFailedTestNames(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MatchingFailedTestNames, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskTestResultSample_matchingFailedTestNames(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskTestResultSample",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskTestResultSample_taskId(ctx context.Context, field graphql.CollectedField, obj *TaskTestResultSample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskTestResultSample_taskId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TaskID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskTestResultSample_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TaskTestResultSample",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TaskTestResultSample_totalTestCount(ctx context.Context, field graphql.CollectedField, obj *TaskTestResultSample) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TaskTestResultSample_totalTestCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalTestCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TaskTestResultSample_totalTestCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TaskTestResult",
+		Object:     "TaskTestResultSample",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -65004,8 +67644,8 @@ func (ec *executionContext) fieldContext_TaskTestResult_totalTestCount(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _TaskTestResult_filteredTestCount(ctx context.Context, field graphql.CollectedField, obj *TaskTestResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TaskTestResult_filteredTestCount(ctx, field)
+func (ec *executionContext) _TestFlakiness_buildVariant(ctx context.Context, field graphql.CollectedField, obj *model.APITestFlakiness) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestFlakiness_buildVariant(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -65018,7 +67658,51 @@ func (ec *executionContext) _TaskTestResult_filteredTestCount(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FilteredTestCount, nil
+		return obj.BuildVariant, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TestFlakiness_buildVariant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestFlakiness",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestFlakiness_numFlips(ctx context.Context, field graphql.CollectedField, obj *model.APITestFlakiness) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestFlakiness_numFlips(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumFlips, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -65035,9 +67719,9 @@ func (ec *executionContext) _TaskTestResult_filteredTestCount(ctx context.Contex
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TaskTestResult_filteredTestCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestFlakiness_numFlips(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TaskTestResult",
+		Object:     "TestFlakiness",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -65048,8 +67732,8 @@ func (ec *executionContext) fieldContext_TaskTestResult_filteredTestCount(_ cont
 	return fc, nil
 }
 
-func (ec *executionContext) _TaskTestResultSample_execution(ctx context.Context, field graphql.CollectedField, obj *TaskTestResultSample) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TaskTestResultSample_execution(ctx, field)
+func (ec *executionContext) _TestFlakiness_numRuns(ctx context.Context, field graphql.CollectedField, obj *model.APITestFlakiness) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestFlakiness_numRuns(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -65062,7 +67746,7 @@ func (ec *executionContext) _TaskTestResultSample_execution(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Execution, nil
+		return obj.NumRuns, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -65079,9 +67763,9 @@ func (ec *executionContext) _TaskTestResultSample_execution(ctx context.Context,
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TaskTestResultSample_execution(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestFlakiness_numRuns(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TaskTestResultSample",
+		Object:     "TestFlakiness",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -65092,8 +67776,8 @@ func (ec *executionContext) fieldContext_TaskTestResultSample_execution(_ contex
 	return fc, nil
 }
 
-func (ec *executionContext) _TaskTestResultSample_matchingFailedTestNames(ctx context.Context, field graphql.CollectedField, obj *TaskTestResultSample) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TaskTestResultSample_matchingFailedTestNames(ctx, field)
+func (ec *executionContext) _TestFlakiness_quarantineReason(ctx context.Context, field graphql.CollectedField, obj *model.APITestFlakiness) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestFlakiness_quarantineReason(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -65106,7 +67790,48 @@ func (ec *executionContext) _TaskTestResultSample_matchingFailedTestNames(ctx co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MatchingFailedTestNames, nil
+		return obj.QuarantineReason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TestFlakiness_quarantineReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestFlakiness",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestFlakiness_quarantined(ctx context.Context, field graphql.CollectedField, obj *model.APITestFlakiness) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestFlakiness_quarantined(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Quarantined, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -65118,14 +67843,96 @@ func (ec *executionContext) _TaskTestResultSample_matchingFailedTestNames(ctx co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TaskTestResultSample_matchingFailedTestNames(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestFlakiness_quarantined(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TaskTestResultSample",
+		Object:     "TestFlakiness",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestFlakiness_quarantinedAt(ctx context.Context, field graphql.CollectedField, obj *model.APITestFlakiness) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestFlakiness_quarantinedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QuarantinedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TestFlakiness_quarantinedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestFlakiness",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestFlakiness_quarantinedBy(ctx context.Context, field graphql.CollectedField, obj *model.APITestFlakiness) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestFlakiness_quarantinedBy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QuarantinedBy, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TestFlakiness_quarantinedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestFlakiness",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -65136,8 +67943,8 @@ func (ec *executionContext) fieldContext_TaskTestResultSample_matchingFailedTest
 	return fc, nil
 }
 
-func (ec *executionContext) _TaskTestResultSample_taskId(ctx context.Context, field graphql.CollectedField, obj *TaskTestResultSample) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TaskTestResultSample_taskId(ctx, field)
+func (ec *executionContext) _TestFlakiness_score(ctx context.Context, field graphql.CollectedField, obj *model.APITestFlakiness) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestFlakiness_score(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -65150,7 +67957,7 @@ func (ec *executionContext) _TaskTestResultSample_taskId(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TaskID, nil
+		return obj.Score, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -65162,14 +67969,99 @@ func (ec *executionContext) _TaskTestResultSample_taskId(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TaskTestResultSample_taskId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestFlakiness_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TaskTestResultSample",
+		Object:     "TestFlakiness",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestFlakiness_scoredAt(ctx context.Context, field graphql.CollectedField, obj *model.APITestFlakiness) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestFlakiness_scoredAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ScoredAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TestFlakiness_scoredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestFlakiness",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TestFlakiness_taskName(ctx context.Context, field graphql.CollectedField, obj *model.APITestFlakiness) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestFlakiness_taskName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TaskName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TestFlakiness_taskName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TestFlakiness",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -65180,8 +68072,8 @@ func (ec *executionContext) fieldContext_TaskTestResultSample_taskId(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _TaskTestResultSample_totalTestCount(ctx context.Context, field graphql.CollectedField, obj *TaskTestResultSample) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TaskTestResultSample_totalTestCount(ctx, field)
+func (ec *executionContext) _TestFlakiness_testName(ctx context.Context, field graphql.CollectedField, obj *model.APITestFlakiness) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TestFlakiness_testName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -65194,7 +68086,7 @@ func (ec *executionContext) _TaskTestResultSample_totalTestCount(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalTestCount, nil
+		return obj.TestName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -65206,19 +68098,19 @@ func (ec *executionContext) _TaskTestResultSample_totalTestCount(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TaskTestResultSample_totalTestCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TestFlakiness_testName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TaskTestResultSample",
+		Object:     "TestFlakiness",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
@@ -67418,6 +70310,8 @@ func (ec *executionContext) fieldContext_UpstreamProject_task(_ context.Context,
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -67436,6 +70330,8 @@ func (ec *executionContext) fieldContext_UpstreamProject_task(_ context.Context,
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -71464,6 +74360,8 @@ func (ec *executionContext) fieldContext_VersionTasks_data(_ context.Context, fi
 				return ec.fieldContext_Task_requester(ctx, field)
 			case "resetWhenFinished":
 				return ec.fieldContext_Task_resetWhenFinished(ctx, field)
+			case "resourceUsage":
+				return ec.fieldContext_Task_resourceUsage(ctx, field)
 			case "revision":
 				return ec.fieldContext_Task_revision(ctx, field)
 			case "scheduledTime":
@@ -71482,6 +74380,8 @@ func (ec *executionContext) fieldContext_VersionTasks_data(_ context.Context, fi
 				return ec.fieldContext_Task_taskGroupMaxHosts(ctx, field)
 			case "taskLogs":
 				return ec.fieldContext_Task_taskLogs(ctx, field)
+			case "testFlakiness":
+				return ec.fieldContext_Task_testFlakiness(ctx, field)
 			case "tests":
 				return ec.fieldContext_Task_tests(ctx, field)
 			case "timeTaken":
@@ -78123,6 +81023,75 @@ func (ec *executionContext) unmarshalInputJiraIssueSubscriberInput(ctx context.C
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputLogSearchOptions(ctx context.Context, obj any) (LogSearchOptions, error) {
+	var it LogSearchOptions
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"caseInsensitive", "contextLines", "limit", "logType", "pattern", "regex", "testLogPaths"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "caseInsensitive":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("caseInsensitive"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CaseInsensitive = data
+		case "contextLines":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("contextLines"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ContextLines = data
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "logType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("logType"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LogType = data
+		case "pattern":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pattern"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pattern = data
+		case "regex":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("regex"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Regex = data
+		case "testLogPaths":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("testLogPaths"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TestLogPaths = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputMainlineCommitsOptions(ctx context.Context, obj any) (MainlineCommitsOptions, error) {
 	var it MainlineCommitsOptions
 	asMap := map[string]any{}
@@ -78841,7 +81810,7 @@ func (ec *executionContext) unmarshalInputPlannerSettingsInput(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"commitQueueFactor", "expectedRuntimeFactor", "generateTaskFactor", "groupVersions", "mainlineTimeInQueueFactor", "numDependentsFactor", "patchFactor", "patchTimeInQueueFactor", "targetTime", "version"}
+	fieldsInOrder := [...]string{"commitQueueFactor", "expectedRuntimeFactor", "generateTaskFactor", "groupVersions", "mainlineTimeInQueueFactor", "numDependentsFactor", "patchFactor", "patchTimeInQueueFactor", "strategy", "targetTime", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -78904,6 +81873,15 @@ func (ec *executionContext) unmarshalInputPlannerSettingsInput(ctx context.Conte
 				return it, err
 			}
 			it.PatchTimeInQueueFactor = data
+		case "strategy":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("strategy"))
+			data, err := ec.unmarshalOPlannerStrategy2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐPlannerStrategy(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			if err = ec.resolvers.PlannerSettingsInput().Strategy(ctx, &it, data); err != nil {
+				return it, err
+			}
 		case "targetTime":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetTime"))
 			data, err := ec.unmarshalNInt2int(ctx, v)
@@ -86460,27 +89438,159 @@ func (ec *executionContext) _JiraConfig(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var jiraIssueSubscriberImplementors = []string{"JiraIssueSubscriber"}
+var jiraIssueSubscriberImplementors = []string{"JiraIssueSubscriber"}
+
+func (ec *executionContext) _JiraIssueSubscriber(ctx context.Context, sel ast.SelectionSet, obj *model.APIJIRAIssueSubscriber) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, jiraIssueSubscriberImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("JiraIssueSubscriber")
+		case "issueType":
+			out.Values[i] = ec._JiraIssueSubscriber_issueType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "project":
+			out.Values[i] = ec._JiraIssueSubscriber_project(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var jiraStatusImplementors = []string{"JiraStatus"}
+
+func (ec *executionContext) _JiraStatus(ctx context.Context, sel ast.SelectionSet, obj *thirdparty.JiraStatus) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, jiraStatusImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("JiraStatus")
+		case "id":
+			out.Values[i] = ec._JiraStatus_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._JiraStatus_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var jiraTicketImplementors = []string{"JiraTicket"}
+
+func (ec *executionContext) _JiraTicket(ctx context.Context, sel ast.SelectionSet, obj *thirdparty.JiraTicket) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, jiraTicketImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("JiraTicket")
+		case "fields":
+			out.Values[i] = ec._JiraTicket_fields(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "key":
+			out.Values[i] = ec._JiraTicket_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var logMessageImplementors = []string{"LogMessage"}
 
-func (ec *executionContext) _JiraIssueSubscriber(ctx context.Context, sel ast.SelectionSet, obj *model.APIJIRAIssueSubscriber) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, jiraIssueSubscriberImplementors)
+func (ec *executionContext) _LogMessage(ctx context.Context, sel ast.SelectionSet, obj *apimodels.LogMessage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, logMessageImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("JiraIssueSubscriber")
-		case "issueType":
-			out.Values[i] = ec._JiraIssueSubscriber_issueType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "project":
-			out.Values[i] = ec._JiraIssueSubscriber_project(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
+			out.Values[i] = graphql.MarshalString("LogMessage")
+		case "message":
+			out.Values[i] = ec._LogMessage_message(ctx, field, obj)
+		case "severity":
+			out.Values[i] = ec._LogMessage_severity(ctx, field, obj)
+		case "timestamp":
+			out.Values[i] = ec._LogMessage_timestamp(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec._LogMessage_type(ctx, field, obj)
+		case "version":
+			out.Values[i] = ec._LogMessage_version(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -86504,115 +89614,43 @@ func (ec *executionContext) _JiraIssueSubscriber(ctx context.Context, sel ast.Se
 	return out
 }
 
-var jiraStatusImplementors = []string{"JiraStatus"}
+var logSearchResultImplementors = []string{"LogSearchResult"}
 
-func (ec *executionContext) _JiraStatus(ctx context.Context, sel ast.SelectionSet, obj *thirdparty.JiraStatus) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, jiraStatusImplementors)
+func (ec *executionContext) _LogSearchResult(ctx context.Context, sel ast.SelectionSet, obj *model.APILogSearchResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, logSearchResultImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("JiraStatus")
-		case "id":
-			out.Values[i] = ec._JiraStatus_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("LogSearchResult")
+		case "data":
+			out.Values[i] = ec._LogSearchResult_data(ctx, field, obj)
+		case "execution":
+			out.Values[i] = ec._LogSearchResult_execution(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._JiraStatus_name(ctx, field, obj)
+		case "isContext":
+			out.Values[i] = ec._LogSearchResult_isContext(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var jiraTicketImplementors = []string{"JiraTicket"}
-
-func (ec *executionContext) _JiraTicket(ctx context.Context, sel ast.SelectionSet, obj *thirdparty.JiraTicket) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, jiraTicketImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("JiraTicket")
-		case "fields":
-			out.Values[i] = ec._JiraTicket_fields(ctx, field, obj)
+		case "lineNumber":
+			out.Values[i] = ec._LogSearchResult_lineNumber(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "key":
-			out.Values[i] = ec._JiraTicket_key(ctx, field, obj)
+		case "logName":
+			out.Values[i] = ec._LogSearchResult_logName(ctx, field, obj)
+		case "priority":
+			out.Values[i] = ec._LogSearchResult_priority(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
-var logMessageImplementors = []string{"LogMessage"}
-
-func (ec *executionContext) _LogMessage(ctx context.Context, sel ast.SelectionSet, obj *apimodels.LogMessage) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, logMessageImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("LogMessage")
-		case "message":
-			out.Values[i] = ec._LogMessage_message(ctx, field, obj)
-		case "severity":
-			out.Values[i] = ec._LogMessage_severity(ctx, field, obj)
 		case "timestamp":
-			out.Values[i] = ec._LogMessage_timestamp(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec._LogMessage_type(ctx, field, obj)
-		case "version":
-			out.Values[i] = ec._LogMessage_version(ctx, field, obj)
+			out.Values[i] = ec._LogSearchResult_timestamp(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -89050,6 +92088,42 @@ func (ec *executionContext) _PlannerSettings(ctx context.Context, sel ast.Select
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "strategy":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PlannerSettings_strategy(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "targetTime":
 			out.Values[i] = ec._PlannerSettings_targetTime(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -92075,29 +95149,166 @@ func (ec *executionContext) _RepoWorkstationConfig(ctx context.Context, sel ast.
 	return out
 }
 
-var repotrackerErrorImplementors = []string{"RepotrackerError"}
+var repotrackerErrorImplementors = []string{"RepotrackerError"}
+
+func (ec *executionContext) _RepotrackerError(ctx context.Context, sel ast.SelectionSet, obj *model.APIRepositoryErrorDetails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, repotrackerErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RepotrackerError")
+		case "exists":
+			out.Values[i] = ec._RepotrackerError_exists(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "invalidRevision":
+			out.Values[i] = ec._RepotrackerError_invalidRevision(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mergeBaseRevision":
+			out.Values[i] = ec._RepotrackerError_mergeBaseRevision(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var resourceLimitsImplementors = []string{"ResourceLimits"}
+
+func (ec *executionContext) _ResourceLimits(ctx context.Context, sel ast.SelectionSet, obj *model.APIResourceLimits) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceLimitsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ResourceLimits")
+		case "lockedMemoryKb":
+			out.Values[i] = ec._ResourceLimits_lockedMemoryKb(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numFiles":
+			out.Values[i] = ec._ResourceLimits_numFiles(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numProcesses":
+			out.Values[i] = ec._ResourceLimits_numProcesses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numTasks":
+			out.Values[i] = ec._ResourceLimits_numTasks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "virtualMemoryKb":
+			out.Values[i] = ec._ResourceLimits_virtualMemoryKb(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var resourceUsageSampleImplementors = []string{"ResourceUsageSample"}
 
-func (ec *executionContext) _RepotrackerError(ctx context.Context, sel ast.SelectionSet, obj *model.APIRepositoryErrorDetails) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, repotrackerErrorImplementors)
+func (ec *executionContext) _ResourceUsageSample(ctx context.Context, sel ast.SelectionSet, obj *resourceusage.Sample) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceUsageSampleImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RepotrackerError")
-		case "exists":
-			out.Values[i] = ec._RepotrackerError_exists(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ResourceUsageSample")
+		case "block":
+			out.Values[i] = ec._ResourceUsageSample_block(ctx, field, obj)
+		case "command":
+			out.Values[i] = ec._ResourceUsageSample_command(ctx, field, obj)
+		case "cpuPercent":
+			out.Values[i] = ec._ResourceUsageSample_cpuPercent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "invalidRevision":
-			out.Values[i] = ec._RepotrackerError_invalidRevision(ctx, field, obj)
+		case "diskReadBytes":
+			out.Values[i] = ec._ResourceUsageSample_diskReadBytes(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "mergeBaseRevision":
-			out.Values[i] = ec._RepotrackerError_mergeBaseRevision(ctx, field, obj)
+		case "diskWriteBytes":
+			out.Values[i] = ec._ResourceUsageSample_diskWriteBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "memoryBytes":
+			out.Values[i] = ec._ResourceUsageSample_memoryBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "netRecvBytes":
+			out.Values[i] = ec._ResourceUsageSample_netRecvBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "netSentBytes":
+			out.Values[i] = ec._ResourceUsageSample_netSentBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "processes":
+			out.Values[i] = ec._ResourceUsageSample_processes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "time":
+			out.Values[i] = ec._ResourceUsageSample_time(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -92124,39 +95335,73 @@ func (ec *executionContext) _RepotrackerError(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var resourceLimitsImplementors = []string{"ResourceLimits"}
+var resourceUsageSummaryImplementors = []string{"ResourceUsageSummary"}
 
-func (ec *executionContext) _ResourceLimits(ctx context.Context, sel ast.SelectionSet, obj *model.APIResourceLimits) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, resourceLimitsImplementors)
+func (ec *executionContext) _ResourceUsageSummary(ctx context.Context, sel ast.SelectionSet, obj *model.APIResourceUsageSummary) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceUsageSummaryImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ResourceLimits")
-		case "lockedMemoryKb":
-			out.Values[i] = ec._ResourceLimits_lockedMemoryKb(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ResourceUsageSummary")
+		case "avgCpuPercent":
+			out.Values[i] = ec._ResourceUsageSummary_avgCpuPercent(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "numFiles":
-			out.Values[i] = ec._ResourceLimits_numFiles(ctx, field, obj)
+		case "avgMemoryBytes":
+			out.Values[i] = ec._ResourceUsageSummary_avgMemoryBytes(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "numProcesses":
-			out.Values[i] = ec._ResourceLimits_numProcesses(ctx, field, obj)
+		case "block":
+			out.Values[i] = ec._ResourceUsageSummary_block(ctx, field, obj)
+		case "command":
+			out.Values[i] = ec._ResourceUsageSummary_command(ctx, field, obj)
+		case "diskReadBytes":
+			out.Values[i] = ec._ResourceUsageSummary_diskReadBytes(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "numTasks":
-			out.Values[i] = ec._ResourceLimits_numTasks(ctx, field, obj)
+		case "diskWriteBytes":
+			out.Values[i] = ec._ResourceUsageSummary_diskWriteBytes(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "virtualMemoryKb":
-			out.Values[i] = ec._ResourceLimits_virtualMemoryKb(ctx, field, obj)
+		case "durationSecs":
+			out.Values[i] = ec._ResourceUsageSummary_durationSecs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "netRecvBytes":
+			out.Values[i] = ec._ResourceUsageSummary_netRecvBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "netSentBytes":
+			out.Values[i] = ec._ResourceUsageSummary_netSentBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "numSamples":
+			out.Values[i] = ec._ResourceUsageSummary_numSamples(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "peakCpuPercent":
+			out.Values[i] = ec._ResourceUsageSummary_peakCpuPercent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "peakMemoryBytes":
+			out.Values[i] = ec._ResourceUsageSummary_peakMemoryBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "peakProcesses":
+			out.Values[i] = ec._ResourceUsageSummary_peakProcesses(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -94099,6 +97344,39 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "resourceUsage":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Task_resourceUsage(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "revision":
 			out.Values[i] = ec._Task_revision(ctx, field, obj)
 		case "scheduledTime":
@@ -94187,6 +97465,42 @@ func (ec *executionContext) _Task(ctx context.Context, sel ast.SelectionSet, obj
 				continue
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "testFlakiness":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Task_testFlakiness(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "tests":
 			field := field
@@ -95005,6 +98319,42 @@ func (ec *executionContext) _TaskLogs(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "searchLogs":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._TaskLogs_searchLogs(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		case "systemLogs":
 			field := field
 
@@ -95271,6 +98621,70 @@ func (ec *executionContext) _TaskQueueItem(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var taskResourceUsageImplementors = []string{"TaskResourceUsage"}
+
+func (ec *executionContext) _TaskResourceUsage(ctx context.Context, sel ast.SelectionSet, obj *model.APITaskResourceUsage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, taskResourceUsageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TaskResourceUsage")
+		case "commands":
+			out.Values[i] = ec._TaskResourceUsage_commands(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "execution":
+			out.Values[i] = ec._TaskResourceUsage_execution(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sampleIntervalSecs":
+			out.Values[i] = ec._TaskResourceUsage_sampleIntervalSecs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "samples":
+			out.Values[i] = ec._TaskResourceUsage_samples(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "taskId":
+			out.Values[i] = ec._TaskResourceUsage_taskId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "total":
+			out.Values[i] = ec._TaskResourceUsage_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var taskSpecifierImplementors = []string{"TaskSpecifier"}
 
 func (ec *executionContext) _TaskSpecifier(ctx context.Context, sel ast.SelectionSet, obj *model.APITaskSpecifier) graphql.Marshaler {
@@ -95358,29 +98772,83 @@ func (ec *executionContext) _TaskStats(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var taskTestResultImplementors = []string{"TaskTestResult"}
+var taskTestResultImplementors = []string{"TaskTestResult"}
+
+func (ec *executionContext) _TaskTestResult(ctx context.Context, sel ast.SelectionSet, obj *TaskTestResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, taskTestResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TaskTestResult")
+		case "testResults":
+			out.Values[i] = ec._TaskTestResult_testResults(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalTestCount":
+			out.Values[i] = ec._TaskTestResult_totalTestCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "filteredTestCount":
+			out.Values[i] = ec._TaskTestResult_filteredTestCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var taskTestResultSampleImplementors = []string{"TaskTestResultSample"}
 
-func (ec *executionContext) _TaskTestResult(ctx context.Context, sel ast.SelectionSet, obj *TaskTestResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, taskTestResultImplementors)
+func (ec *executionContext) _TaskTestResultSample(ctx context.Context, sel ast.SelectionSet, obj *TaskTestResultSample) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, taskTestResultSampleImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("TaskTestResult")
-		case "testResults":
-			out.Values[i] = ec._TaskTestResult_testResults(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TaskTestResultSample")
+		case "execution":
+			out.Values[i] = ec._TaskTestResultSample_execution(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalTestCount":
-			out.Values[i] = ec._TaskTestResult_totalTestCount(ctx, field, obj)
+		case "matchingFailedTestNames":
+			out.Values[i] = ec._TaskTestResultSample_matchingFailedTestNames(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "filteredTestCount":
-			out.Values[i] = ec._TaskTestResult_filteredTestCount(ctx, field, obj)
+		case "taskId":
+			out.Values[i] = ec._TaskTestResultSample_taskId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalTestCount":
+			out.Values[i] = ec._TaskTestResultSample_totalTestCount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -95407,34 +98875,57 @@ func (ec *executionContext) _TaskTestResult(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var taskTestResultSampleImplementors = []string{"TaskTestResultSample"}
+var testFlakinessImplementors = []string{"TestFlakiness"}
 
-func (ec *executionContext) _TaskTestResultSample(ctx context.Context, sel ast.SelectionSet, obj *TaskTestResultSample) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, taskTestResultSampleImplementors)
+func (ec *executionContext) _TestFlakiness(ctx context.Context, sel ast.SelectionSet, obj *model.APITestFlakiness) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, testFlakinessImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("TaskTestResultSample")
-		case "execution":
-			out.Values[i] = ec._TaskTestResultSample_execution(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TestFlakiness")
+		case "buildVariant":
+			out.Values[i] = ec._TestFlakiness_buildVariant(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "matchingFailedTestNames":
-			out.Values[i] = ec._TaskTestResultSample_matchingFailedTestNames(ctx, field, obj)
+		case "numFlips":
+			out.Values[i] = ec._TestFlakiness_numFlips(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "taskId":
-			out.Values[i] = ec._TaskTestResultSample_taskId(ctx, field, obj)
+		case "numRuns":
+			out.Values[i] = ec._TestFlakiness_numRuns(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalTestCount":
-			out.Values[i] = ec._TaskTestResultSample_totalTestCount(ctx, field, obj)
+		case "quarantineReason":
+			out.Values[i] = ec._TestFlakiness_quarantineReason(ctx, field, obj)
+		case "quarantined":
+			out.Values[i] = ec._TestFlakiness_quarantined(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quarantinedAt":
+			out.Values[i] = ec._TestFlakiness_quarantinedAt(ctx, field, obj)
+		case "quarantinedBy":
+			out.Values[i] = ec._TestFlakiness_quarantinedBy(ctx, field, obj)
+		case "score":
+			out.Values[i] = ec._TestFlakiness_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "scoredAt":
+			out.Values[i] = ec._TestFlakiness_scoredAt(ctx, field, obj)
+		case "taskName":
+			out.Values[i] = ec._TestFlakiness_taskName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "testName":
+			out.Values[i] = ec._TestFlakiness_testName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -100207,6 +103698,21 @@ func (ec *executionContext) marshalNInt2int64(ctx context.Context, sel ast.Selec
 	return res
 }
 
+func (ec *executionContext) unmarshalNInt2uint64(ctx context.Context, v any) (uint64, error) {
+	res, err := graphql.UnmarshalUint64(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2uint64(ctx context.Context, sel ast.SelectionSet, v uint64) graphql.Marshaler {
+	res := graphql.MarshalUint64(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
 func (ec *executionContext) unmarshalNInt2ᚕintᚄ(ctx context.Context, v any) ([]int, error) {
 	var vSlice []any
 	if v != nil {
@@ -100435,6 +103941,65 @@ func (ec *executionContext) marshalNLogMessage2ᚖgithubᚗcomᚋevergreenᚑci
 	return ec._LogMessage(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNLogSearchOptions2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐLogSearchOptions(ctx context.Context, v any) (LogSearchOptions, error) {
+	res, err := ec.unmarshalInputLogSearchOptions(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLogSearchResult2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSearchResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APILogSearchResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLogSearchResult2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSearchResult(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNLogSearchResult2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPILogSearchResult(ctx context.Context, sel ast.SelectionSet, v *model.APILogSearchResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LogSearchResult(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNLogkeeperBuild2githubᚗcomᚋevergreenᚑciᚋplankᚐBuild(ctx context.Context, sel ast.SelectionSet, v plank.Build) graphql.Marshaler {
 	return ec._LogkeeperBuild(ctx, sel, &v)
 }
@@ -101190,6 +104755,10 @@ func (ec *executionContext) unmarshalNPlannerSettingsInput2githubᚗcomᚋevergr
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNPlannerStrategy2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐPlannerStrategy(ctx context.Context, sel ast.SelectionSet, v PlannerStrategy) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNPlannerVersion2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐPlannerVersion(ctx context.Context, v any) (PlannerVersion, error) {
 	var res PlannerVersion
 	err := res.UnmarshalGQL(v)
@@ -101822,6 +105391,102 @@ func (ec *executionContext) unmarshalNResourceLimitsInput2githubᚗcomᚋevergre
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNResourceUsageSample2githubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚋresourceusageᚐSample(ctx context.Context, sel ast.SelectionSet, v resourceusage.Sample) graphql.Marshaler {
+	return ec._ResourceUsageSample(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNResourceUsageSample2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚋresourceusageᚐSampleᚄ(ctx context.Context, sel ast.SelectionSet, v []resourceusage.Sample) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNResourceUsageSample2githubᚗcomᚋevergreenᚑciᚋevergreenᚋmodelᚋresourceusageᚐSample(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNResourceUsageSummary2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsageSummary(ctx context.Context, sel ast.SelectionSet, v model.APIResourceUsageSummary) graphql.Marshaler {
+	return ec._ResourceUsageSummary(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNResourceUsageSummary2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsageSummaryᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APIResourceUsageSummary) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNResourceUsageSummary2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIResourceUsageSummary(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNRoundingRule2githubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐRoundingRule(ctx context.Context, v any) (RoundingRule, error) {
 	var res RoundingRule
 	err := res.UnmarshalGQL(v)
@@ -102599,6 +106264,60 @@ func (ec *executionContext) unmarshalNTestFilter2ᚖgithubᚗcomᚋevergreenᚑc
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNTestFlakiness2ᚕᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITestFlakinessᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.APITestFlakiness) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTestFlakiness2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITestFlakiness(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNTestFlakiness2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITestFlakiness(ctx context.Context, sel ast.SelectionSet, v *model.APITestFlakiness) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._TestFlakiness(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNTestLog2githubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐTestLogs(ctx context.Context, sel ast.SelectionSet, v model.TestLogs) graphql.Marshaler {
 	return ec._TestLog(ctx, sel, &v)
 }
@@ -105284,6 +109003,22 @@ func (ec *executionContext) unmarshalOPeriodicBuildInput2ᚕgithubᚗcomᚋeverg
 	return res, nil
 }
 
+func (ec *executionContext) unmarshalOPlannerStrategy2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐPlannerStrategy(ctx context.Context, v any) (*PlannerStrategy, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(PlannerStrategy)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOPlannerStrategy2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋgraphqlᚐPlannerStrategy(ctx context.Context, sel ast.SelectionSet, v *PlannerStrategy) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOPod2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPIPod(ctx context.Context, sel ast.SelectionSet, v *model.APIPod) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -105865,6 +109600,13 @@ func (ec *executionContext) marshalOTaskInfo2githubᚗcomᚋevergreenᚑciᚋeve
 	return ec._TaskInfo(ctx, sel, &v)
 }
 
+func (ec *executionContext) marshalOTaskResourceUsage2ᚖgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskResourceUsage(ctx context.Context, sel ast.SelectionSet, v *model.APITaskResourceUsage) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._TaskResourceUsage(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOTaskSpecifier2ᚕgithubᚗcomᚋevergreenᚑciᚋevergreenᚋrestᚋmodelᚐAPITaskSpecifierᚄ(ctx context.Context, sel ast.SelectionSet, v []model.APITaskSpecifier) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
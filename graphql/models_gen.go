@@ -229,6 +229,18 @@ type ImageToolchainsPayload struct {
 	TotalCount    int                   `json:"totalCount"`
 }
 
+// LogSearchOptions is an input to the taskLogs.searchLogs query.
+// It's used to search the task logs or test logs of every execution of a task.
+type LogSearchOptions struct {
+	CaseInsensitive *bool    `json:"caseInsensitive,omitempty"`
+	ContextLines    *int     `json:"contextLines,omitempty"`
+	Limit           *int     `json:"limit,omitempty"`
+	LogType         *string  `json:"logType,omitempty"`
+	Pattern         string   `json:"pattern"`
+	Regex           *bool    `json:"regex,omitempty"`
+	TestLogPaths    []string `json:"testLogPaths,omitempty"`
+}
+
 type MainlineCommitVersion struct {
 	RolledUpVersions []*model.APIVersion `json:"rolledUpVersions,omitempty"`
 	Version          *model.APIVersion   `json:"version,omitempty"`
@@ -508,6 +520,7 @@ type TaskLogs struct {
 	AllLogs    []*apimodels.LogMessage       `json:"allLogs"`
 	EventLogs  []*model.TaskAPIEventLogEntry `json:"eventLogs"`
 	Execution  int                           `json:"execution"`
+	SearchLogs []*model.APILogSearchResult   `json:"searchLogs"`
 	SystemLogs []*apimodels.LogMessage       `json:"systemLogs"`
 	TaskID     string                        `json:"taskId"`
 	TaskLogs   []*apimodels.LogMessage       `json:"taskLogs"`
@@ -1294,6 +1307,51 @@ func (e OverallocatedRule) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type PlannerStrategy string
+
+const (
+	PlannerStrategyDefault       PlannerStrategy = "DEFAULT"
+	PlannerStrategyFairShare     PlannerStrategy = "FAIR_SHARE"
+	PlannerStrategyShortestFirst PlannerStrategy = "SHORTEST_FIRST"
+	PlannerStrategyDeadline      PlannerStrategy = "DEADLINE"
+)
+
+var AllPlannerStrategy = []PlannerStrategy{
+	PlannerStrategyDefault,
+	PlannerStrategyFairShare,
+	PlannerStrategyShortestFirst,
+	PlannerStrategyDeadline,
+}
+
+func (e PlannerStrategy) IsValid() bool {
+	switch e {
+	case PlannerStrategyDefault, PlannerStrategyFairShare, PlannerStrategyShortestFirst, PlannerStrategyDeadline:
+		return true
+	}
+	return false
+}
+
+func (e PlannerStrategy) String() string {
+	return string(e)
+}
+
+func (e *PlannerStrategy) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PlannerStrategy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PlannerStrategy", str)
+	}
+	return nil
+}
+
+func (e PlannerStrategy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type PlannerVersion string
 
 const (
@@ -1451,6 +1509,7 @@ const (
 	ProviderDocker      Provider = "DOCKER"
 	ProviderEc2Fleet    Provider = "EC2_FLEET"
 	ProviderEc2OnDemand Provider = "EC2_ON_DEMAND"
+	ProviderKubernetes  Provider = "KUBERNETES"
 	ProviderStatic      Provider = "STATIC"
 )
 
@@ -1458,12 +1517,13 @@ var AllProvider = []Provider{
 	ProviderDocker,
 	ProviderEc2Fleet,
 	ProviderEc2OnDemand,
+	ProviderKubernetes,
 	ProviderStatic,
 }
 
 func (e Provider) IsValid() bool {
 	switch e {
-	case ProviderDocker, ProviderEc2Fleet, ProviderEc2OnDemand, ProviderStatic:
+	case ProviderDocker, ProviderEc2Fleet, ProviderEc2OnDemand, ProviderKubernetes, ProviderStatic:
 		return true
 	}
 	return false
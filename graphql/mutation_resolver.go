@@ -66,13 +66,20 @@ func (r *mutationResolver) AddAnnotationIssue(ctx context.Context, taskID string
 		if err := task.AddIssueToAnnotation(ctx, taskID, execution, *issue, usr.Username()); err != nil {
 			return false, InternalServerError.Send(ctx, fmt.Sprintf("adding issue: %s", err.Error()))
 		}
-		return true, nil
 	} else {
 		if err := annotations.AddSuspectedIssueToAnnotation(ctx, taskID, execution, *issue, usr.Username()); err != nil {
 			return false, InternalServerError.Send(ctx, fmt.Sprintf("adding suspected issue: %s", err.Error()))
 		}
-		return true, nil
 	}
+	// Fingerprint the failure so that the issue can be suggested for later
+	// failures that match it.
+	j := units.NewFailureFingerprintJob(taskID, execution)
+	grip.Warning(message.WrapError(amboy.EnqueueUniqueJob(ctx, evergreen.GetEnvironment().RemoteQueue(), j), message.Fields{
+		"message":   "could not enqueue failure fingerprint job",
+		"task_id":   taskID,
+		"execution": execution,
+	}))
+	return true, nil
 }
 
 // EditAnnotationNote is the resolver for the editAnnotationNote field.
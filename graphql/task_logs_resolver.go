@@ -6,8 +6,11 @@ import (
 
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/task"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/evergreen-ci/utility"
 )
 
 // AgentLogs is the resolver for the agentLogs field.
@@ -48,6 +51,50 @@ func (r *taskLogsResolver) EventLogs(ctx context.Context, obj *TaskLogs) ([]*res
 	return apiEventLogPointers, nil
 }
 
+// SearchLogs is the resolver for the searchLogs field.
+func (r *taskLogsResolver) SearchLogs(ctx context.Context, obj *TaskLogs, options LogSearchOptions) ([]*restModel.APILogSearchResult, error) {
+	dbTask, err := task.FindOneIdAndExecution(ctx, obj.TaskID, obj.Execution)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("finding task '%s': %s", obj.TaskID, err.Error()))
+	}
+	if dbTask == nil {
+		return nil, ResourceNotFound.Send(ctx, fmt.Sprintf("task '%s' not found", obj.TaskID))
+	}
+
+	opts := task.LogSearchOptions{
+		TaskLogType:  taskoutput.TaskLogType(utility.FromStringPtr(options.LogType)),
+		TestLogPaths: options.TestLogPaths,
+		Search: log.SearchOptions{
+			Pattern:         options.Pattern,
+			Regex:           utility.FromBoolPtr(options.Regex),
+			CaseInsensitive: utility.FromBoolPtr(options.CaseInsensitive),
+			ContextLines:    utility.FromIntPtr(options.ContextLines),
+			Limit:           utility.FromIntPtr(options.Limit),
+		},
+	}
+	if opts.TaskLogType == "" {
+		opts.TaskLogType = taskoutput.TaskLogTypeAll
+	} else if err = opts.TaskLogType.Validate(false); err != nil {
+		return nil, InputValidationError.Send(ctx, err.Error())
+	}
+	if err = opts.Search.Validate(); err != nil {
+		return nil, InputValidationError.Send(ctx, fmt.Sprintf("invalid search options: %s", err.Error()))
+	}
+
+	results, err := dbTask.SearchLogs(ctx, opts)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("searching logs for task '%s': %s", obj.TaskID, err.Error()))
+	}
+
+	apiResults := make([]*restModel.APILogSearchResult, 0, len(results))
+	for _, result := range results {
+		apiResult := &restModel.APILogSearchResult{}
+		apiResult.BuildFromService(result)
+		apiResults = append(apiResults, apiResult)
+	}
+	return apiResults, nil
+}
+
 // SystemLogs is the resolver for the systemLogs field.
 func (r *taskLogsResolver) SystemLogs(ctx context.Context, obj *TaskLogs) ([]*apimodels.LogMessage, error) {
 	return getTaskLogs(ctx, obj, taskoutput.TaskLogTypeSystem)
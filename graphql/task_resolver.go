@@ -11,7 +11,9 @@ import (
 	"github.com/evergreen-ci/evergreen/model/build"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/rest/data"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/gimlet"
@@ -548,6 +550,22 @@ func (r *taskResolver) ProjectIdentifier(ctx context.Context, obj *restModel.API
 	return obj.ProjectIdentifier, nil
 }
 
+// ResourceUsage is the resolver for the resourceUsage field.
+func (r *taskResolver) ResourceUsage(ctx context.Context, obj *restModel.APITask) (*restModel.APITaskResourceUsage, error) {
+	taskID := utility.FromStringPtr(obj.Id)
+	usage, err := resourceusage.FindOne(ctx, taskID, obj.Execution)
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("finding resource usage for task '%s' execution %d: %s", taskID, obj.Execution, err.Error()))
+	}
+	if usage == nil {
+		return nil, nil
+	}
+
+	apiUsage := &restModel.APITaskResourceUsage{}
+	apiUsage.BuildFromService(usage, true)
+	return apiUsage, nil
+}
+
 // SpawnHostLink is the resolver for the spawnHostLink field.
 func (r *taskResolver) SpawnHostLink(ctx context.Context, obj *restModel.APITask) (*string, error) {
 	hostID := utility.FromStringPtr(obj.HostId)
@@ -578,6 +596,26 @@ func (r *taskResolver) TaskLogs(ctx context.Context, obj *restModel.APITask) (*T
 	return &TaskLogs{TaskID: utility.FromStringPtr(obj.Id), Execution: obj.Execution}, nil
 }
 
+// TestFlakiness is the resolver for the testFlakiness field.
+func (r *taskResolver) TestFlakiness(ctx context.Context, obj *restModel.APITask) ([]*restModel.APITestFlakiness, error) {
+	flakiness, err := testresult.FindTestFlakiness(ctx, evergreen.GetEnvironment(), testresult.FindTestFlakinessOptions{
+		Project:      utility.FromStringPtr(obj.ProjectId),
+		BuildVariant: utility.FromStringPtr(obj.BuildVariant),
+		TaskName:     utility.FromStringPtr(obj.DisplayName),
+	})
+	if err != nil {
+		return nil, InternalServerError.Send(ctx, fmt.Sprintf("finding test flakiness for task '%s': %s", utility.FromStringPtr(obj.Id), err.Error()))
+	}
+
+	apiFlakiness := make([]*restModel.APITestFlakiness, 0, len(flakiness))
+	for _, f := range flakiness {
+		apiFlakinessScore := &restModel.APITestFlakiness{}
+		apiFlakinessScore.BuildFromService(f)
+		apiFlakiness = append(apiFlakiness, apiFlakinessScore)
+	}
+	return apiFlakiness, nil
+}
+
 // Tests is the resolver for the tests field.
 func (r *taskResolver) Tests(ctx context.Context, obj *restModel.APITask, opts *TestFilterOptions) (*TaskTestResult, error) {
 	// Return early if it is known that there are no test results to return.
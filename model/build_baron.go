@@ -2,19 +2,29 @@ package model
 
 import (
 	"context"
+	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/annotations"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
 )
 
-const jiraSource = "JIRA"
+const (
+	jiraSource        = "JIRA"
+	fingerprintSource = "Evergreen"
+
+	// maxFingerprintMatches is the maximum number of past triaged failures
+	// with the same fingerprint whose annotations are used for suggestions.
+	maxFingerprintMatches = 100
+)
 
 func (js *JiraSuggest) GetTimeout() time.Duration {
 	// This function is never called because we are willing to wait forever for the fallback handler
@@ -34,13 +44,104 @@ func (js *JiraSuggest) Suggest(ctx context.Context, t *task.Task) ([]thirdparty.
 	return results.Issues, nil
 }
 
+// FingerprintSuggest suggests the issues that were linked to past failures in
+// the same project whose failure fingerprint matches the task's, so that triage
+// can reuse previous triage without depending on an external search.
+type FingerprintSuggest struct{}
+
+func (fs *FingerprintSuggest) GetTimeout() time.Duration {
+	return 0
+}
+
+// Suggest returns the issues and suspected issues from the annotations of past
+// task executions in the project whose failure matches the task's. Issues
+// linked to more recent failures are returned first.
+func (fs *FingerprintSuggest) Suggest(ctx context.Context, t *task.Task) ([]thirdparty.JiraTicket, error) {
+	fp, err := UpsertFailureFingerprint(ctx, t)
+	if err != nil {
+		return nil, errors.Wrap(err, "fingerprinting task failure")
+	}
+	if fp == nil {
+		return nil, nil
+	}
+
+	matches, err := FindAnnotatedFailureFingerprints(ctx, fp.Project, fp.Fingerprint, maxFingerprintMatches)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding matching annotated failure fingerprints")
+	}
+	var similar []FailureFingerprint
+	taskIds := make([]string, 0, len(matches))
+	for _, match := range matches {
+		if match.Id == fp.Id || !fp.Matches(match) {
+			continue
+		}
+		similar = append(similar, match)
+		taskIds = append(taskIds, match.TaskId)
+	}
+	if len(taskIds) == 0 {
+		return nil, nil
+	}
+
+	taskAnnotations, err := annotations.FindByTaskIds(ctx, utility.UniqueStrings(taskIds))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding annotations for matching failures")
+	}
+	annotationsByExecution := map[string]annotations.TaskAnnotation{}
+	for _, a := range taskAnnotations {
+		annotationsByExecution[failureFingerprintId(a.TaskId, a.TaskExecution)] = a
+	}
+
+	var tickets []thirdparty.JiraTicket
+	seen := map[string]bool{}
+	for _, match := range similar {
+		a, ok := annotationsByExecution[match.Id]
+		if !ok {
+			continue
+		}
+		for _, issues := range [][]annotations.IssueLink{a.Issues, a.SuspectedIssues} {
+			for _, issue := range issues {
+				key := issue.IssueKey
+				if key == "" {
+					key = issue.URL
+				}
+				if key == "" || seen[key] {
+					continue
+				}
+				seen[key] = true
+				tickets = append(tickets, fingerprintSuggestionTicket(key, issue, a))
+			}
+		}
+	}
+	return tickets, nil
+}
+
+// fingerprintSuggestionTicket converts an issue linked to a past failure into a
+// ticket suggestion.
+func fingerprintSuggestionTicket(key string, issue annotations.IssueLink, a annotations.TaskAnnotation) thirdparty.JiraTicket {
+	summary := issue.URL
+	if a.Note != nil && a.Note.Message != "" {
+		summary = a.Note.Message
+	}
+	return thirdparty.JiraTicket{
+		Key: key,
+		Fields: &thirdparty.TicketFields{
+			Summary:     summary,
+			Description: fmt.Sprintf("Linked to task '%s' execution %d, which failed the same way.", a.TaskId, a.TaskExecution),
+		},
+	}
+}
+
 type Suggester interface {
 	Suggest(context.Context, *task.Task) ([]thirdparty.JiraTicket, error)
 	GetTimeout() time.Duration
 }
 
+// MultiSourceSuggest suggests tickets from JIRA if it's configured, falling back
+// to suggestions from past failures with the same fingerprint if JIRA has no
+// results.
 type MultiSourceSuggest struct {
-	JiraSuggester Suggester
+	JiraSuggester        Suggester
+	FingerprintSuggester Suggester
 }
 
 type JiraSuggest struct {
@@ -48,9 +149,18 @@ type JiraSuggest struct {
 	JiraHandler thirdparty.JiraHandler
 }
 
-func (mss *MultiSourceSuggest) Suggest(t *task.Task) ([]thirdparty.JiraTicket, string, error) {
-	tickets, err := mss.JiraSuggester.Suggest(context.TODO(), t)
-	return tickets, jiraSource, err
+func (mss *MultiSourceSuggest) Suggest(ctx context.Context, t *task.Task) ([]thirdparty.JiraTicket, string, error) {
+	if mss.JiraSuggester != nil {
+		tickets, err := mss.JiraSuggester.Suggest(ctx, t)
+		if err != nil || len(tickets) > 0 || mss.FingerprintSuggester == nil {
+			return tickets, jiraSource, err
+		}
+	}
+	if mss.FingerprintSuggester == nil {
+		return nil, "", nil
+	}
+	tickets, err := mss.FingerprintSuggester.Suggest(ctx, t)
+	return tickets, fingerprintSource, err
 }
 
 // GetBuildBaronSettings retrieves build baron settings from project settings.
@@ -145,22 +255,26 @@ func GetSearchReturnInfo(ctx context.Context, taskId string, exec string) (*thir
 	}
 	bbConfig.TicketCreationDefined = false
 
-	// the build baron is configured if the jira search is configured
-	if len(bbProj.TicketSearchProjects) <= 0 {
+	// the build baron is configured if the jira search is configured or if
+	// suggestions from past failures are enabled
+	if len(bbProj.TicketSearchProjects) <= 0 && !bbProj.FingerprintSuggestionsEnabled {
 		bbConfig.SearchConfigured = false
 		return nil, bbConfig, nil
 	}
 	bbConfig.SearchConfigured = true
 
-	jiraHandler := thirdparty.NewJiraHandler(*settings.Jira.Export())
-	jira := &JiraSuggest{bbProj, jiraHandler}
-	multiSource := &MultiSourceSuggest{jira}
-
-	var tickets []thirdparty.JiraTicket
-	var source string
+	multiSource := &MultiSourceSuggest{}
+	var jql string
+	if len(bbProj.TicketSearchProjects) > 0 {
+		jiraHandler := thirdparty.NewJiraHandler(*settings.Jira.Export())
+		multiSource.JiraSuggester = &JiraSuggest{bbProj, jiraHandler}
+		jql = t.GetJQL(bbProj.TicketSearchProjects)
+	}
+	if bbProj.FingerprintSuggestionsEnabled {
+		multiSource.FingerprintSuggester = &FingerprintSuggest{}
+	}
 
-	jql := t.GetJQL(bbProj.TicketSearchProjects)
-	tickets, source, err = multiSource.Suggest(t)
+	tickets, source, err := multiSource.Suggest(ctx, t)
 	if err != nil {
 		return nil, bbConfig, errors.Wrap(err, "searching for tickets")
 	}
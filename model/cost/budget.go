@@ -0,0 +1,185 @@
+package cost
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// BudgetCollection is the collection that stores project budgets.
+const BudgetCollection = "cost_budgets"
+
+// budgetMonthFormat is the format of the month that a budget's notifications
+// were last sent for.
+const budgetMonthFormat = "2006-01"
+
+// defaultAlertThreshold is the percentage of the monthly limit at which to
+// notify if a budget has no alert thresholds.
+const defaultAlertThreshold = 100
+
+// Budget is the monthly spending limit for a project's tasks. Subscribers are
+// notified the first time in a calendar month that the project's spend
+// crosses each alert threshold.
+type Budget struct {
+	ProjectID string `bson:"_id" json:"project_id"`
+	// MonthlyLimit is the amount the project expects to spend on tasks in a
+	// calendar month.
+	MonthlyLimit float64 `bson:"monthly_limit" json:"monthly_limit"`
+	// AlertThresholds are the percentages of the monthly limit that trigger a
+	// notification when crossed. If empty, subscribers are only notified when
+	// the limit is reached.
+	AlertThresholds []int `bson:"alert_thresholds,omitempty" json:"alert_thresholds,omitempty"`
+	// Subscribers are notified when an alert threshold is crossed. Only email
+	// and Slack subscribers are supported.
+	Subscribers []event.Subscriber `bson:"subscribers" json:"subscribers"`
+
+	// NotifiedMonth and NotifiedThresholds record which alert thresholds
+	// subscribers have already been notified about this month.
+	NotifiedMonth      string `bson:"notified_month,omitempty" json:"notified_month,omitempty"`
+	NotifiedThresholds []int  `bson:"notified_thresholds,omitempty" json:"notified_thresholds,omitempty"`
+}
+
+var (
+	BudgetProjectIDKey          = bsonutil.MustHaveTag(Budget{}, "ProjectID")
+	BudgetMonthlyLimitKey       = bsonutil.MustHaveTag(Budget{}, "MonthlyLimit")
+	BudgetAlertThresholdsKey    = bsonutil.MustHaveTag(Budget{}, "AlertThresholds")
+	BudgetSubscribersKey        = bsonutil.MustHaveTag(Budget{}, "Subscribers")
+	BudgetNotifiedMonthKey      = bsonutil.MustHaveTag(Budget{}, "NotifiedMonth")
+	BudgetNotifiedThresholdsKey = bsonutil.MustHaveTag(Budget{}, "NotifiedThresholds")
+)
+
+// Validate checks that the budget is valid and sorts its alert thresholds.
+func (b *Budget) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(b.ProjectID == "", "project ID must be set")
+	catcher.NewWhen(b.MonthlyLimit <= 0, "monthly limit must be positive")
+
+	seen := map[int]bool{}
+	for _, threshold := range b.AlertThresholds {
+		catcher.ErrorfWhen(threshold <= 0, "alert threshold %d must be a positive percentage", threshold)
+		catcher.ErrorfWhen(seen[threshold], "alert threshold %d is duplicated", threshold)
+		seen[threshold] = true
+	}
+	sort.Ints(b.AlertThresholds)
+
+	catcher.NewWhen(len(b.Subscribers) == 0, "must have at least one subscriber")
+	for _, sub := range b.Subscribers {
+		catcher.Wrapf(sub.Validate(), "invalid subscriber '%s'", sub.String())
+		catcher.ErrorfWhen(sub.Type != event.EmailSubscriberType && sub.Type != event.SlackSubscriberType, "subscriber type '%s' is not supported for budgets", sub.Type)
+	}
+
+	return catcher.Resolve()
+}
+
+// Upsert inserts the budget or updates the existing budget's limit, alert
+// thresholds and subscribers. The record of which notifications were already
+// sent is kept.
+func (b *Budget) Upsert(ctx context.Context) error {
+	_, err := db.Upsert(ctx, BudgetCollection, bson.M{BudgetProjectIDKey: b.ProjectID}, bson.M{
+		"$set": bson.M{
+			BudgetMonthlyLimitKey:    b.MonthlyLimit,
+			BudgetAlertThresholdsKey: b.AlertThresholds,
+			BudgetSubscribersKey:     b.Subscribers,
+		},
+	})
+	return errors.Wrapf(err, "upserting budget for project '%s'", b.ProjectID)
+}
+
+// FindBudget returns the project's budget, or nil if it has none.
+func FindBudget(ctx context.Context, projectID string) (*Budget, error) {
+	b := &Budget{}
+	err := db.FindOneQContext(ctx, BudgetCollection, db.Query(bson.M{BudgetProjectIDKey: projectID}), b)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding budget for project '%s'", projectID)
+	}
+	return b, nil
+}
+
+// FindAllBudgets returns every project budget.
+func FindAllBudgets(ctx context.Context) ([]Budget, error) {
+	var budgets []Budget
+	err := db.FindAllQ(ctx, BudgetCollection, db.Query(bson.M{}), &budgets)
+	return budgets, errors.Wrap(err, "finding budgets")
+}
+
+// RemoveBudget deletes the project's budget.
+func RemoveBudget(ctx context.Context, projectID string) error {
+	err := db.Remove(ctx, BudgetCollection, bson.M{BudgetProjectIDKey: projectID})
+	return errors.Wrapf(err, "removing budget for project '%s'", projectID)
+}
+
+// BudgetMonth returns the month that the given time falls in, as used to track
+// budget notifications.
+func BudgetMonth(t time.Time) string {
+	return t.UTC().Format(budgetMonthFormat)
+}
+
+// MonthStart returns the start of the calendar month that the given time falls
+// in, in UTC.
+func MonthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// CrossedThresholds returns the alert thresholds that the given spend has
+// crossed in the given month that subscribers have not been notified about
+// yet, from lowest to highest.
+func (b *Budget) CrossedThresholds(spend float64, month string) []int {
+	thresholds := b.AlertThresholds
+	if len(thresholds) == 0 {
+		thresholds = []int{defaultAlertThreshold}
+	}
+
+	notified := map[int]bool{}
+	if b.NotifiedMonth == month {
+		for _, threshold := range b.NotifiedThresholds {
+			notified[threshold] = true
+		}
+	}
+
+	var crossed []int
+	for _, threshold := range thresholds {
+		if notified[threshold] {
+			continue
+		}
+		if spend >= b.MonthlyLimit*float64(threshold)/100 {
+			crossed = append(crossed, threshold)
+		}
+	}
+	return crossed
+}
+
+// MarkNotified records that subscribers were notified about the given alert
+// thresholds in the given month.
+func (b *Budget) MarkNotified(ctx context.Context, month string, thresholds []int) error {
+	notified := thresholds
+	if b.NotifiedMonth == month {
+		notified = append(append([]int{}, b.NotifiedThresholds...), thresholds...)
+	}
+	sort.Ints(notified)
+
+	err := db.UpdateContext(ctx, BudgetCollection, bson.M{BudgetProjectIDKey: b.ProjectID}, bson.M{
+		"$set": bson.M{
+			BudgetNotifiedMonthKey:      month,
+			BudgetNotifiedThresholdsKey: notified,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "marking budget for project '%s' as notified", b.ProjectID)
+	}
+
+	b.NotifiedMonth = month
+	b.NotifiedThresholds = notified
+	return nil
+}
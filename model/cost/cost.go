@@ -0,0 +1,293 @@
+// Package cost estimates what the hosts that run tasks cost and attributes
+// that cost to the tasks, so that it can be rolled up by project, build
+// variant and requester and checked against project budgets.
+package cost
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Collection is the collection that stores the estimated cost of each task
+// execution.
+const Collection = "task_costs"
+
+// TaskCost is the estimated cost of a single task execution. A task's cost
+// covers the time the task ran plus its share of the time its host spent
+// doing anything else, such as being set up, sitting idle between tasks and
+// being torn down.
+type TaskCost struct {
+	ID           string    `bson:"_id" json:"id"`
+	TaskID       string    `bson:"task_id" json:"task_id"`
+	Execution    int       `bson:"execution" json:"execution"`
+	DisplayName  string    `bson:"display_name" json:"display_name"`
+	Project      string    `bson:"project" json:"project"`
+	BuildVariant string    `bson:"build_variant" json:"build_variant"`
+	Requester    string    `bson:"requester" json:"requester"`
+	Distro       string    `bson:"distro" json:"distro"`
+	HostID       string    `bson:"host_id" json:"host_id"`
+	InstanceType string    `bson:"instance_type,omitempty" json:"instance_type,omitempty"`
+	FinishTime   time.Time `bson:"finish_time" json:"finish_time"`
+
+	// HourlyCost is the rate the task's host was billed at.
+	HourlyCost float64 `bson:"hourly_cost" json:"hourly_cost"`
+	// RunTime is how long the task ran.
+	RunTime time.Duration `bson:"run_time" json:"run_time"`
+	// OverheadTime is the task's share of the time its host was up but not
+	// running any task.
+	OverheadTime time.Duration `bson:"overhead_time" json:"overhead_time"`
+	// Cost is the estimated cost of the task's run time and overhead time.
+	Cost float64 `bson:"cost" json:"cost"`
+}
+
+var (
+	IDKey           = bsonutil.MustHaveTag(TaskCost{}, "ID")
+	TaskIDKey       = bsonutil.MustHaveTag(TaskCost{}, "TaskID")
+	ProjectKey      = bsonutil.MustHaveTag(TaskCost{}, "Project")
+	BuildVariantKey = bsonutil.MustHaveTag(TaskCost{}, "BuildVariant")
+	RequesterKey    = bsonutil.MustHaveTag(TaskCost{}, "Requester")
+	FinishTimeKey   = bsonutil.MustHaveTag(TaskCost{}, "FinishTime")
+	RunTimeKey      = bsonutil.MustHaveTag(TaskCost{}, "RunTime")
+	OverheadTimeKey = bsonutil.MustHaveTag(TaskCost{}, "OverheadTime")
+	CostKey         = bsonutil.MustHaveTag(TaskCost{}, "Cost")
+)
+
+// taskCostID returns the ID of a task execution's cost.
+func taskCostID(taskID string, execution int) string {
+	return fmt.Sprintf("%s_%d", taskID, execution)
+}
+
+// ComputeHostTaskCosts estimates the cost of each task that ran on a
+// terminated host. The host's time that was not spent running tasks,
+// including its setup time and idle time, is split between the tasks in
+// proportion to how long each one ran.
+//
+// The host's overhead is its recorded total idle time, which accrues the time
+// it spent being set up before its first task, between tasks and before it
+// was terminated. Hosts that did not record any idle time, such as hosts in
+// distros that are not ephemeral, fall back to the time they were up but not
+// running a task.
+//
+// Tasks must be finished task executions that ran on the host. Archived
+// executions are identified by their original task ID.
+func ComputeHostTaskCosts(h *host.Host, tasks []task.Task, hourlyCost float64) []TaskCost {
+	var totalRunTime time.Duration
+	runTimes := make([]time.Duration, len(tasks))
+	for i, t := range tasks {
+		if t.StartTime.IsZero() || t.FinishTime.IsZero() || t.FinishTime.Before(t.StartTime) {
+			continue
+		}
+		runTimes[i] = t.FinishTime.Sub(t.StartTime)
+		totalRunTime += runTimes[i]
+	}
+
+	overhead := hostOverhead(h, totalRunTime)
+
+	costs := make([]TaskCost, 0, len(tasks))
+	for i, t := range tasks {
+		if runTimes[i] == 0 {
+			continue
+		}
+
+		taskID := t.Id
+		if t.OldTaskId != "" {
+			taskID = t.OldTaskId
+		}
+		overheadShare := time.Duration(float64(overhead) * float64(runTimes[i]) / float64(totalRunTime))
+		costs = append(costs, TaskCost{
+			ID:           taskCostID(taskID, t.Execution),
+			TaskID:       taskID,
+			Execution:    t.Execution,
+			DisplayName:  t.DisplayName,
+			Project:      t.Project,
+			BuildVariant: t.BuildVariant,
+			Requester:    t.Requester,
+			Distro:       h.Distro.Id,
+			HostID:       h.Id,
+			InstanceType: h.InstanceType,
+			FinishTime:   t.FinishTime,
+			HourlyCost:   hourlyCost,
+			RunTime:      runTimes[i],
+			OverheadTime: overheadShare,
+			Cost:         (runTimes[i] + overheadShare).Hours() * hourlyCost,
+		})
+	}
+
+	return costs
+}
+
+// hostOverhead returns the time the host was up but not running any of its
+// tasks, which ran for the given total run time.
+func hostOverhead(h *host.Host, totalRunTime time.Duration) time.Duration {
+	// Host overhead can't be attributed to tasks if none of them ran.
+	if totalRunTime <= 0 {
+		return 0
+	}
+	if h.TotalIdleTime > 0 {
+		return h.TotalIdleTime
+	}
+
+	upSince := h.StartTime
+	if upSince.IsZero() {
+		upSince = h.CreationTime
+	}
+	// The host can't have been up for less time than its tasks ran.
+	uptime := h.TerminationTime.Sub(upSince)
+	if uptime <= totalRunTime {
+		return 0
+	}
+	return uptime - totalRunTime
+}
+
+// RecordHostTaskCosts estimates and stores the cost of each task execution
+// that ran on the terminated host. It is safe to call more than once for the
+// same host.
+func RecordHostTaskCosts(ctx context.Context, h *host.Host, config evergreen.CostConfig) error {
+	if h.TerminationTime.IsZero() {
+		return errors.Errorf("host '%s' has not terminated", h.Id)
+	}
+
+	tasks, err := task.FindFinishedOnHost(ctx, h.Id)
+	if err != nil {
+		return errors.Wrapf(err, "finding tasks that ran on host '%s'", h.Id)
+	}
+
+	costs := ComputeHostTaskCosts(h, tasks, config.HourlyCost(h.Distro.Id, h.InstanceType))
+	catcher := grip.NewBasicCatcher()
+	for _, c := range costs {
+		catcher.Wrapf(c.Upsert(ctx), "upserting cost for task '%s' execution %d", c.TaskID, c.Execution)
+	}
+	return catcher.Resolve()
+}
+
+// Upsert inserts the task cost or replaces the existing one.
+func (c *TaskCost) Upsert(ctx context.Context) error {
+	_, err := db.ReplaceContext(ctx, Collection, bson.M{IDKey: c.ID}, c)
+	return errors.WithStack(err)
+}
+
+// FindByTask returns the costs of all the executions of a task.
+func FindByTask(ctx context.Context, taskID string) ([]TaskCost, error) {
+	var costs []TaskCost
+	err := db.FindAllQ(ctx, Collection, db.Query(bson.M{TaskIDKey: taskID}), &costs)
+	return costs, errors.WithStack(err)
+}
+
+const (
+	// GroupByProject groups cost summaries by project.
+	GroupByProject = "project"
+	// GroupByBuildVariant groups cost summaries by build variant.
+	GroupByBuildVariant = "build_variant"
+	// GroupByRequester groups cost summaries by requester.
+	GroupByRequester = "requester"
+)
+
+// ValidGroupBy are the fields that cost summaries can be grouped by.
+var ValidGroupBy = []string{GroupByProject, GroupByBuildVariant, GroupByRequester}
+
+// SummaryOptions filter and group task costs into summaries.
+type SummaryOptions struct {
+	// Project, if set, only summarizes the project's task costs.
+	Project string
+	// StartTime and EndTime bound the finish times of the summarized tasks.
+	// EndTime is exclusive.
+	StartTime time.Time
+	EndTime   time.Time
+	// GroupBy are the fields to group costs by. If empty, all matching task
+	// costs are summarized together.
+	GroupBy []string
+}
+
+// Validate checks that the summary options are valid.
+func (o *SummaryOptions) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(o.StartTime.IsZero() || o.EndTime.IsZero(), "start and end time must be set")
+	catcher.NewWhen(!o.EndTime.After(o.StartTime), "end time must be after start time")
+	for _, field := range o.GroupBy {
+		valid := false
+		for _, validField := range ValidGroupBy {
+			valid = valid || field == validField
+		}
+		catcher.ErrorfWhen(!valid, "cannot group by '%s'", field)
+	}
+	return catcher.Resolve()
+}
+
+// Summary is the total estimated cost of a group of task executions.
+type Summary struct {
+	Group        SummaryGroup  `bson:"_id" json:"group"`
+	Cost         float64       `bson:"cost" json:"cost"`
+	RunTime      time.Duration `bson:"run_time" json:"run_time"`
+	OverheadTime time.Duration `bson:"overhead_time" json:"overhead_time"`
+	NumTasks     int           `bson:"num_tasks" json:"num_tasks"`
+}
+
+// SummaryGroup identifies the group a summary is for. Fields that the
+// summaries were not grouped by are empty.
+type SummaryGroup struct {
+	Project      string `bson:"project,omitempty" json:"project,omitempty"`
+	BuildVariant string `bson:"build_variant,omitempty" json:"build_variant,omitempty"`
+	Requester    string `bson:"requester,omitempty" json:"requester,omitempty"`
+}
+
+// Summarize totals the task costs that match the options, sorted from most to
+// least expensive.
+func Summarize(ctx context.Context, opts SummaryOptions) ([]Summary, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid summary options")
+	}
+
+	match := bson.M{FinishTimeKey: bson.M{"$gte": opts.StartTime, "$lt": opts.EndTime}}
+	if opts.Project != "" {
+		match[ProjectKey] = opts.Project
+	}
+	group := bson.M{}
+	for _, field := range opts.GroupBy {
+		group[field] = "$" + field
+	}
+
+	pipeline := []bson.M{
+		{"$match": match},
+		{"$group": bson.M{
+			"_id":           group,
+			"cost":          bson.M{"$sum": "$" + CostKey},
+			"run_time":      bson.M{"$sum": "$" + RunTimeKey},
+			"overhead_time": bson.M{"$sum": "$" + OverheadTimeKey},
+			"num_tasks":     bson.M{"$sum": 1},
+		}},
+		{"$sort": bson.D{{Key: "cost", Value: -1}}},
+	}
+
+	var summaries []Summary
+	if err := db.Aggregate(ctx, Collection, pipeline, &summaries); err != nil {
+		return nil, errors.Wrap(err, "aggregating task costs")
+	}
+	return summaries, nil
+}
+
+// ProjectSpend returns the total estimated cost of the project's tasks that
+// finished in the given time range.
+func ProjectSpend(ctx context.Context, project string, startTime, endTime time.Time) (float64, error) {
+	summaries, err := Summarize(ctx, SummaryOptions{
+		Project:   project,
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if len(summaries) == 0 {
+		return 0, nil
+	}
+	return summaries[0].Cost, nil
+}
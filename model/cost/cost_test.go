@@ -0,0 +1,188 @@
+package cost
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	testutil.Setup()
+}
+
+func TestComputeHostTaskCosts(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := &host.Host{
+		Id:              "h1",
+		Distro:          distro.Distro{Id: "d1"},
+		InstanceType:    "m5.xlarge",
+		StartTime:       start,
+		TerminationTime: start.Add(4 * time.Hour),
+	}
+
+	t.Run("SplitsOverheadByRunTime", func(t *testing.T) {
+		tasks := []task.Task{
+			{Id: "t1", Project: "p", StartTime: start.Add(time.Hour), FinishTime: start.Add(2 * time.Hour)},
+			{Id: "t2_0", OldTaskId: "t2", Execution: 0, Project: "p", StartTime: start.Add(2 * time.Hour), FinishTime: start.Add(4 * time.Hour)},
+		}
+		costs := ComputeHostTaskCosts(h, tasks, 2)
+		require.Len(t, costs, 2)
+
+		assert.Equal(t, "t1_0", costs[0].ID)
+		assert.Equal(t, time.Hour, costs[0].RunTime)
+		assert.Equal(t, 20*time.Minute, costs[0].OverheadTime)
+		assert.InDelta(t, 2*(4.0/3), costs[0].Cost, 0.0001)
+		assert.Equal(t, "d1", costs[0].Distro)
+		assert.Equal(t, "m5.xlarge", costs[0].InstanceType)
+
+		assert.Equal(t, "t2", costs[1].TaskID)
+		assert.Equal(t, "t2_0", costs[1].ID)
+		assert.Equal(t, 2*time.Hour, costs[1].RunTime)
+		assert.Equal(t, 40*time.Minute, costs[1].OverheadTime)
+		assert.InDelta(t, 2*(8.0/3), costs[1].Cost, 0.0001)
+	})
+	t.Run("SkipsTasksThatDidNotRun", func(t *testing.T) {
+		tasks := []task.Task{
+			{Id: "t1", StartTime: start.Add(time.Hour), FinishTime: start.Add(2 * time.Hour)},
+			{Id: "t2", FinishTime: start.Add(2 * time.Hour)},
+		}
+		costs := ComputeHostTaskCosts(h, tasks, 1)
+		require.Len(t, costs, 1)
+		assert.Equal(t, "t1", costs[0].TaskID)
+		assert.Equal(t, 3*time.Hour, costs[0].OverheadTime)
+	})
+	t.Run("UsesRecordedIdleTime", func(t *testing.T) {
+		idleHost := *h
+		idleHost.TotalIdleTime = 30 * time.Minute
+		tasks := []task.Task{
+			{Id: "t1", StartTime: start.Add(time.Hour), FinishTime: start.Add(2 * time.Hour)},
+			{Id: "t2", StartTime: start.Add(2 * time.Hour), FinishTime: start.Add(4 * time.Hour)},
+		}
+		costs := ComputeHostTaskCosts(&idleHost, tasks, 1)
+		require.Len(t, costs, 2)
+		assert.Equal(t, 10*time.Minute, costs[0].OverheadTime)
+		assert.Equal(t, 20*time.Minute, costs[1].OverheadTime)
+		assert.InDelta(t, 2.5, costs[0].Cost+costs[1].Cost, 0.0001)
+	})
+	t.Run("NoOverheadIfTasksOutlastHost", func(t *testing.T) {
+		tasks := []task.Task{
+			{Id: "t1", StartTime: start.Add(-time.Hour), FinishTime: start.Add(5 * time.Hour)},
+		}
+		costs := ComputeHostTaskCosts(h, tasks, 1)
+		require.Len(t, costs, 1)
+		assert.Zero(t, costs[0].OverheadTime)
+		assert.InDelta(t, 6, costs[0].Cost, 0.0001)
+	})
+}
+
+func TestSummarize(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, db.ClearCollections(Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(Collection))
+	}()
+
+	now := time.Now().Round(time.Millisecond)
+	for _, c := range []TaskCost{
+		{ID: "t1_0", Project: "p1", BuildVariant: "bv1", Requester: evergreen.RepotrackerVersionRequester, FinishTime: now, RunTime: time.Hour, Cost: 1},
+		{ID: "t2_0", Project: "p1", BuildVariant: "bv2", Requester: evergreen.PatchVersionRequester, FinishTime: now, RunTime: time.Hour, Cost: 3},
+		{ID: "t3_0", Project: "p1", BuildVariant: "bv2", Requester: evergreen.RepotrackerVersionRequester, FinishTime: now, RunTime: time.Hour, Cost: 2},
+		{ID: "t4_0", Project: "p1", BuildVariant: "bv1", FinishTime: now.Add(-48 * time.Hour), Cost: 10},
+		{ID: "t5_0", Project: "p2", BuildVariant: "bv1", FinishTime: now, Cost: 10},
+	} {
+		require.NoError(t, c.Upsert(ctx))
+	}
+
+	summaries, err := Summarize(ctx, SummaryOptions{
+		Project:   "p1",
+		StartTime: now.Add(-time.Hour),
+		EndTime:   now.Add(time.Hour),
+		GroupBy:   []string{GroupByBuildVariant},
+	})
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, "bv2", summaries[0].Group.BuildVariant)
+	assert.Equal(t, 5.0, summaries[0].Cost)
+	assert.Equal(t, 2*time.Hour, summaries[0].RunTime)
+	assert.Equal(t, 2, summaries[0].NumTasks)
+	assert.Equal(t, "bv1", summaries[1].Group.BuildVariant)
+	assert.Equal(t, 1.0, summaries[1].Cost)
+
+	spend, err := ProjectSpend(ctx, "p1", now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 6.0, spend)
+
+	_, err = Summarize(ctx, SummaryOptions{StartTime: now, EndTime: now.Add(time.Hour), GroupBy: []string{"distro"}})
+	assert.Error(t, err)
+}
+
+func TestBudget(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, db.ClearCollections(BudgetCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(BudgetCollection))
+	}()
+
+	subscriber := event.Subscriber{Type: event.EmailSubscriberType, Target: "me@example.com"}
+
+	t.Run("Validate", func(t *testing.T) {
+		b := Budget{ProjectID: "p", MonthlyLimit: 100, AlertThresholds: []int{100, 50}, Subscribers: []event.Subscriber{subscriber}}
+		require.NoError(t, b.Validate())
+		assert.Equal(t, []int{50, 100}, b.AlertThresholds)
+
+		b.AlertThresholds = []int{50, 50}
+		assert.Error(t, b.Validate())
+
+		b.AlertThresholds = nil
+		b.Subscribers = []event.Subscriber{{Type: event.JIRACommentSubscriberType, Target: "EVG-123"}}
+		assert.Error(t, b.Validate())
+	})
+	t.Run("CrossedThresholds", func(t *testing.T) {
+		b := Budget{MonthlyLimit: 100, AlertThresholds: []int{50, 80, 100}}
+		assert.Empty(t, b.CrossedThresholds(49, "2024-01"))
+		assert.Equal(t, []int{50, 80}, b.CrossedThresholds(90, "2024-01"))
+
+		b.NotifiedMonth = "2024-01"
+		b.NotifiedThresholds = []int{50}
+		assert.Equal(t, []int{80}, b.CrossedThresholds(90, "2024-01"))
+		assert.Equal(t, []int{50, 80}, b.CrossedThresholds(90, "2024-02"))
+
+		b.AlertThresholds = nil
+		assert.Empty(t, b.CrossedThresholds(90, "2024-02"))
+		assert.Equal(t, []int{100}, b.CrossedThresholds(100, "2024-02"))
+	})
+	t.Run("UpsertKeepsNotifications", func(t *testing.T) {
+		b := Budget{ProjectID: "p", MonthlyLimit: 100, Subscribers: []event.Subscriber{subscriber}}
+		require.NoError(t, b.Upsert(ctx))
+		require.NoError(t, b.MarkNotified(ctx, "2024-01", []int{100}))
+
+		b.MonthlyLimit = 200
+		require.NoError(t, b.Upsert(ctx))
+
+		dbBudget, err := FindBudget(ctx, "p")
+		require.NoError(t, err)
+		require.NotNil(t, dbBudget)
+		assert.Equal(t, 200.0, dbBudget.MonthlyLimit)
+		assert.Equal(t, "2024-01", dbBudget.NotifiedMonth)
+		assert.Equal(t, []int{100}, dbBudget.NotifiedThresholds)
+		require.Len(t, dbBudget.Subscribers, 1)
+		assert.Equal(t, subscriber.String(), dbBudget.Subscribers[0].String())
+
+		require.NoError(t, RemoveBudget(ctx, "p"))
+		dbBudget, err = FindBudget(ctx, "p")
+		require.NoError(t, err)
+		assert.Nil(t, dbBudget)
+	})
+}
@@ -44,8 +44,10 @@ var (
 	IsClusterKey             = bsonutil.MustHaveTag(Distro{}, "IsCluster")
 	IceCreamSettingsKey      = bsonutil.MustHaveTag(Distro{}, "IceCreamSettings")
 	// ImageID is not equivalent to AMI. It is the identifier of the base image for the distro.
-	ImageIDKey          = bsonutil.MustHaveTag(Distro{}, "ImageID")
-	SingleTaskDistroKey = bsonutil.MustHaveTag(Distro{}, "SingleTaskDistro")
+	ImageIDKey            = bsonutil.MustHaveTag(Distro{}, "ImageID")
+	SingleTaskDistroKey   = bsonutil.MustHaveTag(Distro{}, "SingleTaskDistro")
+	MaintenanceWindowsKey = bsonutil.MustHaveTag(Distro{}, "MaintenanceWindows")
+	ActiveMaintenanceKey  = bsonutil.MustHaveTag(Distro{}, "ActiveMaintenance")
 )
 
 var (
@@ -63,6 +63,13 @@ type Distro struct {
 
 	// ExecUser is the user to run shell.exec and subprocess.exec processes as. If unset, processes are run as the regular distro User.
 	ExecUser string `bson:"exec_user,omitempty" json:"exec_user,omitempty" mapstructure:"exec_user,omitempty"`
+
+	// MaintenanceWindows are the scheduled periods when tasks are not
+	// dispatched to the distro.
+	MaintenanceWindows []MaintenanceWindow `bson:"maintenance_windows,omitempty" json:"maintenance_windows,omitempty" mapstructure:"maintenance_windows,omitempty"`
+	// ActiveMaintenance is the maintenance window that the distro is
+	// currently in. It's set and cleared by the distro maintenance job.
+	ActiveMaintenance *ActiveMaintenance `bson:"active_maintenance,omitempty" json:"active_maintenance,omitempty" mapstructure:"active_maintenance,omitempty"`
 }
 
 // DistroData is the same as a distro, with the only difference being that all
@@ -257,6 +264,7 @@ type FinderSettings struct {
 
 type PlannerSettings struct {
 	Version                   string        `bson:"version" json:"version" mapstructure:"version"`
+	Strategy                  string        `bson:"strategy,omitempty" json:"strategy,omitempty" mapstructure:"strategy,omitempty"`
 	TargetTime                time.Duration `bson:"target_time" json:"target_time" mapstructure:"target_time,omitempty"`
 	GroupVersions             *bool         `bson:"group_versions" json:"group_versions" mapstructure:"group_versions,omitempty"`
 	PatchFactor               int64         `bson:"patch_zipper_factor" json:"patch_factor" mapstructure:"patch_factor"`
@@ -509,6 +517,8 @@ func (d *Distro) GetImageID() (string, error) {
 		key = "ami"
 	case evergreen.ProviderNameDocker, evergreen.ProviderNameDockerMock:
 		key = "image_url"
+	case evergreen.ProviderNameKubernetes:
+		key = "image"
 	case evergreen.ProviderNameMock, evergreen.ProviderNameStatic:
 		return "", nil
 	default:
@@ -695,6 +705,7 @@ func (d *Distro) GetResolvedPlannerSettings(s *evergreen.Settings) (PlannerSetti
 	ps := d.PlannerSettings
 	resolved := PlannerSettings{
 		Version:                   ps.Version,
+		Strategy:                  ps.Strategy,
 		TargetTime:                ps.TargetTime,
 		GroupVersions:             ps.GroupVersions,
 		PatchFactor:               ps.PatchFactor,
@@ -723,6 +734,12 @@ func (d *Distro) GetResolvedPlannerSettings(s *evergreen.Settings) (PlannerSetti
 	if !utility.StringSliceContains(evergreen.ValidTaskPlannerVersions, resolved.Version) {
 		catcher.Errorf("'%s' is not a valid planner version", resolved.Version)
 	}
+	if resolved.Strategy == "" {
+		resolved.Strategy = evergreen.PlannerStrategyDefault
+	}
+	if !utility.StringSliceContains(evergreen.ValidTaskPlannerStrategies, resolved.Strategy) {
+		catcher.Errorf("'%s' is not a valid planner strategy", resolved.Strategy)
+	}
 	if resolved.TargetTime == 0 {
 		resolved.TargetTime = time.Duration(config.TargetTimeSeconds) * time.Second
 	}
@@ -0,0 +1,172 @@
+package distro
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	adb "github.com/mongodb/anser/db"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// MaintenanceWindow is a period during which no tasks are dispatched to the
+// distro's hosts and no new hosts are created for it. Tasks that are already
+// running when the window starts are allowed to finish. A window is either a
+// one-off window between Start and End, or a recurring window that starts on
+// the Cron schedule and lasts for DurationSecs.
+type MaintenanceWindow struct {
+	// Start is when a one-off window starts.
+	Start time.Time `bson:"start,omitempty" json:"start,omitempty" mapstructure:"start,omitempty"`
+	// End is when a one-off window ends.
+	End time.Time `bson:"end,omitempty" json:"end,omitempty" mapstructure:"end,omitempty"`
+	// Cron is the schedule, in UTC, on which a recurring window starts.
+	Cron string `bson:"cron,omitempty" json:"cron,omitempty" mapstructure:"cron,omitempty"`
+	// DurationSecs is how long a recurring window lasts.
+	DurationSecs int `bson:"duration_secs,omitempty" json:"duration_secs,omitempty" mapstructure:"duration_secs,omitempty"`
+	// Reason is shown to users while the window is active.
+	Reason string `bson:"reason,omitempty" json:"reason,omitempty" mapstructure:"reason,omitempty"`
+	// TerminateIdleHosts, if set, terminates the distro's idle hosts when
+	// the window starts.
+	TerminateIdleHosts bool `bson:"terminate_idle_hosts,omitempty" json:"terminate_idle_hosts,omitempty" mapstructure:"terminate_idle_hosts,omitempty"`
+}
+
+// ActiveMaintenance is the maintenance window that the distro is currently
+// in.
+type ActiveMaintenance struct {
+	Start  time.Time `bson:"start" json:"start" mapstructure:"start"`
+	End    time.Time `bson:"end" json:"end" mapstructure:"end"`
+	Reason string    `bson:"reason,omitempty" json:"reason,omitempty" mapstructure:"reason,omitempty"`
+}
+
+// Validate checks that the window is either a valid one-off window or a valid
+// recurring window.
+func (w *MaintenanceWindow) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	isOneOff := !utility.IsZeroTime(w.Start) || !utility.IsZeroTime(w.End)
+	isRecurring := w.Cron != "" || w.DurationSecs != 0
+	if isOneOff == isRecurring {
+		return errors.New("maintenance window must have either a start and end time or a cron schedule and duration")
+	}
+
+	if isOneOff {
+		catcher.NewWhen(utility.IsZeroTime(w.Start), "one-off maintenance window must have a start time")
+		catcher.NewWhen(utility.IsZeroTime(w.End), "one-off maintenance window must have an end time")
+		catcher.NewWhen(!w.End.After(w.Start), "maintenance window must end after it starts")
+		return catcher.Resolve()
+	}
+
+	catcher.NewWhen(w.DurationSecs <= 0, "recurring maintenance window must have a positive duration")
+	if _, err := maintenanceCronSchedule(w.Cron); err != nil {
+		catcher.Add(err)
+	}
+	return catcher.Resolve()
+}
+
+// ActiveAt returns the start and end of the occurrence of the window that
+// includes the given time. If the window isn't active at the given time, it
+// returns false.
+func (w *MaintenanceWindow) ActiveAt(now time.Time) (start, end time.Time, active bool) {
+	if w.Cron == "" {
+		if !now.Before(w.Start) && now.Before(w.End) {
+			return w.Start, w.End, true
+		}
+		return time.Time{}, time.Time{}, false
+	}
+
+	sched, err := maintenanceCronSchedule(w.Cron)
+	if err != nil || w.DurationSecs <= 0 {
+		return time.Time{}, time.Time{}, false
+	}
+	// An occurrence is active if it started within the last duration, so
+	// check for the first start after the beginning of that period.
+	duration := time.Duration(w.DurationSecs) * time.Second
+	start = sched.Next(now.UTC().Add(-duration))
+	if start.After(now) {
+		return time.Time{}, time.Time{}, false
+	}
+	return start, start.Add(duration), true
+}
+
+func maintenanceCronSchedule(spec string) (cron.Schedule, error) {
+	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.DowOptional | cron.Descriptor)
+	sched, err := parser.Parse(spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing maintenance window cron '%s'", spec)
+	}
+	return sched, nil
+}
+
+// CurrentMaintenanceWindow returns the distro's maintenance window that is
+// active at the given time. If several windows overlap, it returns the one that
+// ends last. It returns nil if no window is active.
+func (d *Distro) CurrentMaintenanceWindow(now time.Time) (*MaintenanceWindow, *ActiveMaintenance) {
+	var current *MaintenanceWindow
+	var active *ActiveMaintenance
+	for i := range d.MaintenanceWindows {
+		start, end, ok := d.MaintenanceWindows[i].ActiveAt(now)
+		if !ok {
+			continue
+		}
+		if active == nil || end.After(active.End) {
+			current = &d.MaintenanceWindows[i]
+			active = &ActiveMaintenance{
+				Start:  start,
+				End:    end,
+				Reason: d.MaintenanceWindows[i].Reason,
+			}
+		}
+	}
+	return current, active
+}
+
+// IsInMaintenance returns whether the distro is in a maintenance window, in
+// which case tasks should not be dispatched to it and hosts should not be
+// created for it.
+func (d *Distro) IsInMaintenance() bool {
+	return d.ActiveMaintenance != nil
+}
+
+// MaintenanceBanner returns the message to show users while the distro is in
+// a maintenance window, or an empty string if it isn't.
+func (d *Distro) MaintenanceBanner() string {
+	if d.ActiveMaintenance == nil {
+		return ""
+	}
+	banner := fmt.Sprintf("Distro '%s' is in scheduled maintenance until %s; tasks will not start on it until then.", d.Id, d.ActiveMaintenance.End.UTC().Format(time.RFC1123))
+	if d.ActiveMaintenance.Reason != "" {
+		banner = fmt.Sprintf("%s Reason: %s", banner, d.ActiveMaintenance.Reason)
+	}
+	return banner
+}
+
+// SetActiveMaintenance records that the distro is in the given maintenance
+// window, or clears it if the window is nil.
+func (d *Distro) SetActiveMaintenance(ctx context.Context, active *ActiveMaintenance) error {
+	update := bson.M{"$unset": bson.M{ActiveMaintenanceKey: 1}}
+	if active != nil {
+		update = bson.M{"$set": bson.M{ActiveMaintenanceKey: active}}
+	}
+	res, err := distroDB().Collection(Collection).UpdateOne(ctx, bson.M{IdKey: d.Id}, update)
+	if err != nil {
+		return errors.Wrapf(err, "setting active maintenance for distro '%s'", d.Id)
+	}
+	if res.MatchedCount == 0 {
+		return adb.ErrNotFound
+	}
+
+	d.ActiveMaintenance = active
+	return nil
+}
+
+// FindWithMaintenance finds the distros that have maintenance windows or are
+// currently in maintenance.
+func FindWithMaintenance(ctx context.Context) ([]Distro, error) {
+	return Find(ctx, bson.M{"$or": []bson.M{
+		{MaintenanceWindowsKey + ".0": bson.M{"$exists": true}},
+		{ActiveMaintenanceKey: bson.M{"$exists": true}},
+	}})
+}
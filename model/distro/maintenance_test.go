@@ -0,0 +1,108 @@
+package distro
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceWindowActiveAt(t *testing.T) {
+	now := time.Date(2024, time.March, 5, 3, 0, 0, 0, time.UTC)
+
+	t.Run("OneOffWindowIsActiveBetweenStartAndEnd", func(t *testing.T) {
+		w := MaintenanceWindow{Start: now.Add(-time.Hour), End: now.Add(time.Hour)}
+		start, end, active := w.ActiveAt(now)
+		assert.True(t, active)
+		assert.True(t, start.Equal(w.Start))
+		assert.True(t, end.Equal(w.End))
+	})
+	t.Run("OneOffWindowIsInactiveAfterEnd", func(t *testing.T) {
+		w := MaintenanceWindow{Start: now.Add(-2 * time.Hour), End: now}
+		_, _, active := w.ActiveAt(now)
+		assert.False(t, active)
+	})
+	t.Run("RecurringWindowIsActiveDuringOccurrence", func(t *testing.T) {
+		w := MaintenanceWindow{Cron: "0 2 * * *", DurationSecs: int((2 * time.Hour).Seconds())}
+		start, end, active := w.ActiveAt(now)
+		assert.True(t, active)
+		assert.True(t, start.Equal(time.Date(2024, time.March, 5, 2, 0, 0, 0, time.UTC)))
+		assert.True(t, end.Equal(time.Date(2024, time.March, 5, 4, 0, 0, 0, time.UTC)))
+	})
+	t.Run("RecurringWindowIsInactiveBetweenOccurrences", func(t *testing.T) {
+		w := MaintenanceWindow{Cron: "0 2 * * *", DurationSecs: int((2 * time.Hour).Seconds())}
+		_, _, active := w.ActiveAt(now.Add(2 * time.Hour))
+		assert.False(t, active)
+	})
+	t.Run("InvalidRecurringWindowIsNeverActive", func(t *testing.T) {
+		w := MaintenanceWindow{Cron: "not a cron", DurationSecs: 3600}
+		_, _, active := w.ActiveAt(now)
+		assert.False(t, active)
+	})
+}
+
+func TestCurrentMaintenanceWindow(t *testing.T) {
+	now := time.Now()
+	d := Distro{
+		Id: "distro",
+		MaintenanceWindows: []MaintenanceWindow{
+			{Start: now.Add(-time.Hour), End: now.Add(time.Hour), Reason: "short"},
+			{Start: now.Add(-time.Hour), End: now.Add(2 * time.Hour), Reason: "long", TerminateIdleHosts: true},
+			{Start: now.Add(time.Hour), End: now.Add(3 * time.Hour), Reason: "later"},
+		},
+	}
+
+	window, active := d.CurrentMaintenanceWindow(now)
+	require.NotNil(t, window)
+	require.NotNil(t, active)
+	assert.True(t, window.TerminateIdleHosts, "window that ends last should be current")
+	assert.Equal(t, "long", active.Reason)
+
+	window, active = d.CurrentMaintenanceWindow(now.Add(4 * time.Hour))
+	assert.Nil(t, window)
+	assert.Nil(t, active)
+}
+
+func TestSetActiveMaintenance(t *testing.T) {
+	require.NoError(t, db.Clear(Collection))
+	defer func() {
+		assert.NoError(t, db.Clear(Collection))
+	}()
+
+	now := time.Now().Truncate(time.Millisecond)
+	withWindow := Distro{
+		Id:                 "with_window",
+		MaintenanceWindows: []MaintenanceWindow{{Start: now, End: now.Add(time.Hour), Reason: "rotating AMIs"}},
+	}
+	require.NoError(t, withWindow.Insert(t.Context()))
+	withoutWindow := Distro{Id: "without_window"}
+	require.NoError(t, withoutWindow.Insert(t.Context()))
+
+	found, err := FindWithMaintenance(t.Context())
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, withWindow.Id, found[0].Id)
+
+	_, active := withWindow.CurrentMaintenanceWindow(now)
+	require.NotNil(t, active)
+	require.NoError(t, withWindow.SetActiveMaintenance(t.Context(), active))
+	assert.True(t, withWindow.IsInMaintenance())
+	assert.Contains(t, withWindow.MaintenanceBanner(), "rotating AMIs")
+
+	dbDistro, err := FindOneId(t.Context(), withWindow.Id)
+	require.NoError(t, err)
+	require.NotNil(t, dbDistro)
+	require.NotNil(t, dbDistro.ActiveMaintenance)
+	assert.True(t, dbDistro.ActiveMaintenance.End.Equal(now.Add(time.Hour)))
+
+	require.NoError(t, withWindow.SetActiveMaintenance(t.Context(), nil))
+	assert.False(t, withWindow.IsInMaintenance())
+	assert.Empty(t, withWindow.MaintenanceBanner())
+
+	dbDistro, err = FindOneId(t.Context(), withWindow.Id)
+	require.NoError(t, err)
+	require.NotNil(t, dbDistro)
+	assert.Nil(t, dbDistro.ActiveMaintenance)
+}
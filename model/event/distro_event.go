@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/evergreen-ci/evergreen"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
 )
@@ -15,6 +16,8 @@ func init() {
 	registry.setUnexpirable(ResourceTypeDistro, EventDistroModified)
 	registry.setUnexpirable(ResourceTypeDistro, EventDistroAMIModfied)
 	registry.setUnexpirable(ResourceTypeDistro, EventDistroRemoved)
+	registry.setUnexpirable(ResourceTypeDistro, EventDistroMaintenanceStarted)
+	registry.setUnexpirable(ResourceTypeDistro, EventDistroMaintenanceEnded)
 }
 
 const (
@@ -26,6 +29,9 @@ const (
 	EventDistroModified   = "DISTRO_MODIFIED"
 	EventDistroAMIModfied = "DISTRO_AMI_MODIFIED"
 	EventDistroRemoved    = "DISTRO_REMOVED"
+
+	EventDistroMaintenanceStarted = "DISTRO_MAINTENANCE_STARTED"
+	EventDistroMaintenanceEnded   = "DISTRO_MAINTENANCE_ENDED"
 )
 
 // DistroEventData implements EventData.
@@ -35,6 +41,11 @@ type DistroEventData struct {
 	Before   any    `bson:"before" json:"before"`
 	After    any    `bson:"after" json:"after"`
 
+	// Fields describing a maintenance window
+	MaintenanceStart  time.Time `bson:"maintenance_start,omitempty" json:"maintenance_start,omitempty"`
+	MaintenanceEnd    time.Time `bson:"maintenance_end,omitempty" json:"maintenance_end,omitempty"`
+	MaintenanceReason string    `bson:"maintenance_reason,omitempty" json:"maintenance_reason,omitempty"`
+
 	// Fields used by legacy UI
 	Data   any    `bson:"dstr,omitempty" json:"dstr,omitempty"`
 	UserId string `bson:"u_id,omitempty" json:"u_id,omitempty"`
@@ -94,3 +105,25 @@ func LogDistroRemoved(ctx context.Context, distroId, userId string, data any) {
 func LogDistroAMIModified(ctx context.Context, distroId, userId string) {
 	LogDistroEvent(ctx, distroId, EventDistroAMIModfied, DistroEventData{UserId: userId})
 }
+
+// LogDistroMaintenanceStarted logs when a distro enters a scheduled maintenance
+// window.
+func LogDistroMaintenanceStarted(ctx context.Context, distroId string, start, end time.Time, reason string) {
+	LogDistroEvent(ctx, distroId, EventDistroMaintenanceStarted, DistroEventData{
+		User:              evergreen.User,
+		MaintenanceStart:  start,
+		MaintenanceEnd:    end,
+		MaintenanceReason: reason,
+	})
+}
+
+// LogDistroMaintenanceEnded logs when a distro leaves a scheduled maintenance
+// window.
+func LogDistroMaintenanceEnded(ctx context.Context, distroId string, start, end time.Time, reason string) {
+	LogDistroEvent(ctx, distroId, EventDistroMaintenanceEnded, DistroEventData{
+		User:              evergreen.User,
+		MaintenanceStart:  start,
+		MaintenanceEnd:    end,
+		MaintenanceReason: reason,
+	})
+}
@@ -12,6 +12,7 @@ func init() {
 	registry.AddType(ResourceTypePatch, patchEventDataFactory)
 	registry.AllowSubscription(ResourceTypePatch, PatchStateChange)
 	registry.AllowSubscription(ResourceTypePatch, PatchChildrenCompletion)
+	registry.AllowSubscription(ResourceTypePatch, PatchMergeQueueBisected)
 }
 
 func patchEventDataFactory() any {
@@ -23,6 +24,7 @@ const (
 
 	PatchStateChange        = "STATE_CHANGE"
 	PatchChildrenCompletion = "CHILDREN_FINISHED"
+	PatchMergeQueueBisected = "MERGE_QUEUE_BISECTED"
 )
 
 type PatchEventData struct {
@@ -70,3 +72,23 @@ func LogPatchChildrenCompletionEvent(ctx context.Context, id, status, author str
 		}))
 	}
 }
+
+// LogPatchMergeQueueBisectedEvent logs that the bisection of a failed GitHub
+// merge queue batch has finished.
+func LogPatchMergeQueueBisectedEvent(ctx context.Context, id string) {
+	event := EventLogEntry{
+		Timestamp:    time.Now().Truncate(0).Round(time.Millisecond),
+		ResourceId:   id,
+		ResourceType: ResourceTypePatch,
+		EventType:    PatchMergeQueueBisected,
+		Data:         &PatchEventData{},
+	}
+
+	if err := event.Log(ctx); err != nil {
+		grip.Error(message.WrapError(err, message.Fields{
+			"resource_type": ResourceTypePatch,
+			"message":       "error logging event",
+			"source":        "event-log-fail",
+		}))
+	}
+}
@@ -1,7 +1,9 @@
 package event
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"net/url"
 
 	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/utility"
@@ -18,6 +20,8 @@ const (
 	EvergreenWebhookSubscriberType  = "evergreen-webhook"
 	EmailSubscriberType             = "email"
 	SlackSubscriberType             = "slack"
+	TeamsSubscriberType             = "teams"
+	PagerDutySubscriberType         = "pagerduty"
 	SubscriberTypeNone              = "none"
 	RunChildPatchSubscriberType     = "run-child-patch"
 
@@ -35,9 +39,15 @@ var SubscriberTypes = []string{
 	EvergreenWebhookSubscriberType,
 	EmailSubscriberType,
 	SlackSubscriberType,
+	TeamsSubscriberType,
+	PagerDutySubscriberType,
 	RunChildPatchSubscriberType,
 }
 
+// DefaultPagerDutyEventsURL is the URL of the PagerDuty Events API v2, which
+// PagerDuty subscribers send their events to unless they set their own URL.
+const DefaultPagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
 type Subscriber struct {
 	Type string `bson:"type"`
 	// sad violin
@@ -73,6 +83,10 @@ func (s *Subscriber) SetBSON(raw mgobson.Raw) error {
 		s.Target = &WebhookSubscriber{}
 	case JIRAIssueSubscriberType:
 		s.Target = &JIRAIssueSubscriber{}
+	case TeamsSubscriberType:
+		s.Target = &TeamsSubscriber{}
+	case PagerDutySubscriberType:
+		s.Target = &PagerDutySubscriber{}
 	case JIRACommentSubscriberType, EmailSubscriberType, SlackSubscriberType:
 		str := ""
 		s.Target = &str
@@ -116,6 +130,14 @@ func (s *Subscriber) Validate() error {
 		catcher.Add(v.validate())
 	case *WebhookSubscriber:
 		catcher.Add(v.validate())
+	case TeamsSubscriber:
+		catcher.Add(v.validate())
+	case *TeamsSubscriber:
+		catcher.Add(v.validate())
+	case PagerDutySubscriber:
+		catcher.Add(v.validate())
+	case *PagerDutySubscriber:
+		catcher.Add(v.validate())
 	}
 
 	return catcher.Resolve()
@@ -174,6 +196,74 @@ func (s *WebhookSubscriber) GetHeader(key string) string {
 	return ""
 }
 
+// TeamsSubscriber posts notifications to a chat channel's incoming webhook,
+// such as a Microsoft Teams channel.
+type TeamsSubscriber struct {
+	URL string `bson:"url"`
+}
+
+func (s *TeamsSubscriber) String() string {
+	if len(s.URL) == 0 {
+		return "NIL_URL"
+	}
+	return s.URL
+}
+
+func (s *TeamsSubscriber) validate() error {
+	return validateHTTPSURL(s.URL)
+}
+
+// PagerDutySubscriber sends notifications as incidents to an incident paging
+// service that implements the PagerDuty Events API v2. Failures trigger an
+// incident and successes resolve it.
+type PagerDutySubscriber struct {
+	// RoutingKey is the integration key of the service to page.
+	RoutingKey string `bson:"routing_key"`
+	// URL is the events API endpoint. If it's not set, it defaults to
+	// DefaultPagerDutyEventsURL.
+	URL string `bson:"url,omitempty"`
+}
+
+// String returns a hash of the routing key so that the key itself is not
+// exposed in notification IDs or logs.
+func (s *PagerDutySubscriber) String() string {
+	if len(s.RoutingKey) == 0 {
+		return "NIL_ROUTING_KEY"
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(s.URL+s.RoutingKey)))[:16]
+}
+
+// EventsURL returns the URL to send events to.
+func (s *PagerDutySubscriber) EventsURL() string {
+	if s.URL == "" {
+		return DefaultPagerDutyEventsURL
+	}
+	return s.URL
+}
+
+func (s *PagerDutySubscriber) validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(s.RoutingKey == "", "routing key cannot be empty")
+	if s.URL != "" {
+		catcher.Add(validateHTTPSURL(s.URL))
+	}
+	return catcher.Resolve()
+}
+
+func validateHTTPSURL(rawURL string) error {
+	if rawURL == "" {
+		return errors.New("url cannot be empty")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrapf(err, "parsing url '%s'", rawURL)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return errors.Errorf("url '%s' must be an absolute HTTPS URL", rawURL)
+	}
+	return nil
+}
+
 type JIRAIssueSubscriber struct {
 	Project   string `bson:"project"`
 	IssueType string `bson:"issue_type"`
@@ -116,6 +116,21 @@ func TestSubscribersStringerWithMissingAttributes(t *testing.T) {
 	webhookSub := WebhookSubscriber{}
 
 	assert.True(strings.HasSuffix(webhookSub.String(), "NIL_URL"))
+
+	teamsSub := TeamsSubscriber{}
+	assert.True(strings.HasSuffix(teamsSub.String(), "NIL_URL"))
+}
+
+func TestPagerDutySubscriber(t *testing.T) {
+	sub := PagerDutySubscriber{RoutingKey: "secret-routing-key"}
+	assert.NotContains(t, sub.String(), "secret-routing-key", "routing key should not be exposed")
+	assert.Equal(t, DefaultPagerDutyEventsURL, sub.EventsURL())
+
+	other := PagerDutySubscriber{RoutingKey: "other-routing-key"}
+	assert.NotEqual(t, sub.String(), other.String())
+
+	sub.URL = "https://events.example.com/v2/enqueue"
+	assert.Equal(t, "https://events.example.com/v2/enqueue", sub.EventsURL())
 }
 
 func TestValidate(t *testing.T) {
@@ -161,6 +176,41 @@ func TestValidate(t *testing.T) {
 			},
 			errorExpected: false,
 		},
+		"TeamsMissingURL": {
+			s: Subscriber{
+				Type:   TeamsSubscriberType,
+				Target: TeamsSubscriber{},
+			},
+			errorExpected: true,
+		},
+		"TeamsInsecureURL": {
+			s: Subscriber{
+				Type:   TeamsSubscriberType,
+				Target: TeamsSubscriber{URL: "http://example.webhook.office.com/webhookb2/abc"},
+			},
+			errorExpected: true,
+		},
+		"ValidTeams": {
+			s: Subscriber{
+				Type:   TeamsSubscriberType,
+				Target: TeamsSubscriber{URL: "https://example.webhook.office.com/webhookb2/abc"},
+			},
+			errorExpected: false,
+		},
+		"PagerDutyMissingRoutingKey": {
+			s: Subscriber{
+				Type:   PagerDutySubscriberType,
+				Target: &PagerDutySubscriber{},
+			},
+			errorExpected: true,
+		},
+		"ValidPagerDuty": {
+			s: Subscriber{
+				Type:   PagerDutySubscriberType,
+				Target: &PagerDutySubscriber{RoutingKey: "key"},
+			},
+			errorExpected: false,
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			if testCase.errorExpected {
@@ -35,6 +35,7 @@ var (
 	subscriptionOwnerTypeKey      = bsonutil.MustHaveTag(Subscription{}, "OwnerType")
 	subscriptionTriggerDataKey    = bsonutil.MustHaveTag(Subscription{}, "TriggerData")
 	subscriptionLastUpdatedKey    = bsonutil.MustHaveTag(Subscription{}, "LastUpdated")
+	subscriptionDigestKey         = bsonutil.MustHaveTag(Subscription{}, "Digest")
 
 	filterObjectKey       = bsonutil.MustHaveTag(Filter{}, "Object")
 	filterIDKey           = bsonutil.MustHaveTag(Filter{}, "ID")
@@ -91,8 +92,49 @@ const (
 	TriggerTaskFirstFailureInVersion = "first-failure-in-version"
 	TriggerTaskStarted               = "task-started"
 	TriggerSpawnHostIdle             = "spawn-host-idle"
+	// TriggerMergeQueueBisected indicates that the bisection of a failed
+	// GitHub merge queue batch has finished.
+	TriggerMergeQueueBisected = "merge-queue-bisected"
 )
 
+// Digest frequencies control whether a subscription's notifications are sent
+// as they happen or collected and sent together in a single summary.
+const (
+	// DigestDefault uses the digest frequency of the subscription's owner.
+	DigestDefault = ""
+	// DigestImmediate sends each notification as it happens.
+	DigestImmediate = "immediate"
+	// DigestHourly sends the notifications from the last hour together.
+	DigestHourly = "hourly"
+	// DigestDaily sends the notifications from the last day together.
+	DigestDaily = "daily"
+)
+
+// IsValidDigest returns whether the digest frequency is valid.
+func IsValidDigest(digest string) bool {
+	return utility.StringSliceContains([]string{DigestDefault, DigestImmediate, DigestHourly, DigestDaily}, digest)
+}
+
+// DigestWindow returns how long notifications are collected for before a
+// digest with the given frequency is sent, or zero if the notifications are
+// sent immediately.
+func DigestWindow(digest string) time.Duration {
+	switch digest {
+	case DigestHourly:
+		return time.Hour
+	case DigestDaily:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// SupportsDigest returns whether notifications to the subscriber type can be
+// collected into a digest.
+func SupportsDigest(subscriberType string) bool {
+	return subscriberType == EmailSubscriberType || subscriberType == SlackSubscriberType
+}
+
 type Subscription struct {
 	ID             string            `bson:"_id"`
 	ResourceType   string            `bson:"type"`
@@ -105,6 +147,9 @@ type Subscription struct {
 	Owner          string            `bson:"owner"`
 	TriggerData    map[string]string `bson:"trigger_data,omitempty"`
 	LastUpdated    time.Time         `bson:"last_updated,omitempty"`
+	// Digest is how often the subscription's notifications are sent. If it's
+	// not set, it defaults to the digest frequency of the owner.
+	Digest string `bson:"digest,omitempty"`
 }
 
 type unmarshalSubscription struct {
@@ -118,6 +163,7 @@ type unmarshalSubscription struct {
 	OwnerType      OwnerType         `bson:"owner_type"`
 	Owner          string            `bson:"owner"`
 	TriggerData    map[string]string `bson:"trigger_data,omitempty"`
+	Digest         string            `bson:"digest,omitempty"`
 }
 
 func (d *Subscription) UnmarshalBSON(in []byte) error {
@@ -141,6 +187,7 @@ func (s *Subscription) SetBSON(raw mgobson.Raw) error {
 	s.Owner = temp.Owner
 	s.OwnerType = temp.OwnerType
 	s.TriggerData = temp.TriggerData
+	s.Digest = temp.Digest
 
 	return nil
 }
@@ -485,6 +532,9 @@ func (s *Subscription) Upsert(ctx context.Context) error {
 	if !utility.IsZeroTime(s.LastUpdated) {
 		update[subscriptionLastUpdatedKey] = s.LastUpdated
 	}
+	if s.Digest != DigestDefault {
+		update[subscriptionDigestKey] = s.Digest
+	}
 
 	// note: this prevents changing the owner of an existing subscription, which is desired
 	c, err := db.ReplaceContext(ctx, SubscriptionsCollection, bson.M{
@@ -589,6 +639,12 @@ func (s *Subscription) Validate() error {
 		catcher.New("JIRA comment/issue subscription not allowed for all tasks in the project")
 	}
 
+	if !IsValidDigest(s.Digest) {
+		catcher.Errorf("'%s' is not a valid digest frequency", s.Digest)
+	} else if DigestWindow(s.Digest) > 0 && !SupportsDigest(s.Subscriber.Type) {
+		catcher.Errorf("subscriber type '%s' does not support digests", s.Subscriber.Type)
+	}
+
 	catcher.Add(s.ValidateSelectors())
 	catcher.Add(s.runCustomValidation())
 	catcher.Add(s.Subscriber.Validate())
@@ -818,6 +874,14 @@ func NewExpiringPatchSuccessSubscription(id string, sub Subscriber) Subscription
 	return subscription
 }
 
+// NewExpiringPatchMergeQueueBisectedSubscription returns a subscription to
+// the result of bisecting the GitHub merge queue batch of the given patch.
+func NewExpiringPatchMergeQueueBisectedSubscription(id string, sub Subscriber) Subscription {
+	subscription := NewSubscriptionByID(ResourceTypePatch, TriggerMergeQueueBisected, id, sub)
+	subscription.LastUpdated = time.Now()
+	return subscription
+}
+
 func NewParentPatchSubscription(id string, sub Subscriber) Subscription {
 	subscription := NewSubscriptionByID(ResourceTypePatch, TriggerOutcome, id, sub)
 	subscription.LastUpdated = time.Now()
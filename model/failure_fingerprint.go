@@ -0,0 +1,389 @@
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/annotations"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/mongodb/grip/level"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const FailureFingerprintsCollection = "failure_fingerprints"
+
+const (
+	// fingerprintLogTailLines is the number of lines read from the end of a
+	// failed task's task log when fingerprinting it.
+	fingerprintLogTailLines = 500
+	// maxFingerprintLogLines is the maximum number of distinct log lines that
+	// are stored with a fingerprint.
+	maxFingerprintLogLines = 20
+	// minFingerprintLogSimilarity is the minimum fraction of distinct log lines
+	// that two failures without failed tests must share to match.
+	minFingerprintLogSimilarity = 0.5
+)
+
+// FailureFingerprint identifies a task execution's failure. Task executions in
+// the same project that failed the same command with the same failed tests
+// share the same fingerprint, regardless of incidental differences such as
+// timestamps, paths and IDs.
+type FailureFingerprint struct {
+	Id        string `bson:"_id" json:"id"`
+	TaskId    string `bson:"task_id" json:"task_id"`
+	Execution int    `bson:"execution" json:"execution"`
+	Project   string `bson:"project" json:"project"`
+	// Fingerprint is a hash of the normalized failing command and failed
+	// tests.
+	Fingerprint string `bson:"fingerprint" json:"fingerprint"`
+	// FailedTests are the normalized names of the failed tests.
+	FailedTests []string `bson:"failed_tests,omitempty" json:"failed_tests,omitempty"`
+	// LogLines are the distinct normalized lines from the end of the task log
+	// that describe the failure. They distinguish failures of the same
+	// command when there are no failed tests to do so.
+	LogLines   []string  `bson:"log_lines,omitempty" json:"log_lines,omitempty"`
+	CreateTime time.Time `bson:"create_time" json:"create_time"`
+}
+
+var (
+	FailureFingerprintTaskIdKey      = bsonutil.MustHaveTag(FailureFingerprint{}, "TaskId")
+	FailureFingerprintExecutionKey   = bsonutil.MustHaveTag(FailureFingerprint{}, "Execution")
+	FailureFingerprintProjectKey     = bsonutil.MustHaveTag(FailureFingerprint{}, "Project")
+	FailureFingerprintFingerprintKey = bsonutil.MustHaveTag(FailureFingerprint{}, "Fingerprint")
+	FailureFingerprintCreateTimeKey  = bsonutil.MustHaveTag(FailureFingerprint{}, "CreateTime")
+)
+
+// failureNormalizers replace the parts of failure text that vary between
+// otherwise identical failures. They are applied in order, so more specific
+// patterns must come before more general ones.
+var failureNormalizers = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+	// match, if set, must return true for a match to be replaced.
+	match func(string) bool
+}{
+	{
+		pattern:     regexp.MustCompile(`\d{4}[-/]\d{2}[-/]\d{2}(?:[T ]\d{2}:\d{2}:\d{2}(?:[.,]\d+)?(?:Z|[+-]\d{2}:?\d{2})?)?`),
+		replacement: "<timestamp>",
+	},
+	{
+		pattern:     regexp.MustCompile(`\b\d{1,2}:\d{2}:\d{2}(?:[.,]\d+)?\b`),
+		replacement: "<timestamp>",
+	},
+	{
+		// Only the directories of absolute paths are replaced so that
+		// failures in different files remain distinguishable.
+		pattern:     regexp.MustCompile(`(?:\b[A-Za-z]:|\B)(?:[\\/][\w.\-]+)+[\\/]`),
+		replacement: "<path>/",
+	},
+	{
+		pattern:     regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`),
+		replacement: "<id>",
+	},
+	{
+		pattern:     regexp.MustCompile(`(?i)\b0x[0-9a-f]+\b`),
+		replacement: "<hex>",
+	},
+	{
+		// Long hex strings, such as commit hashes and object IDs, must contain
+		// both letters and digits so that ordinary words and numbers are left
+		// alone.
+		pattern:     regexp.MustCompile(`(?i)\b[0-9a-f]{7,}\b`),
+		replacement: "<hex>",
+		match: func(s string) bool {
+			return strings.ContainsAny(s, "0123456789") && strings.ContainsAny(strings.ToLower(s), "abcdef")
+		},
+	},
+	{
+		pattern:     regexp.MustCompile(`\b\d+(?:\.\d+)?\b`),
+		replacement: "<num>",
+	},
+	{
+		pattern:     regexp.MustCompile(`\s+`),
+		replacement: " ",
+	},
+}
+
+// NormalizeFailureText removes the parts of the failure text that vary between
+// occurrences of the same failure, such as timestamps, paths, hex IDs and
+// numbers.
+func NormalizeFailureText(text string) string {
+	for _, n := range failureNormalizers {
+		if n.match == nil {
+			text = n.pattern.ReplaceAllString(text, n.replacement)
+			continue
+		}
+		text = n.pattern.ReplaceAllStringFunc(text, func(s string) string {
+			if n.match(s) {
+				return n.replacement
+			}
+			return s
+		})
+	}
+	return strings.TrimSpace(text)
+}
+
+// failureFingerprintText returns the normalized text identifying the task's
+// failure, which consists of its failing command and its failed tests, along
+// with the normalized names of the failed tests. It returns an empty string if
+// the task did not fail.
+func failureFingerprintText(t *task.Task) (string, []string) {
+	if !evergreen.IsFailedTaskStatus(t.Status) {
+		return "", nil
+	}
+
+	var failedTests []string
+	for _, tr := range t.LocalTestResults {
+		if tr.Status != evergreen.TestFailedStatus {
+			continue
+		}
+		name := tr.DisplayTestName
+		if name == "" {
+			name = tr.TestName
+		}
+		failedTests = append(failedTests, NormalizeFailureText(name))
+	}
+	failedTests = utility.UniqueStrings(failedTests)
+	sort.Strings(failedTests)
+
+	parts := []string{"command: " + NormalizeFailureText(t.Details.FailingCommand)}
+	for _, name := range failedTests {
+		parts = append(parts, "test: "+name)
+	}
+
+	return strings.Join(parts, "\n"), failedTests
+}
+
+// normalizeFingerprintLogLines returns the distinct normalized log lines.
+func normalizeFingerprintLogLines(logLines []string) []string {
+	var normalized []string
+	seen := map[string]bool{}
+	for _, line := range logLines {
+		line = NormalizeFailureText(line)
+		if line == "" || seen[line] {
+			continue
+		}
+		seen[line] = true
+		normalized = append(normalized, line)
+	}
+	return normalized
+}
+
+// fingerprintLogLines returns the lines from the end of the task's task log
+// that best describe its failure. Error lines are preferred; if there are
+// none, the last lines of the log are used instead.
+func fingerprintLogLines(ctx context.Context, t *task.Task) ([]string, error) {
+	it, err := t.GetTaskLogs(ctx, taskoutput.TaskLogGetOptions{
+		LogType: taskoutput.TaskLogTypeTask,
+		TailN:   fingerprintLogTailLines,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting task logs")
+	}
+	defer it.Close()
+
+	var errorLines, allLines []string
+	for it.Next() {
+		item := it.Item()
+		allLines = append(allLines, item.Data)
+		if item.Priority >= level.Error {
+			errorLines = append(errorLines, item.Data)
+		}
+	}
+	if err = it.Err(); err != nil {
+		return nil, errors.Wrap(err, "iterating task logs")
+	}
+
+	lines := errorLines
+	if len(lines) == 0 {
+		lines = allLines
+	}
+	if len(lines) > maxFingerprintLogLines {
+		lines = lines[len(lines)-maxFingerprintLogLines:]
+	}
+	return lines, nil
+}
+
+// ComputeFailureFingerprint returns the fingerprint of the task's failure,
+// without its task execution or project. It returns nil if the task did not
+// fail. The task's test results must already be populated.
+func ComputeFailureFingerprint(ctx context.Context, t *task.Task) (*FailureFingerprint, error) {
+	if !evergreen.IsFailedTaskStatus(t.Status) || t.DisplayOnly {
+		return nil, nil
+	}
+
+	text, failedTests := failureFingerprintText(t)
+	if text == "" {
+		return nil, nil
+	}
+	fp := &FailureFingerprint{FailedTests: failedTests}
+	sum := sha256.Sum256([]byte(text))
+	fp.Fingerprint = hex.EncodeToString(sum[:])
+
+	logLines, err := fingerprintLogLines(ctx, t)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting log lines for task '%s'", t.Id)
+	}
+	fp.LogLines = normalizeFingerprintLogLines(logLines)
+
+	return fp, nil
+}
+
+// Matches returns whether the other fingerprint is for the same failure. The
+// failing command and failed tests must be the same. If there are no failed
+// tests, the failing command alone doesn't identify the failure, so the
+// failures' log lines must also be similar.
+func (fp *FailureFingerprint) Matches(other FailureFingerprint) bool {
+	if fp.Fingerprint != other.Fingerprint {
+		return false
+	}
+	if len(fp.FailedTests) > 0 {
+		return true
+	}
+	return logLineSimilarity(fp.LogLines, other.LogLines) >= minFingerprintLogSimilarity
+}
+
+// logLineSimilarity returns the fraction of the distinct log lines in either
+// set that are in both sets. Two empty sets are identical.
+func logLineSimilarity(a, b []string) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	inA := map[string]bool{}
+	for _, line := range a {
+		inA[line] = true
+	}
+	union := len(inA)
+	shared := 0
+	counted := map[string]bool{}
+	for _, line := range b {
+		if counted[line] {
+			continue
+		}
+		counted[line] = true
+		if inA[line] {
+			shared++
+		} else {
+			union++
+		}
+	}
+	return float64(shared) / float64(union)
+}
+
+// UpsertFailureFingerprint computes the fingerprint of the task's failure and
+// stores it so that later failures can be matched against it. If the task
+// execution was already fingerprinted, the existing fingerprint is returned.
+// It returns nil if the task did not fail.
+func UpsertFailureFingerprint(ctx context.Context, t *task.Task) (*FailureFingerprint, error) {
+	taskId := t.Id
+	if t.Archived {
+		taskId = t.OldTaskId
+	}
+	existing, err := FindFailureFingerprint(ctx, taskId, t.Execution)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding existing failure fingerprint")
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	if err = t.PopulateTestResults(ctx); err != nil {
+		return nil, errors.Wrap(err, "populating test results")
+	}
+	fp, err := ComputeFailureFingerprint(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	if fp == nil {
+		return nil, nil
+	}
+
+	fp.Id = failureFingerprintId(taskId, t.Execution)
+	fp.TaskId = taskId
+	fp.Execution = t.Execution
+	fp.Project = t.Project
+	fp.CreateTime = time.Now()
+	if _, err = db.ReplaceContext(ctx, FailureFingerprintsCollection, bson.M{"_id": fp.Id}, fp); err != nil {
+		return nil, errors.Wrapf(err, "upserting failure fingerprint for task '%s' execution %d", taskId, t.Execution)
+	}
+	return fp, nil
+}
+
+func failureFingerprintId(taskId string, execution int) string {
+	return fmt.Sprintf("%s_%d", taskId, execution)
+}
+
+// FindFailureFingerprint returns the failure fingerprint for the task
+// execution, if it exists.
+func FindFailureFingerprint(ctx context.Context, taskId string, execution int) (*FailureFingerprint, error) {
+	fp := &FailureFingerprint{}
+	err := db.FindOneQContext(
+		ctx,
+		FailureFingerprintsCollection,
+		db.Query(bson.M{"_id": failureFingerprintId(taskId, execution)}),
+		fp,
+	)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	return fp, err
+}
+
+// FindAnnotatedFailureFingerprints returns the most recent task executions in
+// the project that failed with the given fingerprint and whose annotations
+// link issues or suspected issues. Failures without failed tests should also
+// be checked with Matches.
+func FindAnnotatedFailureFingerprints(ctx context.Context, project, fingerprint string, limit int) ([]FailureFingerprint, error) {
+	const annotationsField = "annotations"
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			FailureFingerprintProjectKey:     project,
+			FailureFingerprintFingerprintKey: fingerprint,
+		}},
+		{"$sort": bson.M{FailureFingerprintCreateTimeKey: -1}},
+		// Filter out failures that haven't been triaged before limiting so
+		// that many recent un-triaged failures don't hide older triaged ones.
+		{"$lookup": bson.M{
+			"from": annotations.Collection,
+			"let": bson.M{
+				"task_id":   "$" + FailureFingerprintTaskIdKey,
+				"execution": "$" + FailureFingerprintExecutionKey,
+			},
+			"pipeline": []bson.M{
+				{"$match": bson.M{
+					"$expr": bson.M{"$and": []bson.M{
+						{"$eq": bson.A{"$" + annotations.TaskIdKey, "$$task_id"}},
+						{"$eq": bson.A{"$" + annotations.TaskExecutionKey, "$$execution"}},
+					}},
+					"$or": []bson.M{
+						{bsonutil.GetDottedKeyName(annotations.IssuesKey, "0"): bson.M{"$exists": true}},
+						{bsonutil.GetDottedKeyName(annotations.SuspectedIssuesKey, "0"): bson.M{"$exists": true}},
+					},
+				}},
+				{"$project": bson.M{"_id": 1}},
+			},
+			"as": annotationsField,
+		}},
+		{"$match": bson.M{bsonutil.GetDottedKeyName(annotationsField, "0"): bson.M{"$exists": true}}},
+		{"$limit": limit},
+		{"$project": bson.M{annotationsField: 0}},
+	}
+	fps := []FailureFingerprint{}
+	if err := db.Aggregate(ctx, FailureFingerprintsCollection, pipeline, &fps); err != nil {
+		return nil, errors.Wrapf(err, "finding annotated failures with fingerprint '%s' in project '%s'", fingerprint, project)
+	}
+	return fps, nil
+}
@@ -0,0 +1,213 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/annotations"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestNormalizeFailureText(t *testing.T) {
+	for tName, tCase := range map[string]struct {
+		text     string
+		expected string
+	}{
+		"Timestamps": {
+			text:     "[2024/03/05 12:01:02.123] started at 2024-03-05T12:01:02Z, finished at 12:03:04",
+			expected: "[<timestamp>] started at <timestamp>, finished at <timestamp>",
+		},
+		"AbsolutePaths": {
+			text:     "error in /data/mci/abc123/src/foo_test.go and C:\\data\\mci\\bar.go",
+			expected: "error in <path>/foo_test.go and <path>/bar.go",
+		},
+		"RelativePathsAreUnchanged": {
+			text:     "error in src/foo_test.go",
+			expected: "error in src/foo_test.go",
+		},
+		"HexIDs": {
+			text:     "object 507f1f77bcf86cd799439011 at 0xc000123abc with id 123e4567-e89b-12d3-a456-426614174000",
+			expected: "object <hex> at <hex> with id <id>",
+		},
+		"HexLikeWordsAreUnchanged": {
+			text:     "the facade was defaced",
+			expected: "the facade was defaced",
+		},
+		"Numbers": {
+			text:     "connection to 10.2.3.4:27017 refused (pid 1234)",
+			expected: "connection to <num>.<num>:<num> refused (pid <num>)",
+		},
+		"Whitespace": {
+			text:     "  too \t many\n spaces  ",
+			expected: "too many spaces",
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			assert.Equal(t, tCase.expected, NormalizeFailureText(tCase.text))
+		})
+	}
+}
+
+func TestFailureFingerprintText(t *testing.T) {
+	failedTask := func(description string, tests ...string) *task.Task {
+		tsk := &task.Task{
+			Status: evergreen.TaskFailed,
+			Details: apimodels.TaskEndDetail{
+				FailingCommand: "'shell.exec' in function 'run tests' (step 3 of 5)",
+				Description:    description,
+			},
+		}
+		for _, name := range tests {
+			tsk.LocalTestResults = append(tsk.LocalTestResults, testresult.TestResult{TestName: name, Status: evergreen.TestFailedStatus})
+		}
+		tsk.LocalTestResults = append(tsk.LocalTestResults, testresult.TestResult{TestName: "passing_test", Status: evergreen.TestSucceededStatus})
+		return tsk
+	}
+
+	text, failedTests := failureFingerprintText(failedTask("command failed after 12.5 seconds", "TestB", "TestA"))
+	assert.Equal(t, `command: 'shell.exec' in function 'run tests' (step <num> of <num>)
+test: TestA
+test: TestB`, text)
+	assert.Equal(t, []string{"TestA", "TestB"}, failedTests)
+
+	sameText, _ := failureFingerprintText(failedTask("command failed after 3 minutes", "TestA", "TestB"))
+	assert.Equal(t, text, sameText, "same command and failed tests should have the same text")
+	differentText, _ := failureFingerprintText(failedTask("command failed after 12.5 seconds", "TestC"))
+	assert.NotEqual(t, text, differentText, "different failed tests should have different text")
+
+	succeeded := failedTask("")
+	succeeded.Status = evergreen.TaskSucceeded
+	text, _ = failureFingerprintText(succeeded)
+	assert.Empty(t, text)
+}
+
+func TestNormalizeFingerprintLogLines(t *testing.T) {
+	assert.Equal(t, []string{
+		"[<timestamp>] process <num> exited with code <num>",
+		"[<timestamp>] assertion failed in <path>/foo_test.go:<num>",
+	}, normalizeFingerprintLogLines([]string{
+		"[2024/03/05 12:01:02.123] process 1234 exited with code 1",
+		"[2024/03/05 12:01:02.124] process 1234 exited with code 1",
+		"",
+		"[2024/03/05 12:01:03.000] assertion failed in /data/mci/abc123/src/foo_test.go:45",
+	}))
+}
+
+func TestFailureFingerprintMatches(t *testing.T) {
+	withTests := FailureFingerprint{Fingerprint: "abc", FailedTests: []string{"TestA"}, LogLines: []string{"a", "b"}}
+	assert.True(t, withTests.Matches(FailureFingerprint{Fingerprint: "abc", FailedTests: []string{"TestA"}, LogLines: []string{"c"}}), "failures with the same failed tests should match regardless of logs")
+	assert.False(t, withTests.Matches(FailureFingerprint{Fingerprint: "def", FailedTests: []string{"TestB"}, LogLines: []string{"a", "b"}}))
+
+	withoutTests := FailureFingerprint{Fingerprint: "abc", LogLines: []string{"a", "b", "c"}}
+	assert.True(t, withoutTests.Matches(FailureFingerprint{Fingerprint: "abc", LogLines: []string{"a", "b", "c", "d"}}), "similar logs should match")
+	assert.False(t, withoutTests.Matches(FailureFingerprint{Fingerprint: "abc", LogLines: []string{"c", "d", "e"}}), "dissimilar logs should not match")
+	assert.False(t, withoutTests.Matches(FailureFingerprint{Fingerprint: "def", LogLines: []string{"a", "b", "c"}}), "different commands should not match")
+	assert.True(t, (&FailureFingerprint{Fingerprint: "abc"}).Matches(FailureFingerprint{Fingerprint: "abc"}))
+}
+
+func TestFingerprintSuggest(t *testing.T) {
+	require.NoError(t, db.ClearCollections(FailureFingerprintsCollection, annotations.Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(FailureFingerprintsCollection, annotations.Collection))
+	}()
+
+	now := time.Now()
+	for _, fp := range []FailureFingerprint{
+		{TaskId: "current", Execution: 0, Project: "proj", Fingerprint: "abc", LogLines: []string{"error: a", "error: b"}},
+		{TaskId: "recent", Execution: 1, Project: "proj", Fingerprint: "abc", LogLines: []string{"error: a", "error: b"}, CreateTime: now.Add(-time.Hour)},
+		{TaskId: "old", Execution: 0, Project: "proj", Fingerprint: "abc", LogLines: []string{"error: a", "error: b", "error: c"}, CreateTime: now.Add(-24 * time.Hour)},
+		{TaskId: "different_failure", Execution: 0, Project: "proj", Fingerprint: "def", LogLines: []string{"error: a", "error: b"}, CreateTime: now},
+		{TaskId: "different_logs", Execution: 0, Project: "proj", Fingerprint: "abc", LogLines: []string{"error: c", "error: d"}, CreateTime: now},
+		{TaskId: "other_project", Execution: 0, Project: "other", Fingerprint: "abc", LogLines: []string{"error: a", "error: b"}, CreateTime: now},
+	} {
+		fp.Id = failureFingerprintId(fp.TaskId, fp.Execution)
+		_, err := db.ReplaceContext(t.Context(), FailureFingerprintsCollection, bson.M{"_id": fp.Id}, fp)
+		require.NoError(t, err)
+	}
+	for _, a := range []annotations.TaskAnnotation{
+		{
+			Id:              "recent_1",
+			TaskId:          "recent",
+			TaskExecution:   1,
+			Note:            &annotations.Note{Message: "flaky network"},
+			Issues:          []annotations.IssueLink{{IssueKey: "EVG-2", URL: "https://jira/EVG-2"}},
+			SuspectedIssues: []annotations.IssueLink{{IssueKey: "EVG-1", URL: "https://jira/EVG-1"}},
+		},
+		{
+			Id:            "recent_0",
+			TaskId:        "recent",
+			TaskExecution: 0,
+			Issues:        []annotations.IssueLink{{IssueKey: "EVG-3", URL: "https://jira/EVG-3"}},
+		},
+		{
+			Id:            "old_0",
+			TaskId:        "old",
+			TaskExecution: 0,
+			Issues:        []annotations.IssueLink{{IssueKey: "EVG-1", URL: "https://jira/EVG-1"}, {URL: "https://tracker/123"}},
+		},
+		{
+			Id:            "different_failure_0",
+			TaskId:        "different_failure",
+			TaskExecution: 0,
+			Issues:        []annotations.IssueLink{{IssueKey: "EVG-4", URL: "https://jira/EVG-4"}},
+		},
+		{
+			Id:            "different_logs_0",
+			TaskId:        "different_logs",
+			TaskExecution: 0,
+			Issues:        []annotations.IssueLink{{IssueKey: "EVG-6", URL: "https://jira/EVG-6"}},
+		},
+		{
+			Id:            "other_project_0",
+			TaskId:        "other_project",
+			TaskExecution: 0,
+			Issues:        []annotations.IssueLink{{IssueKey: "EVG-5", URL: "https://jira/EVG-5"}},
+		},
+	} {
+		require.NoError(t, db.Insert(t.Context(), annotations.Collection, a))
+	}
+
+	tickets, err := (&FingerprintSuggest{}).Suggest(t.Context(), &task.Task{Id: "current", Project: "proj", Status: evergreen.TaskFailed})
+	require.NoError(t, err)
+	require.Len(t, tickets, 3)
+	assert.Equal(t, "EVG-2", tickets[0].Key, "issues from more recent failures should come first")
+	assert.Equal(t, "flaky network", tickets[0].Fields.Summary)
+	assert.Equal(t, "EVG-1", tickets[1].Key)
+	assert.Equal(t, "https://tracker/123", tickets[2].Key, "issues without a key should be identified by URL")
+	assert.Equal(t, "https://tracker/123", tickets[2].Fields.Summary)
+
+	t.Run("MultiSourceFallsBackToFingerprints", func(t *testing.T) {
+		mss := &MultiSourceSuggest{FingerprintSuggester: &FingerprintSuggest{}}
+		tickets, source, err := mss.Suggest(t.Context(), &task.Task{Id: "current", Project: "proj", Status: evergreen.TaskFailed})
+		require.NoError(t, err)
+		assert.Equal(t, fingerprintSource, source)
+		assert.Len(t, tickets, 3)
+	})
+	t.Run("IgnoresUntriagedMatches", func(t *testing.T) {
+		for i := 0; i < maxFingerprintMatches; i++ {
+			fp := FailureFingerprint{TaskId: fmt.Sprintf("untriaged_%d", i), Project: "proj", Fingerprint: "abc", LogLines: []string{"error: a", "error: b"}, CreateTime: now.Add(-time.Minute)}
+			fp.Id = failureFingerprintId(fp.TaskId, fp.Execution)
+			_, err := db.ReplaceContext(t.Context(), FailureFingerprintsCollection, bson.M{"_id": fp.Id}, fp)
+			require.NoError(t, err)
+		}
+		noIssues := annotations.TaskAnnotation{
+			Id:     "untriaged_0_0",
+			TaskId: "untriaged_0",
+			Note:   &annotations.Note{Message: "no issues linked"},
+		}
+		require.NoError(t, db.Insert(t.Context(), annotations.Collection, noIssues))
+
+		tickets, err := (&FingerprintSuggest{}).Suggest(t.Context(), &task.Task{Id: "current", Project: "proj", Status: evergreen.TaskFailed})
+		require.NoError(t, err)
+		require.Len(t, tickets, 3, "more recent untriaged failures should not hide older triaged ones")
+		assert.Equal(t, "EVG-2", tickets[0].Key)
+	})
+}
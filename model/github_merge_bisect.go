@@ -0,0 +1,133 @@
+package model
+
+import (
+	"context"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+// updateMergeQueueBisect starts bisecting a GitHub merge queue batch if its
+// patch failed, or records the result of a patch that tests part of a failed
+// batch. The patches for each step of the bisection are created
+// asynchronously.
+func updateMergeQueueBisect(ctx context.Context, p *patch.Patch, status string) error {
+	if p.MergeQueueBisectStep != nil {
+		return continueMergeQueueBisect(ctx, p, status)
+	}
+	if status != evergreen.VersionFailed || !p.CanBisectMergeQueueBatch() {
+		return nil
+	}
+
+	started, err := p.StartMergeQueueBisect(ctx)
+	if err != nil {
+		return err
+	}
+	if !started {
+		return nil
+	}
+
+	ghSub := event.NewGithubMergeAPISubscriber(event.GithubMergeSubscriber{
+		Owner: p.GithubMergeData.Org,
+		Repo:  p.GithubMergeData.Repo,
+		Ref:   p.GithubMergeData.HeadSHA,
+	})
+	sub := event.NewExpiringPatchMergeQueueBisectedSubscription(p.Id.Hex(), ghSub)
+	if err = sub.Upsert(ctx); err != nil {
+		return errors.Wrapf(err, "inserting merge queue bisect subscription for patch '%s'", p.Id.Hex())
+	}
+
+	grip.Info(message.Fields{
+		"message":     "starting merge queue bisect",
+		"patch_id":    p.Id.Hex(),
+		"project_id":  p.Project,
+		"num_commits": len(p.GithubMergeData.Commits),
+		"next_index":  p.MergeQueueBisect.NextIndex,
+		"head_sha":    p.GithubMergeData.HeadSHA,
+	})
+
+	return nil
+}
+
+// continueMergeQueueBisect records whether a patch that tests part of a
+// failed batch passed and moves the bisection of the batch on to the next
+// step. A failed step only counts against its commit if a task or test failed;
+// if every failure was a system or setup failure, the bisection stops as
+// inconclusive.
+func continueMergeQueueBisect(ctx context.Context, p *patch.Patch, status string) error {
+	step := p.MergeQueueBisectStep
+	batch, err := patch.FindOneId(ctx, step.BatchPatchID)
+	if err != nil {
+		return errors.Wrapf(err, "finding merge queue batch patch '%s'", step.BatchPatchID)
+	}
+	if batch == nil {
+		return errors.Errorf("merge queue batch patch '%s' not found", step.BatchPatchID)
+	}
+	if batch.MergeQueueBisect == nil || batch.MergeQueueBisect.NextPatchID != p.Id.Hex() {
+		return nil
+	}
+
+	info := *batch.MergeQueueBisect
+	if status == evergreen.VersionSucceeded {
+		info.AddResult(step.CommitIndex, true)
+	} else {
+		failed, err := hasTaskFailure(ctx, p.Version)
+		if err != nil {
+			return errors.Wrapf(err, "checking failures for merge queue bisect patch '%s'", p.Id.Hex())
+		}
+		if failed {
+			info.AddResult(step.CommitIndex, false)
+		} else {
+			info.SetInconclusive()
+		}
+	}
+	updated, err := batch.UpdateMergeQueueBisect(ctx, p.Id.Hex(), info)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return nil
+	}
+
+	grip.Info(message.Fields{
+		"message":            "merge queue bisect step finished",
+		"patch_id":           batch.Id.Hex(),
+		"step_patch_id":      p.Id.Hex(),
+		"step_status":        status,
+		"commit_index":       step.CommitIndex,
+		"last_passing_index": info.LastPassingIndex,
+		"last_failing_index": info.LastFailingIndex,
+		"bisect_status":      info.Status,
+	})
+	if info.Status == patch.MergeQueueBisectFound || info.Status == patch.MergeQueueBisectInconclusive {
+		event.LogPatchMergeQueueBisectedEvent(ctx, batch.Id.Hex())
+	}
+
+	return nil
+}
+
+// hasTaskFailure returns whether any task in the version failed because of a
+// task or test failure rather than a system or setup failure.
+func hasTaskFailure(ctx context.Context, versionID string) (bool, error) {
+	if versionID == "" {
+		return false, nil
+	}
+	failedTasks, err := task.FindWithFields(ctx, task.FailedTasksByVersion(versionID), task.DetailsKey, task.AbortedKey, task.DisplayOnlyKey)
+	if err != nil {
+		return false, errors.Wrapf(err, "finding failed tasks for version '%s'", versionID)
+	}
+	for _, t := range failedTasks {
+		if t.DisplayOnly || t.Aborted {
+			continue
+		}
+		if t.Details.Type != evergreen.CommandTypeSystem && t.Details.Type != evergreen.CommandTypeSetup {
+			return true, nil
+		}
+	}
+	return false, nil
+}
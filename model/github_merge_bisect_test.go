@@ -0,0 +1,170 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMergeQueueBisect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, db.ClearCollections(patch.Collection, event.SubscriptionsCollection, event.EventCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(patch.Collection, event.SubscriptionsCollection, event.EventCollection))
+	}()
+
+	batch := &patch.Patch{
+		Id:      mgobson.NewObjectId(),
+		Githash: "base",
+		GithubMergeData: thirdparty.GithubMergeGroup{
+			Org:        "evergreen-ci",
+			Repo:       "evergreen",
+			BaseBranch: "main",
+			HeadBranch: "gh-readonly-queue/main/pr-2-head",
+			HeadSHA:    "sha1",
+			Commits: []thirdparty.GithubMergeGroupCommit{
+				{SHA: "sha0", Title: "First (#1)", PRNumber: 1},
+				{SHA: "sha1", Title: "Second (#2)", PRNumber: 2},
+			},
+		},
+	}
+	require.NoError(t, batch.Insert(ctx))
+
+	findBatch := func(t *testing.T) *patch.Patch {
+		dbBatch, err := patch.FindOneId(ctx, batch.Id.Hex())
+		require.NoError(t, err)
+		require.NotNil(t, dbBatch)
+		return dbBatch
+	}
+
+	require.NoError(t, updateMergeQueueBisect(ctx, batch, evergreen.VersionSucceeded))
+	assert.Nil(t, findBatch(t).MergeQueueBisect, "should not bisect a passing batch")
+
+	require.NoError(t, updateMergeQueueBisect(ctx, batch, evergreen.VersionFailed))
+	dbBatch := findBatch(t)
+	require.NotNil(t, dbBatch.MergeQueueBisect)
+	assert.Equal(t, patch.MergeQueueBisectPending, dbBatch.MergeQueueBisect.Status)
+	assert.Equal(t, 0, dbBatch.MergeQueueBisect.NextIndex)
+
+	subs, err := event.FindSubscriptionsByAttributes(ctx, event.ResourceTypePatch, event.Attributes{ID: []string{batch.Id.Hex()}})
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	assert.Equal(t, event.TriggerMergeQueueBisected, subs[0].Trigger)
+	assert.Equal(t, event.GithubMergeSubscriberType, subs[0].Subscriber.Type)
+
+	step := &patch.Patch{
+		Id: mgobson.NewObjectId(),
+		GithubMergeData: thirdparty.GithubMergeGroup{
+			HeadSHA: "sha0",
+		},
+		MergeQueueBisectStep: &patch.MergeQueueBisectStep{
+			BatchPatchID: batch.Id.Hex(),
+			CommitIndex:  0,
+		},
+	}
+	require.NoError(t, step.Insert(ctx))
+	require.NoError(t, dbBatch.SetMergeQueueBisectRunning(ctx, 0, step.Id.Hex()))
+
+	require.NoError(t, updateMergeQueueBisect(ctx, step, evergreen.VersionSucceeded))
+	dbBatch = findBatch(t)
+	assert.Equal(t, patch.MergeQueueBisectFound, dbBatch.MergeQueueBisect.Status)
+	assert.Equal(t, 1, dbBatch.MergeQueueBisect.CulpritIndex())
+
+	events, err := event.FindAllByResourceID(ctx, batch.Id.Hex())
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, event.PatchMergeQueueBisected, events[0].EventType)
+
+	require.NoError(t, updateMergeQueueBisect(ctx, step, evergreen.VersionFailed))
+	assert.Equal(t, 1, findBatch(t).MergeQueueBisect.CulpritIndex(), "should ignore results for steps that already finished")
+}
+
+func TestContinueMergeQueueBisectWithFailedStep(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for tName, tCase := range map[string]struct {
+		detailsType    string
+		expectedStatus string
+	}{
+		"TestFailureCountsAgainstCommit": {
+			detailsType:    evergreen.CommandTypeTest,
+			expectedStatus: patch.MergeQueueBisectFound,
+		},
+		"SystemFailureIsInconclusive": {
+			detailsType:    evergreen.CommandTypeSystem,
+			expectedStatus: patch.MergeQueueBisectInconclusive,
+		},
+		"SetupFailureIsInconclusive": {
+			detailsType:    evergreen.CommandTypeSetup,
+			expectedStatus: patch.MergeQueueBisectInconclusive,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(patch.Collection, task.Collection, event.EventCollection))
+			defer func() {
+				assert.NoError(t, db.ClearCollections(patch.Collection, task.Collection, event.EventCollection))
+			}()
+
+			batch := &patch.Patch{
+				Id:      mgobson.NewObjectId(),
+				Githash: "base",
+				GithubMergeData: thirdparty.GithubMergeGroup{
+					HeadSHA: "sha1",
+					Commits: []thirdparty.GithubMergeGroupCommit{
+						{SHA: "sha0", Title: "First (#1)", PRNumber: 1},
+						{SHA: "sha1", Title: "Second (#2)", PRNumber: 2},
+					},
+				},
+			}
+			require.NoError(t, batch.Insert(ctx))
+			started, err := batch.StartMergeQueueBisect(ctx)
+			require.NoError(t, err)
+			require.True(t, started)
+
+			step := &patch.Patch{
+				Id:      mgobson.NewObjectId(),
+				Version: "step_version",
+				MergeQueueBisectStep: &patch.MergeQueueBisectStep{
+					BatchPatchID: batch.Id.Hex(),
+					CommitIndex:  0,
+				},
+			}
+			require.NoError(t, step.Insert(ctx))
+			require.NoError(t, batch.SetMergeQueueBisectRunning(ctx, 0, step.Id.Hex()))
+			tsk := task.Task{
+				Id:      "t0",
+				Version: step.Version,
+				Status:  evergreen.TaskFailed,
+				Details: apimodels.TaskEndDetail{
+					Status: evergreen.TaskFailed,
+					Type:   tCase.detailsType,
+				},
+			}
+			require.NoError(t, tsk.Insert(ctx))
+
+			require.NoError(t, updateMergeQueueBisect(ctx, step, evergreen.VersionFailed))
+			dbBatch, err := patch.FindOneId(ctx, batch.Id.Hex())
+			require.NoError(t, err)
+			require.NotNil(t, dbBatch)
+			assert.Equal(t, tCase.expectedStatus, dbBatch.MergeQueueBisect.Status)
+
+			events, err := event.FindAllByResourceID(ctx, batch.Id.Hex())
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			assert.Equal(t, event.PatchMergeQueueBisected, events[0].EventType)
+		})
+	}
+}
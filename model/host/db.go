@@ -153,6 +153,17 @@ func ByUserRecentlyTerminated(user string, timestamp time.Time) bson.M {
 	}
 }
 
+// FindTerminatedTaskHostsBetween returns the task hosts that were terminated
+// in the given time range. The end of the range is exclusive.
+func FindTerminatedTaskHostsBetween(ctx context.Context, start, end time.Time) ([]Host, error) {
+	return Find(ctx, bson.M{
+		StartedByKey:       evergreen.User,
+		StatusKey:          evergreen.HostTerminated,
+		TerminationTimeKey: bson.M{"$gte": start, "$lt": end},
+		ParentIDKey:        bson.M{"$exists": false},
+	})
+}
+
 // byActiveForTasks returns a query that finds all task hosts that are active in
 // the cloud provider.
 func byActiveForTasks() bson.M {
@@ -17,6 +17,9 @@ type chunkInfo struct {
 	end      int64
 	numLines int
 	upload   int64
+	// compressed indicates whether the chunk is stored in the compressed,
+	// indexed format.
+	compressed bool
 }
 
 // chunkGroup represents a set of chunks belonging to a single log.
@@ -24,3 +27,40 @@ type chunkGroup struct {
 	name   string
 	chunks []chunkInfo
 }
+
+// convertedChunkKeys returns the keys of the plain-text chunks that also have
+// a compressed copy, which happens while a chunk is being converted to the
+// compressed format.
+func convertedChunkKeys(chunks []chunkInfo) map[string]bool {
+	compressedKeys := map[string]bool{}
+	for _, chunk := range chunks {
+		if chunk.compressed {
+			compressedKeys[chunk.key] = true
+		}
+	}
+
+	converted := map[string]bool{}
+	for _, chunk := range chunks {
+		if !chunk.compressed && compressedKeys[chunk.key+compressedChunkKeySuffix] {
+			converted[chunk.key] = true
+		}
+	}
+	return converted
+}
+
+// withoutConvertedChunks returns the chunks without the plain-text chunks that
+// have a compressed copy so that their lines are only read once.
+func withoutConvertedChunks(chunks []chunkInfo) []chunkInfo {
+	converted := convertedChunkKeys(chunks)
+	if len(converted) == 0 {
+		return chunks
+	}
+
+	filtered := make([]chunkInfo, 0, len(chunks)-len(converted))
+	for _, chunk := range chunks {
+		if !converted[chunk.key] {
+			filtered = append(filtered, chunk)
+		}
+	}
+	return filtered
+}
@@ -55,7 +55,7 @@ func newChunkIterator(ctx context.Context, opts chunkIteratorOptions) *chunkIter
 		next:       make(chan *chunkReader, 1),
 		catcher:    grip.NewBasicCatcher(),
 	}
-	go it.worker(ctx)
+	go it.worker(ctx, lineOffset)
 
 	return it
 }
@@ -81,6 +81,9 @@ func (it *chunkIterator) Next() bool {
 				it.exhausted = !it.catcher.HasErrors()
 				return false
 			}
+			// Compressed chunks may skip leading lines without
+			// reading them, which count against the line offset.
+			it.lineOffset = max(0, it.lineOffset-it.reader.skippedLines)
 		}
 
 		data, err := it.reader.ReadString('\n')
@@ -131,21 +134,37 @@ func (it *chunkIterator) Next() bool {
 	return true
 }
 
-func (it *chunkIterator) worker(ctx context.Context) {
+func (it *chunkIterator) worker(ctx context.Context, lineOffset int) {
 	defer func() {
 		it.catcher.Add(recovery.HandlePanicWithError(recover(), nil, "log chunk iterator worker"))
 		close(it.next)
 	}()
 
-	for _, chunk := range it.opts.chunks {
+	for i, chunk := range it.opts.chunks {
 		r, err := it.opts.bucket.Get(ctx, chunk.key)
 		if err != nil {
 			it.catcher.Wrap(err, "getting chunk from bucket")
 			return
 		}
 
+		reader := newChunkReader(r, chunk.numLines)
+		if chunk.compressed {
+			skipOpts := compressedChunkSkipOptions{start: it.opts.start}
+			if i == 0 {
+				// The line offset only ever applies to the first
+				// chunk.
+				skipOpts.lineOffset = lineOffset
+			}
+			reader, err = newCompressedChunkReader(r, chunk.numLines, skipOpts)
+			if err != nil {
+				it.catcher.Add(r.Close())
+				it.catcher.Wrapf(err, "reading compressed chunk '%s'", chunk.key)
+				return
+			}
+		}
+
 		select {
-		case it.next <- newChunkReader(r, chunk.numLines):
+		case it.next <- reader:
 		case <-ctx.Done():
 			it.catcher.Add(ctx.Err())
 			return
@@ -205,6 +224,9 @@ func filterChunksByLimit(chunks []chunkInfo, limit int) []chunkInfo {
 
 type chunkReader struct {
 	numLines int
+	// skippedLines is the number of leading lines of the chunk that were
+	// skipped before reading.
+	skippedLines int
 
 	*bufio.Reader
 	io.ReadCloser
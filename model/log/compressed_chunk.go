@@ -0,0 +1,174 @@
+/*
+Compressed Log Chunk
+
+A compressed log chunk stores the same raw lines as a plain-text chunk, split
+into fixed-size blocks that are each compressed as an independent gzip member.
+The chunk begins with a single line of JSON describing each block (its
+compressed size, number of lines, and time range) followed by the concatenated
+blocks. The sparse index allows readers to skip whole blocks that fall outside
+of a requested time or line range without decompressing them.
+*/
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+const (
+	// compressedChunkKeySuffix is the suffix appended to the storage key
+	// of log chunks written in the compressed format. Chunk keys without
+	// this suffix are plain-text chunks.
+	compressedChunkKeySuffix = ".v1"
+	// compressedChunkBlockSize is the maximum number of lines written to
+	// a single compressed block of a chunk.
+	compressedChunkBlockSize = 256
+)
+
+// compressedChunkIndex is the sparse index written at the head of each
+// compressed log chunk.
+type compressedChunkIndex struct {
+	Blocks []compressedChunkBlock `json:"blocks"`
+}
+
+// compressedChunkBlock describes a single compressed block of a chunk.
+type compressedChunkBlock struct {
+	Size     int64 `json:"size"`
+	NumLines int   `json:"num_lines"`
+	Start    int64 `json:"start"`
+	End      int64 `json:"end"`
+}
+
+// encodeCompressedChunk returns the given lines encoded as a compressed log
+// chunk. Each line is formatted with the given function before compression.
+func encodeCompressedChunk(lines []LogLine, format func(LogLine) string) ([]byte, error) {
+	var (
+		index  compressedChunkIndex
+		blocks bytes.Buffer
+	)
+	for i := 0; i < len(lines); i += compressedChunkBlockSize {
+		blockLines := lines[i:min(i+compressedChunkBlockSize, len(lines))]
+
+		block := compressedChunkBlock{
+			NumLines: len(blockLines),
+			Start:    blockLines[0].Timestamp,
+			End:      blockLines[0].Timestamp,
+		}
+		size := blocks.Len()
+		gz := gzip.NewWriter(&blocks)
+		for _, line := range blockLines {
+			if line.Timestamp < block.Start {
+				block.Start = line.Timestamp
+			}
+			if line.Timestamp > block.End {
+				block.End = line.Timestamp
+			}
+			if _, err := gz.Write([]byte(format(line))); err != nil {
+				return nil, errors.Wrap(err, "compressing log lines")
+			}
+		}
+		if err := gz.Close(); err != nil {
+			return nil, errors.Wrap(err, "flushing compressed block")
+		}
+		block.Size = int64(blocks.Len() - size)
+
+		index.Blocks = append(index.Blocks, block)
+	}
+
+	header, err := json.Marshal(index)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling chunk index")
+	}
+
+	chunk := make([]byte, 0, len(header)+1+blocks.Len())
+	chunk = append(chunk, header...)
+	chunk = append(chunk, '\n')
+	chunk = append(chunk, blocks.Bytes()...)
+
+	return chunk, nil
+}
+
+// compressedChunkSkipOptions represent the line offset and start time used to
+// skip leading blocks of a compressed chunk.
+type compressedChunkSkipOptions struct {
+	lineOffset int
+	start      *int64
+}
+
+// newCompressedChunkReader returns a chunk reader over the decompressed lines
+// of the compressed chunk in r. Leading blocks that fall entirely within the
+// line offset or entirely before the start time are skipped without being
+// decompressed; the number of skipped lines is recorded in the returned
+// reader.
+func newCompressedChunkReader(r io.ReadCloser, numLines int, opts compressedChunkSkipOptions) (*chunkReader, error) {
+	br := bufio.NewReader(r)
+	header, err := br.ReadBytes('\n')
+	if err != nil {
+		return nil, errors.Wrap(err, "reading chunk index")
+	}
+	var index compressedChunkIndex
+	if err = json.Unmarshal(header, &index); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling chunk index")
+	}
+
+	var skippedLines int
+	var blockIdx int
+	for ; blockIdx < len(index.Blocks); blockIdx++ {
+		block := index.Blocks[blockIdx]
+		withinOffset := skippedLines+block.NumLines <= opts.lineOffset
+		beforeStart := opts.start != nil && block.End < *opts.start
+		if !withinOffset && !beforeStart {
+			break
+		}
+
+		if _, err = io.CopyN(io.Discard, br, block.Size); err != nil {
+			return nil, errors.Wrap(err, "skipping compressed block")
+		}
+		skippedLines += block.NumLines
+	}
+
+	if blockIdx == len(index.Blocks) {
+		return &chunkReader{
+			numLines:     numLines - skippedLines,
+			skippedLines: skippedLines,
+			Reader:       bufio.NewReader(strings.NewReader("")),
+			ReadCloser:   r,
+		}, nil
+	}
+
+	gz, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating gzip reader")
+	}
+
+	return &chunkReader{
+		numLines:     numLines - skippedLines,
+		skippedLines: skippedLines,
+		Reader:       bufio.NewReader(gz),
+		ReadCloser:   &compressedChunkCloser{gz: gz, r: r},
+	}, nil
+}
+
+// compressedChunkCloser closes both the gzip reader and the underlying chunk
+// reader.
+type compressedChunkCloser struct {
+	gz *gzip.Reader
+	r  io.ReadCloser
+}
+
+func (c *compressedChunkCloser) Read(p []byte) (int, error) { return c.gz.Read(p) }
+
+func (c *compressedChunkCloser) Close() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(c.gz.Close())
+	catcher.Add(c.r.Close())
+
+	return catcher.Resolve()
+}
@@ -0,0 +1,139 @@
+package log
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// defaultSearchMatchLimit is the maximum number of matching lines returned by
+// a search when no limit is specified.
+const defaultSearchMatchLimit = 1000
+
+// SearchOptions represents the arguments for searching Evergreen logs.
+type SearchOptions struct {
+	// Pattern is the substring or regular expression to match against
+	// each log line. Required.
+	Pattern string
+	// Regex, when true, treats the pattern as a regular expression
+	// instead of a literal substring.
+	Regex bool
+	// CaseInsensitive, when true, matches the pattern without regard to
+	// case.
+	CaseInsensitive bool
+	// ContextLines is the number of lines before and after each matching
+	// line to also return. Ignored if less than or equal to 0.
+	ContextLines int
+	// Limit is the maximum number of matching lines to return, excluding
+	// context lines. Defaults to 1000.
+	Limit int
+}
+
+// Validate checks that the search options are valid and sets defaults.
+func (o *SearchOptions) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(o.Pattern == "", "must specify a search pattern")
+	catcher.NewWhen(o.ContextLines < 0, "context lines cannot be negative")
+	catcher.NewWhen(o.Limit < 0, "limit cannot be negative")
+	if o.Limit == 0 {
+		o.Limit = defaultSearchMatchLimit
+	}
+	if catcher.HasErrors() {
+		return catcher.Resolve()
+	}
+
+	_, err := o.matcher()
+	return err
+}
+
+// matcher returns a function that reports whether a log line matches the
+// search pattern.
+func (o *SearchOptions) matcher() (func(string) bool, error) {
+	if !o.Regex {
+		if o.CaseInsensitive {
+			pattern := strings.ToLower(o.Pattern)
+			return func(data string) bool { return strings.Contains(strings.ToLower(data), pattern) }, nil
+		}
+		return func(data string) bool { return strings.Contains(data, o.Pattern) }, nil
+	}
+
+	pattern := o.Pattern
+	if o.CaseInsensitive {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, errors.Wrap(err, "compiling search pattern")
+	}
+
+	return re.MatchString, nil
+}
+
+// SearchResult is a single line returned by a log search.
+type SearchResult struct {
+	LogLine
+	// LineNumber is the 0-based position of the line in the searched
+	// log iterator.
+	LineNumber int
+	// IsContext is true if the line does not match the search pattern and
+	// is only returned as context for a nearby matching line.
+	IsContext bool
+}
+
+// Search reads the log iterator and returns the lines that match the search
+// options, in order, along with any requested context lines. The iterator is
+// closed once the search completes.
+func Search(it LogIterator, opts SearchOptions) ([]SearchResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid search options")
+	}
+	match, err := opts.matcher()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		results      []SearchResult
+		before       []SearchResult
+		numMatches   int
+		afterPending int
+		lineNumber   int
+	)
+	for ; it.Next(); lineNumber++ {
+		result := SearchResult{LogLine: it.Item(), LineNumber: lineNumber}
+
+		if match(result.Data) {
+			if numMatches == opts.Limit {
+				break
+			}
+			numMatches++
+
+			results = append(results, before...)
+			before = before[:0]
+			results = append(results, result)
+			afterPending = opts.ContextLines
+			continue
+		}
+
+		result.IsContext = true
+		if afterPending > 0 {
+			results = append(results, result)
+			afterPending--
+			continue
+		}
+		if opts.ContextLines > 0 {
+			if len(before) == opts.ContextLines {
+				before = append(before[:0], before[1:]...)
+			}
+			before = append(before, result)
+		}
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(it.Err())
+	catcher.Add(it.Close())
+
+	return results, errors.Wrap(catcher.Resolve(), "searching log lines")
+}
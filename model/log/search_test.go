@@ -0,0 +1,94 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	lines := []LogLine{
+		{LogName: "task", Timestamp: 1, Data: "starting test suite"},
+		{LogName: "task", Timestamp: 2, Data: "running TestOne"},
+		{LogName: "task", Timestamp: 3, Data: "--- PASS: TestOne"},
+		{LogName: "task", Timestamp: 4, Data: "running TestTwo"},
+		{LogName: "task", Timestamp: 5, Data: "--- FAIL: TestTwo"},
+		{LogName: "task", Timestamp: 6, Data: "error: connection refused"},
+		{LogName: "task", Timestamp: 7, Data: "running TestThree"},
+		{LogName: "task", Timestamp: 8, Data: "--- FAIL: TestThree"},
+	}
+
+	for _, test := range []struct {
+		name            string
+		opts            SearchOptions
+		expectedNumbers []int
+		expectedContext []bool
+		hasErr          bool
+	}{
+		{
+			name:            "Substring",
+			opts:            SearchOptions{Pattern: "FAIL"},
+			expectedNumbers: []int{4, 7},
+			expectedContext: []bool{false, false},
+		},
+		{
+			name:            "CaseInsensitiveSubstring",
+			opts:            SearchOptions{Pattern: "fail", CaseInsensitive: true},
+			expectedNumbers: []int{4, 7},
+			expectedContext: []bool{false, false},
+		},
+		{
+			name:            "Regex",
+			opts:            SearchOptions{Pattern: `^--- (PASS|FAIL): TestT`, Regex: true},
+			expectedNumbers: []int{4, 7},
+			expectedContext: []bool{false, false},
+		},
+		{
+			name:            "ContextLines",
+			opts:            SearchOptions{Pattern: "FAIL", ContextLines: 1},
+			expectedNumbers: []int{3, 4, 5, 6, 7},
+			expectedContext: []bool{true, false, true, true, false},
+		},
+		{
+			name:            "Limit",
+			opts:            SearchOptions{Pattern: "running", Limit: 2},
+			expectedNumbers: []int{1, 3},
+			expectedContext: []bool{false, false},
+		},
+		{
+			name:            "NoMatches",
+			opts:            SearchOptions{Pattern: "panic"},
+			expectedNumbers: nil,
+		},
+		{
+			name:   "EmptyPattern",
+			opts:   SearchOptions{},
+			hasErr: true,
+		},
+		{
+			name:   "InvalidRegex",
+			opts:   SearchOptions{Pattern: "(", Regex: true},
+			hasErr: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			results, err := Search(newBasicIterator(lines), test.opts)
+			if test.hasErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var numbers []int
+			var context []bool
+			for _, result := range results {
+				assert.Equal(t, lines[result.LineNumber], result.LogLine)
+				numbers = append(numbers, result.LineNumber)
+				context = append(context, result.IsContext)
+			}
+			assert.Equal(t, test.expectedNumbers, numbers)
+			assert.Equal(t, test.expectedContext, context)
+		})
+	}
+}
@@ -32,6 +32,15 @@ func TestLogService(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "V1",
+			constructor: func(t *testing.T) LogService {
+				bucket, err := pail.NewLocalBucket(pail.LocalOptions{Path: t.TempDir()})
+				require.NoError(t, err)
+
+				return NewLogServiceV1(bucket)
+			},
+		},
 	} {
 		t.Run(impl.name, func(t *testing.T) {
 			svc := impl.constructor(t)
@@ -45,7 +45,7 @@ func (s *logServiceV0) Get(ctx context.Context, getOpts GetOptions) (LogIterator
 	for _, chunks := range allLogChunks {
 		its = append(its, newChunkIterator(ctx, chunkIteratorOptions{
 			bucket:    s.bucket,
-			chunks:    chunks.chunks,
+			chunks:    withoutConvertedChunks(chunks.chunks),
 			parser:    s.getParser(chunks.name),
 			start:     start,
 			end:       end,
@@ -180,7 +180,8 @@ func (s *logServiceV0) createChunkKey(sequence int, start, end int64, numLines i
 
 // parseChunkKey returns the chunk info encoded in the given key.
 func (s *logServiceV0) parseChunkKey(prefix, key string) (chunkInfo, error) {
-	parsedKey := strings.Split(key, "_")
+	compressed := strings.HasSuffix(key, compressedChunkKeySuffix)
+	parsedKey := strings.Split(strings.TrimSuffix(key, compressedChunkKeySuffix), "_")
 	if len(parsedKey) < 3 || len(parsedKey) > 5 {
 		return chunkInfo{}, errors.New("invalid key format")
 	}
@@ -217,12 +218,13 @@ func (s *logServiceV0) parseChunkKey(prefix, key string) (chunkInfo, error) {
 	}
 
 	return chunkInfo{
-		key:      prefix + "/" + key,
-		sequence: sequence,
-		start:    start,
-		end:      end,
-		numLines: numLines,
-		upload:   upload,
+		key:        prefix + "/" + key,
+		sequence:   sequence,
+		start:      start,
+		end:        end,
+		numLines:   numLines,
+		upload:     upload,
+		compressed: compressed,
 	}, nil
 }
 
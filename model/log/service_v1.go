@@ -0,0 +1,131 @@
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/evergreen-ci/pail"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// logServiceV1 implements a pail-backed log service for Evergreen that writes
+// log chunks in the compressed, indexed format. It reads both compressed and
+// plain-text chunks, so logs written by the V0 service remain readable.
+type logServiceV1 struct {
+	*logServiceV0
+}
+
+// NewLogServiceV1 returns a new V1 Evergreen log service.
+func NewLogServiceV1(bucket pail.Bucket) *logServiceV1 {
+	return &logServiceV1{logServiceV0: NewLogServiceV0(bucket)}
+}
+
+func (s *logServiceV1) Append(ctx context.Context, logName string, sequence int, lines []LogLine) error {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	chunk, err := encodeCompressedChunk(lines, s.formatRawLine)
+	if err != nil {
+		return errors.Wrap(err, "encoding compressed log chunk")
+	}
+
+	key := fmt.Sprintf("%s/%s%s", logName, s.createChunkKey(sequence, lines[0].Timestamp, lines[len(lines)-1].Timestamp, len(lines)), compressedChunkKeySuffix)
+	return errors.Wrap(s.bucket.Put(ctx, key, bytes.NewReader(chunk)), "writing log chunk to bucket")
+}
+
+// Convert rewrites each plain-text chunk of the logs with the given prefix in
+// the compressed format and removes the original chunk. It returns the number
+// of chunks converted. Chunks that are already compressed are left as is, and
+// plain-text chunks that already have a compressed copy from an interrupted
+// conversion are only removed.
+func (s *logServiceV1) Convert(ctx context.Context, prefix string) (int, error) {
+	chunkGroups, _, _, err := s.getLogChunks(ctx, []string{prefix})
+	if err != nil {
+		return 0, errors.Wrap(err, "getting log chunks")
+	}
+
+	var converted int
+	for _, group := range chunkGroups {
+		alreadyConverted := convertedChunkKeys(group.chunks)
+		for _, chunk := range group.chunks {
+			if chunk.compressed {
+				continue
+			}
+			if alreadyConverted[chunk.key] {
+				if err = s.bucket.Remove(ctx, chunk.key); err != nil {
+					return converted, errors.Wrapf(err, "removing converted plain-text log chunk '%s'", chunk.key)
+				}
+				converted++
+				continue
+			}
+
+			if err = s.convertChunk(ctx, group.name, chunk); err != nil {
+				return converted, errors.Wrapf(err, "converting log chunk '%s'", chunk.key)
+			}
+			converted++
+		}
+	}
+
+	return converted, nil
+}
+
+// convertChunk rewrites a single plain-text chunk in the compressed format.
+// The compressed chunk is written before the original is removed so that the
+// log is never missing lines. Readers skip the original while both exist.
+func (s *logServiceV1) convertChunk(ctx context.Context, logName string, chunk chunkInfo) error {
+	r, err := s.bucket.Get(ctx, chunk.key)
+	if err != nil {
+		return errors.Wrap(err, "getting chunk from bucket")
+	}
+
+	lines, err := s.readRawLines(r, s.getParser(logName))
+	if err != nil {
+		return err
+	}
+	if len(lines) != chunk.numLines {
+		return errors.New("corrupt data")
+	}
+
+	compressed, err := encodeCompressedChunk(lines, s.formatRawLine)
+	if err != nil {
+		return errors.Wrap(err, "encoding compressed log chunk")
+	}
+	if err = s.bucket.Put(ctx, chunk.key+compressedChunkKeySuffix, bytes.NewReader(compressed)); err != nil {
+		return errors.Wrap(err, "writing compressed log chunk to bucket")
+	}
+
+	return errors.Wrap(s.bucket.Remove(ctx, chunk.key), "removing plain-text log chunk from bucket")
+}
+
+// readRawLines reads and parses all of the lines of a plain-text chunk.
+func (s *logServiceV1) readRawLines(r io.ReadCloser, parser LineParser) ([]LogLine, error) {
+	catcher := grip.NewBasicCatcher()
+
+	var lines []LogLine
+	br := bufio.NewReader(r)
+	for {
+		data, err := br.ReadString('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			catcher.Wrap(err, "reading log line")
+			break
+		}
+
+		line, err := parser(data)
+		if err != nil {
+			catcher.Wrap(err, "parsing log line")
+			break
+		}
+		lines = append(lines, line)
+	}
+	catcher.Wrap(r.Close(), "closing log chunk")
+
+	return lines, catcher.Resolve()
+}
@@ -0,0 +1,175 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/pail"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogServiceV1(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ts := time.Now().UnixNano()
+	makeLines := func(n int) []LogLine {
+		lines := make([]LogLine, n)
+		for i := range lines {
+			lines[i] = LogLine{
+				Priority:  level.Info,
+				Timestamp: ts + int64(i)*int64(time.Second),
+				Data:      fmt.Sprintf("Line number %d.", i),
+			}
+		}
+		return lines
+	}
+
+	t.Run("RandomAccessAcrossBlocks", func(t *testing.T) {
+		bucket, err := pail.NewLocalBucket(pail.LocalOptions{Path: t.TempDir()})
+		require.NoError(t, err)
+		svc := NewLogServiceV1(bucket)
+
+		logName := utility.RandomString()
+		lines := makeLines(3*compressedChunkBlockSize + 10)
+		for i := range lines {
+			lines[i].LogName = logName
+		}
+		require.NoError(t, svc.Append(ctx, logName, 0, lines[:2*compressedChunkBlockSize+5]))
+		require.NoError(t, svc.Append(ctx, logName, 1, lines[2*compressedChunkBlockSize+5:]))
+
+		for _, test := range []struct {
+			name          string
+			opts          GetOptions
+			expectedLines []LogLine
+		}{
+			{
+				name:          "All",
+				opts:          GetOptions{LogNames: []string{logName}},
+				expectedLines: lines,
+			},
+			{
+				name: "TailN",
+				opts: GetOptions{
+					LogNames: []string{logName},
+					TailN:    compressedChunkBlockSize + 20,
+				},
+				expectedLines: lines[len(lines)-compressedChunkBlockSize-20:],
+			},
+			{
+				name: "Start",
+				opts: GetOptions{
+					LogNames: []string{logName},
+					Start:    utility.ToInt64Ptr(lines[compressedChunkBlockSize+3].Timestamp),
+				},
+				expectedLines: lines[compressedChunkBlockSize+3:],
+			},
+			{
+				name: "StartAndEnd",
+				opts: GetOptions{
+					LogNames: []string{logName},
+					Start:    utility.ToInt64Ptr(lines[compressedChunkBlockSize+3].Timestamp),
+					End:      utility.ToInt64Ptr(lines[2*compressedChunkBlockSize].Timestamp),
+				},
+				expectedLines: lines[compressedChunkBlockSize+3 : 2*compressedChunkBlockSize+1],
+			},
+			{
+				name: "LineLimit",
+				opts: GetOptions{
+					LogNames:  []string{logName},
+					LineLimit: compressedChunkBlockSize + 1,
+				},
+				expectedLines: lines[:compressedChunkBlockSize+1],
+			},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				assert.Equal(t, test.expectedLines, readLogLines(t, svc, ctx, test.opts))
+			})
+		}
+	})
+	t.Run("Convert", func(t *testing.T) {
+		bucket, err := pail.NewLocalBucket(pail.LocalOptions{Path: t.TempDir()})
+		require.NoError(t, err)
+		v0 := NewLogServiceV0(bucket)
+		v1 := NewLogServiceV1(bucket)
+
+		prefix := utility.RandomString()
+		logName := prefix + "/task"
+		lines := makeLines(compressedChunkBlockSize + 1)
+		for i := range lines {
+			lines[i].LogName = logName
+		}
+		require.NoError(t, v0.Append(ctx, logName, 0, lines[:10]))
+		require.NoError(t, v1.Append(ctx, logName, 1, lines[10:]))
+
+		converted, err := v1.Convert(ctx, prefix)
+		require.NoError(t, err)
+		assert.Equal(t, 1, converted)
+
+		it, err := bucket.List(ctx, prefix)
+		require.NoError(t, err)
+		var numKeys int
+		for it.Next(ctx) {
+			assert.True(t, strings.HasSuffix(it.Item().Name(), compressedChunkKeySuffix))
+			numKeys++
+		}
+		require.NoError(t, it.Err())
+		assert.Equal(t, 2, numKeys)
+
+		assert.Equal(t, lines, readLogLines(t, v1, ctx, GetOptions{LogNames: []string{logName}}))
+		assert.Equal(t, lines, readLogLines(t, v0, ctx, GetOptions{LogNames: []string{logName}}))
+
+		converted, err = v1.Convert(ctx, prefix)
+		require.NoError(t, err)
+		assert.Zero(t, converted)
+	})
+	t.Run("InterruptedConvert", func(t *testing.T) {
+		bucket, err := pail.NewLocalBucket(pail.LocalOptions{Path: t.TempDir()})
+		require.NoError(t, err)
+		v0 := NewLogServiceV0(bucket)
+		v1 := NewLogServiceV1(bucket)
+
+		prefix := utility.RandomString()
+		logName := prefix + "/task"
+		lines := makeLines(20)
+		for i := range lines {
+			lines[i].LogName = logName
+		}
+		require.NoError(t, v0.Append(ctx, logName, 0, lines))
+
+		// Write the compressed copy of the chunk without removing the
+		// plain-text chunk, as if the conversion was interrupted.
+		it, err := bucket.List(ctx, prefix)
+		require.NoError(t, err)
+		require.True(t, it.Next(ctx))
+		plainKey := it.Item().Name()
+		require.False(t, it.Next(ctx))
+		require.NoError(t, it.Err())
+		compressed, err := encodeCompressedChunk(lines, v1.formatRawLine)
+		require.NoError(t, err)
+		require.NoError(t, bucket.Put(ctx, plainKey+compressedChunkKeySuffix, bytes.NewReader(compressed)))
+
+		assert.Equal(t, lines, readLogLines(t, v1, ctx, GetOptions{LogNames: []string{logName}}), "lines should not be read from both chunks")
+		assert.Equal(t, lines, readLogLines(t, v0, ctx, GetOptions{LogNames: []string{logName}}), "lines should not be read from both chunks")
+
+		converted, err := v1.Convert(ctx, prefix)
+		require.NoError(t, err)
+		assert.Equal(t, 1, converted)
+
+		it, err = bucket.List(ctx, prefix)
+		require.NoError(t, err)
+		require.True(t, it.Next(ctx))
+		assert.Equal(t, plainKey+compressedChunkKeySuffix, it.Item().Name())
+		assert.False(t, it.Next(ctx))
+		require.NoError(t, it.Err())
+
+		assert.Equal(t, lines, readLogLines(t, v1, ctx, GetOptions{LogNames: []string{logName}}))
+	})
+}
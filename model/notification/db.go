@@ -26,6 +26,9 @@ var (
 	subscriberKey = bsonutil.MustHaveTag(Notification{}, "Subscriber")
 	sentAtKey     = bsonutil.MustHaveTag(Notification{}, "SentAt")
 	errorKey      = bsonutil.MustHaveTag(Notification{}, "Error")
+	digestKey     = bsonutil.MustHaveTag(Notification{}, "Digest")
+	createdAtKey  = bsonutil.MustHaveTag(Notification{}, "CreatedAt")
+	digestIDKey   = bsonutil.MustHaveTag(Notification{}, "DigestID")
 )
 
 type unmarshalNotification struct {
@@ -36,6 +39,10 @@ type unmarshalNotification struct {
 	SentAt   time.Time            `bson:"sent_at,omitempty"`
 	Error    string               `bson:"error,omitempty"`
 	Metadata NotificationMetadata `bson:"metadata,omitempty"`
+
+	Digest    string    `bson:"digest,omitempty"`
+	CreatedAt time.Time `bson:"created_at,omitempty"`
+	DigestID  string    `bson:"digest_id,omitempty"`
 }
 
 func (d *Notification) UnmarshalBSON(in []byte) error {
@@ -65,6 +72,12 @@ func (n *Notification) SetBSON(raw mgobson.Raw) error {
 	case event.SlackSubscriberType:
 		n.Payload = &SlackPayload{}
 
+	case event.TeamsSubscriberType:
+		n.Payload = &TeamsPayload{}
+
+	case event.PagerDutySubscriberType:
+		n.Payload = &PagerDutyPayload{}
+
 	case event.GithubPullRequestSubscriberType, event.GithubCheckSubscriberType, event.GithubMergeSubscriberType:
 		n.Payload = &message.GithubStatus{}
 
@@ -81,6 +94,9 @@ func (n *Notification) SetBSON(raw mgobson.Raw) error {
 	n.SentAt = temp.SentAt
 	n.Error = temp.Error
 	n.Metadata = temp.Metadata
+	n.Digest = temp.Digest
+	n.CreatedAt = temp.CreatedAt
+	n.DigestID = temp.DigestID
 
 	return nil
 }
@@ -122,9 +138,14 @@ func FindByEventID(ctx context.Context, id string) ([]Notification, error) {
 	return notifications, err
 }
 
+// FindUnprocessed returns the unsent notifications that are sent by
+// themselves rather than in a digest.
 func FindUnprocessed(ctx context.Context) ([]Notification, error) {
 	notifications := []Notification{}
-	err := db.FindAllQ(ctx, Collection, db.Query(bson.M{sentAtKey: bson.M{"$exists": false}}), &notifications)
+	err := db.FindAllQ(ctx, Collection, db.Query(bson.M{
+		sentAtKey: bson.M{"$exists": false},
+		digestKey: bson.M{"$exists": false},
+	}), &notifications)
 
 	return notifications, errors.Wrap(err, "finding unprocessed notifications")
 }
@@ -0,0 +1,208 @@
+package notification
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// maxDigestSlackAttachments is the maximum number of attachments that Slack
+// accepts in a single message.
+const maxDigestSlackAttachments = 100
+
+// Digest is a group of notifications to the same subscriber that are sent
+// together in a single summarized message.
+type Digest struct {
+	Subscriber event.Subscriber
+	// Frequency is how often the digest is sent.
+	Frequency string
+	// Notifications are sorted from oldest to newest.
+	Notifications []Notification
+}
+
+// FindUnsentDigestNotifications returns the unsent notifications that are
+// waiting to be sent in a digest.
+func FindUnsentDigestNotifications(ctx context.Context) ([]Notification, error) {
+	notifications := []Notification{}
+	err := db.FindAllQ(ctx, Collection, db.Query(bson.M{
+		sentAtKey: bson.M{"$exists": false},
+		digestKey: bson.M{"$exists": true},
+	}).Sort([]string{createdAtKey}), &notifications)
+
+	return notifications, errors.Wrap(err, "finding unsent digest notifications")
+}
+
+// GroupDueDigests groups the notifications into digests by subscriber and
+// frequency, and returns the digests that are due. A digest is due once its
+// oldest notification has waited for the digest frequency's window.
+func GroupDueDigests(notifications []Notification, now time.Time) []Digest {
+	digestsByKey := map[string]*Digest{}
+	var keys []string
+	for _, n := range notifications {
+		key := fmt.Sprintf("%s-%s", n.Digest, n.Subscriber.String())
+		d, ok := digestsByKey[key]
+		if !ok {
+			d = &Digest{Subscriber: n.Subscriber, Frequency: n.Digest}
+			digestsByKey[key] = d
+			keys = append(keys, key)
+		}
+		d.Notifications = append(d.Notifications, n)
+	}
+	sort.Strings(keys)
+
+	var due []Digest
+	for _, key := range keys {
+		d := digestsByKey[key]
+		sort.SliceStable(d.Notifications, func(i, j int) bool {
+			return d.Notifications[i].CreatedAt.Before(d.Notifications[j].CreatedAt)
+		})
+		window := event.DigestWindow(d.Frequency)
+		if now.Sub(d.Notifications[0].CreatedAt) < window {
+			continue
+		}
+		due = append(due, *d)
+	}
+
+	return due
+}
+
+// Notification combines the digest's notifications into a single notification
+// to the subscriber. Its ID is derived from the IDs of all the digest's
+// notifications, so that creating the same digest twice produces the same
+// notification, while a digest that also contains newer notifications is a
+// different notification.
+func (d *Digest) Notification() (*Notification, error) {
+	if len(d.Notifications) == 0 {
+		return nil, errors.New("cannot create a digest without any notifications")
+	}
+
+	var payload any
+	var err error
+	switch d.Subscriber.Type {
+	case event.EmailSubscriberType:
+		payload, err = d.emailPayload()
+	case event.SlackSubscriberType:
+		payload, err = d.slackPayload()
+	default:
+		return nil, errors.Errorf("subscriber type '%s' does not support digests", d.Subscriber.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notification{
+		ID:         d.id(),
+		Subscriber: d.Subscriber,
+		Payload:    payload,
+	}, nil
+}
+
+// id returns the ID of the digest's combined notification.
+func (d *Digest) id() string {
+	ids := make([]string, 0, len(d.Notifications))
+	for _, n := range d.Notifications {
+		ids = append(ids, n.ID)
+	}
+	sort.Strings(ids)
+
+	hash := sha256.New()
+	for _, id := range ids {
+		// Terminate each ID so that different sets of IDs can't hash the
+		// same.
+		_, _ = hash.Write([]byte(id + "\x00"))
+	}
+	return fmt.Sprintf("digest-%s", hex.EncodeToString(hash.Sum(nil)))
+}
+
+func (d *Digest) summary() string {
+	return fmt.Sprintf("Evergreen %s digest: %d notification(s)", d.Frequency, len(d.Notifications))
+}
+
+func (d *Digest) emailPayload() (*message.Email, error) {
+	emails := make([]*message.Email, 0, len(d.Notifications))
+	plainText := true
+	for _, n := range d.Notifications {
+		email, ok := n.Payload.(*message.Email)
+		if !ok || email == nil {
+			return nil, errors.Errorf("email payload for notification '%s' is invalid", n.ID)
+		}
+		plainText = plainText && email.PlainTextContents
+		emails = append(emails, email)
+	}
+
+	var body strings.Builder
+	for i, email := range emails {
+		if plainText {
+			if i > 0 {
+				body.WriteString("\n\n----------\n\n")
+			}
+			body.WriteString(email.Subject + "\n\n" + email.Body)
+			continue
+		}
+
+		if i > 0 {
+			body.WriteString("<hr/>\n")
+		}
+		emailBody := email.Body
+		if email.PlainTextContents {
+			emailBody = "<pre>" + html.EscapeString(emailBody) + "</pre>"
+		}
+		body.WriteString(fmt.Sprintf("<h3>%s</h3>\n%s\n", html.EscapeString(email.Subject), emailBody))
+	}
+
+	return &message.Email{
+		Subject:           d.summary(),
+		Body:              body.String(),
+		PlainTextContents: plainText,
+	}, nil
+}
+
+func (d *Digest) slackPayload() (*SlackPayload, error) {
+	lines := []string{d.summary()}
+	var attachments []message.SlackAttachment
+	for _, n := range d.Notifications {
+		payload, ok := n.Payload.(*SlackPayload)
+		if !ok || payload == nil {
+			return nil, errors.Errorf("slack payload for notification '%s' is invalid", n.ID)
+		}
+		lines = append(lines, "• "+payload.Body)
+		attachments = append(attachments, payload.Attachments...)
+	}
+	if len(attachments) > maxDigestSlackAttachments {
+		attachments = attachments[:maxDigestSlackAttachments]
+	}
+
+	return &SlackPayload{
+		Body:        strings.Join(lines, "\n"),
+		Attachments: attachments,
+	}, nil
+}
+
+// MarkDigested marks the notifications as sent in the digest notification with
+// the given ID.
+func MarkDigested(ctx context.Context, ids []string, digestID string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := db.UpdateAllContext(ctx, Collection,
+		bson.M{idKey: bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{
+			sentAtKey:   time.Now().Truncate(time.Millisecond),
+			digestIDKey: digestID,
+		}},
+	)
+
+	return errors.Wrapf(err, "marking notifications as sent in digest '%s'", digestID)
+}
@@ -0,0 +1,148 @@
+package notification
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/mongodb/grip/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigests(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	email := "a@example.com"
+	emailSub := event.Subscriber{Type: event.EmailSubscriberType, Target: &email}
+	slack := "#general"
+	slackSub := event.Subscriber{Type: event.SlackSubscriberType, Target: &slack}
+
+	makeEmail := func(id, digest string, createdAt time.Time) Notification {
+		return Notification{
+			ID:         id,
+			Subscriber: emailSub,
+			Payload:    &message.Email{Subject: "subject " + id, Body: "body " + id, PlainTextContents: true},
+			Digest:     digest,
+			CreatedAt:  createdAt,
+		}
+	}
+	makeSlack := func(id, digest string, createdAt time.Time) Notification {
+		return Notification{
+			ID:         id,
+			Subscriber: slackSub,
+			Payload: &SlackPayload{
+				Body:        "body " + id,
+				Attachments: []message.SlackAttachment{{Title: id}},
+			},
+			Digest:    digest,
+			CreatedAt: createdAt,
+		}
+	}
+
+	t.Run("GroupDueDigests", func(t *testing.T) {
+		digests := GroupDueDigests([]Notification{
+			makeEmail("e2", event.DigestHourly, now.Add(-10*time.Minute)),
+			makeEmail("e1", event.DigestHourly, now.Add(-2*time.Hour)),
+			makeEmail("e3", event.DigestDaily, now.Add(-2*time.Hour)),
+			makeSlack("s1", event.DigestHourly, now.Add(-30*time.Minute)),
+		}, now)
+		require.Len(t, digests, 1, "only the hourly email digest should be due")
+		assert.Equal(t, event.DigestHourly, digests[0].Frequency)
+		require.Len(t, digests[0].Notifications, 2)
+		assert.Equal(t, "e1", digests[0].Notifications[0].ID, "notifications should be sorted oldest first")
+		assert.Equal(t, "e2", digests[0].Notifications[1].ID)
+	})
+	t.Run("EmailNotification", func(t *testing.T) {
+		d := Digest{
+			Subscriber:    emailSub,
+			Frequency:     event.DigestDaily,
+			Notifications: []Notification{makeEmail("e1", event.DigestDaily, now), makeEmail("e2", event.DigestDaily, now)},
+		}
+		n, err := d.Notification()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(n.ID, "digest-"))
+		sameDigest := Digest{
+			Subscriber:    emailSub,
+			Frequency:     event.DigestDaily,
+			Notifications: []Notification{d.Notifications[1], d.Notifications[0]},
+		}
+		sameNotification, err := sameDigest.Notification()
+		require.NoError(t, err)
+		assert.Equal(t, n.ID, sameNotification.ID, "same notifications should produce the same digest ID")
+		partialDigest := Digest{
+			Subscriber:    emailSub,
+			Frequency:     event.DigestDaily,
+			Notifications: d.Notifications[:1],
+		}
+		partialNotification, err := partialDigest.Notification()
+		require.NoError(t, err)
+		assert.NotEqual(t, n.ID, partialNotification.ID, "different notifications should produce a different digest ID")
+		payload, ok := n.Payload.(*message.Email)
+		require.True(t, ok)
+		assert.True(t, payload.PlainTextContents)
+		assert.Contains(t, payload.Subject, "2 notification(s)")
+		assert.Contains(t, payload.Body, "subject e1")
+		assert.Contains(t, payload.Body, "body e2")
+
+		html := makeEmail("e3", event.DigestDaily, now)
+		html.Payload = &message.Email{Subject: "subject e3", Body: "<p>body e3</p>"}
+		d.Notifications = append(d.Notifications, html)
+		n, err = d.Notification()
+		require.NoError(t, err)
+		payload, ok = n.Payload.(*message.Email)
+		require.True(t, ok)
+		assert.False(t, payload.PlainTextContents, "digest should be HTML if any notification is HTML")
+		assert.Contains(t, payload.Body, "<pre>body e1</pre>")
+		assert.Contains(t, payload.Body, "<p>body e3</p>")
+	})
+	t.Run("SlackNotification", func(t *testing.T) {
+		d := Digest{
+			Subscriber:    slackSub,
+			Frequency:     event.DigestHourly,
+			Notifications: []Notification{makeSlack("s1", event.DigestHourly, now), makeSlack("s2", event.DigestHourly, now)},
+		}
+		n, err := d.Notification()
+		require.NoError(t, err)
+		payload, ok := n.Payload.(*SlackPayload)
+		require.True(t, ok)
+		assert.Contains(t, payload.Body, "body s1")
+		assert.Contains(t, payload.Body, "body s2")
+		assert.Len(t, payload.Attachments, 2)
+	})
+	t.Run("FindAndMarkDigested", func(t *testing.T) {
+		require.NoError(t, db.Clear(Collection))
+		defer func() {
+			assert.NoError(t, db.Clear(Collection))
+		}()
+
+		immediate := makeEmail("immediate", "", now)
+		require.NoError(t, InsertMany(t.Context(),
+			immediate,
+			makeEmail("e1", event.DigestHourly, now.Add(-2*time.Hour)),
+			makeSlack("s1", event.DigestHourly, now.Add(-2*time.Hour)),
+		))
+
+		unprocessed, err := FindUnprocessed(t.Context())
+		require.NoError(t, err)
+		require.Len(t, unprocessed, 1, "digest notifications should not be sent immediately")
+		assert.Equal(t, "immediate", unprocessed[0].ID)
+
+		unsent, err := FindUnsentDigestNotifications(t.Context())
+		require.NoError(t, err)
+		assert.Len(t, unsent, 2)
+
+		require.NoError(t, MarkDigested(t.Context(), []string{"e1"}, "digest-e1"))
+		n, err := Find(t.Context(), "e1")
+		require.NoError(t, err)
+		require.NotNil(t, n)
+		assert.Equal(t, "digest-e1", n.DigestID)
+		assert.False(t, n.SentAt.IsZero())
+
+		unsent, err = FindUnsentDigestNotifications(t.Context())
+		require.NoError(t, err)
+		require.Len(t, unsent, 1)
+		assert.Equal(t, "s1", unsent[0].ID)
+	})
+}
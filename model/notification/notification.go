@@ -3,6 +3,7 @@ package notification
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -19,6 +20,10 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 )
 
+// unsignedWebhookRetries is the number of times to retry sending a
+// notification to a third-party webhook.
+const unsignedWebhookRetries = 3
+
 // makeNotificationID creates a string representing the notification generated
 // from the given event, with the given trigger, for the given subscriber.
 // This function will produce an ID that will collide to prevent duplicate
@@ -54,6 +59,16 @@ type Notification struct {
 	SentAt   time.Time            `bson:"sent_at,omitempty"`
 	Error    string               `bson:"error,omitempty"`
 	Metadata NotificationMetadata `bson:"metadata,omitempty"`
+
+	// Digest is how often the notification is sent together with the
+	// subscriber's other notifications. If it's empty, the notification is
+	// sent by itself as soon as it's created.
+	Digest string `bson:"digest,omitempty"`
+	// CreatedAt is when a notification that's sent in a digest was created.
+	CreatedAt time.Time `bson:"created_at,omitempty"`
+	// DigestID is the ID of the digest notification that the notification
+	// was sent in.
+	DigestID string `bson:"digest_id,omitempty"`
 }
 
 type NotificationMetadata struct {
@@ -65,7 +80,7 @@ type NotificationMetadata struct {
 // notification from the evergreen environment
 func (n *Notification) SenderKey() (evergreen.SenderKey, error) {
 	switch n.Subscriber.Type {
-	case event.EvergreenWebhookSubscriberType:
+	case event.EvergreenWebhookSubscriberType, event.TeamsSubscriberType, event.PagerDutySubscriberType:
 		return evergreen.SenderEvergreenWebhook, nil
 
 	case event.EmailSubscriberType:
@@ -179,6 +194,38 @@ func (n *Notification) Composer(ctx context.Context) (message.Composer, error) {
 
 		return message.NewSlackMessage(level.Notice, formattedTarget, payload.Body, payload.Attachments), nil
 
+	case event.TeamsSubscriberType:
+		sub, ok := n.Subscriber.Target.(*event.TeamsSubscriber)
+		if !ok {
+			return nil, errors.New("teams subscriber is invalid")
+		}
+		payload, ok := n.Payload.(*TeamsPayload)
+		if !ok || payload == nil {
+			return nil, errors.New("teams payload is invalid")
+		}
+		body, err := payload.body()
+		if err != nil {
+			return nil, err
+		}
+
+		return util.NewWebhookMessage(n.unsignedWebhook(sub.URL, body)), nil
+
+	case event.PagerDutySubscriberType:
+		sub, ok := n.Subscriber.Target.(*event.PagerDutySubscriber)
+		if !ok {
+			return nil, errors.New("pagerduty subscriber is invalid")
+		}
+		payload, ok := n.Payload.(*PagerDutyPayload)
+		if !ok || payload == nil {
+			return nil, errors.New("pagerduty payload is invalid")
+		}
+		body, err := payload.body(sub.RoutingKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return util.NewWebhookMessage(n.unsignedWebhook(sub.EventsURL(), body)), nil
+
 	case event.GithubPullRequestSubscriberType:
 		sub := n.Subscriber.Target.(*event.GithubPullRequestSubscriber)
 		payload, ok := n.Payload.(*message.GithubStatus)
@@ -217,6 +264,19 @@ func (n *Notification) Composer(ctx context.Context) (message.Composer, error) {
 	}
 }
 
+// unsignedWebhook returns a webhook that posts the JSON body to a third-party
+// service's URL.
+func (n *Notification) unsignedWebhook(url string, body []byte) util.EvergreenWebhook {
+	return util.EvergreenWebhook{
+		NotificationID: n.ID,
+		URL:            url,
+		Body:           body,
+		Headers:        http.Header{"Content-Type": []string{"application/json"}},
+		Retries:        unsignedWebhookRetries,
+		Unsigned:       true,
+	}
+}
+
 func (n *Notification) MarkSent(ctx context.Context) error {
 	if len(n.ID) == 0 {
 		return errors.New("notification has no ID")
@@ -299,6 +359,8 @@ type NotificationStats struct {
 	Slack             int `json:"slack" bson:"slack" yaml:"slack"`
 	GithubCheck       int `json:"github_check" bson:"github_check" yaml:"github_check"`
 	GithubMerge       int `json:"github_merge" bson:"github_merge" yaml:"github_merge"`
+	Teams             int `json:"teams" bson:"teams" yaml:"teams"`
+	PagerDuty         int `json:"pagerduty" bson:"pagerduty" yaml:"pagerduty"`
 }
 
 func CollectUnsentNotificationStats(ctx context.Context) (*NotificationStats, error) {
@@ -358,6 +420,12 @@ func CollectUnsentNotificationStats(ctx context.Context) (*NotificationStats, er
 		case event.SlackSubscriberType:
 			nStats.Slack = data.Count
 
+		case event.TeamsSubscriberType:
+			nStats.Teams = data.Count
+
+		case event.PagerDutySubscriberType:
+			nStats.PagerDuty = data.Count
+
 		default:
 			grip.Error(message.Fields{
 				"message": fmt.Sprintf("unknown subscriber '%s'", data.Key),
@@ -355,6 +355,77 @@ func (s *notificationSuite) TestSlackPayload() {
 	s.True(c.Loggable())
 }
 
+func (s *notificationSuite) TestTeamsPayload() {
+	s.n.ID = "1"
+	s.n.Subscriber.Type = event.TeamsSubscriberType
+	s.n.Subscriber.Target = &event.TeamsSubscriber{URL: "https://example.com/webhook"}
+	s.n.Payload = &TeamsPayload{
+		Title: "title",
+		Text:  "text",
+		URL:   "https://evergreen.example.com/task/1",
+		Color: "#ce3c3e",
+	}
+
+	s.NoError(InsertMany(s.T().Context(), s.n))
+
+	n, err := Find(s.T().Context(), s.n.ID)
+	s.NoError(err)
+	s.Require().NotNil(n)
+	s.Equal(s.n, *n)
+
+	c, err := n.Composer(s.T().Context())
+	s.NoError(err)
+	s.Require().NotNil(c)
+	s.True(c.Loggable())
+	webhook, ok := c.Raw().(*util.EvergreenWebhook)
+	s.Require().True(ok)
+	s.True(webhook.Unsigned)
+	s.Equal("https://example.com/webhook", webhook.URL)
+	s.Contains(string(webhook.Body), `"@type":"MessageCard"`)
+	s.Contains(string(webhook.Body), "https://evergreen.example.com/task/1")
+}
+
+func (s *notificationSuite) TestPagerDutyPayload() {
+	s.n.ID = "1"
+	s.n.Subscriber.Type = event.PagerDutySubscriberType
+	s.n.Subscriber.Target = &event.PagerDutySubscriber{RoutingKey: "routing-key"}
+	s.n.Payload = &PagerDutyPayload{
+		Action:   PagerDutyActionTrigger,
+		DedupKey: "dedup",
+		Summary:  "summary",
+		Source:   "project",
+		Severity: "error",
+	}
+
+	s.NoError(InsertMany(s.T().Context(), s.n))
+
+	n, err := Find(s.T().Context(), s.n.ID)
+	s.NoError(err)
+	s.Require().NotNil(n)
+	s.Equal(s.n, *n)
+
+	c, err := n.Composer(s.T().Context())
+	s.NoError(err)
+	s.Require().NotNil(c)
+	s.True(c.Loggable())
+	webhook, ok := c.Raw().(*util.EvergreenWebhook)
+	s.Require().True(ok)
+	s.Equal(event.DefaultPagerDutyEventsURL, webhook.URL)
+	s.Contains(string(webhook.Body), `"routing_key":"routing-key"`)
+	s.Contains(string(webhook.Body), `"event_action":"trigger"`)
+	s.Contains(string(webhook.Body), `"dedup_key":"dedup"`)
+	s.Contains(string(webhook.Body), `"summary":"summary"`)
+
+	n.Payload = &PagerDutyPayload{Action: PagerDutyActionResolve, DedupKey: "dedup"}
+	c, err = n.Composer(s.T().Context())
+	s.NoError(err)
+	s.Require().NotNil(c)
+	webhook, ok = c.Raw().(*util.EvergreenWebhook)
+	s.Require().True(ok)
+	s.Contains(string(webhook.Body), `"event_action":"resolve"`)
+	s.NotContains(string(webhook.Body), `"payload"`)
+}
+
 func (s *notificationSuite) TestGithubPayload() {
 	s.n.ID = "1"
 	s.n.Subscriber.Type = event.GithubPullRequestSubscriberType
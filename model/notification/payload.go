@@ -1,8 +1,121 @@
 package notification
 
-import "github.com/mongodb/grip/message"
+import (
+	"encoding/json"
+
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	// PagerDutyActionTrigger opens an incident, or adds to the open incident
+	// with the same deduplication key.
+	PagerDutyActionTrigger = "trigger"
+	// PagerDutyActionResolve resolves the open incident with the same
+	// deduplication key.
+	PagerDutyActionResolve = "resolve"
+)
 
 type SlackPayload struct {
 	Body        string                    `bson:"body"`
 	Attachments []message.SlackAttachment `bson:"attachments"`
 }
+
+// TeamsPayload is a message to post to a chat channel's incoming webhook.
+type TeamsPayload struct {
+	Title string `bson:"title"`
+	Text  string `bson:"text"`
+	URL   string `bson:"url"`
+	Color string `bson:"color"`
+}
+
+// body returns the message as a JSON message card.
+func (p *TeamsPayload) body() ([]byte, error) {
+	type openURITarget struct {
+		OS  string `json:"os"`
+		URI string `json:"uri"`
+	}
+	type action struct {
+		Type    string          `json:"@type"`
+		Name    string          `json:"name"`
+		Targets []openURITarget `json:"targets"`
+	}
+	card := struct {
+		Type            string   `json:"@type"`
+		Context         string   `json:"@context"`
+		Summary         string   `json:"summary"`
+		ThemeColor      string   `json:"themeColor,omitempty"`
+		Title           string   `json:"title"`
+		Text            string   `json:"text"`
+		PotentialAction []action `json:"potentialAction,omitempty"`
+	}{
+		Type:       "MessageCard",
+		Context:    "https://schema.org/extensions",
+		Summary:    p.Title,
+		ThemeColor: p.Color,
+		Title:      p.Title,
+		Text:       p.Text,
+	}
+	if p.URL != "" {
+		card.PotentialAction = []action{{
+			Type:    "OpenUri",
+			Name:    "View in Evergreen",
+			Targets: []openURITarget{{OS: "default", URI: p.URL}},
+		}}
+	}
+
+	body, err := json.Marshal(card)
+	return body, errors.Wrap(err, "marshalling message card")
+}
+
+// PagerDutyPayload is an event to send to an incident paging service. Events
+// with the same deduplication key refer to the same incident.
+type PagerDutyPayload struct {
+	Action   string `bson:"action"`
+	DedupKey string `bson:"dedup_key"`
+	Summary  string `bson:"summary"`
+	Source   string `bson:"source"`
+	Severity string `bson:"severity"`
+	URL      string `bson:"url"`
+}
+
+// body returns the event in the format of the PagerDuty Events API v2.
+func (p *PagerDutyPayload) body(routingKey string) ([]byte, error) {
+	type link struct {
+		Href string `json:"href"`
+		Text string `json:"text"`
+	}
+	type eventPayload struct {
+		Summary  string `json:"summary"`
+		Source   string `json:"source"`
+		Severity string `json:"severity"`
+	}
+	pdEvent := struct {
+		RoutingKey  string        `json:"routing_key"`
+		EventAction string        `json:"event_action"`
+		DedupKey    string        `json:"dedup_key"`
+		Payload     *eventPayload `json:"payload,omitempty"`
+		Client      string        `json:"client"`
+		ClientURL   string        `json:"client_url,omitempty"`
+		Links       []link        `json:"links,omitempty"`
+	}{
+		RoutingKey:  routingKey,
+		EventAction: p.Action,
+		DedupKey:    p.DedupKey,
+		Client:      "Evergreen",
+		ClientURL:   p.URL,
+	}
+	if p.Action == PagerDutyActionTrigger {
+		pdEvent.Payload = &eventPayload{
+			Summary:  p.Summary,
+			Source:   p.Source,
+			Severity: p.Severity,
+		}
+	}
+	if p.URL != "" {
+		pdEvent.Links = []link{{Href: p.URL, Text: "View in Evergreen"}}
+	}
+
+	body, err := json.Marshal(pdEvent)
+	return body, errors.Wrap(err, "marshalling PagerDuty event")
+}
@@ -46,6 +46,7 @@ var (
 	PatchedProjectConfigKey = bsonutil.MustHaveTag(Patch{}, "PatchedProjectConfig")
 	AliasKey                = bsonutil.MustHaveTag(Patch{}, "Alias")
 	githubMergeDataKey      = bsonutil.MustHaveTag(Patch{}, "GithubMergeData")
+	mergeQueueBisectKey     = bsonutil.MustHaveTag(Patch{}, "MergeQueueBisect")
 	githubPatchDataKey      = bsonutil.MustHaveTag(Patch{}, "GithubPatchData")
 	MergePatchKey           = bsonutil.MustHaveTag(Patch{}, "MergePatch")
 	TriggersKey             = bsonutil.MustHaveTag(Patch{}, "Triggers")
@@ -2,6 +2,7 @@ package patch
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -63,6 +64,10 @@ type githubMergeIntent struct {
 
 	// Repo is the GitHub repository name
 	Repo string `bson:"repo"`
+
+	// BisectStep is set if the intent is for a patch that tests part of a
+	// failed merge queue batch rather than a merge group from GitHub.
+	BisectStep *MergeQueueBisectStep `bson:"bisect_step,omitempty"`
 }
 
 // NewGithubIntent creates an Intent from a google/go-github MergeGroup.
@@ -125,6 +130,39 @@ func NewGithubMergeIntent(msgDeliveryID string, caller string, mg *github.MergeG
 	}, nil
 }
 
+// NewGithubMergeBisectIntent creates an intent for a patch that tests the
+// commits of a failed merge queue batch up to and including the commit at the
+// given index. The patch checks out the commit by its SHA rather than the
+// merge group's branch, since GitHub deletes the branch once the merge group
+// fails.
+func NewGithubMergeBisectIntent(batch *Patch, commitIndex int) (Intent, error) {
+	commit, err := batch.mergeQueueBisectCommit(commitIndex)
+	if err != nil {
+		return nil, err
+	}
+	if batch.GithubMergeData.HeadBranch == "" {
+		return nil, errors.Errorf("merge queue patch '%s' has no head branch", batch.Id.Hex())
+	}
+
+	id := fmt.Sprintf("%s-bisect-%d", batch.Id.Hex(), commitIndex)
+	return &githubMergeIntent{
+		DocumentID: id,
+		MsgID:      id,
+		IntentType: GithubMergeIntentType,
+		Org:        batch.GithubMergeData.Org,
+		Repo:       batch.GithubMergeData.Repo,
+		HeadRef:    "refs/heads/" + batch.GithubMergeData.HeadBranch,
+		HeadSHA:    commit.SHA,
+		HeadCommit: commit.Title,
+		BaseSHA:    batch.Githash,
+		CalledBy:   AutomatedCaller,
+		BisectStep: &MergeQueueBisectStep{
+			BatchPatchID: batch.Id.Hex(),
+			CommitIndex:  commitIndex,
+		},
+	}, nil
+}
+
 // SetProcessed should be called by an amboy queue after creating a patch from an intent.
 func (g *githubMergeIntent) SetProcessed(ctx context.Context) error {
 	g.Processed = true
@@ -208,6 +246,11 @@ func (g *githubMergeIntent) NewPatch() *Patch {
 	ghReadOnlyQueue := split[2]
 	lastElement := split[len(split)-1]
 	headBranch := strings.Join([]string{ghReadOnlyQueue, baseBranch, lastElement}, "/")
+	if g.BisectStep != nil {
+		// The merge group's branch is deleted when the merge group fails,
+		// so patches that bisect it check out the commit by its SHA.
+		headBranch = ""
+	}
 
 	patchDoc := &Patch{
 		Id:      mgobson.NewObjectId(),
@@ -223,6 +266,7 @@ func (g *githubMergeIntent) NewPatch() *Patch {
 			HeadSHA:    g.HeadSHA,
 			HeadCommit: g.HeadCommit,
 		},
+		MergeQueueBisectStep: g.BisectStep,
 	}
 	return patchDoc
 }
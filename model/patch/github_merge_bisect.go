@@ -0,0 +1,249 @@
+package patch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Statuses of a GitHub merge queue bisection.
+const (
+	// MergeQueueBisectPending indicates that the patch for the next commit
+	// to test has not been created yet.
+	MergeQueueBisectPending = "pending"
+	// MergeQueueBisectRunning indicates that the patch for the next commit
+	// to test is running.
+	MergeQueueBisectRunning = "running"
+	// MergeQueueBisectFound indicates that the commit that caused the batch
+	// to fail has been found.
+	MergeQueueBisectFound = "found"
+	// MergeQueueBisectFailed indicates that the bisection could not continue.
+	MergeQueueBisectFailed = "failed"
+	// MergeQueueBisectInconclusive indicates that a step of the bisection
+	// failed for a reason other than a task or test failure, such as a system
+	// or setup failure, so it doesn't show whether the commit is at fault.
+	MergeQueueBisectInconclusive = "inconclusive"
+)
+
+// MergeQueueBisectInfo is the state of the bisection of a failed GitHub merge
+// queue batch to find the PR that caused it to fail. Like bisect stepback,
+// each step tests the commit halfway between the last commit known to pass and
+// the last commit known to fail, until they're adjacent.
+//
+// Commits are identified by their index in the merge group's commits. Index -1
+// is the base of the merge group, which is assumed to pass, and the last index
+// is the head of the whole batch, which is known to fail.
+type MergeQueueBisectInfo struct {
+	Status           string `bson:"status"`
+	LastPassingIndex int    `bson:"last_passing_index"`
+	LastFailingIndex int    `bson:"last_failing_index"`
+	// NextIndex is the index of the commit that's being tested or that
+	// should be tested next.
+	NextIndex int `bson:"next_index"`
+	// NextPatchID is the ID of the patch testing the commit at NextIndex.
+	NextPatchID string `bson:"next_patch_id,omitempty"`
+	// PatchIDs are the IDs of all the patches created for the bisection.
+	PatchIDs []string `bson:"patch_ids,omitempty"`
+}
+
+// MergeQueueBisectStep identifies a patch that tests part of a failed GitHub
+// merge queue batch.
+type MergeQueueBisectStep struct {
+	// BatchPatchID is the ID of the patch for the whole batch.
+	BatchPatchID string `bson:"batch_patch_id"`
+	// CommitIndex is the index of the commit in the batch that the patch
+	// tests, along with every commit before it.
+	CommitIndex int `bson:"commit_index"`
+}
+
+var (
+	mergeQueueBisectStatusKey      = bsonutil.MustHaveTag(MergeQueueBisectInfo{}, "Status")
+	mergeQueueBisectNextIndexKey   = bsonutil.MustHaveTag(MergeQueueBisectInfo{}, "NextIndex")
+	mergeQueueBisectNextPatchIDKey = bsonutil.MustHaveTag(MergeQueueBisectInfo{}, "NextPatchID")
+	mergeQueueBisectPatchIDsKey    = bsonutil.MustHaveTag(MergeQueueBisectInfo{}, "PatchIDs")
+)
+
+// NewMergeQueueBisectInfo returns the initial bisection state for a failed
+// batch with the given number of commits.
+func NewMergeQueueBisectInfo(numCommits int) MergeQueueBisectInfo {
+	info := MergeQueueBisectInfo{
+		LastPassingIndex: -1,
+		LastFailingIndex: numCommits - 1,
+	}
+	info.next()
+	return info
+}
+
+// AddResult records whether the patch testing the commit at the given index
+// passed and moves on to the next step.
+func (b *MergeQueueBisectInfo) AddResult(index int, passed bool) {
+	if passed {
+		b.LastPassingIndex = index
+	} else {
+		b.LastFailingIndex = index
+	}
+	b.next()
+}
+
+// SetInconclusive stops the bisection because the patch testing the commit at
+// NextIndex failed without a task or test failure.
+func (b *MergeQueueBisectInfo) SetInconclusive() {
+	b.Status = MergeQueueBisectInconclusive
+	b.NextPatchID = ""
+}
+
+func (b *MergeQueueBisectInfo) next() {
+	b.NextPatchID = ""
+	if b.LastFailingIndex <= b.LastPassingIndex+1 {
+		b.Status = MergeQueueBisectFound
+		b.NextIndex = b.LastFailingIndex
+		return
+	}
+	b.Status = MergeQueueBisectPending
+	b.NextIndex = (b.LastPassingIndex + b.LastFailingIndex) / 2
+}
+
+// CulpritIndex returns the index of the commit that caused the batch to fail,
+// or -1 if it has not been found.
+func (b *MergeQueueBisectInfo) CulpritIndex() int {
+	if b.Status != MergeQueueBisectFound {
+		return -1
+	}
+	return b.LastFailingIndex
+}
+
+// CanBisectMergeQueueBatch returns whether the patch is for a GitHub merge
+// queue batch that has more than one PR and has not already been bisected.
+func (p *Patch) CanBisectMergeQueueBatch() bool {
+	return p.IsMergeQueuePatch() && p.MergeQueueBisectStep == nil && p.MergeQueueBisect == nil && len(p.GithubMergeData.Commits) > 1
+}
+
+// MergeQueueBisectDescription returns a short description of the result of
+// the bisection of the patch's batch, suitable for a GitHub status.
+func (p *Patch) MergeQueueBisectDescription() string {
+	if p.MergeQueueBisect == nil {
+		return ""
+	}
+	if p.MergeQueueBisect.Status == MergeQueueBisectInconclusive {
+		return "could not determine which PR caused the merge group to fail due to a system or setup failure"
+	}
+	culpritIndex := p.MergeQueueBisect.CulpritIndex()
+	if culpritIndex < 0 || culpritIndex >= len(p.GithubMergeData.Commits) {
+		return "could not determine which PR caused the merge group to fail"
+	}
+	culprit := p.GithubMergeData.Commits[culpritIndex]
+	sha := culprit.SHA
+	if len(sha) > 7 {
+		sha = sha[:7]
+	}
+	if culprit.PRNumber > 0 {
+		return fmt.Sprintf("PR #%d (%s) caused the merge group to fail", culprit.PRNumber, sha)
+	}
+	return fmt.Sprintf("commit %s caused the merge group to fail", sha)
+}
+
+// UpdateMergeQueueBisect sets the bisection state of the patch if the
+// bisection is still waiting on the given step patch. It returns false if the
+// bisection has already moved on.
+func (p *Patch) UpdateMergeQueueBisect(ctx context.Context, stepPatchID string, info MergeQueueBisectInfo) (bool, error) {
+	res, err := evergreen.GetEnvironment().DB().Collection(Collection).UpdateOne(ctx,
+		bson.M{
+			IdKey: p.Id,
+			bsonutil.GetDottedKeyName(mergeQueueBisectKey, mergeQueueBisectNextPatchIDKey): stepPatchID,
+		},
+		bson.M{"$set": bson.M{mergeQueueBisectKey: info}},
+	)
+	if err != nil {
+		return false, errors.Wrapf(err, "updating merge queue bisect for patch '%s'", p.Id.Hex())
+	}
+	if res.ModifiedCount == 0 {
+		return false, nil
+	}
+	p.MergeQueueBisect = &info
+	return true, nil
+}
+
+// SetMergeQueueBisectFailed records that the bisection of the patch's batch
+// could not continue.
+func (p *Patch) SetMergeQueueBisectFailed(ctx context.Context) error {
+	err := UpdateOne(ctx, bson.M{IdKey: p.Id}, bson.M{"$set": bson.M{
+		bsonutil.GetDottedKeyName(mergeQueueBisectKey, mergeQueueBisectStatusKey): MergeQueueBisectFailed,
+	}})
+	if err != nil {
+		return errors.Wrapf(err, "setting merge queue bisect failed for patch '%s'", p.Id.Hex())
+	}
+	if p.MergeQueueBisect != nil {
+		p.MergeQueueBisect.Status = MergeQueueBisectFailed
+	}
+	return nil
+}
+
+// StartMergeQueueBisect begins the bisection of the patch's failed batch. It
+// returns false if the bisection was already started.
+func (p *Patch) StartMergeQueueBisect(ctx context.Context) (bool, error) {
+	info := NewMergeQueueBisectInfo(len(p.GithubMergeData.Commits))
+	res, err := evergreen.GetEnvironment().DB().Collection(Collection).UpdateOne(ctx,
+		bson.M{
+			IdKey:               p.Id,
+			mergeQueueBisectKey: bson.M{"$exists": false},
+		},
+		bson.M{"$set": bson.M{mergeQueueBisectKey: info}},
+	)
+	if err != nil {
+		return false, errors.Wrapf(err, "starting merge queue bisect for patch '%s'", p.Id.Hex())
+	}
+	if res.ModifiedCount == 0 {
+		return false, nil
+	}
+	p.MergeQueueBisect = &info
+	return true, nil
+}
+
+// SetMergeQueueBisectRunning records that the patch testing the next commit
+// of the bisection was created. It errors if the bisection has moved on from
+// the given commit index.
+func (p *Patch) SetMergeQueueBisectRunning(ctx context.Context, index int, patchID string) error {
+	res, err := evergreen.GetEnvironment().DB().Collection(Collection).UpdateOne(ctx,
+		bson.M{
+			IdKey: p.Id,
+			bsonutil.GetDottedKeyName(mergeQueueBisectKey, mergeQueueBisectStatusKey):    MergeQueueBisectPending,
+			bsonutil.GetDottedKeyName(mergeQueueBisectKey, mergeQueueBisectNextIndexKey): index,
+		},
+		bson.M{
+			"$set": bson.M{
+				bsonutil.GetDottedKeyName(mergeQueueBisectKey, mergeQueueBisectStatusKey):      MergeQueueBisectRunning,
+				bsonutil.GetDottedKeyName(mergeQueueBisectKey, mergeQueueBisectNextPatchIDKey): patchID,
+			},
+			"$push": bson.M{bsonutil.GetDottedKeyName(mergeQueueBisectKey, mergeQueueBisectPatchIDsKey): patchID},
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(err, "setting merge queue bisect running for patch '%s'", p.Id.Hex())
+	}
+	if res.ModifiedCount == 0 {
+		return errors.Errorf("merge queue bisect for patch '%s' is not pending commit index %d", p.Id.Hex(), index)
+	}
+	return nil
+}
+
+// FindMergeQueuePatchesPendingBisect returns the merge queue batch patches
+// whose bisection is waiting for the patch for the next commit to be created.
+func FindMergeQueuePatchesPendingBisect(ctx context.Context) ([]Patch, error) {
+	return Find(ctx, db.Query(bson.M{
+		bsonutil.GetDottedKeyName(mergeQueueBisectKey, mergeQueueBisectStatusKey): MergeQueueBisectPending,
+	}).Project(ExcludePatchDiff))
+}
+
+// mergeQueueBisectCommit returns the commit of the batch at the given index.
+func (p *Patch) mergeQueueBisectCommit(index int) (thirdparty.GithubMergeGroupCommit, error) {
+	if index < 0 || index >= len(p.GithubMergeData.Commits) {
+		return thirdparty.GithubMergeGroupCommit{}, errors.Errorf("commit index %d is out of range for merge group with %d commits", index, len(p.GithubMergeData.Commits))
+	}
+	return p.GithubMergeData.Commits[index], nil
+}
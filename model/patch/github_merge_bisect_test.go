@@ -0,0 +1,120 @@
+package patch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeQueueBisectInfo(t *testing.T) {
+	t.Run("FindsFirstFailingCommit", func(t *testing.T) {
+		for culprit := 0; culprit < 5; culprit++ {
+			info := NewMergeQueueBisectInfo(5)
+			var numSteps int
+			for info.Status == MergeQueueBisectPending {
+				numSteps++
+				require.LessOrEqual(t, numSteps, 3, "should bisect in logarithmic steps")
+				info.AddResult(info.NextIndex, info.NextIndex < culprit)
+			}
+			assert.Equal(t, MergeQueueBisectFound, info.Status)
+			assert.Equal(t, culprit, info.CulpritIndex())
+		}
+	})
+	t.Run("StartsHalfwayThroughBatch", func(t *testing.T) {
+		info := NewMergeQueueBisectInfo(4)
+		assert.Equal(t, MergeQueueBisectPending, info.Status)
+		assert.Equal(t, -1, info.LastPassingIndex)
+		assert.Equal(t, 3, info.LastFailingIndex)
+		assert.Equal(t, 1, info.NextIndex)
+		assert.Equal(t, -1, info.CulpritIndex())
+	})
+}
+
+func TestMergeQueueBisectPatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, db.ClearCollections(Collection, IntentCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(Collection, IntentCollection))
+	}()
+
+	batch := &Patch{
+		Id:      mgobson.NewObjectId(),
+		Githash: "base",
+		GithubMergeData: thirdparty.GithubMergeGroup{
+			Org:        "evergreen-ci",
+			Repo:       "evergreen",
+			BaseBranch: "main",
+			HeadBranch: "gh-readonly-queue/main/pr-3-head",
+			HeadSHA:    "sha2",
+			Commits: []thirdparty.GithubMergeGroupCommit{
+				{SHA: "sha0", Title: "First (#1)", PRNumber: 1},
+				{SHA: "sha1234567", Title: "Second (#2)", PRNumber: 2},
+				{SHA: "sha2", Title: "Third (#3)", PRNumber: 3},
+			},
+		},
+	}
+	require.NoError(t, batch.Insert(ctx))
+	require.True(t, batch.CanBisectMergeQueueBatch())
+
+	started, err := batch.StartMergeQueueBisect(ctx)
+	require.NoError(t, err)
+	require.True(t, started)
+	assert.False(t, batch.CanBisectMergeQueueBatch())
+	started, err = batch.StartMergeQueueBisect(ctx)
+	require.NoError(t, err)
+	assert.False(t, started, "should not restart bisect")
+
+	pending, err := FindMergeQueuePatchesPendingBisect(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, batch.Id, pending[0].Id)
+	assert.Equal(t, 0, pending[0].MergeQueueBisect.NextIndex)
+
+	intent, err := NewGithubMergeBisectIntent(batch, 0)
+	require.NoError(t, err)
+	stepPatch := intent.NewPatch()
+	assert.Equal(t, "base", stepPatch.Githash)
+	assert.Equal(t, "sha0", stepPatch.GithubMergeData.HeadSHA)
+	assert.Equal(t, "main", stepPatch.GithubMergeData.BaseBranch)
+	assert.Empty(t, stepPatch.GithubMergeData.HeadBranch, "should check out the commit instead of the merge group's branch")
+	require.NotNil(t, stepPatch.MergeQueueBisectStep)
+	assert.Equal(t, batch.Id.Hex(), stepPatch.MergeQueueBisectStep.BatchPatchID)
+	_, err = NewGithubMergeBisectIntent(batch, 3)
+	assert.Error(t, err, "commit index should be in range")
+
+	assert.Error(t, batch.SetMergeQueueBisectRunning(ctx, 1, "step0"), "should not run a commit that isn't next")
+	require.NoError(t, batch.SetMergeQueueBisectRunning(ctx, 0, "step0"))
+	pending, err = FindMergeQueuePatchesPendingBisect(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	dbBatch, err := FindOneId(ctx, batch.Id.Hex())
+	require.NoError(t, err)
+	info := *dbBatch.MergeQueueBisect
+	info.AddResult(0, true)
+	updated, err := dbBatch.UpdateMergeQueueBisect(ctx, "other", info)
+	require.NoError(t, err)
+	assert.False(t, updated, "should not update for a step patch that isn't running")
+	updated, err = dbBatch.UpdateMergeQueueBisect(ctx, "step0", info)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	dbBatch, err = FindOneId(ctx, batch.Id.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, MergeQueueBisectPending, dbBatch.MergeQueueBisect.Status)
+	assert.Equal(t, 1, dbBatch.MergeQueueBisect.NextIndex)
+	assert.Equal(t, []string{"step0"}, dbBatch.MergeQueueBisect.PatchIDs)
+
+	info = *dbBatch.MergeQueueBisect
+	info.AddResult(1, false)
+	assert.Equal(t, MergeQueueBisectFound, info.Status)
+	dbBatch.MergeQueueBisect = &info
+	assert.Equal(t, "PR #2 (sha1234) caused the merge group to fail", dbBatch.MergeQueueBisectDescription())
+}
@@ -28,6 +28,15 @@ type VariantTasks struct {
 	DisplayTasks []DisplayTask `bson:"displaytasks"`
 }
 
+// PathFilteredTask is a task that was not created because none of the changed
+// files matched its changed-path filters.
+type PathFilteredTask struct {
+	Variant string `bson:"variant" json:"variant"`
+	Task    string `bson:"task" json:"task"`
+	// Reason explains which filters excluded the task.
+	Reason string `bson:"reason" json:"reason"`
+}
+
 // MergeVariantsTasks merges two slices of VariantsTasks into a single set.
 func MergeVariantsTasks(vts1, vts2 []VariantTasks) []VariantTasks {
 	bvToVT := map[string]VariantTasks{}
@@ -156,7 +165,16 @@ type Patch struct {
 	MergePatch           string                               `bson:"merge_patch"`
 	GithubPatchData      thirdparty.GithubPatch               `bson:"github_patch_data,omitempty"`
 	GithubMergeData      thirdparty.GithubMergeGroup          `bson:"github_merge_data,omitempty"`
-	GitInfo              *GitMetadata                         `bson:"git_info,omitempty"`
+	// MergeQueueBisect is the state of the bisection of a failed GitHub merge
+	// queue batch. It's only set for the patch of the whole batch.
+	MergeQueueBisect *MergeQueueBisectInfo `bson:"merge_queue_bisect,omitempty"`
+	// MergeQueueBisectStep is only set for the patches that test part of a
+	// failed GitHub merge queue batch.
+	MergeQueueBisectStep *MergeQueueBisectStep `bson:"merge_queue_bisect_step,omitempty"`
+	GitInfo              *GitMetadata          `bson:"git_info,omitempty"`
+	// PathFilteredTasks are the tasks that were not added to the patch because
+	// none of the changed files matched their changed-path filters.
+	PathFilteredTasks []PathFilteredTask `bson:"path_filtered_tasks,omitempty"`
 	// DisplayNewUI is only used when roundtripping the patch via the CLI
 	DisplayNewUI bool `bson:"display_new_ui,omitempty"`
 	// MergeStatus is only used in gitServePatch to send the status of this
@@ -622,6 +622,7 @@ func FinalizePatch(ctx context.Context, p *patch.Patch, requester string) (*Vers
 		Parameters:           params,
 		Activated:            utility.TruePtr(),
 		AuthorEmail:          authorEmail,
+		PathFilteredTasks:    p.PathFilteredTasks,
 	}
 
 	mfst, err := constructManifest(ctx, patchVersion, projectRef, project.Modules)
@@ -0,0 +1,185 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen/model/patch"
+	ignore "github.com/sabhiram/go-gitignore"
+)
+
+// HasPathFilters returns whether the task unit only runs when certain files
+// change.
+func (bvt *BuildVariantTaskUnit) HasPathFilters() bool {
+	return len(bvt.Paths) > 0 || len(bvt.IgnorePaths) > 0
+}
+
+// SkipOnChangedFiles returns whether the task unit should not run because none
+// of the changed files are relevant to it. A changed file is relevant if it
+// matches one of the Paths patterns (or there are no Paths patterns) and it
+// doesn't match any of the IgnorePaths patterns. If the changed files are not
+// known, the task unit is never skipped.
+func (bvt *BuildVariantTaskUnit) SkipOnChangedFiles(files []string) bool {
+	if !bvt.HasPathFilters() || len(files) == 0 {
+		return false
+	}
+
+	// CompileIgnoreLines has a silly API: it always returns a nil error.
+	var includer, ignorer *ignore.GitIgnore
+	if len(bvt.Paths) > 0 {
+		includer = ignore.CompileIgnoreLines(bvt.Paths...)
+	}
+	if len(bvt.IgnorePaths) > 0 {
+		ignorer = ignore.CompileIgnoreLines(bvt.IgnorePaths...)
+	}
+	for _, f := range files {
+		if includer != nil && !includer.MatchesPath(f) {
+			continue
+		}
+		if ignorer != nil && ignorer.MatchesPath(f) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// pathFilterSkipReason explains why the task unit was skipped for the changed
+// files.
+func (bvt *BuildVariantTaskUnit) pathFilterSkipReason() string {
+	var filters []string
+	if len(bvt.Paths) > 0 {
+		filters = append(filters, fmt.Sprintf("paths [%s]", strings.Join(bvt.Paths, ", ")))
+	}
+	if len(bvt.IgnorePaths) > 0 {
+		filters = append(filters, fmt.Sprintf("ignore_paths [%s]", strings.Join(bvt.IgnorePaths, ", ")))
+	}
+	return fmt.Sprintf("no changed files matched %s", strings.Join(filters, " excluding "))
+}
+
+// HasPathFilters returns whether any build variant or task in the project only
+// runs when certain files change.
+func (p *Project) HasPathFilters() bool {
+	for _, bv := range p.BuildVariants {
+		if len(bv.Paths) > 0 || len(bv.IgnorePaths) > 0 {
+			return true
+		}
+		for _, bvt := range bv.Tasks {
+			if bvt.HasPathFilters() {
+				return true
+			}
+		}
+	}
+	for _, t := range p.Tasks {
+		if len(t.Paths) > 0 || len(t.IgnorePaths) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterVariantsTasksByChangedFiles removes the tasks that should not run for
+// the changed files from the variants and tasks, except for the requested
+// tasks, which always run. It returns the remaining variants and tasks along
+// with the tasks that were removed. Display tasks are removed if all of their
+// execution tasks were removed.
+func (p *Project) FilterVariantsTasksByChangedFiles(vts []patch.VariantTasks, files []string, requested map[TVPair]bool) ([]patch.VariantTasks, []patch.PathFilteredTask) {
+	if len(files) == 0 || !p.HasPathFilters() {
+		return vts, nil
+	}
+
+	var filtered []patch.PathFilteredTask
+	remaining := make([]patch.VariantTasks, 0, len(vts))
+	for _, vt := range vts {
+		removed := map[string]bool{}
+		tasks := make([]string, 0, len(vt.Tasks))
+		for _, t := range vt.Tasks {
+			if requested[TVPair{Variant: vt.Variant, TaskName: t}] {
+				tasks = append(tasks, t)
+				continue
+			}
+			bvt := p.FindTaskForVariant(t, vt.Variant)
+			if bvt != nil && bvt.SkipOnChangedFiles(files) {
+				removed[t] = true
+				filtered = append(filtered, patch.PathFilteredTask{
+					Variant: vt.Variant,
+					Task:    t,
+					Reason:  bvt.pathFilterSkipReason(),
+				})
+				continue
+			}
+			tasks = append(tasks, t)
+		}
+
+		displayTasks := make([]patch.DisplayTask, 0, len(vt.DisplayTasks))
+		for _, dt := range vt.DisplayTasks {
+			execTasks := make([]string, 0, len(dt.ExecTasks))
+			for _, et := range dt.ExecTasks {
+				if !removed[et] {
+					execTasks = append(execTasks, et)
+				}
+			}
+			if len(dt.ExecTasks) > 0 && len(execTasks) == 0 {
+				continue
+			}
+			dt.ExecTasks = execTasks
+			displayTasks = append(displayTasks, dt)
+		}
+
+		if len(tasks) == 0 && len(displayTasks) == 0 {
+			continue
+		}
+		vt.Tasks = tasks
+		vt.DisplayTasks = displayTasks
+		remaining = append(remaining, vt)
+	}
+	return remaining, filtered
+}
+
+// PathFilteredTasks returns the tasks in the project that would otherwise run
+// for the requester but should not run for the changed files.
+func (p *Project) PathFilteredTasks(files []string, requester string) []patch.PathFilteredTask {
+	filtered, _ := p.filterTasksByChangedFiles(files, requester)
+	return filtered
+}
+
+// PathFiltersSkipAllTasks returns whether none of the tasks that would
+// otherwise run for the requester should run for the changed files.
+func (p *Project) PathFiltersSkipAllTasks(files []string, requester string) bool {
+	filtered, numRunnable := p.filterTasksByChangedFiles(files, requester)
+	return numRunnable > 0 && len(filtered) == numRunnable
+}
+
+// filterTasksByChangedFiles returns the tasks that should not run for the
+// changed files, along with the number of tasks that would otherwise run for
+// the requester.
+func (p *Project) filterTasksByChangedFiles(files []string, requester string) ([]patch.PathFilteredTask, int) {
+	if len(files) == 0 || !p.HasPathFilters() {
+		return nil, 0
+	}
+
+	var filtered []patch.PathFilteredTask
+	numRunnable := 0
+	for _, bv := range p.BuildVariants {
+		for _, bvt := range bv.Tasks {
+			units := []BuildVariantTaskUnit{bvt}
+			if bvt.IsGroup {
+				units = p.tasksFromGroup(bvt)
+			}
+			for _, unit := range units {
+				if unit.IsDisabled() || unit.SkipOnRequester(requester) {
+					continue
+				}
+				numRunnable++
+				if unit.SkipOnChangedFiles(files) {
+					filtered = append(filtered, patch.PathFilteredTask{
+						Variant: bv.Name,
+						Task:    unit.Name,
+						Reason:  unit.pathFilterSkipReason(),
+					})
+				}
+			}
+		}
+	}
+	return filtered, numRunnable
+}
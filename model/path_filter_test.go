@@ -0,0 +1,217 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkipOnChangedFiles(t *testing.T) {
+	for tName, tCase := range map[string]struct {
+		bvt          BuildVariantTaskUnit
+		files        []string
+		expectedSkip bool
+	}{
+		"NoFiltersNeverSkips": {
+			bvt:   BuildVariantTaskUnit{},
+			files: []string{"docs/README.md"},
+		},
+		"UnknownChangedFilesNeverSkips": {
+			bvt: BuildVariantTaskUnit{Paths: []string{"src/"}},
+		},
+		"MatchingPathRuns": {
+			bvt:   BuildVariantTaskUnit{Paths: []string{"src/"}},
+			files: []string{"docs/README.md", "src/main.go"},
+		},
+		"NoMatchingPathSkips": {
+			bvt:          BuildVariantTaskUnit{Paths: []string{"src/"}},
+			files:        []string{"docs/README.md"},
+			expectedSkip: true,
+		},
+		"AllIgnoredPathsSkips": {
+			bvt:          BuildVariantTaskUnit{IgnorePaths: []string{"*.md"}},
+			files:        []string{"docs/README.md", "CHANGELOG.md"},
+			expectedSkip: true,
+		},
+		"SomeUnignoredPathRuns": {
+			bvt:   BuildVariantTaskUnit{IgnorePaths: []string{"*.md"}},
+			files: []string{"docs/README.md", "main.go"},
+		},
+		"MatchingPathThatIsIgnoredSkips": {
+			bvt:          BuildVariantTaskUnit{Paths: []string{"src/"}, IgnorePaths: []string{"*_test.go"}},
+			files:        []string{"src/main_test.go", "docs/README.md"},
+			expectedSkip: true,
+		},
+		"MatchingPathThatIsNotIgnoredRuns": {
+			bvt:   BuildVariantTaskUnit{Paths: []string{"src/"}, IgnorePaths: []string{"*_test.go"}},
+			files: []string{"src/main_test.go", "src/main.go"},
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			assert.Equal(t, tCase.expectedSkip, tCase.bvt.SkipOnChangedFiles(tCase.files))
+		})
+	}
+}
+
+func TestPathFilters(t *testing.T) {
+	projYAML := `
+tasks:
+  - name: compile
+  - name: docs
+    paths:
+      - "docs/"
+  - name: lint
+    ignore_paths:
+      - "*.md"
+  - name: group_task
+task_groups:
+  - name: group
+    tasks:
+      - group_task
+buildvariants:
+  - name: backend
+    run_on:
+      - localhost
+    paths:
+      - "backend/"
+    tasks:
+      - name: compile
+      - name: lint
+      - name: group
+  - name: everything
+    run_on:
+      - localhost
+    tasks:
+      - name: compile
+      - name: docs
+      - name: lint
+        paths:
+          - "frontend/"
+    display_tasks:
+      - name: display
+        execution_tasks:
+          - docs
+`
+	p := &Project{}
+	_, err := LoadProjectInto(t.Context(), []byte(projYAML), nil, "", p)
+	require.NoError(t, err)
+	require.True(t, p.HasPathFilters())
+
+	t.Run("Translation", func(t *testing.T) {
+		compile := p.FindTaskForVariant("compile", "backend")
+		require.NotNil(t, compile)
+		assert.Equal(t, []string{"backend/"}, compile.Paths, "task should inherit build variant paths")
+
+		lint := p.FindTaskForVariant("lint", "backend")
+		require.NotNil(t, lint)
+		assert.Equal(t, []string{"backend/"}, lint.Paths)
+		assert.Equal(t, []string{"*.md"}, lint.IgnorePaths, "task should inherit project task ignore paths")
+
+		lint = p.FindTaskForVariant("lint", "everything")
+		require.NotNil(t, lint)
+		assert.Equal(t, []string{"frontend/"}, lint.Paths, "build variant task paths should override project task paths")
+		assert.Equal(t, []string{"*.md"}, lint.IgnorePaths)
+
+		groupTask := p.FindTaskForVariant("group_task", "backend")
+		require.NotNil(t, groupTask)
+		assert.Equal(t, []string{"backend/"}, groupTask.Paths, "task group task should inherit build variant paths")
+	})
+	t.Run("PathFilteredTasks", func(t *testing.T) {
+		filtered := p.PathFilteredTasks([]string{"docs/README.md"}, evergreen.RepotrackerVersionRequester)
+		assert.ElementsMatch(t, []patch.PathFilteredTask{
+			{Variant: "backend", Task: "compile", Reason: "no changed files matched paths [backend/]"},
+			{Variant: "backend", Task: "lint", Reason: "no changed files matched paths [backend/] excluding ignore_paths [*.md]"},
+			{Variant: "backend", Task: "group_task", Reason: "no changed files matched paths [backend/]"},
+			{Variant: "everything", Task: "lint", Reason: "no changed files matched paths [frontend/] excluding ignore_paths [*.md]"},
+		}, filtered)
+		assert.False(t, p.PathFiltersSkipAllTasks([]string{"docs/README.md"}, evergreen.RepotrackerVersionRequester))
+		assert.Empty(t, p.PathFilteredTasks(nil, evergreen.RepotrackerVersionRequester))
+	})
+	t.Run("PathFiltersSkipAllTasks", func(t *testing.T) {
+		onlyBackend := &Project{}
+		_, err := LoadProjectInto(t.Context(), []byte(`
+tasks:
+  - name: compile
+buildvariants:
+  - name: backend
+    run_on:
+      - localhost
+    paths:
+      - "backend/"
+    tasks:
+      - name: compile
+`), nil, "", onlyBackend)
+		require.NoError(t, err)
+		assert.True(t, onlyBackend.PathFiltersSkipAllTasks([]string{"docs/README.md"}, evergreen.RepotrackerVersionRequester))
+		assert.False(t, onlyBackend.PathFiltersSkipAllTasks([]string{"backend/main.go"}, evergreen.RepotrackerVersionRequester))
+	})
+	t.Run("FilterVariantsTasksByChangedFiles", func(t *testing.T) {
+		vts := []patch.VariantTasks{
+			{Variant: "backend", Tasks: []string{"compile", "lint"}},
+			{
+				Variant:      "everything",
+				Tasks:        []string{"compile", "docs", "lint"},
+				DisplayTasks: []patch.DisplayTask{{Name: "display", ExecTasks: []string{"docs"}}},
+			},
+		}
+		remaining, filtered := p.FilterVariantsTasksByChangedFiles(vts, []string{"frontend/app.ts"}, nil)
+		require.Len(t, remaining, 1, "backend variant should be removed since all its tasks were filtered")
+		assert.Equal(t, "everything", remaining[0].Variant)
+		assert.Equal(t, []string{"compile", "lint"}, remaining[0].Tasks)
+		assert.Empty(t, remaining[0].DisplayTasks, "display task should be removed since all its execution tasks were filtered")
+		assert.Len(t, filtered, 3)
+
+		remaining, filtered = p.FilterVariantsTasksByChangedFiles(vts, nil, nil)
+		assert.Equal(t, vts, remaining)
+		assert.Empty(t, filtered)
+
+		requested := map[TVPair]bool{{Variant: "backend", TaskName: "compile"}: true}
+		remaining, filtered = p.FilterVariantsTasksByChangedFiles(vts, []string{"frontend/app.ts"}, requested)
+		require.Len(t, remaining, 2, "requested task should not be filtered")
+		assert.Equal(t, "backend", remaining[0].Variant)
+		assert.Equal(t, []string{"compile"}, remaining[0].Tasks)
+		assert.Len(t, filtered, 2)
+	})
+	t.Run("BuildProjectTVPairs", func(t *testing.T) {
+		require.NoError(t, db.Clear(ProjectAliasCollection))
+		defer func() {
+			assert.NoError(t, db.Clear(ProjectAliasCollection))
+		}()
+		p.Identifier = "path_filters"
+		alias := ProjectAlias{ProjectID: p.Identifier, Alias: "everything", Variant: "^backend$", Task: ".*"}
+		require.NoError(t, alias.Upsert(t.Context()))
+		changedFiles := []patch.ModulePatch{{
+			PatchSet: patch.PatchSet{Summary: []thirdparty.Summary{{Name: "frontend/app.ts"}}},
+		}}
+
+		aliasPatch := &patch.Patch{Patches: changedFiles}
+		p.BuildProjectTVPairs(t.Context(), aliasPatch, alias.Alias)
+		assert.Empty(t, aliasPatch.VariantsTasks, "tasks selected by an alias should be filtered")
+		assert.NotEmpty(t, aliasPatch.PathFilteredTasks)
+
+		explicitPatch := &patch.Patch{
+			Patches:       changedFiles,
+			BuildVariants: []string{"backend"},
+			Tasks:         []string{"compile"},
+		}
+		p.BuildProjectTVPairs(t.Context(), explicitPatch, "")
+		require.Len(t, explicitPatch.VariantsTasks, 1, "explicitly requested tasks should not be filtered")
+		assert.Equal(t, []string{"compile"}, explicitPatch.VariantsTasks[0].Tasks)
+		assert.Empty(t, explicitPatch.PathFilteredTasks)
+
+		mixedPatch := &patch.Patch{
+			Patches:       changedFiles,
+			BuildVariants: []string{"backend"},
+			Tasks:         []string{"compile"},
+		}
+		p.BuildProjectTVPairs(t.Context(), mixedPatch, alias.Alias)
+		require.Len(t, mixedPatch.VariantsTasks, 1)
+		assert.Equal(t, []string{"compile"}, mixedPatch.VariantsTasks[0].Tasks, "only the tasks selected by the alias should be filtered")
+		assert.NotEmpty(t, mixedPatch.PathFilteredTasks)
+	})
+}
@@ -122,6 +122,10 @@ type BuildVariantTaskUnit struct {
 	AllowedRequesters []evergreen.UserRequester `yaml:"allowed_requesters,omitempty" bson:"allowed_requesters,omitempty"`
 	Priority          int64                     `yaml:"priority,omitempty" bson:"priority"`
 	DependsOn         []TaskUnitDependency      `yaml:"depends_on,omitempty" bson:"depends_on"`
+	// Paths and IgnorePaths are gitignore-style patterns that filter the task
+	// unit by the files changed in the version. See SkipOnChangedFiles.
+	Paths       []string `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	IgnorePaths []string `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
 
 	// the distros that the task can be run on
 	RunOn    []string `yaml:"run_on,omitempty" bson:"run_on"`
@@ -211,6 +215,12 @@ func (bvt *BuildVariantTaskUnit) Populate(pt ProjectTask, bv BuildVariant) {
 	if len(bvt.AllowedRequesters) == 0 {
 		bvt.AllowedRequesters = pt.AllowedRequesters
 	}
+	if len(bvt.Paths) == 0 {
+		bvt.Paths = pt.Paths
+	}
+	if len(bvt.IgnorePaths) == 0 {
+		bvt.IgnorePaths = pt.IgnorePaths
+	}
 	if bvt.Stepback == nil {
 		bvt.Stepback = pt.Stepback
 	}
@@ -232,6 +242,12 @@ func (bvt *BuildVariantTaskUnit) Populate(pt ProjectTask, bv BuildVariant) {
 	if len(bvt.AllowedRequesters) == 0 {
 		bvt.AllowedRequesters = bv.AllowedRequesters
 	}
+	if len(bvt.Paths) == 0 {
+		bvt.Paths = bv.Paths
+	}
+	if len(bvt.IgnorePaths) == 0 {
+		bvt.IgnorePaths = bv.IgnorePaths
+	}
 	if bvt.Disable == nil {
 		bvt.Disable = bv.Disable
 	}
@@ -389,6 +405,12 @@ type BuildVariant struct {
 	// requester-related filters such as Patchable, PatchOnly, AllowForGitTag,
 	// and GitTagOnly. By default, all requesters are allowed to run the task.
 	AllowedRequesters []evergreen.UserRequester `yaml:"allowed_requesters,omitempty" bson:"allowed_requesters,omitempty"`
+	// Paths, if set, only runs tasks in this build variant if at least one of
+	// the changed files matches one of these gitignore-style patterns.
+	Paths []string `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	// IgnorePaths skips tasks in this build variant if all of the changed
+	// files match these gitignore-style patterns.
+	IgnorePaths []string `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
 
 	// Use a *bool so that there are 3 possible states:
 	//   1. nil   = not overriding the project setting (default)
@@ -735,6 +757,8 @@ type ProjectTask struct {
 	AllowedRequesters []evergreen.UserRequester `yaml:"allowed_requesters,omitempty" bson:"allowed_requesters,omitempty"`
 	Stepback          *bool                     `yaml:"stepback,omitempty" bson:"stepback,omitempty"`
 	MustHaveResults   *bool                     `yaml:"must_have_test_results,omitempty" bson:"must_have_test_results,omitempty"`
+	Paths             []string                  `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	IgnorePaths       []string                  `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
 }
 
 const (
@@ -1627,6 +1651,8 @@ func (p *Project) tasksFromGroup(bvTaskGroup BuildVariantTaskUnit) []BuildVarian
 			AllowForGitTag:    bvTaskGroup.AllowForGitTag,
 			GitTagOnly:        bvTaskGroup.GitTagOnly,
 			AllowedRequesters: bvTaskGroup.AllowedRequesters,
+			Paths:             bvTaskGroup.Paths,
+			IgnorePaths:       bvTaskGroup.IgnorePaths,
 			Priority:          bvTaskGroup.Priority,
 			DependsOn:         bvTaskGroup.DependsOn,
 			RunOn:             bvTaskGroup.RunOn,
@@ -1682,8 +1708,21 @@ func (p *Project) IgnoresAllFiles(files []string) bool {
 // BuildProjectTVPairs resolves the build variants and tasks into which build
 // variants will run and which tasks will run on each build variant. This
 // filters out tasks that cannot run due to being disabled or having an
-// unmatched requester (e.g. a patch-only task for a mainline commit).
+// unmatched requester (e.g. a patch-only task for a mainline commit). Tasks
+// selected by the alias are also filtered out if they're not relevant to the
+// files changed in the patch, but tasks that were explicitly requested always
+// run.
 func (p *Project) BuildProjectTVPairs(ctx context.Context, patchDoc *patch.Patch, alias string) {
+	var requested map[TVPair]bool
+	if alias != "" {
+		_, _, requestedVTs := p.ResolvePatchVTs(ctx, patchDoc, patchDoc.GetRequester(), "", true)
+		requested = map[TVPair]bool{}
+		for _, vt := range requestedVTs {
+			for _, t := range vt.Tasks {
+				requested[TVPair{Variant: vt.Variant, TaskName: t}] = true
+			}
+		}
+	}
 	patchDoc.BuildVariants, patchDoc.Tasks, patchDoc.VariantsTasks = p.ResolvePatchVTs(ctx, patchDoc, patchDoc.GetRequester(), alias, true)
 
 	// Connect the execution tasks to the display tasks.
@@ -1707,6 +1746,25 @@ func (p *Project) BuildProjectTVPairs(ctx context.Context, patchDoc *patch.Patch
 		vts = append(vts, vt)
 	}
 	patchDoc.VariantsTasks = vts
+
+	// Remove the tasks that aren't relevant to the files changed in the patch,
+	// unless every task was explicitly requested.
+	if alias == "" {
+		return
+	}
+	var filtered []patch.PathFilteredTask
+	patchDoc.VariantsTasks, filtered = p.FilterVariantsTasksByChangedFiles(patchDoc.VariantsTasks, patchDoc.FilesChanged(), requested)
+	if len(filtered) == 0 {
+		return
+	}
+	patchDoc.PathFilteredTasks = filtered
+	patchDoc.BuildVariants = []string{}
+	patchDoc.Tasks = []string{}
+	for _, vt := range patchDoc.VariantsTasks {
+		patchDoc.BuildVariants = append(patchDoc.BuildVariants, vt.Variant)
+		patchDoc.Tasks = append(patchDoc.Tasks, vt.Tasks...)
+	}
+	patchDoc.Tasks = utility.UniqueStrings(patchDoc.Tasks)
 }
 
 // ResolvePatchVTs resolves a list of build variants and tasks into a list of
@@ -0,0 +1,245 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ProjectKeyValCollection holds the keys that tasks share within a project
+// using the keyval.get, keyval.set, keyval.cas and keyval.lock commands. Unlike
+// the keys incremented by keyval.inc, these keys are scoped to a project and
+// expire.
+const ProjectKeyValCollection = "project_keyvals"
+
+const (
+	// DefaultProjectKeyValTTL is how long a key lives if no TTL is given.
+	DefaultProjectKeyValTTL = 7 * 24 * time.Hour
+	// MaxProjectKeyValTTL is the longest TTL that a key can have.
+	MaxProjectKeyValTTL = 30 * 24 * time.Hour
+	// DefaultProjectKeyValLockTTL is how long a lock is held if no lease is
+	// given.
+	DefaultProjectKeyValLockTTL = 15 * time.Minute
+	// MaxProjectKeyValKeyLength is the maximum length of a key.
+	MaxProjectKeyValKeyLength = 256
+	// MaxProjectKeyValValueSize is the maximum size of a value in bytes.
+	MaxProjectKeyValValueSize = 16 * 1024
+)
+
+// ProjectKeyValID identifies a key within a project.
+type ProjectKeyValID struct {
+	ProjectID string `bson:"project_id" json:"project_id"`
+	Key       string `bson:"key" json:"key"`
+	// Namespace separates the keys of patch tasks from the project's
+	// mainline keys. It's empty for mainline keys and is the patch's version
+	// ID for keys set by a patch's tasks, so that patches can't read or
+	// overwrite keys that mainline tasks rely on, or each other's keys.
+	Namespace string `bson:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// NewProjectKeyValID returns the ID of the key for a task with the given
+// requester and version.
+func NewProjectKeyValID(projectID, key, requester, versionID string) ProjectKeyValID {
+	id := ProjectKeyValID{ProjectID: projectID, Key: key}
+	if evergreen.IsPatchRequester(requester) {
+		id.Namespace = versionID
+	}
+	return id
+}
+
+// ProjectKeyVal is a value that tasks in a project share. A lock is a key
+// whose value is the ID of the task that holds it.
+type ProjectKeyVal struct {
+	ID    ProjectKeyValID `bson:"_id" json:"id"`
+	Value string          `bson:"value" json:"value"`
+	// UpdatedBy is the ID of the task that last set the value.
+	UpdatedBy string    `bson:"updated_by,omitempty" json:"updated_by,omitempty"`
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+	// ExpiresAt is when the key is deleted. Keys that have expired are
+	// treated as unset even before they're deleted.
+	ExpiresAt time.Time `bson:"expires_at" json:"expires_at"`
+}
+
+var (
+	ProjectKeyValIDKey        = bsonutil.MustHaveTag(ProjectKeyVal{}, "ID")
+	ProjectKeyValValueKey     = bsonutil.MustHaveTag(ProjectKeyVal{}, "Value")
+	ProjectKeyValUpdatedByKey = bsonutil.MustHaveTag(ProjectKeyVal{}, "UpdatedBy")
+	ProjectKeyValUpdatedAtKey = bsonutil.MustHaveTag(ProjectKeyVal{}, "UpdatedAt")
+	ProjectKeyValExpiresAtKey = bsonutil.MustHaveTag(ProjectKeyVal{}, "ExpiresAt")
+
+	projectKeyValIDProjectIDKey = bsonutil.MustHaveTag(ProjectKeyValID{}, "ProjectID")
+	projectKeyValIDKeyKey       = bsonutil.MustHaveTag(ProjectKeyValID{}, "Key")
+)
+
+// ValidateProjectKeyVal checks that the key and value can be stored.
+func ValidateProjectKeyVal(key, value string) error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(key == "", "key cannot be empty")
+	catcher.ErrorfWhen(len(key) > MaxProjectKeyValKeyLength, "key cannot be longer than %d characters", MaxProjectKeyValKeyLength)
+	catcher.ErrorfWhen(len(value) > MaxProjectKeyValValueSize, "value cannot be larger than %d bytes", MaxProjectKeyValValueSize)
+	return catcher.Resolve()
+}
+
+// GetProjectKeyValTTL returns the TTL for a key given the requested TTL, which
+// uses the default if it's zero and is capped at the maximum.
+func GetProjectKeyValTTL(ttl, defaultTTL time.Duration) time.Duration {
+	if ttl <= 0 {
+		return defaultTTL
+	}
+	if ttl > MaxProjectKeyValTTL {
+		return MaxProjectKeyValTTL
+	}
+	return ttl
+}
+
+// projectKeyValNotExpired returns a query that matches keys that haven't expired yet.
+func projectKeyValNotExpired(now time.Time) bson.M {
+	return bson.M{ProjectKeyValExpiresAtKey: bson.M{"$gt": now}}
+}
+
+// FindProjectKeyVal returns the unexpired key, or nil if it's not set.
+func FindProjectKeyVal(ctx context.Context, id ProjectKeyValID) (*ProjectKeyVal, error) {
+	q := projectKeyValNotExpired(time.Now())
+	q[ProjectKeyValIDKey] = id
+	kv := &ProjectKeyVal{}
+	err := db.FindOneQContext(ctx, ProjectKeyValCollection, db.Query(q), kv)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding key '%s' in project '%s'", id.Key, id.ProjectID)
+	}
+	return kv, nil
+}
+
+// FindProjectKeyVals returns all the unexpired keys in the project, including
+// the keys of patches, sorted by key.
+func FindProjectKeyVals(ctx context.Context, projectID string) ([]ProjectKeyVal, error) {
+	q := projectKeyValNotExpired(time.Now())
+	q[bsonutil.GetDottedKeyName(ProjectKeyValIDKey, projectKeyValIDProjectIDKey)] = projectID
+	kvs := []ProjectKeyVal{}
+	err := db.FindAllQ(ctx, ProjectKeyValCollection, db.Query(q).Sort([]string{bsonutil.GetDottedKeyName(ProjectKeyValIDKey, projectKeyValIDKeyKey)}), &kvs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding keys in project '%s'", projectID)
+	}
+	return kvs, nil
+}
+
+// SetProjectKeyVal sets the key to the value, overwriting any existing value.
+func SetProjectKeyVal(ctx context.Context, id ProjectKeyValID, value, updatedBy string, ttl time.Duration) (*ProjectKeyVal, error) {
+	now := time.Now()
+	kv := newProjectKeyVal(id, value, updatedBy, now, ttl)
+	_, err := evergreen.GetEnvironment().DB().Collection(ProjectKeyValCollection).ReplaceOne(ctx,
+		bson.M{ProjectKeyValIDKey: kv.ID},
+		kv,
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "setting key '%s' in project '%s'", id.Key, id.ProjectID)
+	}
+	return &kv, nil
+}
+
+// CompareAndSwapProjectKeyVal sets the key to the value only if its current
+// value is the expected one. An empty expected value means that the key must
+// not be set. It returns whether the value was swapped.
+func CompareAndSwapProjectKeyVal(ctx context.Context, id ProjectKeyValID, expected, value, updatedBy string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	kv := newProjectKeyVal(id, value, updatedBy, now, ttl)
+
+	var q bson.M
+	if expected == "" {
+		q = bson.M{
+			ProjectKeyValIDKey:        kv.ID,
+			ProjectKeyValExpiresAtKey: bson.M{"$lte": now},
+		}
+	} else {
+		q = projectKeyValNotExpired(now)
+		q[ProjectKeyValIDKey] = kv.ID
+		q[ProjectKeyValValueKey] = expected
+	}
+
+	swapped, err := replaceProjectKeyVal(ctx, q, kv, expected == "")
+	return swapped, errors.Wrapf(err, "swapping key '%s' in project '%s'", id.Key, id.ProjectID)
+}
+
+// LockProjectKeyVal acquires the lock with the given key for the owner, or
+// extends the lease if the owner already holds it. It returns false if another
+// owner holds the lock.
+func LockProjectKeyVal(ctx context.Context, id ProjectKeyValID, owner string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	kv := newProjectKeyVal(id, owner, owner, now, ttl)
+	q := bson.M{
+		ProjectKeyValIDKey: kv.ID,
+		"$or": []bson.M{
+			{ProjectKeyValValueKey: owner},
+			{ProjectKeyValExpiresAtKey: bson.M{"$lte": now}},
+		},
+	}
+
+	locked, err := replaceProjectKeyVal(ctx, q, kv, true)
+	return locked, errors.Wrapf(err, "locking key '%s' in project '%s'", id.Key, id.ProjectID)
+}
+
+// UnlockProjectKeyVal releases the lock with the given key if the owner holds
+// it. It returns false if the owner didn't hold the lock.
+func UnlockProjectKeyVal(ctx context.Context, id ProjectKeyValID, owner string) (bool, error) {
+	res, err := evergreen.GetEnvironment().DB().Collection(ProjectKeyValCollection).DeleteOne(ctx, bson.M{
+		ProjectKeyValIDKey:    id,
+		ProjectKeyValValueKey: owner,
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "unlocking key '%s' in project '%s'", id.Key, id.ProjectID)
+	}
+	return res.DeletedCount > 0, nil
+}
+
+// DeleteProjectKeyVal deletes the key from the project, including any patch's
+// key with the same name.
+func DeleteProjectKeyVal(ctx context.Context, projectID, key string) error {
+	return errors.Wrapf(db.RemoveAll(ctx, ProjectKeyValCollection, bson.M{
+		bsonutil.GetDottedKeyName(ProjectKeyValIDKey, projectKeyValIDProjectIDKey): projectID,
+		bsonutil.GetDottedKeyName(ProjectKeyValIDKey, projectKeyValIDKeyKey):       key,
+	}), "deleting key '%s' from project '%s'", key, projectID)
+}
+
+// DeleteProjectKeyVals deletes all the keys from the project.
+func DeleteProjectKeyVals(ctx context.Context, projectID string) error {
+	return errors.Wrapf(db.RemoveAll(ctx, ProjectKeyValCollection, bson.M{
+		bsonutil.GetDottedKeyName(ProjectKeyValIDKey, projectKeyValIDProjectIDKey): projectID,
+	}), "deleting keys from project '%s'", projectID)
+}
+
+func newProjectKeyVal(id ProjectKeyValID, value, updatedBy string, now time.Time, ttl time.Duration) ProjectKeyVal {
+	return ProjectKeyVal{
+		ID:        id,
+		Value:     value,
+		UpdatedBy: updatedBy,
+		UpdatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+}
+
+// replaceProjectKeyVal replaces the key matching the query. If upsert is true
+// and no key matches the query, it inserts the key unless it already exists.
+// It returns whether the key was replaced or inserted.
+func replaceProjectKeyVal(ctx context.Context, q bson.M, kv ProjectKeyVal, upsert bool) (bool, error) {
+	res, err := evergreen.GetEnvironment().DB().Collection(ProjectKeyValCollection).ReplaceOne(ctx, q, kv, options.Replace().SetUpsert(upsert))
+	if db.IsDuplicateKey(err) {
+		// The key exists but didn't match the query, so the insert
+		// conflicted with it.
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0 || res.UpsertedCount > 0, nil
+}
@@ -0,0 +1,175 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectKeyVal(t *testing.T) {
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T){
+		"SetAndFind": func(ctx context.Context, t *testing.T) {
+			_, err := SetProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "build_id"}, "v1", "t1", time.Hour)
+			require.NoError(t, err)
+			_, err = SetProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "build_id"}, "v2", "t2", time.Hour)
+			require.NoError(t, err)
+			_, err = SetProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p2", Key: "build_id"}, "other", "t3", time.Hour)
+			require.NoError(t, err)
+
+			kv, err := FindProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "build_id"})
+			require.NoError(t, err)
+			require.NotNil(t, kv)
+			assert.Equal(t, "v2", kv.Value)
+			assert.Equal(t, "t2", kv.UpdatedBy)
+
+			kvs, err := FindProjectKeyVals(ctx, "p1")
+			require.NoError(t, err)
+			require.Len(t, kvs, 1)
+			assert.Equal(t, "build_id", kvs[0].ID.Key)
+		},
+		"IgnoresExpiredKeys": func(ctx context.Context, t *testing.T) {
+			_, err := SetProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "build_id"}, "v1", "t1", -time.Minute)
+			require.NoError(t, err)
+
+			kv, err := FindProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "build_id"})
+			require.NoError(t, err)
+			assert.Nil(t, kv)
+			kvs, err := FindProjectKeyVals(ctx, "p1")
+			require.NoError(t, err)
+			assert.Empty(t, kvs)
+
+			swapped, err := CompareAndSwapProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "build_id"}, "", "v2", "t2", time.Hour)
+			require.NoError(t, err)
+			assert.True(t, swapped, "expired key should count as unset")
+		},
+		"CompareAndSwap": func(ctx context.Context, t *testing.T) {
+			swapped, err := CompareAndSwapProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "build_id"}, "", "v1", "t1", time.Hour)
+			require.NoError(t, err)
+			assert.True(t, swapped)
+			swapped, err = CompareAndSwapProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "build_id"}, "", "v2", "t2", time.Hour)
+			require.NoError(t, err)
+			assert.False(t, swapped, "key is already set")
+			swapped, err = CompareAndSwapProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "build_id"}, "wrong", "v2", "t2", time.Hour)
+			require.NoError(t, err)
+			assert.False(t, swapped)
+			swapped, err = CompareAndSwapProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "build_id"}, "v1", "v2", "t2", time.Hour)
+			require.NoError(t, err)
+			assert.True(t, swapped)
+
+			kv, err := FindProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "build_id"})
+			require.NoError(t, err)
+			require.NotNil(t, kv)
+			assert.Equal(t, "v2", kv.Value)
+		},
+		"LockAndUnlock": func(ctx context.Context, t *testing.T) {
+			locked, err := LockProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "deploy"}, "t1", time.Hour)
+			require.NoError(t, err)
+			assert.True(t, locked)
+			locked, err = LockProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "deploy"}, "t2", time.Hour)
+			require.NoError(t, err)
+			assert.False(t, locked, "lock is held by another task")
+			locked, err = LockProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "deploy"}, "t1", time.Hour)
+			require.NoError(t, err)
+			assert.True(t, locked, "owner should be able to extend its lease")
+
+			unlocked, err := UnlockProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "deploy"}, "t2")
+			require.NoError(t, err)
+			assert.False(t, unlocked)
+			unlocked, err = UnlockProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "deploy"}, "t1")
+			require.NoError(t, err)
+			assert.True(t, unlocked)
+
+			locked, err = LockProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "deploy"}, "t2", time.Hour)
+			require.NoError(t, err)
+			assert.True(t, locked)
+		},
+		"LockExpires": func(ctx context.Context, t *testing.T) {
+			locked, err := LockProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "deploy"}, "t1", -time.Minute)
+			require.NoError(t, err)
+			assert.True(t, locked)
+			locked, err = LockProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: "deploy"}, "t2", time.Hour)
+			require.NoError(t, err)
+			assert.True(t, locked, "should acquire lock with an expired lease")
+		},
+		"PatchesUseTheirOwnNamespace": func(ctx context.Context, t *testing.T) {
+			mainlineID := NewProjectKeyValID("p1", "build_id", evergreen.RepotrackerVersionRequester, "v1")
+			assert.Empty(t, mainlineID.Namespace)
+			_, err := SetProjectKeyVal(ctx, mainlineID, "mainline", "t1", time.Hour)
+			require.NoError(t, err)
+
+			for _, requester := range []string{evergreen.PatchVersionRequester, evergreen.GithubPRRequester} {
+				patchID := NewProjectKeyValID("p1", "build_id", requester, "patch_version")
+				assert.Equal(t, "patch_version", patchID.Namespace)
+				kv, err := FindProjectKeyVal(ctx, patchID)
+				require.NoError(t, err)
+				assert.Nil(t, kv, "patch should not see mainline key")
+			}
+
+			patchID := NewProjectKeyValID("p1", "build_id", evergreen.GithubPRRequester, "patch_version")
+			_, err = SetProjectKeyVal(ctx, patchID, "patch", "t2", time.Hour)
+			require.NoError(t, err)
+			locked, err := LockProjectKeyVal(ctx, NewProjectKeyValID("p1", "deploy", evergreen.GithubPRRequester, "patch_version"), "t2", time.Hour)
+			require.NoError(t, err)
+			assert.True(t, locked)
+
+			kv, err := FindProjectKeyVal(ctx, mainlineID)
+			require.NoError(t, err)
+			require.NotNil(t, kv)
+			assert.Equal(t, "mainline", kv.Value, "patch should not overwrite mainline key")
+			locked, err = LockProjectKeyVal(ctx, NewProjectKeyValID("p1", "deploy", evergreen.RepotrackerVersionRequester, "v1"), "t1", time.Hour)
+			require.NoError(t, err)
+			assert.True(t, locked, "patch should not hold mainline lock")
+
+			require.NoError(t, DeleteProjectKeyVal(ctx, "p1", "build_id"))
+			kvs, err := FindProjectKeyVals(ctx, "p1")
+			require.NoError(t, err)
+			require.Len(t, kvs, 2)
+			for _, kv := range kvs {
+				assert.Equal(t, "deploy", kv.ID.Key)
+			}
+		},
+		"Delete": func(ctx context.Context, t *testing.T) {
+			for _, key := range []string{"a", "b"} {
+				_, err := SetProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p1", Key: key}, "v", "t1", time.Hour)
+				require.NoError(t, err)
+			}
+			_, err := SetProjectKeyVal(ctx, ProjectKeyValID{ProjectID: "p2", Key: "a"}, "v", "t1", time.Hour)
+			require.NoError(t, err)
+
+			require.NoError(t, DeleteProjectKeyVal(ctx, "p1", "a"))
+			kvs, err := FindProjectKeyVals(ctx, "p1")
+			require.NoError(t, err)
+			require.Len(t, kvs, 1)
+			assert.Equal(t, "b", kvs[0].ID.Key)
+
+			require.NoError(t, DeleteProjectKeyVals(ctx, "p1"))
+			kvs, err = FindProjectKeyVals(ctx, "p1")
+			require.NoError(t, err)
+			assert.Empty(t, kvs)
+			kvs, err = FindProjectKeyVals(ctx, "p2")
+			require.NoError(t, err)
+			assert.Len(t, kvs, 1)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			require.NoError(t, db.Clear(ProjectKeyValCollection))
+			defer func() {
+				assert.NoError(t, db.Clear(ProjectKeyValCollection))
+			}()
+			tCase(ctx, t)
+		})
+	}
+}
+
+func TestValidateProjectKeyVal(t *testing.T) {
+	assert.NoError(t, ValidateProjectKeyVal("key", "value"))
+	assert.Error(t, ValidateProjectKeyVal("", "value"))
+	assert.Error(t, ValidateProjectKeyVal("key", string(make([]byte, MaxProjectKeyValValueSize+1))))
+}
@@ -77,6 +77,9 @@ type ParserProject struct {
 	UpdatedByGenerators []string `yaml:"updated_by_generators,omitempty" bson:"updated_by_generators,omitempty"`
 	// List of yamls to merge
 	Include []parserInclude `yaml:"include,omitempty" bson:"include,omitempty"`
+	// TemplateParameters are the parameters that this file accepts when it is
+	// included by another file.
+	TemplateParameters []parserTemplateParameter `yaml:"template_parameters,omitempty" bson:"template_parameters,omitempty"`
 
 	// Beginning of ParserProject mergeable fields (this comment is used by the linter).
 	Stepback           *bool                      `yaml:"stepback,omitempty" bson:"stepback,omitempty"`
@@ -153,6 +156,8 @@ type parserTask struct {
 	AllowedRequesters []evergreen.UserRequester `yaml:"allowed_requesters,omitempty" bson:"allowed_requesters,omitempty"`
 	Stepback          *bool                     `yaml:"stepback,omitempty" bson:"stepback,omitempty"`
 	MustHaveResults   *bool                     `yaml:"must_have_test_results,omitempty" bson:"must_have_test_results,omitempty"`
+	Paths             parserStringSlice         `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	IgnorePaths       parserStringSlice         `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
 }
 
 func (pp *ParserProject) Insert(ctx context.Context) error {
@@ -244,6 +249,14 @@ func (pd *parserDependency) UnmarshalYAML(unmarshal func(any) error) error {
 type parserInclude struct {
 	FileName string `yaml:"filename,omitempty" bson:"filename,omitempty"`
 	Module   string `yaml:"module,omitempty" bson:"module,omitempty"`
+	// Repo is the repository, in the format owner/repo, to include the file
+	// from. Files from another repository must be pinned to a Ref, such as a
+	// tag or commit hash.
+	Repo string `yaml:"repo,omitempty" bson:"repo,omitempty"`
+	Ref  string `yaml:"ref,omitempty" bson:"ref,omitempty"`
+	// Params are substituted into the included file's references to its
+	// template parameters before it is merged.
+	Params map[string]any `yaml:"params,omitempty" bson:"params,omitempty"`
 }
 
 // TaskSelector handles the selection of specific task/variant combinations
@@ -341,6 +354,8 @@ type parserBV struct {
 	AllowForGitTag    *bool                     `yaml:"allow_for_git_tag,omitempty" bson:"allow_for_git_tag,omitempty"`
 	GitTagOnly        *bool                     `yaml:"git_tag_only,omitempty" bson:"git_tag_only,omitempty"`
 	AllowedRequesters []evergreen.UserRequester `yaml:"allowed_requesters,omitempty" bson:"allowed_requesters,omitempty"`
+	Paths             parserStringSlice         `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	IgnorePaths       parserStringSlice         `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
 
 	// internal matrix stuff
 	MatrixId  string      `yaml:"matrix_id,omitempty" bson:"matrix_id,omitempty"`
@@ -410,6 +425,8 @@ func (pbv *parserBV) canMerge() bool {
 		pbv.AllowForGitTag == nil &&
 		pbv.GitTagOnly == nil &&
 		len(pbv.AllowedRequesters) == 0 &&
+		pbv.Paths == nil &&
+		pbv.IgnorePaths == nil &&
 		pbv.MatrixId == "" &&
 		pbv.MatrixVal == nil &&
 		pbv.Matrix == nil &&
@@ -425,6 +442,8 @@ type parserBVTaskUnit struct {
 	AllowForGitTag    *bool                     `yaml:"allow_for_git_tag,omitempty" bson:"allow_for_git_tag,omitempty"`
 	GitTagOnly        *bool                     `yaml:"git_tag_only,omitempty" bson:"git_tag_only,omitempty"`
 	AllowedRequesters []evergreen.UserRequester `yaml:"allowed_requesters,omitempty" bson:"allowed_requesters,omitempty"`
+	Paths             parserStringSlice         `yaml:"paths,omitempty" bson:"paths,omitempty"`
+	IgnorePaths       parserStringSlice         `yaml:"ignore_paths,omitempty" bson:"ignore_paths,omitempty"`
 	Priority          int64                     `yaml:"priority,omitempty" bson:"priority,omitempty"`
 	DependsOn         parserDependencies        `yaml:"depends_on,omitempty" bson:"depends_on,omitempty"`
 	ExecTimeoutSecs   int                       `yaml:"exec_timeout_secs,omitempty" bson:"exec_timeout_secs,omitempty"`
@@ -659,8 +678,13 @@ func processIntermediateProjectIncludes(ctx context.Context, identifier string,
 		"remote_path": localOpts.RemotePath,
 		"read_from":   localOpts.ReadFileFrom,
 		"module":      include.Module,
+		"repo":        include.Repo,
+		"ref":         include.Ref,
 	})
-	if include.Module != "" {
+	if include.Repo != "" {
+		yaml, err = retrieveFileForRepo(ctx, include, projectOpts.Ref)
+		err = errors.Wrapf(err, "%s: retrieving file for repo '%s' at ref '%s'", LoadProjectError, include.Repo, include.Ref)
+	} else if include.Module != "" {
 		yaml, err = retrieveFileForModule(ctx, *localOpts, intermediateProject.Modules, include)
 		err = errors.Wrapf(err, "%s: retrieving file for module '%s'", LoadProjectError, include.Module)
 	} else {
@@ -669,7 +693,7 @@ func processIntermediateProjectIncludes(ctx context.Context, identifier string,
 	}
 	outputYAMLs <- yamlTuple{
 		yaml: yaml,
-		name: include.FileName,
+		name: include.key(),
 		err:  err,
 	}
 }
@@ -699,6 +723,13 @@ func LoadProjectInto(ctx context.Context, data []byte, opts *GetProjectOpts, ide
 			err = errors.New("trying to open include files with empty options")
 			return nil, errors.Wrapf(err, LoadProjectError)
 		}
+		catcher := grip.NewBasicCatcher()
+		for _, include := range intermediateProject.Include {
+			catcher.Add(include.validate())
+		}
+		if catcher.HasErrors() {
+			return intermediateProject, errors.Wrapf(catcher.Resolve(), "%s: invalid includes", LoadProjectError)
+		}
 
 		wg := sync.WaitGroup{}
 		outputYAMLs := make(chan yamlTuple, len(intermediateProject.Include))
@@ -729,7 +760,6 @@ func LoadProjectInto(ctx context.Context, data []byte, opts *GetProjectOpts, ide
 		close(outputYAMLs)
 
 		yamlMap := map[string][]byte{}
-		catcher := grip.NewBasicCatcher()
 		for elem := range outputYAMLs {
 			catcher.Add(elem.err)
 			if thirdparty.IsFileNotFound(errors.Cause(elem.err)) {
@@ -746,10 +776,15 @@ func LoadProjectInto(ctx context.Context, data []byte, opts *GetProjectOpts, ide
 
 		// We promise to iterate over includes in the order they are defined.
 		for _, path := range intermediateProject.Include {
-			if _, ok := yamlMap[path.FileName]; !ok {
+			yml, ok := yamlMap[path.key()]
+			if !ok {
 				return intermediateProject, errors.WithStack(errors.Errorf("yaml was nil in map for %s, but it never should be", path.FileName))
 			}
-			add, err := createIntermediateProject(yamlMap[path.FileName], opts.UnmarshalStrict)
+			yml, err = applyIncludeParams(yml, path)
+			if err != nil {
+				return intermediateProject, errors.Wrapf(err, "%s: applying parameters to file '%s'", LoadProjectError, path.FileName)
+			}
+			add, err := createIntermediateProject(yml, opts.UnmarshalStrict)
 			if err != nil {
 				// Return intermediateProject even if we run into issues to show merge progress.
 				return intermediateProject, errors.Wrapf(err, "%s: loading file '%s'", LoadProjectError, path.FileName)
@@ -772,6 +807,7 @@ func LoadProjectInto(ctx context.Context, data []byte, opts *GetProjectOpts, ide
 	// Intermediate project is used to save parser project as a YAML so removing the includes verifies that
 	// they have been processed.
 	intermediateProject.Include = nil
+	intermediateProject.TemplateParameters = nil
 
 	return intermediateProject, errors.Wrapf(err, LoadProjectError)
 }
@@ -1138,6 +1174,8 @@ func evaluateTaskUnits(tse *taskSelectorEvaluator, tgse *tagSelectorEvaluator, v
 			evalErrs = append(evalErrs, errors.Errorf("spaces are not allowed in task names ('%s')", pt.Name))
 		}
 		t.AllowedRequesters = pt.AllowedRequesters
+		t.Paths = pt.Paths
+		t.IgnorePaths = pt.IgnorePaths
 		t.DependsOn, errs = evaluateDependsOn(tse.tagEval, tgse, vse, pt.DependsOn)
 		evalErrs = append(evalErrs, errs...)
 		tasks = append(tasks, t)
@@ -1212,6 +1250,8 @@ func evaluateBuildVariants(tse *taskSelectorEvaluator, tgse *tagSelectorEvaluato
 			Tags:               pbv.Tags,
 		}
 		bv.AllowedRequesters = pbv.AllowedRequesters
+		bv.Paths = pbv.Paths
+		bv.IgnorePaths = pbv.IgnorePaths
 		bv.Tasks, unmatchedSelectors, unmatchedCriteria, errs = evaluateBVTasks(tse, tgse, vse, pbv, tasks)
 		if len(unmatchedSelectors) > 0 {
 			bv.TranslationWarnings = append(bv.TranslationWarnings, fmt.Sprintf("buildvariant '%s' has unmatched selector: '%s'", pbv.Name, strings.Join(unmatchedSelectors, "', '")))
@@ -1464,6 +1504,8 @@ func getParserBuildVariantTaskUnit(name string, pt parserTask, bvt parserBVTaskU
 		CreateCheckRun: bvt.CreateCheckRun,
 	}
 	res.AllowedRequesters = bvt.AllowedRequesters
+	res.Paths = bvt.Paths
+	res.IgnorePaths = bvt.IgnorePaths
 	if res.Priority == 0 {
 		res.Priority = pt.Priority
 	}
@@ -1485,6 +1527,12 @@ func getParserBuildVariantTaskUnit(name string, pt parserTask, bvt parserBVTaskU
 	if len(res.AllowedRequesters) == 0 {
 		res.AllowedRequesters = pt.AllowedRequesters
 	}
+	if len(res.Paths) == 0 {
+		res.Paths = pt.Paths
+	}
+	if len(res.IgnorePaths) == 0 {
+		res.IgnorePaths = pt.IgnorePaths
+	}
 	if res.Stepback == nil {
 		res.Stepback = pt.Stepback
 	}
@@ -1513,6 +1561,12 @@ func getParserBuildVariantTaskUnit(name string, pt parserTask, bvt parserBVTaskU
 	if len(res.AllowedRequesters) == 0 {
 		res.AllowedRequesters = bv.AllowedRequesters
 	}
+	if len(res.Paths) == 0 {
+		res.Paths = bv.Paths
+	}
+	if len(res.IgnorePaths) == 0 {
+		res.IgnorePaths = bv.IgnorePaths
+	}
 
 	if res.Disable == nil {
 		res.Disable = bv.Disable
@@ -0,0 +1,302 @@
+package model
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	templateParameterTypeString = "string"
+	templateParameterTypeInt    = "int"
+	templateParameterTypeBool   = "bool"
+)
+
+// templateParamRegex matches references to template parameters in an included
+// file, e.g. ${{ params.test_suite }}. The syntax is deliberately different
+// from expansions, which are evaluated at runtime rather than when the file is
+// included.
+var templateParamRegex = regexp.MustCompile(`\$\{\{\s*params\.([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// templateParameterTags maps each parameter type to the YAML tag of a scalar
+// that consists only of a reference to a parameter of that type.
+var templateParameterTags = map[string]string{
+	templateParameterTypeString: "!!str",
+	templateParameterTypeInt:    "!!int",
+	templateParameterTypeBool:   "!!bool",
+}
+
+// parserTemplateParameter declares a parameter that an included file accepts
+// from the includes that reference it.
+type parserTemplateParameter struct {
+	Name        string `yaml:"name" bson:"name"`
+	Type        string `yaml:"type,omitempty" bson:"type,omitempty"`
+	Description string `yaml:"description,omitempty" bson:"description,omitempty"`
+	// Default is the value used if the include does not set the parameter. If
+	// there is no default, the parameter is required.
+	Default any `yaml:"default,omitempty" bson:"default,omitempty"`
+}
+
+// getType returns the parameter's type, which defaults to string.
+func (p *parserTemplateParameter) getType() string {
+	if p.Type == "" {
+		return templateParameterTypeString
+	}
+	return p.Type
+}
+
+// format checks that the value matches the parameter's type and returns the
+// text to substitute for it.
+func (p *parserTemplateParameter) format(value any) (string, error) {
+	switch p.getType() {
+	case templateParameterTypeString:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	case templateParameterTypeInt:
+		if i, ok := value.(int); ok {
+			return strconv.Itoa(i), nil
+		}
+	case templateParameterTypeBool:
+		if b, ok := value.(bool); ok {
+			return strconv.FormatBool(b), nil
+		}
+	default:
+		return "", errors.Errorf("parameter '%s' has invalid type '%s'", p.Name, p.Type)
+	}
+	return "", errors.Errorf("parameter '%s' must be of type %s but got '%v'", p.Name, p.getType(), value)
+}
+
+// validate checks that the include is well-formed.
+func (i *parserInclude) validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(i.FileName == "", "include must specify a filename")
+	catcher.ErrorfWhen(i.Repo != "" && i.Module != "", "include '%s' cannot specify both a repo and a module", i.FileName)
+	if i.Repo != "" {
+		catcher.ErrorfWhen(i.Ref == "", "include '%s' from repo '%s' must be pinned to a ref", i.FileName, i.Repo)
+		_, _, err := i.getOwnerAndRepo()
+		catcher.Add(err)
+	} else {
+		catcher.ErrorfWhen(i.Ref != "", "include '%s' can only specify a ref if it specifies a repo", i.FileName)
+	}
+	return catcher.Resolve()
+}
+
+// getOwnerAndRepo returns the owner and name of the repo that the include
+// references.
+func (i *parserInclude) getOwnerAndRepo() (string, string, error) {
+	parts := strings.Split(i.Repo, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.Errorf("include repo '%s' must be in the format 'owner/repo'", i.Repo)
+	}
+	return parts[0], parts[1], nil
+}
+
+// key uniquely identifies the file that the include references.
+func (i *parserInclude) key() string {
+	if i.Repo != "" {
+		return fmt.Sprintf("%s@%s:%s", i.Repo, i.Ref, i.FileName)
+	}
+	if i.Module != "" {
+		return fmt.Sprintf("%s:%s", i.Module, i.FileName)
+	}
+	return i.FileName
+}
+
+// checkIncludeRepoOwner checks that the include's repo belongs to either the
+// project's own owner or one of the GitHub organizations allowed by the admin
+// settings, so that projects can't pull configuration from arbitrary repos.
+func (i *parserInclude) checkIncludeRepoOwner(projectOwner string, allowedOwners []string) error {
+	owner, _, err := i.getOwnerAndRepo()
+	if err != nil {
+		return err
+	}
+	if owner == projectOwner || utility.StringSliceContains(allowedOwners, owner) {
+		return nil
+	}
+	return errors.Errorf("include repo '%s' must belong to the project's owner or an allowed GitHub organization", i.Repo)
+}
+
+// retrieveFileForRepo retrieves the included file from the repo at the pinned
+// ref. The file is always read from GitHub, since the pinned ref can't be
+// modified by patches or local changes.
+func retrieveFileForRepo(ctx context.Context, include parserInclude, projectRef *ProjectRef) ([]byte, error) {
+	var projectOwner string
+	if projectRef != nil {
+		projectOwner = projectRef.Owner
+	}
+	var allowedOwners []string
+	if settings := evergreen.GetEnvironment().Settings(); settings != nil {
+		allowedOwners = settings.GithubOrgs
+	}
+	if err := include.checkIncludeRepoOwner(projectOwner, allowedOwners); err != nil {
+		return nil, err
+	}
+	owner, repo, err := include.getOwnerAndRepo()
+	if err != nil {
+		return nil, err
+	}
+	return retrieveFile(ctx, GetProjectOpts{
+		Ref: &ProjectRef{
+			Owner: owner,
+			Repo:  repo,
+		},
+		RemotePath:   include.FileName,
+		Revision:     include.Ref,
+		ReadFileFrom: ReadFromGithub,
+		Identifier:   include.Repo,
+	})
+}
+
+// applyIncludeParams substitutes the include's parameters into the included
+// file's references to them. Parameters must be declared by the included
+// file, and every referenced parameter must either be set by the include or
+// have a default. Parameters are substituted into the parsed YAML scalars
+// rather than the raw text, so a parameter value can't change the structure
+// of the included file.
+func applyIncludeParams(yml []byte, include parserInclude) ([]byte, error) {
+	declared := struct {
+		TemplateParameters []parserTemplateParameter `yaml:"template_parameters"`
+	}{}
+	if err := util.UnmarshalYAMLWithFallback(yml, &declared); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling template parameters")
+	}
+	if len(declared.TemplateParameters) == 0 && len(include.Params) == 0 {
+		return yml, nil
+	}
+
+	catcher := grip.NewBasicCatcher()
+	declaredNames := map[string]bool{}
+	values := map[string]templateParamValue{}
+	for _, param := range declared.TemplateParameters {
+		if param.Name == "" {
+			catcher.New("template parameter must have a name")
+			continue
+		}
+		if declaredNames[param.Name] {
+			catcher.Errorf("template parameter '%s' is declared more than once", param.Name)
+			continue
+		}
+		declaredNames[param.Name] = true
+		value, ok := include.Params[param.Name]
+		if !ok {
+			if param.Default == nil {
+				catcher.Errorf("required parameter '%s' is not set", param.Name)
+				continue
+			}
+			value = param.Default
+		}
+		formatted, err := param.format(value)
+		if err != nil {
+			catcher.Add(err)
+			continue
+		}
+		values[param.Name] = templateParamValue{text: formatted, tag: templateParameterTags[param.getType()]}
+	}
+
+	var unknown []string
+	for name := range include.Params {
+		if !declaredNames[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		catcher.Errorf("parameter '%s' is not declared by the included file", name)
+	}
+	if catcher.HasErrors() {
+		return nil, catcher.Resolve()
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(yml, &root); err != nil {
+		return nil, errors.Wrap(err, "parsing included YAML")
+	}
+	var undeclared []string
+	substituteTemplateParams(&root, values, &undeclared)
+	for _, name := range utility.UniqueStrings(undeclared) {
+		catcher.Errorf("parameter '%s' is referenced but not declared by the included file", name)
+	}
+	if catcher.HasErrors() {
+		return nil, catcher.Resolve()
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&root); err != nil {
+		return nil, errors.Wrap(err, "encoding included YAML")
+	}
+	if err := enc.Close(); err != nil {
+		return nil, errors.Wrap(err, "closing YAML encoder")
+	}
+	return buf.Bytes(), nil
+}
+
+// templateParamValue is the value substituted for a template parameter.
+type templateParamValue struct {
+	text string
+	tag  string
+}
+
+// substituteTemplateParams replaces references to template parameters in the
+// node's scalars, including mapping keys. A scalar that consists only of a
+// reference takes on the parameter's type; otherwise, the substituted scalar
+// is a string. The names of referenced parameters that have no value are
+// added to undeclared.
+func substituteTemplateParams(node *yaml.Node, values map[string]templateParamValue, undeclared *[]string) {
+	if node == nil || node.Kind == yaml.AliasNode {
+		return
+	}
+	if node.Kind != yaml.ScalarNode {
+		for _, child := range node.Content {
+			substituteTemplateParams(child, values, undeclared)
+		}
+		return
+	}
+
+	loc := templateParamRegex.FindStringSubmatchIndex(node.Value)
+	if loc == nil {
+		return
+	}
+	if loc[0] == 0 && loc[1] == len(node.Value) {
+		name := node.Value[loc[2]:loc[3]]
+		value, ok := values[name]
+		if !ok {
+			*undeclared = append(*undeclared, name)
+			return
+		}
+		node.Value = value.text
+		node.Tag = value.tag
+		if value.tag != "!!str" {
+			node.Style = 0
+		}
+		return
+	}
+
+	substituted := false
+	node.Value = templateParamRegex.ReplaceAllStringFunc(node.Value, func(ref string) string {
+		name := templateParamRegex.FindStringSubmatch(ref)[1]
+		value, ok := values[name]
+		if !ok {
+			*undeclared = append(*undeclared, name)
+			return ref
+		}
+		substituted = true
+		return value.text
+	})
+	if substituted {
+		node.Tag = "!!str"
+	}
+}
@@ -0,0 +1,204 @@
+package model
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestApplyIncludeParams(t *testing.T) {
+	const template = `
+template_parameters:
+  - name: suite
+  - name: shards
+    type: int
+    default: 1
+  - name: race
+    type: bool
+    default: false
+tasks:
+  - name: ${{ params.suite }}_tests
+    commands:
+      - command: shell.exec
+        params:
+          script: go test --shards=${{params.shards}} --race=${{ params.race }} ${suite_flags}
+`
+	for tName, tCase := range map[string]struct {
+		yml           string
+		params        map[string]any
+		expected      string
+		errorContains string
+	}{
+		"SubstitutesParamsAndDefaults": {
+			yml:      template,
+			params:   map[string]any{"suite": "core", "race": true},
+			expected: "script: go test --shards=1 --race=true ${suite_flags}",
+		},
+		"FileWithoutParamsIsUnchanged": {
+			yml:      "tasks:\n  - name: ${{ params.suite }}\n",
+			expected: "name: ${{ params.suite }}",
+		},
+		"MissingRequiredParamErrors": {
+			yml:           template,
+			params:        map[string]any{"shards": 2},
+			errorContains: "required parameter 'suite' is not set",
+		},
+		"WrongTypeErrors": {
+			yml:           template,
+			params:        map[string]any{"suite": "core", "shards": "two"},
+			errorContains: "parameter 'shards' must be of type int",
+		},
+		"UnknownParamErrors": {
+			yml:           template,
+			params:        map[string]any{"suite": "core", "timeout": 10},
+			errorContains: "parameter 'timeout' is not declared",
+		},
+		"UndeclaredReferenceErrors": {
+			yml:           "template_parameters:\n  - name: suite\ntasks:\n  - name: ${{ params.suite }}_${{ params.variant }}\n",
+			params:        map[string]any{"suite": "core"},
+			errorContains: "parameter 'variant' is referenced but not declared",
+		},
+		"InvalidTypeErrors": {
+			yml:           "template_parameters:\n  - name: suite\n    type: list\n",
+			params:        map[string]any{"suite": "core"},
+			errorContains: "invalid type 'list'",
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			yml, err := applyIncludeParams([]byte(tCase.yml), parserInclude{FileName: "template.yml", Params: tCase.params})
+			if tCase.errorContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tCase.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, string(yml), tCase.expected)
+		})
+	}
+}
+
+func TestApplyIncludeParamsCannotInjectYAML(t *testing.T) {
+	const template = `
+template_parameters:
+  - name: suite
+  - name: shards
+    type: int
+  - name: race
+    type: bool
+tasks:
+  - name: ${{ params.suite }}
+    commands:
+      - command: shell.exec
+        params:
+          shards: ${{ params.shards }}
+          race: ${{ params.race }}
+          script: run-tests ${{ params.suite }}
+`
+	suite := "core\ntasks:\n  - name: injected\n# "
+	yml, err := applyIncludeParams([]byte(template), parserInclude{
+		FileName: "template.yml",
+		Params:   map[string]any{"suite": suite, "shards": 3, "race": true},
+	})
+	require.NoError(t, err)
+
+	var out struct {
+		Tasks []struct {
+			Name     string `yaml:"name"`
+			Commands []struct {
+				Params map[string]any `yaml:"params"`
+			} `yaml:"commands"`
+		} `yaml:"tasks"`
+	}
+	require.NoError(t, yaml.Unmarshal(yml, &out))
+	require.Len(t, out.Tasks, 1, "parameter value should not add tasks")
+	assert.Equal(t, suite, out.Tasks[0].Name)
+	require.Len(t, out.Tasks[0].Commands, 1)
+	params := out.Tasks[0].Commands[0].Params
+	assert.Equal(t, "run-tests "+suite, params["script"])
+	assert.Equal(t, 3, params["shards"], "exact reference should keep the parameter's type")
+	assert.Equal(t, true, params["race"], "exact reference should keep the parameter's type")
+
+	yml, err = applyIncludeParams([]byte(template), parserInclude{
+		FileName: "template.yml",
+		Params:   map[string]any{"suite": "true", "shards": 1, "race": false},
+	})
+	require.NoError(t, err)
+	out.Tasks = nil
+	require.NoError(t, yaml.Unmarshal(yml, &out))
+	require.Len(t, out.Tasks, 1)
+	assert.Equal(t, "true", out.Tasks[0].Name, "string parameter should stay a string")
+}
+
+func TestParserIncludeCheckIncludeRepoOwner(t *testing.T) {
+	include := parserInclude{FileName: "a.yml", Repo: "evergreen-ci/templates", Ref: "v1.2.0"}
+	assert.NoError(t, include.checkIncludeRepoOwner("evergreen-ci", nil), "project's own owner should be allowed")
+	assert.NoError(t, include.checkIncludeRepoOwner("mongodb", []string{"evergreen-ci"}), "allowed organization should be allowed")
+
+	err := include.checkIncludeRepoOwner("mongodb", []string{"10gen"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must belong to the project's owner or an allowed GitHub organization")
+	assert.Error(t, include.checkIncludeRepoOwner("", nil), "include without a project owner should only allow allowed organizations")
+}
+
+func TestParserIncludeValidate(t *testing.T) {
+	assert.NoError(t, (&parserInclude{FileName: "a.yml"}).validate())
+	assert.NoError(t, (&parserInclude{FileName: "a.yml", Repo: "evergreen-ci/templates", Ref: "v1.2.0"}).validate())
+	assert.Error(t, (&parserInclude{FileName: "a.yml", Repo: "evergreen-ci/templates"}).validate(), "repo includes must be pinned")
+	assert.Error(t, (&parserInclude{FileName: "a.yml", Repo: "templates", Ref: "v1.2.0"}).validate(), "repo must include the owner")
+	assert.Error(t, (&parserInclude{FileName: "a.yml", Repo: "evergreen-ci/templates", Ref: "v1.2.0", Module: "m"}).validate())
+	assert.Error(t, (&parserInclude{FileName: "a.yml", Ref: "v1.2.0"}).validate(), "ref requires a repo")
+	assert.Error(t, (&parserInclude{}).validate())
+}
+
+func TestLoadProjectIntoWithIncludeParams(t *testing.T) {
+	templatePath := filepath.Join(t.TempDir(), "tests.yml")
+	require.NoError(t, os.WriteFile(templatePath, []byte(`
+template_parameters:
+  - name: suite
+  - name: shards
+    type: int
+    default: 1
+tasks:
+  - name: ${{ params.suite }}_tests
+    commands:
+      - command: shell.exec
+        params:
+          script: run-tests --suite=${{ params.suite }} --shards=${{ params.shards }}
+`), 0644))
+
+	yml := `
+include:
+  - filename: ` + templatePath + `
+    params:
+      suite: core
+  - filename: ` + templatePath + `
+    params:
+      suite: auth
+      shards: 4
+buildvariants:
+  - name: bv
+    run_on:
+      - localhost
+    tasks:
+      - name: core_tests
+      - name: auth_tests
+`
+	p := &Project{}
+	pp, err := LoadProjectInto(t.Context(), []byte(yml), &GetProjectOpts{ReadFileFrom: ReadFromLocal, UnmarshalStrict: true}, "id", p)
+	require.NoError(t, err)
+	assert.Empty(t, pp.TemplateParameters)
+
+	core := p.FindProjectTask("core_tests")
+	require.NotNil(t, core)
+	require.Len(t, core.Commands, 1)
+	assert.Equal(t, "run-tests --suite=core --shards=1", core.Commands[0].Params["script"])
+
+	auth := p.FindProjectTask("auth_tests")
+	require.NotNil(t, auth)
+	require.Len(t, auth.Commands, 1)
+	assert.Equal(t, "run-tests --suite=auth --shards=4", auth.Commands[0].Params["script"])
+}
@@ -70,6 +70,9 @@ type ProjectRef struct {
 	DeactivatePrevious     *bool               `bson:"deactivate_previous,omitempty" json:"deactivate_previous,omitempty" yaml:"deactivate_previous"`
 	NotifyOnBuildFailure   *bool               `bson:"notify_on_failure,omitempty" json:"notify_on_failure,omitempty"`
 	Triggers               []TriggerDefinition `bson:"triggers" json:"triggers"`
+	// NotificationDigest is how often to send the notifications for the
+	// project's subscriptions that don't set their own digest frequency.
+	NotificationDigest string `bson:"notification_digest,omitempty" json:"notification_digest,omitempty"`
 	// all aliases defined for the project
 	PatchTriggerAliases []patch.PatchTriggerDefinition `bson:"patch_trigger_aliases" json:"patch_trigger_aliases"`
 	// all PatchTriggerAliases applied to github patch intents
@@ -486,6 +489,7 @@ var (
 	projectRefStepbackBisectKey                     = bsonutil.MustHaveTag(ProjectRef{}, "StepbackBisect")
 	projectRefVersionControlEnabledKey              = bsonutil.MustHaveTag(ProjectRef{}, "VersionControlEnabled")
 	projectRefNotifyOnFailureKey                    = bsonutil.MustHaveTag(ProjectRef{}, "NotifyOnBuildFailure")
+	projectRefNotificationDigestKey                 = bsonutil.MustHaveTag(ProjectRef{}, "NotificationDigest")
 	projectRefSpawnHostScriptPathKey                = bsonutil.MustHaveTag(ProjectRef{}, "SpawnHostScriptPath")
 	projectRefTriggersKey                           = bsonutil.MustHaveTag(ProjectRef{}, "Triggers")
 	projectRefPatchTriggerAliasesKey                = bsonutil.MustHaveTag(ProjectRef{}, "PatchTriggerAliases")
@@ -2248,11 +2252,15 @@ func SaveProjectPageForSection(ctx context.Context, projectId string, p *Project
 				},
 			})
 	case ProjectPageNotificationsSection:
+		if !event.IsValidDigest(p.NotificationDigest) {
+			return false, errors.Errorf("invalid notification digest frequency '%s'", p.NotificationDigest)
+		}
 		err = db.UpdateContext(ctx, coll,
 			bson.M{ProjectRefIdKey: projectId},
 			bson.M{
 				"$set": bson.M{projectRefNotifyOnFailureKey: p.NotifyOnBuildFailure,
-					projectRefBannerKey: p.Banner},
+					projectRefNotificationDigestKey: p.NotificationDigest,
+					projectRefBannerKey:             p.Banner},
 			})
 	case ProjectPageWorkstationsSection:
 		err = db.UpdateContext(ctx, coll,
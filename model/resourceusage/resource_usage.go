@@ -0,0 +1,225 @@
+// Package resourceusage stores the resource usage of the processes that a task
+// runs, sampled by the agent while the task runs.
+package resourceusage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Collection is the collection that stores task resource usage.
+const Collection = "task_resource_usage"
+
+// MaxSamples is the maximum number of samples stored for a task execution.
+// Longer time series are downsampled to fit.
+const MaxSamples = 2000
+
+// TaskResourceUsage is the time series of resource usage for a single task
+// execution.
+type TaskResourceUsage struct {
+	ID        string `bson:"_id" json:"-"`
+	TaskID    string `bson:"task_id" json:"task_id"`
+	Execution int    `bson:"execution" json:"execution"`
+	// SampleIntervalSecs is the number of seconds between the samples that
+	// the agent took. A downsampled sample covers Count of these intervals.
+	SampleIntervalSecs float64  `bson:"sample_interval_secs" json:"sample_interval_secs"`
+	Samples            []Sample `bson:"samples" json:"samples"`
+}
+
+// Sample is the resource usage of a task's processes at a point in time,
+// attributed to the command that was running at the time. Counters such as
+// disk and network bytes are the amount since the previous sample. The keys
+// are short because a task may have thousands of samples.
+type Sample struct {
+	Time time.Time `bson:"t" json:"time"`
+	// Block is the block of commands, such as pre or the main task block, that
+	// the command belongs to.
+	Block   string `bson:"b,omitempty" json:"block,omitempty"`
+	Command string `bson:"c,omitempty" json:"command,omitempty"`
+	// Count is the number of samples that were merged into this one by
+	// downsampling. Zero means that the sample was not merged.
+	Count int `bson:"n,omitempty" json:"count,omitempty"`
+	// CPUPercent is the CPU usage of all of the task's processes, where 100
+	// is one fully-used core. For merged samples, CPUPercent and MemoryBytes
+	// are averages and the peaks are kept separately.
+	CPUPercent      float64 `bson:"cpu" json:"cpu_percent"`
+	MemoryBytes     uint64  `bson:"mem" json:"memory_bytes"`
+	PeakCPUPercent  float64 `bson:"pcpu,omitempty" json:"peak_cpu_percent,omitempty"`
+	PeakMemoryBytes uint64  `bson:"pmem,omitempty" json:"peak_memory_bytes,omitempty"`
+	DiskReadBytes   uint64  `bson:"dr,omitempty" json:"disk_read_bytes"`
+	DiskWriteBytes  uint64  `bson:"dw,omitempty" json:"disk_write_bytes"`
+	// NetSentBytes and NetRecvBytes are for the whole host because network
+	// usage is not available per process.
+	NetSentBytes uint64 `bson:"ns,omitempty" json:"net_sent_bytes"`
+	NetRecvBytes uint64 `bson:"nr,omitempty" json:"net_recv_bytes"`
+	Processes    int    `bson:"p" json:"processes"`
+}
+
+var (
+	IDKey        = bsonutil.MustHaveTag(TaskResourceUsage{}, "ID")
+	TaskIDKey    = bsonutil.MustHaveTag(TaskResourceUsage{}, "TaskID")
+	ExecutionKey = bsonutil.MustHaveTag(TaskResourceUsage{}, "Execution")
+)
+
+func usageID(taskID string, execution int) string {
+	return fmt.Sprintf("%s_%d", taskID, execution)
+}
+
+// Upsert stores the resource usage, replacing any already stored for the task
+// execution. The samples are downsampled to at most MaxSamples first.
+func (u *TaskResourceUsage) Upsert(ctx context.Context) error {
+	u.ID = usageID(u.TaskID, u.Execution)
+	u.Samples = Downsample(u.Samples, MaxSamples)
+	_, err := db.ReplaceContext(ctx, Collection, bson.M{IDKey: u.ID}, u)
+	return errors.Wrapf(err, "upserting resource usage for task '%s' execution %d", u.TaskID, u.Execution)
+}
+
+// FindOne returns the resource usage for the task execution, or nil if there
+// is none.
+func FindOne(ctx context.Context, taskID string, execution int) (*TaskResourceUsage, error) {
+	u := &TaskResourceUsage{}
+	err := db.FindOneQContext(ctx, Collection, db.Query(bson.M{IDKey: usageID(taskID, execution)}), u)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding resource usage for task '%s' execution %d", taskID, execution)
+	}
+	return u, nil
+}
+
+// Downsample merges adjacent pairs of samples until there are at most max.
+// Merged samples keep the average and peak CPU and memory usage, the peak
+// number of processes, the total counters and the number of samples they
+// cover, so summaries are the same before and after downsampling. Samples
+// from different commands are never merged, so the result may still slightly
+// exceed max.
+func Downsample(samples []Sample, max int) []Sample {
+	for max > 0 && len(samples) > max {
+		merged := make([]Sample, 0, len(samples)/2+1)
+		for i := 0; i < len(samples); i++ {
+			s := samples[i]
+			if i+1 < len(samples) && samples[i+1].Block == s.Block && samples[i+1].Command == s.Command {
+				s = mergeSamples(s, samples[i+1])
+				i++
+			}
+			merged = append(merged, s)
+		}
+		if len(merged) == len(samples) {
+			break
+		}
+		samples = merged
+	}
+	return samples
+}
+
+func mergeSamples(a, b Sample) Sample {
+	merged := b
+	countA, countB := a.count(), b.count()
+	merged.Count = countA + countB
+	merged.CPUPercent = (a.CPUPercent*float64(countA) + b.CPUPercent*float64(countB)) / float64(merged.Count)
+	merged.MemoryBytes = (a.MemoryBytes*uint64(countA) + b.MemoryBytes*uint64(countB)) / uint64(merged.Count)
+	merged.PeakCPUPercent = max(a.peakCPUPercent(), b.peakCPUPercent())
+	merged.PeakMemoryBytes = max(a.peakMemoryBytes(), b.peakMemoryBytes())
+	merged.Processes = max(a.Processes, b.Processes)
+	merged.DiskReadBytes += a.DiskReadBytes
+	merged.DiskWriteBytes += a.DiskWriteBytes
+	merged.NetSentBytes += a.NetSentBytes
+	merged.NetRecvBytes += a.NetRecvBytes
+	return merged
+}
+
+// count returns the number of samples that the agent took that this sample
+// covers.
+func (s Sample) count() int {
+	if s.Count == 0 {
+		return 1
+	}
+	return s.Count
+}
+
+func (s Sample) peakCPUPercent() float64 {
+	return max(s.CPUPercent, s.PeakCPUPercent)
+}
+
+func (s Sample) peakMemoryBytes() uint64 {
+	return max(s.MemoryBytes, s.PeakMemoryBytes)
+}
+
+// Summary is the peak, average and total resource usage over a set of
+// samples. NumSamples counts the samples that the agent took, including ones
+// that were merged by downsampling.
+type Summary struct {
+	Block           string  `json:"block,omitempty"`
+	Command         string  `json:"command,omitempty"`
+	NumSamples      int     `json:"num_samples"`
+	DurationSecs    float64 `json:"duration_secs"`
+	PeakCPUPercent  float64 `json:"peak_cpu_percent"`
+	AvgCPUPercent   float64 `json:"avg_cpu_percent"`
+	PeakMemoryBytes uint64  `json:"peak_memory_bytes"`
+	AvgMemoryBytes  uint64  `json:"avg_memory_bytes"`
+	PeakProcesses   int     `json:"peak_processes"`
+	DiskReadBytes   uint64  `json:"disk_read_bytes"`
+	DiskWriteBytes  uint64  `json:"disk_write_bytes"`
+	NetSentBytes    uint64  `json:"net_sent_bytes"`
+	NetRecvBytes    uint64  `json:"net_recv_bytes"`
+}
+
+func (s *Summary) add(sample Sample) {
+	count := sample.count()
+	s.NumSamples += count
+	s.PeakCPUPercent = max(s.PeakCPUPercent, sample.peakCPUPercent())
+	s.PeakMemoryBytes = max(s.PeakMemoryBytes, sample.peakMemoryBytes())
+	s.PeakProcesses = max(s.PeakProcesses, sample.Processes)
+	// Accumulate the totals in the average fields and divide at the end.
+	// Merged samples are weighted by the number of samples they cover.
+	s.AvgCPUPercent += sample.CPUPercent * float64(count)
+	s.AvgMemoryBytes += sample.MemoryBytes * uint64(count)
+	s.DiskReadBytes += sample.DiskReadBytes
+	s.DiskWriteBytes += sample.DiskWriteBytes
+	s.NetSentBytes += sample.NetSentBytes
+	s.NetRecvBytes += sample.NetRecvBytes
+}
+
+func (s *Summary) finish(intervalSecs float64) {
+	if s.NumSamples == 0 {
+		return
+	}
+	s.AvgCPUPercent /= float64(s.NumSamples)
+	s.AvgMemoryBytes /= uint64(s.NumSamples)
+	s.DurationSecs = float64(s.NumSamples) * intervalSecs
+}
+
+// Summarize returns the resource usage summary for the whole task and for
+// each command, in the order that the commands first ran.
+func (u *TaskResourceUsage) Summarize() (Summary, []Summary) {
+	var total Summary
+	var byCommand []Summary
+	indexes := map[[2]string]int{}
+	for _, sample := range u.Samples {
+		total.add(sample)
+
+		key := [2]string{sample.Block, sample.Command}
+		i, ok := indexes[key]
+		if !ok {
+			i = len(byCommand)
+			indexes[key] = i
+			byCommand = append(byCommand, Summary{Block: sample.Block, Command: sample.Command})
+		}
+		byCommand[i].add(sample)
+	}
+
+	total.finish(u.SampleIntervalSecs)
+	for i := range byCommand {
+		byCommand[i].finish(u.SampleIntervalSecs)
+	}
+
+	return total, byCommand
+}
@@ -0,0 +1,170 @@
+package resourceusage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	testutil.Setup()
+}
+
+func TestDownsample(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var samples []Sample
+	for i := 0; i < 8; i++ {
+		samples = append(samples, Sample{
+			Time:          start.Add(time.Duration(i) * 10 * time.Second),
+			Command:       "cmd",
+			CPUPercent:    float64(i * 10),
+			MemoryBytes:   uint64(100 - i),
+			DiskReadBytes: 1,
+		})
+	}
+
+	t.Run("MergesPairs", func(t *testing.T) {
+		downsampled := Downsample(samples, 4)
+		require.Len(t, downsampled, 4)
+		assert.Equal(t, 2, downsampled[0].Count)
+		assert.EqualValues(t, 5, downsampled[0].CPUPercent, "should average the CPU")
+		assert.EqualValues(t, 10, downsampled[0].PeakCPUPercent, "should keep the peak CPU")
+		assert.EqualValues(t, 99, downsampled[0].MemoryBytes, "should average the memory")
+		assert.EqualValues(t, 100, downsampled[0].PeakMemoryBytes, "should keep the peak memory")
+		assert.EqualValues(t, 2, downsampled[0].DiskReadBytes, "should sum the counters")
+		assert.Equal(t, samples[1].Time, downsampled[0].Time)
+	})
+	t.Run("WeightsMergedSamples", func(t *testing.T) {
+		downsampled := Downsample(samples, 2)
+		require.Len(t, downsampled, 2)
+		assert.Equal(t, 4, downsampled[0].Count)
+		assert.EqualValues(t, 15, downsampled[0].CPUPercent)
+		assert.EqualValues(t, 30, downsampled[0].PeakCPUPercent)
+		assert.EqualValues(t, 100, downsampled[0].PeakMemoryBytes)
+		assert.EqualValues(t, 4, downsampled[0].DiskReadBytes)
+	})
+	t.Run("NoopUnderMax", func(t *testing.T) {
+		downsampled := Downsample(samples, 10)
+		assert.Equal(t, samples, downsampled)
+	})
+	t.Run("DoesNotMergeDifferentCommands", func(t *testing.T) {
+		mixed := []Sample{{Command: "a"}, {Command: "b"}, {Command: "c"}}
+		downsampled := Downsample(mixed, 1)
+		assert.Len(t, downsampled, 3)
+	})
+}
+
+func TestSummarize(t *testing.T) {
+	u := TaskResourceUsage{
+		SampleIntervalSecs: 10,
+		Samples: []Sample{
+			{Block: "pre", Command: "setup", CPUPercent: 50, MemoryBytes: 100, DiskWriteBytes: 5, Processes: 1},
+			{Block: "task", Command: "test", CPUPercent: 200, MemoryBytes: 1000, DiskWriteBytes: 10, Processes: 4},
+			{Block: "task", Command: "test", CPUPercent: 100, MemoryBytes: 3000, DiskWriteBytes: 20, Processes: 2},
+		},
+	}
+
+	total, byCommand := u.Summarize()
+	assert.Equal(t, 3, total.NumSamples)
+	assert.EqualValues(t, 30, total.DurationSecs)
+	assert.EqualValues(t, 200, total.PeakCPUPercent)
+	assert.InDelta(t, 116.67, total.AvgCPUPercent, 0.01)
+	assert.EqualValues(t, 3000, total.PeakMemoryBytes)
+	assert.EqualValues(t, 35, total.DiskWriteBytes)
+	assert.Equal(t, 4, total.PeakProcesses)
+
+	require.Len(t, byCommand, 2)
+	assert.Equal(t, "setup", byCommand[0].Command)
+	assert.Equal(t, 1, byCommand[0].NumSamples)
+	assert.Equal(t, "test", byCommand[1].Command)
+	assert.Equal(t, "task", byCommand[1].Block)
+	assert.EqualValues(t, 150, byCommand[1].AvgCPUPercent)
+	assert.EqualValues(t, 2000, byCommand[1].AvgMemoryBytes)
+	assert.EqualValues(t, 3000, byCommand[1].PeakMemoryBytes)
+	assert.EqualValues(t, 20, byCommand[1].DurationSecs)
+}
+
+func TestSummarizeDownsampled(t *testing.T) {
+	var samples []Sample
+	for i := 0; i < 9; i++ {
+		samples = append(samples, Sample{
+			Command:       "compile",
+			CPUPercent:    float64(i%3) * 50,
+			MemoryBytes:   uint64(1000 + i*100),
+			DiskReadBytes: 10,
+			Processes:     i % 4,
+		})
+	}
+	samples = append(samples, Sample{Command: "test", CPUPercent: 400, MemoryBytes: 5000, Processes: 8})
+	for i := 0; i < 4; i++ {
+		samples = append(samples, Sample{Command: "upload", CPUPercent: 10, MemoryBytes: 200, NetSentBytes: 100})
+	}
+
+	original := TaskResourceUsage{SampleIntervalSecs: 10, Samples: samples}
+	downsampled := TaskResourceUsage{SampleIntervalSecs: 10, Samples: Downsample(samples, 4)}
+	require.Less(t, len(downsampled.Samples), len(samples))
+
+	checkSummary := func(t *testing.T, expected, actual Summary) {
+		assert.Equal(t, expected.Block, actual.Block)
+		assert.Equal(t, expected.Command, actual.Command)
+		assert.Equal(t, expected.NumSamples, actual.NumSamples)
+		assert.Equal(t, expected.DurationSecs, actual.DurationSecs)
+		assert.Equal(t, expected.PeakCPUPercent, actual.PeakCPUPercent)
+		assert.InDelta(t, expected.AvgCPUPercent, actual.AvgCPUPercent, 0.001)
+		assert.Equal(t, expected.PeakMemoryBytes, actual.PeakMemoryBytes)
+		// Averaging merged samples' memory truncates to whole bytes.
+		assert.InDelta(t, expected.AvgMemoryBytes, actual.AvgMemoryBytes, 1)
+		assert.Equal(t, expected.PeakProcesses, actual.PeakProcesses)
+		assert.Equal(t, expected.DiskReadBytes, actual.DiskReadBytes)
+		assert.Equal(t, expected.NetSentBytes, actual.NetSentBytes)
+	}
+
+	expectedTotal, expectedByCommand := original.Summarize()
+	total, byCommand := downsampled.Summarize()
+	checkSummary(t, expectedTotal, total)
+	assert.EqualValues(t, 140, total.DurationSecs)
+	require.Len(t, byCommand, len(expectedByCommand))
+	for i := range expectedByCommand {
+		checkSummary(t, expectedByCommand[i], byCommand[i])
+	}
+}
+
+func TestUpsertAndFindOne(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, db.Clear(Collection))
+	defer func() {
+		assert.NoError(t, db.Clear(Collection))
+	}()
+
+	found, err := FindOne(ctx, "t1", 0)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	u := &TaskResourceUsage{
+		TaskID:             "t1",
+		Execution:          1,
+		SampleIntervalSecs: 10,
+		Samples:            []Sample{{Command: "cmd", CPUPercent: 50, MemoryBytes: 100}},
+	}
+	require.NoError(t, u.Upsert(ctx))
+
+	u.Samples = append(u.Samples, Sample{Command: "cmd", CPUPercent: 70, MemoryBytes: 200})
+	require.NoError(t, u.Upsert(ctx))
+
+	found, err = FindOne(ctx, "t1", 1)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, "t1", found.TaskID)
+	assert.Equal(t, 1, found.Execution)
+	assert.Len(t, found.Samples, 2, "upserting should replace the samples")
+
+	found, err = FindOne(ctx, "t1", 0)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
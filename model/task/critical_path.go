@@ -0,0 +1,213 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// CriticalPath describes which chain of tasks determined a version's
+// wall-clock makespan.
+type CriticalPath struct {
+	// StartTime is the time the earliest task in the version was scheduled.
+	StartTime time.Time
+	// FinishTime is the time the last task in the version finished.
+	FinishTime time.Time
+	// Path is the chain of tasks, in order of execution, that ends with
+	// the last task to finish. Each task in the path could not become ready
+	// to run until the task before it finished.
+	Path []CriticalPathTask
+	// Tasks contains every finished task in the version, in topological
+	// order with tasks before the tasks that depend on them.
+	Tasks []CriticalPathTask
+}
+
+// Makespan returns the wall-clock time between the version's first task being
+// scheduled and its last task finishing.
+func (cp *CriticalPath) Makespan() time.Duration {
+	return cp.FinishTime.Sub(cp.StartTime)
+}
+
+// CriticalPathTask contains the timing of a single task in a version's
+// critical path analysis.
+type CriticalPathTask struct {
+	TaskNode
+	ScheduledTime time.Time
+	// ReadyTime is the time the task was both scheduled and had all of its
+	// dependencies finish.
+	ReadyTime  time.Time
+	StartTime  time.Time
+	FinishTime time.Time
+	// DependencyWait is the time between the task being scheduled and its
+	// last dependency finishing.
+	DependencyWait time.Duration
+	// QueueWait is the time between the task becoming ready and starting.
+	QueueWait time.Duration
+	// RunTime is the time between the task starting and finishing.
+	RunTime time.Duration
+	// Slack is how much later the task could have finished without delaying
+	// the version's finish time, assuming every task that depends on it
+	// takes as long after becoming ready as it actually did.
+	Slack time.Duration
+	// OnCriticalPath is true if the task is in the critical path.
+	OnCriticalPath bool
+}
+
+// VersionCriticalPath computes the critical path of the version given by
+// versionID. Only finished tasks are considered. Display tasks are not
+// included, but dependencies on a display task are treated as dependencies on
+// each of its execution tasks.
+func VersionCriticalPath(ctx context.Context, versionID string) (*CriticalPath, error) {
+	tasks, err := FindWithFields(ctx, ByVersion(versionID),
+		IdKey, DisplayNameKey, BuildVariantKey, DependsOnKey, DisplayOnlyKey, ExecutionTasksKey,
+		ScheduledTimeKey, ActivatedTimeKey, StartTimeKey, FinishTimeKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting tasks for version '%s'", versionID)
+	}
+
+	return computeCriticalPath(tasks)
+}
+
+func computeCriticalPath(tasks []Task) (*CriticalPath, error) {
+	finished := make(map[string]Task, len(tasks))
+	execTasks := make(map[string][]string)
+	for _, t := range tasks {
+		if t.DisplayOnly {
+			execTasks[t.Id] = t.ExecutionTasks
+			continue
+		}
+		if utility.IsZeroTime(t.StartTime) || utility.IsZeroTime(t.FinishTime) {
+			continue
+		}
+		finished[t.Id] = t
+	}
+	if len(finished) == 0 {
+		return nil, errors.New("version has no finished tasks")
+	}
+
+	// Edges point from depended on tasks to the tasks that depend on them,
+	// so sorting the graph orders dependencies first.
+	g := NewDependencyGraph(true)
+	for _, t := range tasks {
+		if _, ok := finished[t.Id]; ok {
+			g.AddTaskNode(t.ToTaskNode())
+		}
+	}
+	for _, t := range tasks {
+		if _, ok := finished[t.Id]; !ok {
+			continue
+		}
+		for _, dep := range t.DependsOn {
+			depIDs := []string{dep.TaskId}
+			if ids, ok := execTasks[dep.TaskId]; ok {
+				depIDs = ids
+			}
+			for _, depID := range depIDs {
+				if depTask, ok := finished[depID]; ok {
+					g.AddEdge(t.ToTaskNode(), depTask.ToTaskNode(), dep.Status)
+				}
+			}
+		}
+	}
+
+	sorted, err := g.TopologicalStableSort()
+	if err != nil {
+		return nil, errors.Wrap(err, "sorting tasks")
+	}
+	if len(sorted) != len(finished) {
+		return nil, errors.New("version's task dependencies contain a cycle")
+	}
+
+	cp := &CriticalPath{}
+	cpTasks := make(map[TaskNode]*CriticalPathTask, len(sorted))
+	dependents := make(map[TaskNode][]TaskNode, len(sorted))
+	var last *CriticalPathTask
+	for _, node := range sorted {
+		t := finished[node.ID]
+		cpTask := &CriticalPathTask{
+			TaskNode:      node,
+			ScheduledTime: t.ScheduledTime,
+			StartTime:     t.StartTime,
+			FinishTime:    t.FinishTime,
+		}
+		if utility.IsZeroTime(cpTask.ScheduledTime) || cpTask.ScheduledTime.After(cpTask.StartTime) {
+			cpTask.ScheduledTime = t.ActivatedTime
+		}
+		if utility.IsZeroTime(cpTask.ScheduledTime) || cpTask.ScheduledTime.After(cpTask.StartTime) {
+			cpTask.ScheduledTime = t.StartTime
+		}
+
+		cpTask.ReadyTime = cpTask.ScheduledTime
+		for _, edge := range g.EdgesIntoTask(node) {
+			dependents[edge.From] = append(dependents[edge.From], node)
+			if depFinish := cpTasks[edge.From].FinishTime; depFinish.After(cpTask.ReadyTime) {
+				cpTask.ReadyTime = depFinish
+			}
+		}
+		if cpTask.ReadyTime.After(cpTask.StartTime) {
+			// Tasks can start before a dependency finishes if the
+			// dependency was overridden or restarted.
+			cpTask.ReadyTime = cpTask.StartTime
+		}
+		cpTask.DependencyWait = cpTask.ReadyTime.Sub(cpTask.ScheduledTime)
+		cpTask.QueueWait = cpTask.StartTime.Sub(cpTask.ReadyTime)
+		cpTask.RunTime = cpTask.FinishTime.Sub(cpTask.StartTime)
+
+		if utility.IsZeroTime(cp.StartTime) || cpTask.ScheduledTime.Before(cp.StartTime) {
+			cp.StartTime = cpTask.ScheduledTime
+		}
+		if last == nil || cpTask.FinishTime.After(last.FinishTime) {
+			last = cpTask
+		}
+		cpTasks[node] = cpTask
+	}
+	cp.FinishTime = last.FinishTime
+
+	// Compute the latest time each task could have finished by walking the
+	// tasks backwards from the tasks that depend on them.
+	latestFinish := make(map[TaskNode]time.Time, len(sorted))
+	for i := len(sorted) - 1; i >= 0; i-- {
+		node := sorted[i]
+		lf := cp.FinishTime
+		for _, dependent := range dependents[node] {
+			d := cpTasks[dependent]
+			if dependentLF := latestFinish[dependent].Add(-d.FinishTime.Sub(d.ReadyTime)); dependentLF.Before(lf) {
+				lf = dependentLF
+			}
+		}
+		latestFinish[node] = lf
+		cpTasks[node].Slack = max(0, lf.Sub(cpTasks[node].FinishTime))
+	}
+
+	// Walk backwards from the last task to finish through the dependencies
+	// that gated when each task became ready.
+	path := []*CriticalPathTask{last}
+	for current := last; current.DependencyWait > 0; {
+		var gate *CriticalPathTask
+		for _, edge := range g.EdgesIntoTask(current.TaskNode) {
+			if dep := cpTasks[edge.From]; dep.FinishTime.Equal(current.ReadyTime) {
+				gate = dep
+				break
+			}
+		}
+		if gate == nil {
+			break
+		}
+		path = append(path, gate)
+		current = gate
+	}
+	for _, cpTask := range path {
+		cpTask.OnCriticalPath = true
+	}
+
+	for _, node := range sorted {
+		cp.Tasks = append(cp.Tasks, *cpTasks[node])
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		cp.Path = append(cp.Path, *path[i])
+	}
+
+	return cp, nil
+}
@@ -0,0 +1,81 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeCriticalPath(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+
+	// compile -> test -> package is the critical path. lint runs in
+	// parallel and finishes early, while docs depends on the "display"
+	// display task containing lint.
+	tasks := []Task{
+		{Id: "compile", DisplayName: "compile", BuildVariant: "bv", ScheduledTime: at(0), StartTime: at(2), FinishTime: at(12)},
+		{Id: "lint", DisplayName: "lint", BuildVariant: "bv", ScheduledTime: at(0), StartTime: at(1), FinishTime: at(5)},
+		{Id: "display", DisplayName: "display", BuildVariant: "bv", DisplayOnly: true, ExecutionTasks: []string{"lint"}},
+		{Id: "test", DisplayName: "test", BuildVariant: "bv", ScheduledTime: at(0), StartTime: at(15), FinishTime: at(35),
+			DependsOn: []Dependency{{TaskId: "compile"}}},
+		{Id: "docs", DisplayName: "docs", BuildVariant: "bv", ScheduledTime: at(0), StartTime: at(6), FinishTime: at(10),
+			DependsOn: []Dependency{{TaskId: "display"}}},
+		{Id: "package", DisplayName: "package", BuildVariant: "bv", ScheduledTime: at(0), StartTime: at(36), FinishTime: at(40),
+			DependsOn: []Dependency{{TaskId: "test"}, {TaskId: "docs"}}},
+		{Id: "unfinished", DisplayName: "unfinished", BuildVariant: "bv", ScheduledTime: at(0)},
+	}
+
+	cp, err := computeCriticalPath(tasks)
+	require.NoError(t, err)
+	assert.Equal(t, at(0), cp.StartTime)
+	assert.Equal(t, at(40), cp.FinishTime)
+	assert.Equal(t, 40*time.Minute, cp.Makespan())
+
+	var path []string
+	for _, cpTask := range cp.Path {
+		path = append(path, cpTask.ID)
+		assert.True(t, cpTask.OnCriticalPath)
+	}
+	assert.Equal(t, []string{"compile", "test", "package"}, path)
+
+	require.Len(t, cp.Tasks, 5)
+	byID := map[string]CriticalPathTask{}
+	for _, cpTask := range cp.Tasks {
+		byID[cpTask.ID] = cpTask
+	}
+
+	test := byID["test"]
+	assert.Equal(t, at(12), test.ReadyTime)
+	assert.Equal(t, 12*time.Minute, test.DependencyWait)
+	assert.Equal(t, 3*time.Minute, test.QueueWait)
+	assert.Equal(t, 20*time.Minute, test.RunTime)
+	assert.Zero(t, test.Slack)
+
+	// package needs 5 minutes after becoming ready, so docs could have
+	// finished as late as 35 minutes in.
+	docs := byID["docs"]
+	assert.Equal(t, at(5), docs.ReadyTime)
+	assert.Equal(t, 25*time.Minute, docs.Slack)
+	assert.False(t, docs.OnCriticalPath)
+
+	// lint gates docs through the display task, and docs needs 5 minutes
+	// after becoming ready.
+	lint := byID["lint"]
+	assert.Equal(t, 25*time.Minute, lint.Slack)
+	assert.False(t, lint.OnCriticalPath)
+
+	t.Run("NoFinishedTasks", func(t *testing.T) {
+		_, err := computeCriticalPath([]Task{{Id: "unfinished"}})
+		assert.Error(t, err)
+	})
+	t.Run("Cycle", func(t *testing.T) {
+		_, err := computeCriticalPath([]Task{
+			{Id: "t1", StartTime: at(1), FinishTime: at(2), DependsOn: []Dependency{{TaskId: "t2"}}},
+			{Id: "t2", StartTime: at(1), FinishTime: at(2), DependsOn: []Dependency{{TaskId: "t1"}}},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -1632,6 +1632,31 @@ func FindWithSort(ctx context.Context, filter bson.M, sort []string) ([]Task, er
 	return tasks, err
 }
 
+// FindWithUncompressedLogs returns up to limit finished task executions,
+// including archived executions, whose task logs are stored in the plain-text
+// log format and can be converted to the compressed, indexed log format.
+func FindWithUncompressedLogs(ctx context.Context, limit int) ([]Task, error) {
+	filter := bson.M{
+		bsonutil.GetDottedKeyName(TaskOutputInfoKey, "task_logs", "version"): 1,
+		StatusKey:      bson.M{"$in": evergreen.TaskCompletedStatuses},
+		DisplayOnlyKey: bson.M{"$ne": true},
+	}
+	tasks, err := FindAll(ctx, db.Query(filter).WithFields(IdKey, ExecutionKey).Limit(limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding tasks")
+	}
+	if len(tasks) >= limit {
+		return tasks, nil
+	}
+
+	oldTasks, err := FindAllOld(ctx, db.Query(filter).WithFields(IdKey, OldTaskIdKey, ExecutionKey, ArchivedKey).Limit(limit-len(tasks)))
+	if err != nil {
+		return nil, errors.Wrap(err, "finding archived tasks")
+	}
+
+	return append(tasks, oldTasks...), nil
+}
+
 // Find returns really all tasks that satisfy the query.
 func FindAll(ctx context.Context, query db.Q) ([]Task, error) {
 	tasks := []Task{}
@@ -1646,6 +1671,35 @@ func FindAllOld(ctx context.Context, query db.Q) ([]Task, error) {
 	return tasks, err
 }
 
+// FindFinishedOnHost returns all the finished executions of tasks that ran
+// on the host, including archived executions. Only the fields needed to
+// attribute the host's cost to the tasks are populated.
+func FindFinishedOnHost(ctx context.Context, hostID string) ([]Task, error) {
+	query := db.Query(bson.M{
+		HostIdKey: hostID,
+		StatusKey: bson.M{"$in": evergreen.TaskCompletedStatuses},
+	}).WithFields(
+		IdKey,
+		OldTaskIdKey,
+		ExecutionKey,
+		DisplayNameKey,
+		ProjectKey,
+		BuildVariantKey,
+		RequesterKey,
+		StartTimeKey,
+		FinishTimeKey,
+	)
+	tasks, err := FindAll(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding tasks")
+	}
+	oldTasks, err := FindAllOld(ctx, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding archived tasks")
+	}
+	return append(tasks, oldTasks...), nil
+}
+
 // UpdateOne updates one task.
 func UpdateOne(ctx context.Context, query any, update any) error {
 	return db.UpdateContext(
@@ -3018,3 +3072,46 @@ func GetLatestTaskFromImage(ctx context.Context, imageID string) (*Task, error)
 	}
 	return nil, nil
 }
+
+// FindRestartedByProjectSinceFinishTime returns up to limit of the project's
+// most recently finished tasks that have test results and have been restarted
+// at least once, i.e. have run more than once on the same revision.
+func FindRestartedByProjectSinceFinishTime(ctx context.Context, projectID string, finishedAfter time.Time, limit int) ([]Task, error) {
+	query := db.Query(bson.M{
+		ProjectKey:        projectID,
+		ExecutionKey:      bson.M{"$gt": 0},
+		StatusKey:         bson.M{"$in": evergreen.TaskCompletedStatuses},
+		FinishTimeKey:     bson.M{"$gte": finishedAfter},
+		DisplayOnlyKey:    bson.M{"$ne": true},
+		ResultsServiceKey: bson.M{"$exists": true},
+	}).WithFields(IdKey, ExecutionKey, BuildVariantKey, DisplayNameKey, ResultsServiceKey).Sort([]string{"-" + FinishTimeKey}).Limit(limit)
+
+	return FindAll(ctx, query)
+}
+
+// FindOldExecutionsWithResults returns the archived executions of the task
+// that have test results.
+func FindOldExecutionsWithResults(ctx context.Context, taskID string) ([]Task, error) {
+	query := db.Query(bson.M{
+		OldTaskIdKey:      taskID,
+		ResultsServiceKey: bson.M{"$exists": true},
+	}).WithFields(OldTaskIdKey, ExecutionKey, ResultsServiceKey)
+
+	return FindAllOld(ctx, query)
+}
+
+// FindRecentPatchRunsWithResults returns the most recently finished patch runs
+// of the given project task that have test results.
+func FindRecentPatchRunsWithResults(ctx context.Context, projectID, buildVariant, taskName string, finishedAfter time.Time, limit int) ([]Task, error) {
+	query := db.Query(bson.M{
+		ProjectKey:        projectID,
+		BuildVariantKey:   buildVariant,
+		DisplayNameKey:    taskName,
+		RequesterKey:      bson.M{"$in": evergreen.PatchRequesters},
+		StatusKey:         bson.M{"$in": evergreen.TaskCompletedStatuses},
+		FinishTimeKey:     bson.M{"$gte": finishedAfter},
+		ResultsServiceKey: bson.M{"$exists": true},
+	}).WithFields(IdKey, ExecutionKey, VersionKey, FinishTimeKey, ResultsServiceKey).Sort([]string{"-" + FinishTimeKey}).Limit(limit)
+
+	return FindAll(ctx, query)
+}
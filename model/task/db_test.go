@@ -12,6 +12,7 @@ import (
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model/annotations"
 	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/taskoutput"
 	"github.com/evergreen-ci/utility"
 	"github.com/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
@@ -2281,3 +2282,39 @@ func TestGetLatestTaskFromImage(t *testing.T) {
 	require.NotNil(t, latestTask)
 	assert.Equal(t, "t2", latestTask.Id)
 }
+
+func TestFindWithUncompressedLogs(t *testing.T) {
+	require.NoError(t, db.ClearCollections(Collection, OldCollection))
+	uncompressed := &taskoutput.TaskOutput{TaskLogs: taskoutput.TaskLogOutput{Version: 1}}
+	compressed := &taskoutput.TaskOutput{TaskLogs: taskoutput.TaskLogOutput{Version: 2}}
+	tasks := []Task{
+		{Id: "uncompressed", Status: evergreen.TaskSucceeded, TaskOutputInfo: uncompressed},
+		{Id: "compressed", Status: evergreen.TaskSucceeded, TaskOutputInfo: compressed},
+		{Id: "running", Status: evergreen.TaskStarted, TaskOutputInfo: uncompressed},
+		{Id: "display", Status: evergreen.TaskSucceeded, DisplayOnly: true, TaskOutputInfo: uncompressed},
+	}
+	for _, tsk := range tasks {
+		require.NoError(t, tsk.Insert(t.Context()))
+	}
+	oldTasks := []Task{
+		{Id: "archived_0", OldTaskId: "archived", Archived: true, Status: evergreen.TaskFailed, TaskOutputInfo: uncompressed},
+		{Id: "archived_1", OldTaskId: "archived", Execution: 1, Archived: true, Status: evergreen.TaskFailed, TaskOutputInfo: compressed},
+	}
+	for _, tsk := range oldTasks {
+		require.NoError(t, db.Insert(t.Context(), OldCollection, tsk))
+	}
+
+	found, err := FindWithUncompressedLogs(t.Context(), 10)
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, "uncompressed", found[0].Id)
+	assert.False(t, found[0].Archived)
+	assert.Equal(t, "archived", found[1].OldTaskId)
+	assert.Equal(t, 0, found[1].Execution)
+	assert.True(t, found[1].Archived)
+
+	found, err = FindWithUncompressedLogs(t.Context(), 1)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, "uncompressed", found[0].Id)
+}
@@ -1849,6 +1849,124 @@ func (t *Task) GetTestLogs(ctx context.Context, getOpts taskoutput.TestLogGetOpt
 	return output.TestLogs.Get(ctx, taskOpts, getOpts)
 }
 
+// LogSearchOptions represents the arguments for searching a task's logs.
+type LogSearchOptions struct {
+	// TaskLogType is the type of task log to search. Ignored if
+	// TestLogPaths is set.
+	TaskLogType taskoutput.TaskLogType
+	// TestLogPaths are the paths of the test logs to search, prefixes may
+	// be specified. If set, test logs are searched instead of task logs.
+	TestLogPaths []string
+	// Search are the search options applied to the logs of each
+	// execution.
+	Search log.SearchOptions
+}
+
+// LogSearchResult is a line returned by a search of a task's logs.
+type LogSearchResult struct {
+	log.SearchResult
+	// Execution is the execution of the task whose logs contain the line.
+	Execution int
+}
+
+// SearchLogs searches the logs of every execution of the task, starting with
+// the first execution. The search limit applies to each execution
+// separately.
+func (t *Task) SearchLogs(ctx context.Context, opts LogSearchOptions) ([]LogSearchResult, error) {
+	if t.DisplayOnly {
+		return nil, errors.New("cannot search logs of a display task")
+	}
+	if err := opts.Search.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid search options")
+	}
+
+	taskID := t.Id
+	if t.Archived {
+		taskID = t.OldTaskId
+	}
+	latest, err := GetLatestExecution(ctx, taskID)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting latest execution")
+	}
+
+	var results []LogSearchResult
+	for execution := 0; execution <= latest; execution++ {
+		execTask, err := FindOneIdAndExecution(ctx, taskID, execution)
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding execution %d", execution)
+		}
+		if execTask == nil {
+			continue
+		}
+
+		var it log.LogIterator
+		if len(opts.TestLogPaths) > 0 {
+			it, err = execTask.GetTestLogs(ctx, taskoutput.TestLogGetOptions{LogPaths: opts.TestLogPaths})
+		} else {
+			it, err = execTask.GetTaskLogs(ctx, taskoutput.TaskLogGetOptions{LogType: opts.TaskLogType})
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting logs for execution %d", execution)
+		}
+
+		matches, err := log.Search(it, opts.Search)
+		if err != nil {
+			return nil, errors.Wrapf(err, "searching logs for execution %d", execution)
+		}
+		for _, match := range matches {
+			results = append(results, LogSearchResult{SearchResult: match, Execution: execution})
+		}
+	}
+
+	return results, nil
+}
+
+// CompressTaskOutput rewrites the task's task and test logs in the
+// compressed, indexed log format and persists the updated task output info.
+func (t *Task) CompressTaskOutput(ctx context.Context) error {
+	if t.DisplayOnly {
+		return errors.New("cannot compress task output for a display task")
+	}
+
+	output, ok := t.getTaskOutputSafe()
+	if !ok || t.TaskOutputInfo == nil {
+		return nil
+	}
+
+	taskID := t.Id
+	if t.Archived {
+		taskID = t.OldTaskId
+	}
+	taskOpts := taskoutput.TaskOptions{
+		ProjectID: t.Project,
+		TaskID:    taskID,
+		Execution: t.Execution,
+	}
+
+	taskLogs, err := output.TaskLogs.Compress(ctx, taskOpts)
+	if err != nil {
+		return errors.Wrap(err, "compressing task logs")
+	}
+	testLogs, err := output.TestLogs.Compress(ctx, taskOpts)
+	if err != nil {
+		return errors.Wrap(err, "compressing test logs")
+	}
+	updated := *output
+	updated.TaskLogs = taskLogs
+	updated.TestLogs = testLogs
+
+	coll := Collection
+	if t.Archived {
+		coll = OldCollection
+	}
+	if err = db.UpdateContext(ctx, coll, bson.M{IdKey: t.Id}, bson.M{"$set": bson.M{TaskOutputInfoKey: &updated}}); err != nil {
+		return errors.Wrap(err, "updating task output info")
+	}
+	t.TaskOutputInfo = &updated
+
+	return nil
+}
+
 // SetResultsInfo sets the task's test results info.
 //
 // Note that if failedResults is false, ResultsFailed is not set. This is
@@ -4137,10 +4255,36 @@ func (t *Task) FindAbortingAndResettingDependencies(ctx context.Context) ([]Task
 // SortingValueBreakdown is the full breakdown of the final value used to sort on in the queue,
 // with accompanying breakdowns of priority and rank value.
 type SortingValueBreakdown struct {
+	// Strategy is the distro planner strategy used to compute the value.
+	Strategy           string
 	TaskGroupLength    int64
 	TotalValue         int64
 	PriorityBreakdown  PriorityBreakdown
 	RankValueBreakdown RankValueBreakdown
+	// StrategyBreakdown contains the inputs specific to the planner
+	// strategy. It is not set for the default strategy.
+	StrategyBreakdown StrategyBreakdown
+}
+
+// StrategyBreakdown contains information on the factors that planner
+// strategies other than the default strategy use to compute the value used to
+// sort in the queue.
+type StrategyBreakdown struct {
+	// ExpectedRuntimeMinutes is the average expected runtime of the tasks
+	// in the unit, which the shortest-first strategy prefers to be low.
+	ExpectedRuntimeMinutes int64
+	// DeadlineSlackMinutes is how long the unit can wait before its tasks
+	// are expected to finish after their deadline, which the deadline
+	// strategy prefers to be low. It is negative if the deadline would
+	// already be missed.
+	DeadlineSlackMinutes int64
+	// FairShareProject is the project whose share of the queue the unit
+	// counts against in the fair-share strategy.
+	FairShareProject string
+	// FairShareOffsetMinutes is the amount of priority-weighted expected
+	// runtime queued ahead of the unit by the same project, which the
+	// fair-share strategy prefers to be low.
+	FairShareOffsetMinutes int64
 }
 
 // PriorityBreakdown contains information on how much various factors impacted the custom
@@ -4190,6 +4334,7 @@ const (
 	priorityBreakdownAttributePrefix = "evergreen.priority_breakdown"
 	rankBreakdownAttributePrefix     = "evergreen.rank_breakdown"
 	priorityScaledRankAttribute      = "evergreen.priority_scaled_rank"
+	plannerStrategyAttribute         = "evergreen.planner_strategy"
 )
 
 // SetSortingValueBreakdownAttributes saves a full breakdown which compartmentalizes each factor that played a role in computing the
@@ -4201,6 +4346,7 @@ func (t *Task) SetSortingValueBreakdownAttributes(ctx context.Context, breakdown
 		attribute.String(evergreen.DistroIDOtelAttribute, t.DistroId),
 		attribute.String(evergreen.TaskIDOtelAttribute, t.Id),
 		attribute.Int64(priorityScaledRankAttribute, breakdown.TotalValue),
+		attribute.String(plannerStrategyAttribute, breakdown.Strategy),
 		// Priority values
 		attribute.Int64(fmt.Sprintf("%s.base_priority", priorityBreakdownAttributePrefix), breakdown.PriorityBreakdown.InitialPriorityImpact),
 		attribute.Int64(fmt.Sprintf("%s.task_group", priorityBreakdownAttributePrefix), breakdown.PriorityBreakdown.TaskGroupImpact),
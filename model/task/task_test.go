@@ -14,11 +14,13 @@ import (
 	"github.com/evergreen-ci/evergreen/model/annotations"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/log"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/taskoutput"
 	"github.com/evergreen-ci/evergreen/testutil"
 	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/level"
 	"github.com/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
@@ -5468,3 +5470,94 @@ func TestSetGeneratedJSONStorageMethod(t *testing.T) {
 		})
 	}
 }
+
+func TestCompressTaskOutput(t *testing.T) {
+	lines := []log.LogLine{
+		{Priority: level.Info, Timestamp: time.Now().UnixNano(), Data: "line 1"},
+		{Priority: level.Info, Timestamp: time.Now().UnixNano(), Data: "line 2"},
+	}
+	readLines := func(t *testing.T, it log.LogIterator) []string {
+		var data []string
+		for it.Next() {
+			data = append(data, it.Item().Data)
+		}
+		require.NoError(t, it.Err())
+		return data
+	}
+	makeTask := func(t *testing.T) Task {
+		bucket := evergreen.BucketConfig{Type: evergreen.BucketTypeLocal, Name: t.TempDir()}
+		return Task{
+			Id:        "t1",
+			Project:   "project",
+			Execution: 1,
+			Status:    evergreen.TaskSucceeded,
+			TaskOutputInfo: &taskoutput.TaskOutput{
+				TaskLogs: taskoutput.TaskLogOutput{Version: 1, BucketConfig: bucket},
+				TestLogs: taskoutput.TestLogOutput{Version: 1, BucketConfig: bucket},
+			},
+		}
+	}
+	writeLogs := func(t *testing.T, tsk Task, taskID string) {
+		taskOpts := taskoutput.TaskOptions{ProjectID: tsk.Project, TaskID: taskID, Execution: tsk.Execution}
+		require.NoError(t, tsk.TaskOutputInfo.TaskLogs.Append(t.Context(), taskOpts, taskoutput.TaskLogTypeTask, lines))
+		require.NoError(t, tsk.TaskOutputInfo.TestLogs.Append(t.Context(), taskOpts, "test.log", lines))
+	}
+	checkCompressed := func(t *testing.T, tsk *Task) {
+		require.NotNil(t, tsk)
+		require.NotNil(t, tsk.TaskOutputInfo)
+		assert.Equal(t, 2, tsk.TaskOutputInfo.TaskLogs.Version)
+		assert.Equal(t, 2, tsk.TaskOutputInfo.TestLogs.Version)
+
+		it, err := tsk.GetTaskLogs(t.Context(), taskoutput.TaskLogGetOptions{LogType: taskoutput.TaskLogTypeTask})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"line 1", "line 2"}, readLines(t, it))
+		it, err = tsk.GetTestLogs(t.Context(), taskoutput.TestLogGetOptions{LogPaths: []string{"test.log"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"line 1", "line 2"}, readLines(t, it))
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"CompressesTaskAndTestLogs": func(t *testing.T) {
+			tsk := makeTask(t)
+			require.NoError(t, tsk.Insert(t.Context()))
+			writeLogs(t, tsk, tsk.Id)
+
+			require.NoError(t, tsk.CompressTaskOutput(t.Context()))
+			checkCompressed(t, &tsk)
+
+			dbTask, err := FindOneIdAndExecution(t.Context(), tsk.Id, tsk.Execution)
+			require.NoError(t, err)
+			checkCompressed(t, dbTask)
+		},
+		"CompressesArchivedExecution": func(t *testing.T) {
+			tsk := makeTask(t)
+			tsk.Id = "t1_1"
+			tsk.OldTaskId = "t1"
+			tsk.Archived = true
+			require.NoError(t, db.Insert(t.Context(), OldCollection, &tsk))
+			writeLogs(t, tsk, tsk.OldTaskId)
+
+			require.NoError(t, tsk.CompressTaskOutput(t.Context()))
+
+			dbTask, err := FindOneOldByIdAndExecution(t.Context(), tsk.OldTaskId, tsk.Execution)
+			require.NoError(t, err)
+			checkCompressed(t, dbTask)
+		},
+		"FailsForDisplayTask": func(t *testing.T) {
+			tsk := makeTask(t)
+			tsk.DisplayOnly = true
+			assert.Error(t, tsk.CompressTaskOutput(t.Context()))
+		},
+		"NoopsWithoutTaskOutput": func(t *testing.T) {
+			tsk := Task{Id: "t1", Status: evergreen.TaskSucceeded}
+			require.NoError(t, tsk.Insert(t.Context()))
+			assert.NoError(t, tsk.CompressTaskOutput(t.Context()))
+			assert.Nil(t, tsk.TaskOutputInfo)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(Collection, OldCollection))
+			tCase(t)
+		})
+	}
+}
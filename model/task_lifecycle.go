@@ -15,6 +15,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/pod"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/utility"
 	adb "github.com/mongodb/anser/db"
@@ -764,6 +765,88 @@ func doBisectStepbackForGeneratedTask(ctx context.Context, generator *task.Task,
 	return nil
 }
 
+// onlyQuarantinedTestsFailed returns whether every failed test result of the
+// task belongs to a test that is quarantined in the task's project. Failures of
+// quarantined tests are still recorded, but do not fail the task.
+func onlyQuarantinedTestsFailed(ctx context.Context, t *task.Task) bool {
+	env := evergreen.GetEnvironment()
+	quarantined, err := testresult.FindQuarantinedTestNames(ctx, env, t.Project, t.BuildVariant, t.DisplayName)
+	if err != nil {
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message": "could not find quarantined tests",
+			"task_id": t.Id,
+			"project": t.Project,
+		}))
+		return false
+	}
+	if len(quarantined) == 0 {
+		return false
+	}
+
+	failed, err := t.GetTestResults(ctx, env, &testresult.FilterOptions{Statuses: []string{evergreen.TestFailedStatus}})
+	if err != nil {
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message": "could not get failed test results",
+			"task_id": t.Id,
+			"project": t.Project,
+		}))
+		return false
+	}
+	if len(failed.Results) == 0 {
+		return false
+	}
+	for _, result := range failed.Results {
+		if !utility.StringSliceContains(quarantined, result.GetDisplayTestName()) {
+			return false
+		}
+	}
+
+	grip.Info(message.Fields{
+		"message":      "ignoring test failures because all failed tests are quarantined",
+		"task_id":      t.Id,
+		"execution":    t.Execution,
+		"project":      t.Project,
+		"failed_tests": len(failed.Results),
+	})
+
+	return true
+}
+
+// isTestRunnerFailure returns whether the task failed because of the command
+// that ran its tests, which is assumed if the task has failed test results and
+// it failed with a test failure rather than a system or setup failure, a
+// timeout or an OOM kill. Most test runners exit non-zero when a test fails,
+// so the failed tests fail the command as well as the test results.
+func isTestRunnerFailure(t *task.Task, detail *apimodels.TaskEndDetail) bool {
+	if !t.ResultsFailed || detail.Status != evergreen.TaskFailed {
+		return false
+	}
+	if detail.Type == evergreen.CommandTypeSystem || detail.Type == evergreen.CommandTypeSetup {
+		return false
+	}
+	if detail.TimedOut || (detail.OOMTracker != nil && detail.OOMTracker.Detected) {
+		return false
+	}
+	return true
+}
+
+// ignoreQuarantinedTestRunnerFailure marks a task whose test runner only
+// failed because of quarantined tests as succeeded. The failing command is
+// kept in the other failing commands so the failure is still visible.
+func ignoreQuarantinedTestRunnerFailure(detail *apimodels.TaskEndDetail) {
+	if detail.FailingCommand != "" {
+		detail.OtherFailingCommands = append(detail.OtherFailingCommands, apimodels.FailingCommand{
+			FullDisplayName:     detail.FailingCommand,
+			FailureMetadataTags: detail.FailureMetadataTags,
+		})
+	}
+	detail.Status = evergreen.TaskSucceeded
+	detail.Type = ""
+	detail.Description = ""
+	detail.FailingCommand = ""
+	detail.FailureMetadataTags = nil
+}
+
 // MarkEnd updates the task as being finished, performs a stepback if necessary, and updates the build status.
 func MarkEnd(ctx context.Context, settings *evergreen.Settings, t *task.Task, caller string,
 	finishTime time.Time, detail *apimodels.TaskEndDetail) error {
@@ -774,10 +857,12 @@ func MarkEnd(ctx context.Context, settings *evergreen.Settings, t *task.Task, ca
 	const slowThreshold = time.Second
 
 	detailsCopy := *detail
-	if t.ResultsFailed && detailsCopy.Status != evergreen.TaskFailed {
+	if t.ResultsFailed && detailsCopy.Status != evergreen.TaskFailed && !onlyQuarantinedTestsFailed(ctx, t) {
 		detailsCopy.Type = evergreen.CommandTypeTest
 		detailsCopy.Status = evergreen.TaskFailed
 		detailsCopy.Description = evergreen.TaskDescriptionResultsFailed
+	} else if isTestRunnerFailure(t, &detailsCopy) && onlyQuarantinedTestsFailed(ctx, t) {
+		ignoreQuarantinedTestRunnerFailure(&detailsCopy)
 	}
 
 	if t.Status == detailsCopy.Status {
@@ -1620,6 +1705,13 @@ func UpdatePatchStatus(ctx context.Context, p *patch.Patch, status string) error
 		if err := p.MarkFinished(ctx, status, time.Now()); err != nil {
 			return errors.Wrapf(err, "marking patch '%s' as finished with status '%s'", p.Id.Hex(), status)
 		}
+		if p.IsMergeQueuePatch() {
+			grip.Error(message.WrapError(updateMergeQueueBisect(ctx, p, status), message.Fields{
+				"message":  "could not update merge queue bisect",
+				"patch_id": p.Id.Hex(),
+				"status":   status,
+			}))
+		}
 	} else if err := p.UpdateStatus(ctx, status); err != nil {
 		return errors.Wrapf(err, "updating patch '%s' with status '%s'", p.Id.Hex(), status)
 	}
@@ -5297,6 +5297,116 @@ func TestResetStaleTask(t *testing.T) {
 	}
 }
 
+func TestMarkEndWithQuarantinedTests(t *testing.T) {
+	env := evergreen.GetEnvironment()
+	settings := testutil.TestConfig()
+
+	for tName, tCase := range map[string]struct {
+		failedTests     []string
+		detail          apimodels.TaskEndDetail
+		expectedStatus  string
+		expectedDetails func(t *testing.T, details apimodels.TaskEndDetail)
+	}{
+		"OnlyQuarantinedTestsFailedWithCommandSucceeding": {
+			failedTests:    []string{"flaky_test"},
+			detail:         apimodels.TaskEndDetail{Status: evergreen.TaskSucceeded},
+			expectedStatus: evergreen.TaskSucceeded,
+		},
+		"OnlyQuarantinedTestsFailedWithCommandFailing": {
+			failedTests: []string{"flaky_test"},
+			detail: apimodels.TaskEndDetail{
+				Status:         evergreen.TaskFailed,
+				Type:           evergreen.CommandTypeTest,
+				FailingCommand: "'shell.exec' in 'run tests'",
+			},
+			expectedStatus: evergreen.TaskSucceeded,
+			expectedDetails: func(t *testing.T, details apimodels.TaskEndDetail) {
+				assert.Empty(t, details.FailingCommand)
+				require.Len(t, details.OtherFailingCommands, 1)
+				assert.Equal(t, "'shell.exec' in 'run tests'", details.OtherFailingCommands[0].FullDisplayName)
+			},
+		},
+		"QuarantinedAndRealTestsFailedWithCommandFailing": {
+			failedTests: []string{"flaky_test", "real_test"},
+			detail: apimodels.TaskEndDetail{
+				Status:         evergreen.TaskFailed,
+				Type:           evergreen.CommandTypeTest,
+				FailingCommand: "'shell.exec' in 'run tests'",
+			},
+			expectedStatus: evergreen.TaskFailed,
+			expectedDetails: func(t *testing.T, details apimodels.TaskEndDetail) {
+				assert.Equal(t, "'shell.exec' in 'run tests'", details.FailingCommand)
+			},
+		},
+		"QuarantinedAndRealTestsFailedWithCommandSucceeding": {
+			failedTests:    []string{"flaky_test", "real_test"},
+			detail:         apimodels.TaskEndDetail{Status: evergreen.TaskSucceeded},
+			expectedStatus: evergreen.TaskFailed,
+			expectedDetails: func(t *testing.T, details apimodels.TaskEndDetail) {
+				assert.Equal(t, evergreen.TaskDescriptionResultsFailed, details.Description)
+			},
+		},
+		"SystemFailureIsNotIgnored": {
+			failedTests: []string{"flaky_test"},
+			detail: apimodels.TaskEndDetail{
+				Status: evergreen.TaskFailed,
+				Type:   evergreen.CommandTypeSystem,
+			},
+			expectedStatus: evergreen.TaskFailed,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx := t.Context()
+			require.NoError(t, db.ClearCollections(task.Collection, build.Collection, VersionCollection, host.Collection,
+				ParserProjectCollection, testresult.FlakinessCollection, event.EventCollection))
+			require.NoError(t, testresult.ClearLocal(ctx, env))
+
+			tsk := task.Task{
+				Id:             "t1",
+				DisplayName:    "unit_tests",
+				BuildVariant:   "linux",
+				Project:        "sample",
+				Status:         evergreen.TaskStarted,
+				Activated:      true,
+				ActivatedTime:  time.Now(),
+				BuildId:        "b",
+				Version:        "v",
+				HostId:         "hostId",
+				ResultsService: testresult.TestResultsServiceLocal,
+				ResultsFailed:  true,
+			}
+			require.NoError(t, tsk.Insert(ctx))
+			require.NoError(t, (&host.Host{Id: "hostId", RunningTask: tsk.Id}).Insert(ctx))
+			require.NoError(t, (&build.Build{Id: "b", Version: "v"}).Insert(ctx))
+			require.NoError(t, (&Version{Id: "v", Requester: evergreen.RepotrackerVersionRequester, Status: evergreen.VersionStarted}).Insert(ctx))
+			require.NoError(t, (&ParserProject{Id: "v", Identifier: utility.ToStringPtr("sample")}).Insert(ctx))
+
+			results := []testresult.TestResult{{TaskID: tsk.Id, TestName: "passing_test", Status: evergreen.TestSucceededStatus}}
+			for _, name := range tCase.failedTests {
+				results = append(results, testresult.TestResult{TaskID: tsk.Id, TestName: name, Status: evergreen.TestFailedStatus})
+			}
+			require.NoError(t, testresult.NewLocalService(env).AppendTestResults(ctx, results))
+			require.NoError(t, testresult.SetTestQuarantine(ctx, env, testresult.TestFlakinessID{
+				Project:      tsk.Project,
+				BuildVariant: tsk.BuildVariant,
+				TaskName:     tsk.DisplayName,
+				TestName:     "flaky_test",
+			}, true, "me", "flaky"))
+
+			detail := tCase.detail
+			require.NoError(t, MarkEnd(ctx, settings, &tsk, "", time.Now(), &detail))
+
+			dbTask, err := task.FindOneId(ctx, tsk.Id)
+			require.NoError(t, err)
+			require.NotNil(t, dbTask)
+			assert.Equal(t, tCase.expectedStatus, dbTask.Status)
+			if tCase.expectedDetails != nil {
+				tCase.expectedDetails(t, dbTask.Details)
+			}
+		})
+	}
+}
+
 func TestMarkEndWithNoResults(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
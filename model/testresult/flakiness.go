@@ -0,0 +1,307 @@
+package testresult
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// FlakinessCollection is the collection that stores test flakiness scores and
+// test quarantine state.
+const FlakinessCollection = "test_flakiness"
+
+// TestFlakiness represents how flaky a single test is within a project's task
+// and whether the test is quarantined.
+//
+// A test flips when it both passes and fails across the executions of the
+// same task, i.e. on the same revision. The flakiness score is the fraction of
+// scored tasks in which the test flipped.
+type TestFlakiness struct {
+	ID       TestFlakinessID `bson:"_id" json:"id"`
+	Score    float64         `bson:"score" json:"score"`
+	NumRuns  int             `bson:"num_runs" json:"num_runs"`
+	NumFlips int             `bson:"num_flips" json:"num_flips"`
+	ScoredAt time.Time       `bson:"scored_at" json:"scored_at"`
+
+	// Quarantined tests are still recorded, but their failures do not
+	// fail the task.
+	Quarantined      bool      `bson:"quarantined" json:"quarantined"`
+	QuarantinedBy    string    `bson:"quarantined_by,omitempty" json:"quarantined_by,omitempty"`
+	QuarantinedAt    time.Time `bson:"quarantined_at,omitempty" json:"quarantined_at,omitempty"`
+	QuarantineReason string    `bson:"quarantine_reason,omitempty" json:"quarantine_reason,omitempty"`
+}
+
+// TestFlakinessID uniquely identifies a test within a project's task.
+type TestFlakinessID struct {
+	Project      string `bson:"project" json:"project"`
+	BuildVariant string `bson:"build_variant" json:"build_variant"`
+	TaskName     string `bson:"task_name" json:"task_name"`
+	TestName     string `bson:"test_name" json:"test_name"`
+}
+
+var (
+	flakinessIDKey               = bsonutil.MustHaveTag(TestFlakiness{}, "ID")
+	flakinessScoreKey            = bsonutil.MustHaveTag(TestFlakiness{}, "Score")
+	flakinessNumRunsKey          = bsonutil.MustHaveTag(TestFlakiness{}, "NumRuns")
+	flakinessNumFlipsKey         = bsonutil.MustHaveTag(TestFlakiness{}, "NumFlips")
+	flakinessScoredAtKey         = bsonutil.MustHaveTag(TestFlakiness{}, "ScoredAt")
+	flakinessQuarantinedKey      = bsonutil.MustHaveTag(TestFlakiness{}, "Quarantined")
+	flakinessQuarantinedByKey    = bsonutil.MustHaveTag(TestFlakiness{}, "QuarantinedBy")
+	flakinessQuarantinedAtKey    = bsonutil.MustHaveTag(TestFlakiness{}, "QuarantinedAt")
+	flakinessQuarantineReasonKey = bsonutil.MustHaveTag(TestFlakiness{}, "QuarantineReason")
+
+	flakinessIDProjectKey      = bsonutil.MustHaveTag(TestFlakinessID{}, "Project")
+	flakinessIDBuildVariantKey = bsonutil.MustHaveTag(TestFlakinessID{}, "BuildVariant")
+	flakinessIDTaskNameKey     = bsonutil.MustHaveTag(TestFlakinessID{}, "TaskName")
+	flakinessIDTestNameKey     = bsonutil.MustHaveTag(TestFlakinessID{}, "TestName")
+)
+
+// TestFlakinessScorer accumulates the test results of restarted tasks in a
+// project and scores the flakiness of each test.
+type TestFlakinessScorer struct {
+	project string
+	counts  map[TestFlakinessID]*TestFlakiness
+}
+
+// NewTestFlakinessScorer returns a scorer for the tests of the given project.
+func NewTestFlakinessScorer(project string) *TestFlakinessScorer {
+	return &TestFlakinessScorer{
+		project: project,
+		counts:  map[TestFlakinessID]*TestFlakiness{},
+	}
+}
+
+// AddTask adds the test results of every execution of a single task. Each
+// test that ran in more than one execution counts as one run, and as one flip
+// if it both passed and failed. Tests that only ran once cannot flip and are
+// ignored.
+func (s *TestFlakinessScorer) AddTask(buildVariant, taskName string, executions [][]TestResult) {
+	type testOutcomes struct {
+		numExecutions int
+		passed        bool
+		failed        bool
+	}
+	outcomes := map[string]*testOutcomes{}
+	for _, results := range executions {
+		seen := map[string]bool{}
+		for _, result := range results {
+			name := result.GetDisplayTestName()
+			o, ok := outcomes[name]
+			if !ok {
+				o = &testOutcomes{}
+				outcomes[name] = o
+			}
+			if !seen[name] {
+				seen[name] = true
+				o.numExecutions++
+			}
+
+			switch result.Status {
+			case evergreen.TestFailedStatus:
+				o.failed = true
+			case evergreen.TestSucceededStatus:
+				o.passed = true
+			}
+		}
+	}
+
+	for name, o := range outcomes {
+		if o.numExecutions < 2 {
+			continue
+		}
+
+		id := TestFlakinessID{
+			Project:      s.project,
+			BuildVariant: buildVariant,
+			TaskName:     taskName,
+			TestName:     name,
+		}
+		count, ok := s.counts[id]
+		if !ok {
+			count = &TestFlakiness{ID: id}
+			s.counts[id] = count
+		}
+		count.NumRuns++
+		if o.passed && o.failed {
+			count.NumFlips++
+		}
+	}
+}
+
+// Scores returns the flakiness score of every test added to the scorer,
+// sorted from most to least flaky.
+func (s *TestFlakinessScorer) Scores(scoredAt time.Time) []TestFlakiness {
+	scores := make([]TestFlakiness, 0, len(s.counts))
+	for _, count := range s.counts {
+		score := *count
+		score.Score = float64(score.NumFlips) / float64(score.NumRuns)
+		score.ScoredAt = scoredAt
+		scores = append(scores, score)
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].ID.TestName < scores[j].ID.TestName
+	})
+
+	return scores
+}
+
+// UpsertTestFlakinessScores stores the given flakiness scores. The quarantine
+// state of existing tests is not modified.
+func UpsertTestFlakinessScores(ctx context.Context, env evergreen.Environment, scores []TestFlakiness) error {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(scores))
+	for _, score := range scores {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{flakinessIDKey: score.ID}).
+			SetUpdate(bson.M{"$set": bson.M{
+				flakinessScoreKey:    score.Score,
+				flakinessNumRunsKey:  score.NumRuns,
+				flakinessNumFlipsKey: score.NumFlips,
+				flakinessScoredAtKey: score.ScoredAt,
+			}}).
+			SetUpsert(true))
+	}
+	_, err := env.DB().Collection(FlakinessCollection).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+
+	return errors.Wrap(err, "upserting test flakiness scores")
+}
+
+// RemoveStaleTestFlakinessScores removes the project's tests that have not
+// been scored since the given time. Quarantined tests are never removed.
+func RemoveStaleTestFlakinessScores(ctx context.Context, env evergreen.Environment, project string, scoredBefore time.Time) error {
+	_, err := env.DB().Collection(FlakinessCollection).DeleteMany(ctx, bson.M{
+		bsonutil.GetDottedKeyName(flakinessIDKey, flakinessIDProjectKey): project,
+		flakinessScoredAtKey:    bson.M{"$lt": scoredBefore},
+		flakinessQuarantinedKey: bson.M{"$ne": true},
+	})
+
+	return errors.Wrapf(err, "removing stale test flakiness scores for project '%s'", project)
+}
+
+// FindTestFlakinessOptions represents the filtering arguments for fetching
+// test flakiness scores.
+type FindTestFlakinessOptions struct {
+	// Project is the ID of the project. Required.
+	Project string
+	// BuildVariant and TaskName optionally restrict the results to a
+	// single build variant or task.
+	BuildVariant string
+	TaskName     string
+	// MinScore optionally excludes tests with a lower flakiness score.
+	MinScore float64
+	// QuarantinedOnly, when true, only returns quarantined tests.
+	QuarantinedOnly bool
+	// Limit optionally caps the number of results.
+	Limit int
+}
+
+// FindTestFlakiness returns the flakiness of the tests that match the given
+// options, sorted from most to least flaky.
+func FindTestFlakiness(ctx context.Context, env evergreen.Environment, opts FindTestFlakinessOptions) ([]TestFlakiness, error) {
+	if opts.Project == "" {
+		return nil, errors.New("must specify a project")
+	}
+
+	filter := bson.M{bsonutil.GetDottedKeyName(flakinessIDKey, flakinessIDProjectKey): opts.Project}
+	if opts.BuildVariant != "" {
+		filter[bsonutil.GetDottedKeyName(flakinessIDKey, flakinessIDBuildVariantKey)] = opts.BuildVariant
+	}
+	if opts.TaskName != "" {
+		filter[bsonutil.GetDottedKeyName(flakinessIDKey, flakinessIDTaskNameKey)] = opts.TaskName
+	}
+	if opts.MinScore > 0 {
+		filter[flakinessScoreKey] = bson.M{"$gte": opts.MinScore}
+	}
+	if opts.QuarantinedOnly {
+		filter[flakinessQuarantinedKey] = true
+	}
+
+	findOpts := options.Find().SetSort(bson.D{
+		{Key: flakinessScoreKey, Value: -1},
+		{Key: bsonutil.GetDottedKeyName(flakinessIDKey, flakinessIDTestNameKey), Value: 1},
+	})
+	if opts.Limit > 0 {
+		findOpts.SetLimit(int64(opts.Limit))
+	}
+	cur, err := env.DB().Collection(FlakinessCollection).Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding test flakiness")
+	}
+
+	var flakiness []TestFlakiness
+	if err = cur.All(ctx, &flakiness); err != nil {
+		return nil, errors.Wrap(err, "decoding test flakiness")
+	}
+
+	return flakiness, nil
+}
+
+// FindQuarantinedTestNames returns the names of the quarantined tests of the
+// given project task.
+func FindQuarantinedTestNames(ctx context.Context, env evergreen.Environment, project, buildVariant, taskName string) ([]string, error) {
+	flakiness, err := FindTestFlakiness(ctx, env, FindTestFlakinessOptions{
+		Project:         project,
+		BuildVariant:    buildVariant,
+		TaskName:        taskName,
+		QuarantinedOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(flakiness))
+	for _, f := range flakiness {
+		names = append(names, f.ID.TestName)
+	}
+
+	return names, nil
+}
+
+// SetTestQuarantine quarantines or unquarantines the given test. A test can be
+// quarantined before it has ever been scored.
+func SetTestQuarantine(ctx context.Context, env evergreen.Environment, id TestFlakinessID, quarantined bool, user, reason string) error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(id.Project == "", "must specify a project")
+	catcher.NewWhen(id.BuildVariant == "", "must specify a build variant")
+	catcher.NewWhen(id.TaskName == "", "must specify a task name")
+	catcher.NewWhen(id.TestName == "", "must specify a test name")
+	if catcher.HasErrors() {
+		return errors.Wrap(catcher.Resolve(), "invalid test")
+	}
+
+	var update bson.M
+	if quarantined {
+		update = bson.M{"$set": bson.M{
+			flakinessQuarantinedKey:      true,
+			flakinessQuarantinedByKey:    user,
+			flakinessQuarantinedAtKey:    time.Now(),
+			flakinessQuarantineReasonKey: reason,
+		}}
+	} else {
+		update = bson.M{
+			"$set": bson.M{flakinessQuarantinedKey: false},
+			"$unset": bson.M{
+				flakinessQuarantinedByKey:    1,
+				flakinessQuarantinedAtKey:    1,
+				flakinessQuarantineReasonKey: 1,
+			},
+		}
+	}
+	_, err := env.DB().Collection(FlakinessCollection).UpdateOne(ctx, bson.M{flakinessIDKey: id}, update, options.Update().SetUpsert(true))
+
+	return errors.Wrapf(err, "setting quarantine for test '%s'", id.TestName)
+}
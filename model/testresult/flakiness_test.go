@@ -0,0 +1,119 @@
+package testresult
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestFlakinessScorer(t *testing.T) {
+	result := func(name, status string) TestResult {
+		return TestResult{TestName: name, Status: status}
+	}
+
+	scorer := NewTestFlakinessScorer("project")
+	scorer.AddTask("bv", "task", [][]TestResult{
+		{result("flaky", evergreen.TestFailedStatus), result("stable", evergreen.TestSucceededStatus), result("once", evergreen.TestFailedStatus)},
+		{result("flaky", evergreen.TestSucceededStatus), result("stable", evergreen.TestSucceededStatus)},
+	})
+	scorer.AddTask("bv", "task", [][]TestResult{
+		{result("flaky", evergreen.TestSucceededStatus), result("stable", evergreen.TestSucceededStatus)},
+		{result("flaky", evergreen.TestSucceededStatus), result("stable", evergreen.TestSucceededStatus)},
+	})
+	scorer.AddTask("bv", "other_task", [][]TestResult{
+		{result("flaky", evergreen.TestFailedStatus)},
+		{result("flaky", evergreen.TestSucceededStatus)},
+	})
+
+	now := time.Now()
+	scores := scorer.Scores(now)
+	require.Len(t, scores, 3)
+
+	assert.Equal(t, TestFlakinessID{Project: "project", BuildVariant: "bv", TaskName: "other_task", TestName: "flaky"}, scores[0].ID)
+	assert.Equal(t, 1.0, scores[0].Score)
+	assert.Equal(t, 1, scores[0].NumRuns)
+	assert.Equal(t, 1, scores[0].NumFlips)
+
+	assert.Equal(t, TestFlakinessID{Project: "project", BuildVariant: "bv", TaskName: "task", TestName: "flaky"}, scores[1].ID)
+	assert.Equal(t, 0.5, scores[1].Score)
+	assert.Equal(t, 2, scores[1].NumRuns)
+	assert.Equal(t, 1, scores[1].NumFlips)
+
+	assert.Equal(t, "stable", scores[2].ID.TestName)
+	assert.Zero(t, scores[2].Score)
+	assert.Equal(t, 2, scores[2].NumRuns)
+	assert.Equal(t, now, scores[2].ScoredAt)
+}
+
+func TestTestFlakinessDB(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := testutil.NewEnvironment(ctx, t)
+	require.NoError(t, env.DB().Collection(FlakinessCollection).Drop(ctx))
+	defer func() {
+		assert.NoError(t, env.DB().Collection(FlakinessCollection).Drop(ctx))
+	}()
+
+	flaky := TestFlakinessID{Project: "project", BuildVariant: "bv", TaskName: "task", TestName: "flaky"}
+	stable := TestFlakinessID{Project: "project", BuildVariant: "bv", TaskName: "task", TestName: "stable"}
+	scoredAt := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	require.NoError(t, UpsertTestFlakinessScores(ctx, env, []TestFlakiness{
+		{ID: flaky, Score: 0.5, NumRuns: 2, NumFlips: 1, ScoredAt: scoredAt},
+		{ID: stable, NumRuns: 2, ScoredAt: scoredAt},
+	}))
+
+	t.Run("FindSortsByScore", func(t *testing.T) {
+		flakiness, err := FindTestFlakiness(ctx, env, FindTestFlakinessOptions{Project: "project"})
+		require.NoError(t, err)
+		require.Len(t, flakiness, 2)
+		assert.Equal(t, flaky, flakiness[0].ID)
+		assert.Equal(t, 0.5, flakiness[0].Score)
+		assert.Equal(t, stable, flakiness[1].ID)
+	})
+	t.Run("FindWithMinScore", func(t *testing.T) {
+		flakiness, err := FindTestFlakiness(ctx, env, FindTestFlakinessOptions{Project: "project", MinScore: 0.1})
+		require.NoError(t, err)
+		require.Len(t, flakiness, 1)
+		assert.Equal(t, flaky, flakiness[0].ID)
+	})
+	t.Run("FindRequiresProject", func(t *testing.T) {
+		_, err := FindTestFlakiness(ctx, env, FindTestFlakinessOptions{})
+		assert.Error(t, err)
+	})
+	t.Run("Quarantine", func(t *testing.T) {
+		require.NoError(t, SetTestQuarantine(ctx, env, flaky, true, "me", "too flaky"))
+
+		names, err := FindQuarantinedTestNames(ctx, env, "project", "bv", "task")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"flaky"}, names)
+
+		// Rescoring the test does not affect its quarantine state.
+		require.NoError(t, UpsertTestFlakinessScores(ctx, env, []TestFlakiness{{ID: flaky, Score: 1, NumRuns: 1, NumFlips: 1, ScoredAt: scoredAt}}))
+		flakiness, err := FindTestFlakiness(ctx, env, FindTestFlakinessOptions{Project: "project", QuarantinedOnly: true})
+		require.NoError(t, err)
+		require.Len(t, flakiness, 1)
+		assert.Equal(t, 1.0, flakiness[0].Score)
+		assert.Equal(t, "me", flakiness[0].QuarantinedBy)
+		assert.Equal(t, "too flaky", flakiness[0].QuarantineReason)
+
+		// Stale quarantined tests are not removed.
+		require.NoError(t, RemoveStaleTestFlakinessScores(ctx, env, "project", time.Now()))
+		flakiness, err = FindTestFlakiness(ctx, env, FindTestFlakinessOptions{Project: "project"})
+		require.NoError(t, err)
+		require.Len(t, flakiness, 1)
+		assert.Equal(t, flaky, flakiness[0].ID)
+
+		require.NoError(t, SetTestQuarantine(ctx, env, flaky, false, "me", ""))
+		names, err = FindQuarantinedTestNames(ctx, env, "project", "bv", "task")
+		require.NoError(t, err)
+		assert.Empty(t, names)
+	})
+	t.Run("QuarantineRequiresTest", func(t *testing.T) {
+		assert.Error(t, SetTestQuarantine(ctx, env, TestFlakinessID{Project: "project"}, true, "me", ""))
+	})
+}
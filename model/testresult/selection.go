@@ -0,0 +1,189 @@
+package testresult
+
+import (
+	"path"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// Built-in test selection strategies.
+const (
+	// TestSelectionStrategyCoFailure selects tests that have previously
+	// failed in runs that changed the same files as the current change.
+	TestSelectionStrategyCoFailure = "co_failure"
+	// TestSelectionStrategyRecentFailure selects tests that have recently
+	// failed, regardless of which files changed.
+	TestSelectionStrategyRecentFailure = "recent_failure"
+	// TestSelectionStrategyNewTests selects tests that have never run
+	// before.
+	TestSelectionStrategyNewTests = "new_tests"
+)
+
+// TestSelectionStrategies are all the built-in test selection strategies.
+var TestSelectionStrategies = []string{
+	TestSelectionStrategyCoFailure,
+	TestSelectionStrategyRecentFailure,
+	TestSelectionStrategyNewTests,
+}
+
+const (
+	// coFailureFileWeight is the score a test gets for each past failure in
+	// a run that changed one of the same files.
+	coFailureFileWeight = 1.0
+	// coFailureDirWeight is the score a test gets for each past failure in
+	// a run that only changed files in one of the same directories.
+	coFailureDirWeight = 0.5
+	// recentFailureWeight is the score a test gets for each recent failure.
+	recentFailureWeight = 0.25
+)
+
+// TestSelectorOptions configure a TestSelector.
+type TestSelectorOptions struct {
+	// Strategies are the strategies to select tests with. If empty, all
+	// strategies are used.
+	Strategies []string
+	// RecentFailureWindow is how far back a failure counts as recent for
+	// the recent failure strategy.
+	RecentFailureWindow time.Duration
+}
+
+// TestSelector ranks and selects the tests of a task that are likely to be
+// affected by a change using the test results of the task's past runs and the
+// files those runs changed.
+type TestSelector struct {
+	opts       TestSelectorOptions
+	strategies map[string]bool
+	runs       []testSelectionRun
+	seen       map[string]bool
+}
+
+type testSelectionRun struct {
+	files       map[string]bool
+	dirs        map[string]bool
+	failedTests []string
+	finishedAt  time.Time
+}
+
+// NewTestSelector returns a test selector with the given options.
+func NewTestSelector(opts TestSelectorOptions) (*TestSelector, error) {
+	strategies := map[string]bool{}
+	for _, s := range opts.Strategies {
+		if !utility.StringSliceContains(TestSelectionStrategies, s) {
+			return nil, errors.Errorf("invalid test selection strategy '%s'", s)
+		}
+		strategies[s] = true
+	}
+	if len(strategies) == 0 {
+		for _, s := range TestSelectionStrategies {
+			strategies[s] = true
+		}
+	}
+
+	return &TestSelector{
+		opts:       opts,
+		strategies: strategies,
+		seen:       map[string]bool{},
+	}, nil
+}
+
+// AddRun adds the test results of a past run of the task along with the files
+// that the run changed.
+func (s *TestSelector) AddRun(filesChanged []string, results []TestResult, finishedAt time.Time) {
+	run := testSelectionRun{
+		files:      map[string]bool{},
+		dirs:       map[string]bool{},
+		finishedAt: finishedAt,
+	}
+	for _, f := range filesChanged {
+		run.files[f] = true
+		run.dirs[path.Dir(f)] = true
+	}
+	for _, result := range results {
+		name := result.GetDisplayTestName()
+		s.seen[name] = true
+		if result.Status == evergreen.TestFailedStatus {
+			run.failedTests = append(run.failedTests, name)
+		}
+	}
+
+	s.runs = append(s.runs, run)
+}
+
+// NumRuns returns the number of past runs added to the selector.
+func (s *TestSelector) NumRuns() int {
+	return len(s.runs)
+}
+
+// Select returns the subset of the given tests that are likely to be affected
+// by a change to the given files, ranked from most to least likely. Tests that
+// have never run before are always selected first. If there is no history or
+// no changed files to select with, all the tests are returned unchanged.
+func (s *TestSelector) Select(tests []string, filesChanged []string, now time.Time) []string {
+	if len(s.runs) == 0 || len(filesChanged) == 0 {
+		return tests
+	}
+
+	files := map[string]bool{}
+	dirs := map[string]bool{}
+	for _, f := range filesChanged {
+		files[f] = true
+		dirs[path.Dir(f)] = true
+	}
+
+	scores := map[string]float64{}
+	for _, run := range s.runs {
+		if len(run.failedTests) == 0 {
+			continue
+		}
+
+		var weight float64
+		if s.strategies[TestSelectionStrategyCoFailure] {
+			weight += coFailureWeight(run, files, dirs)
+		}
+		if s.strategies[TestSelectionStrategyRecentFailure] && now.Sub(run.finishedAt) <= s.opts.RecentFailureWindow {
+			weight += recentFailureWeight
+		}
+		if weight == 0 {
+			continue
+		}
+		for _, name := range run.failedTests {
+			scores[name] += weight
+		}
+	}
+
+	var newTests, selected []string
+	for _, name := range tests {
+		if !s.seen[name] && s.strategies[TestSelectionStrategyNewTests] {
+			newTests = append(newTests, name)
+			continue
+		}
+		if scores[name] > 0 {
+			selected = append(selected, name)
+		}
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		return scores[selected[i]] > scores[selected[j]]
+	})
+
+	return append(newTests, selected...)
+}
+
+// coFailureWeight returns how closely the files changed by a past run match
+// the given files.
+func coFailureWeight(run testSelectionRun, files, dirs map[string]bool) float64 {
+	for f := range files {
+		if run.files[f] {
+			return coFailureFileWeight
+		}
+	}
+	for dir := range dirs {
+		if run.dirs[dir] {
+			return coFailureDirWeight
+		}
+	}
+	return 0
+}
@@ -0,0 +1,69 @@
+package testresult
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestSelector(t *testing.T) {
+	result := func(name, status string) TestResult {
+		return TestResult{TestName: name, Status: status}
+	}
+	now := time.Now()
+	tests := []string{"auth", "billing", "docs", "flaky", "new"}
+
+	makeSelector := func(t *testing.T, strategies ...string) *TestSelector {
+		s, err := NewTestSelector(TestSelectorOptions{
+			Strategies:          strategies,
+			RecentFailureWindow: 24 * time.Hour,
+		})
+		require.NoError(t, err)
+
+		s.AddRun([]string{"auth/login.go"}, []TestResult{
+			result("auth", evergreen.TestFailedStatus),
+			result("billing", evergreen.TestSucceededStatus),
+			result("docs", evergreen.TestSucceededStatus),
+		}, now.Add(-10*24*time.Hour))
+		s.AddRun([]string{"auth/session.go"}, []TestResult{
+			result("auth", evergreen.TestFailedStatus),
+			result("billing", evergreen.TestFailedStatus),
+			result("docs", evergreen.TestSucceededStatus),
+		}, now.Add(-10*24*time.Hour))
+		s.AddRun([]string{"README.md"}, []TestResult{
+			result("flaky", evergreen.TestFailedStatus),
+			result("docs", evergreen.TestSucceededStatus),
+		}, now.Add(-time.Hour))
+		return s
+	}
+
+	t.Run("RanksCoFailuresAndIncludesNewAndRecentlyFailedTests", func(t *testing.T) {
+		s := makeSelector(t)
+		assert.Equal(t, 3, s.NumRuns())
+		assert.Equal(t, []string{"new", "auth", "billing", "flaky"}, s.Select(tests, []string{"auth/login.go"}, now))
+	})
+	t.Run("MatchesFilesInSameDirectory", func(t *testing.T) {
+		s := makeSelector(t, TestSelectionStrategyCoFailure)
+		assert.Equal(t, []string{"auth", "billing"}, s.Select(tests, []string{"auth/token.go"}, now))
+	})
+	t.Run("OnlyUsesGivenStrategies", func(t *testing.T) {
+		s := makeSelector(t, TestSelectionStrategyNewTests)
+		assert.Equal(t, []string{"new"}, s.Select(tests, []string{"auth/login.go"}, now))
+	})
+	t.Run("ReturnsAllTestsWithoutChangedFiles", func(t *testing.T) {
+		s := makeSelector(t)
+		assert.Equal(t, tests, s.Select(tests, nil, now))
+	})
+	t.Run("ReturnsAllTestsWithoutHistory", func(t *testing.T) {
+		s, err := NewTestSelector(TestSelectorOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, tests, s.Select(tests, []string{"auth/login.go"}, now))
+	})
+	t.Run("FailsWithInvalidStrategy", func(t *testing.T) {
+		_, err := NewTestSelector(TestSelectorOptions{Strategies: []string{"invalid"}})
+		assert.Error(t, err)
+	})
+}
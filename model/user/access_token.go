@@ -0,0 +1,250 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	adb "github.com/mongodb/anser/db"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const AccessTokensCollection = "user_access_tokens"
+
+const (
+	// AccessTokenPrefix is the prefix of every personal access token, which
+	// distinguishes them from users' API keys.
+	AccessTokenPrefix = "evg_pat_"
+
+	// AccessTokenScopeAll allows every request that the user is permitted to
+	// make.
+	AccessTokenScopeAll = "all"
+	// AccessTokenScopeRead allows read-only requests.
+	AccessTokenScopeRead = "read"
+	// AccessTokenScopePatches allows submitting patches and requests for
+	// existing patches, such as configuring, scheduling, restarting and
+	// aborting them.
+	AccessTokenScopePatches = "patches"
+
+	// MaxAccessTokenLifetime is the longest that an access token can be valid
+	// for.
+	MaxAccessTokenLifetime = 365 * 24 * time.Hour
+
+	// accessTokenLastUsedResolution is how often an access token's last used
+	// time is updated, so that every request does not need to write to the
+	// database.
+	accessTokenLastUsedResolution = time.Minute
+)
+
+// ValidAccessTokenScopes are the scopes that access tokens can be restricted
+// to.
+var ValidAccessTokenScopes = []string{AccessTokenScopeAll, AccessTokenScopeRead, AccessTokenScopePatches}
+
+// AccessToken is a named personal access token that authenticates requests as
+// its user. Unlike the user's API key, a user can have many access tokens,
+// each of which expires, is restricted to a set of scopes and optionally
+// projects, and can be revoked individually. Only a hash of the token is
+// stored; the token itself is only available when it is created.
+type AccessToken struct {
+	Id        string `bson:"_id" json:"id"`
+	UserId    string `bson:"user_id" json:"user_id"`
+	Name      string `bson:"name" json:"name"`
+	TokenHash string `bson:"token_hash" json:"-"`
+	// Scopes restrict the requests that the token can make. A request is
+	// allowed if any of the scopes allow it.
+	Scopes []string `bson:"scopes" json:"scopes"`
+	// Projects, if set, restricts the token to requests for the given project
+	// IDs.
+	Projects   []string  `bson:"projects,omitempty" json:"projects,omitempty"`
+	CreatedAt  time.Time `bson:"created_at" json:"created_at"`
+	ExpiresAt  time.Time `bson:"expires_at" json:"expires_at"`
+	LastUsedAt time.Time `bson:"last_used_at,omitempty" json:"last_used_at,omitempty"`
+}
+
+var (
+	AccessTokenIdKey         = bsonutil.MustHaveTag(AccessToken{}, "Id")
+	AccessTokenUserIdKey     = bsonutil.MustHaveTag(AccessToken{}, "UserId")
+	AccessTokenNameKey       = bsonutil.MustHaveTag(AccessToken{}, "Name")
+	AccessTokenTokenHashKey  = bsonutil.MustHaveTag(AccessToken{}, "TokenHash")
+	AccessTokenExpiresAtKey  = bsonutil.MustHaveTag(AccessToken{}, "ExpiresAt")
+	AccessTokenLastUsedAtKey = bsonutil.MustHaveTag(AccessToken{}, "LastUsedAt")
+)
+
+// AccessTokenOptions are the options for creating an access token.
+type AccessTokenOptions struct {
+	Name      string
+	Scopes    []string
+	Projects  []string
+	ExpiresAt time.Time
+}
+
+// Validate checks that the options are valid.
+func (opts *AccessTokenOptions) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(strings.TrimSpace(opts.Name) == "", "access token name cannot be empty")
+	catcher.NewWhen(len(opts.Scopes) == 0, "access token must have at least one scope")
+	for _, scope := range opts.Scopes {
+		catcher.ErrorfWhen(!utility.StringSliceContains(ValidAccessTokenScopes, scope), "invalid access token scope '%s', must be one of: %s", scope, strings.Join(ValidAccessTokenScopes, ", "))
+	}
+	catcher.NewWhen(!opts.ExpiresAt.After(time.Now()), "access token expiration must be in the future")
+	catcher.ErrorfWhen(opts.ExpiresAt.After(time.Now().Add(MaxAccessTokenLifetime)), "access token cannot be valid for longer than %s", MaxAccessTokenLifetime)
+	return catcher.Resolve()
+}
+
+// CreateAccessToken creates a new access token for the user. It returns the
+// stored access token and the token itself, which cannot be retrieved again.
+func CreateAccessToken(ctx context.Context, userId string, opts AccessTokenOptions) (*AccessToken, string, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, "", errors.Wrap(err, "invalid access token options")
+	}
+	existing, err := FindAccessTokenByName(ctx, userId, opts.Name)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "checking for existing access token")
+	}
+	if existing != nil {
+		return nil, "", errors.Errorf("access token '%s' already exists", opts.Name)
+	}
+
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return nil, "", errors.Wrap(err, "generating access token")
+	}
+	token := AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	t := &AccessToken{
+		Id:        utility.RandomString(),
+		UserId:    userId,
+		Name:      strings.TrimSpace(opts.Name),
+		TokenHash: hashAccessToken(token),
+		Scopes:    utility.UniqueStrings(opts.Scopes),
+		Projects:  utility.UniqueStrings(opts.Projects),
+		CreatedAt: time.Now(),
+		ExpiresAt: opts.ExpiresAt,
+	}
+	if err = db.Insert(ctx, AccessTokensCollection, t); err != nil {
+		return nil, "", errors.Wrapf(err, "inserting access token '%s'", opts.Name)
+	}
+	return t, token, nil
+}
+
+func hashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// FindAccessTokenByToken returns the access token matching the given token,
+// if it exists. The token may be expired.
+func FindAccessTokenByToken(ctx context.Context, token string) (*AccessToken, error) {
+	if !strings.HasPrefix(token, AccessTokenPrefix) {
+		return nil, nil
+	}
+	return findOneAccessToken(ctx, bson.M{AccessTokenTokenHashKey: hashAccessToken(token)})
+}
+
+// FindAccessTokenByName returns the user's access token with the given name,
+// if it exists.
+func FindAccessTokenByName(ctx context.Context, userId, name string) (*AccessToken, error) {
+	return findOneAccessToken(ctx, bson.M{
+		AccessTokenUserIdKey: userId,
+		AccessTokenNameKey:   strings.TrimSpace(name),
+	})
+}
+
+func findOneAccessToken(ctx context.Context, query bson.M) (*AccessToken, error) {
+	t := &AccessToken{}
+	err := db.FindOneQContext(ctx, AccessTokensCollection, db.Query(query), t)
+	if adb.ResultsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "finding access token")
+	}
+	return t, nil
+}
+
+// FindAccessTokensForUser returns all of the user's access tokens, including
+// expired ones.
+func FindAccessTokensForUser(ctx context.Context, userId string) ([]AccessToken, error) {
+	tokens := []AccessToken{}
+	err := db.FindAllQ(ctx, AccessTokensCollection, db.Query(bson.M{AccessTokenUserIdKey: userId}).Sort([]string{AccessTokenNameKey}), &tokens)
+	return tokens, errors.Wrapf(err, "finding access tokens for user '%s'", userId)
+}
+
+// RevokeAccessToken deletes the user's access token with the given ID.
+func RevokeAccessToken(ctx context.Context, userId, id string) error {
+	res, err := evergreen.GetEnvironment().DB().Collection(AccessTokensCollection).DeleteOne(ctx, bson.M{
+		AccessTokenIdKey:     id,
+		AccessTokenUserIdKey: userId,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "revoking access token '%s'", id)
+	}
+	if res.DeletedCount == 0 {
+		return errors.Errorf("access token '%s' not found", id)
+	}
+	return nil
+}
+
+// RevokeAllAccessTokens deletes all of the user's access tokens.
+func RevokeAllAccessTokens(ctx context.Context, userId string) error {
+	return errors.Wrapf(db.RemoveAll(ctx, AccessTokensCollection, bson.M{AccessTokenUserIdKey: userId}), "revoking access tokens for user '%s'", userId)
+}
+
+// IsExpired returns whether the access token has expired.
+func (t *AccessToken) IsExpired() bool {
+	return !time.Now().Before(t.ExpiresAt)
+}
+
+// AllowsRequest returns whether the access token's scopes allow a request
+// with the given HTTP method. isPatchRequest indicates whether the request is
+// for an existing patch.
+func (t *AccessToken) AllowsRequest(method string, isPatchRequest bool) bool {
+	isRead := method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+	for _, scope := range t.Scopes {
+		switch scope {
+		case AccessTokenScopeAll:
+			return true
+		case AccessTokenScopeRead:
+			if isRead {
+				return true
+			}
+		case AccessTokenScopePatches:
+			if isPatchRequest {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AllowsProject returns whether the access token can be used for requests for
+// the given project.
+func (t *AccessToken) AllowsProject(projectId string) bool {
+	return len(t.Projects) == 0 || utility.StringSliceContains(t.Projects, projectId)
+}
+
+// UpdateLastUsed records that the access token was just used.
+func (t *AccessToken) UpdateLastUsed(ctx context.Context) error {
+	now := time.Now()
+	if now.Sub(t.LastUsedAt) < accessTokenLastUsedResolution {
+		return nil
+	}
+	if err := db.UpdateContext(ctx, AccessTokensCollection, bson.M{AccessTokenIdKey: t.Id}, bson.M{
+		"$set": bson.M{AccessTokenLastUsedAtKey: now},
+	}); err != nil {
+		return errors.Wrapf(err, "updating last used time for access token '%s'", t.Id)
+	}
+	t.LastUsedAt = now
+	return nil
+}
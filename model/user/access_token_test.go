@@ -0,0 +1,145 @@
+package user
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokens(t *testing.T) {
+	validOpts := func() AccessTokenOptions {
+		return AccessTokenOptions{
+			Name:      "ci",
+			Scopes:    []string{AccessTokenScopeRead},
+			ExpiresAt: time.Now().Add(24 * time.Hour),
+		}
+	}
+
+	for tName, tCase := range map[string]func(t *testing.T){
+		"CreateReturnsTokenThatCanBeFound": func(t *testing.T) {
+			created, token, err := CreateAccessToken(t.Context(), "me", validOpts())
+			require.NoError(t, err)
+			assert.Contains(t, token, AccessTokenPrefix)
+			assert.NotContains(t, created.TokenHash, token)
+
+			found, err := FindAccessTokenByToken(t.Context(), token)
+			require.NoError(t, err)
+			require.NotNil(t, found)
+			assert.Equal(t, created.Id, found.Id)
+			assert.Equal(t, "me", found.UserId)
+			assert.Equal(t, []string{AccessTokenScopeRead}, found.Scopes)
+		},
+		"FindByTokenIgnoresUnknownTokens": func(t *testing.T) {
+			_, _, err := CreateAccessToken(t.Context(), "me", validOpts())
+			require.NoError(t, err)
+
+			found, err := FindAccessTokenByToken(t.Context(), AccessTokenPrefix+"unknown")
+			require.NoError(t, err)
+			assert.Nil(t, found)
+
+			found, err = FindAccessTokenByToken(t.Context(), "api_key")
+			require.NoError(t, err)
+			assert.Nil(t, found)
+		},
+		"CreateFailsWithDuplicateName": func(t *testing.T) {
+			_, _, err := CreateAccessToken(t.Context(), "me", validOpts())
+			require.NoError(t, err)
+			_, _, err = CreateAccessToken(t.Context(), "me", validOpts())
+			assert.Error(t, err)
+			_, _, err = CreateAccessToken(t.Context(), "someone_else", validOpts())
+			assert.NoError(t, err)
+		},
+		"CreateFailsWithInvalidOptions": func(t *testing.T) {
+			opts := validOpts()
+			opts.Name = ""
+			_, _, err := CreateAccessToken(t.Context(), "me", opts)
+			assert.Error(t, err)
+
+			opts = validOpts()
+			opts.Scopes = []string{"admin"}
+			_, _, err = CreateAccessToken(t.Context(), "me", opts)
+			assert.Error(t, err)
+
+			opts = validOpts()
+			opts.ExpiresAt = time.Now().Add(-time.Hour)
+			_, _, err = CreateAccessToken(t.Context(), "me", opts)
+			assert.Error(t, err)
+
+			opts = validOpts()
+			opts.ExpiresAt = time.Now().Add(2 * MaxAccessTokenLifetime)
+			_, _, err = CreateAccessToken(t.Context(), "me", opts)
+			assert.Error(t, err)
+		},
+		"RevokeOnlyDeletesUsersToken": func(t *testing.T) {
+			created, token, err := CreateAccessToken(t.Context(), "me", validOpts())
+			require.NoError(t, err)
+
+			assert.Error(t, RevokeAccessToken(t.Context(), "someone_else", created.Id))
+			require.NoError(t, RevokeAccessToken(t.Context(), "me", created.Id))
+
+			found, err := FindAccessTokenByToken(t.Context(), token)
+			require.NoError(t, err)
+			assert.Nil(t, found)
+			assert.Error(t, RevokeAccessToken(t.Context(), "me", created.Id))
+		},
+		"ClearUserRevokesTokens": func(t *testing.T) {
+			require.NoError(t, db.Insert(t.Context(), Collection, &DBUser{Id: "me"}))
+			_, _, err := CreateAccessToken(t.Context(), "me", validOpts())
+			require.NoError(t, err)
+
+			require.NoError(t, ClearUser(t.Context(), "me"))
+			tokens, err := FindAccessTokensForUser(t.Context(), "me")
+			require.NoError(t, err)
+			assert.Empty(t, tokens)
+		},
+		"UpdateLastUsed": func(t *testing.T) {
+			created, token, err := CreateAccessToken(t.Context(), "me", validOpts())
+			require.NoError(t, err)
+			require.NoError(t, created.UpdateLastUsed(t.Context()))
+
+			found, err := FindAccessTokenByToken(t.Context(), token)
+			require.NoError(t, err)
+			require.NotNil(t, found)
+			assert.WithinDuration(t, time.Now(), found.LastUsedAt, time.Minute)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(AccessTokensCollection, Collection))
+			tCase(t)
+		})
+	}
+}
+
+func TestAccessTokenAllowsRequest(t *testing.T) {
+	for _, tCase := range []struct {
+		name    string
+		scopes  []string
+		method  string
+		isPatch bool
+		allowed bool
+	}{
+		{name: "AllAllowsWrites", scopes: []string{AccessTokenScopeAll}, method: http.MethodPost, allowed: true},
+		{name: "ReadAllowsGet", scopes: []string{AccessTokenScopeRead}, method: http.MethodGet, allowed: true},
+		{name: "ReadRejectsWrites", scopes: []string{AccessTokenScopeRead}, method: http.MethodDelete},
+		{name: "PatchesAllowsPatchWrites", scopes: []string{AccessTokenScopePatches}, method: http.MethodPost, isPatch: true, allowed: true},
+		{name: "PatchesRejectsOtherRequests", scopes: []string{AccessTokenScopePatches}, method: http.MethodGet},
+		{name: "ScopesAreAdditive", scopes: []string{AccessTokenScopeRead, AccessTokenScopePatches}, method: http.MethodGet, allowed: true},
+		{name: "NoScopesRejects", method: http.MethodGet},
+	} {
+		t.Run(tCase.name, func(t *testing.T) {
+			token := AccessToken{Scopes: tCase.scopes}
+			assert.Equal(t, tCase.allowed, token.AllowsRequest(tCase.method, tCase.isPatch))
+		})
+	}
+}
+
+func TestAccessTokenAllowsProject(t *testing.T) {
+	assert.True(t, (&AccessToken{}).AllowsProject("any"))
+	token := AccessToken{Projects: []string{"mci"}}
+	assert.True(t, token.AllowsProject("mci"))
+	assert.False(t, token.AllowsProject("other"))
+}
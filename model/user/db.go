@@ -498,7 +498,7 @@ func ClearLoginCache(user gimlet.User) error {
 	return nil
 }
 
-// ClearUser clears the users settings, roles and invalidates their login cache.
+// ClearUser clears the users settings, roles and access tokens and invalidates their login cache.
 // It also sets their settings to use Spruce so rehires have Spruce enabled by default.
 func ClearUser(ctx context.Context, userId string) error {
 	unsetUpdate := bson.M{
@@ -514,6 +514,9 @@ func ClearUser(ctx context.Context, userId string) error {
 	if err := UpdateOneContext(ctx, query, unsetUpdate); err != nil {
 		return errors.Wrap(err, "unsetting user settings")
 	}
+	if err := RevokeAllAccessTokens(ctx, userId); err != nil {
+		return err
+	}
 	setUpdate := bson.M{
 		"$set": bson.M{
 			SettingsKey: bson.M{
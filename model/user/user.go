@@ -92,6 +92,9 @@ type NotificationPreferences struct {
 	SpawnHostExpirationID string                     `bson:"spawn_host_expiration_id,omitempty" json:"-"`
 	SpawnHostOutcome      UserSubscriptionPreference `bson:"spawn_host_outcome" json:"spawn_host_outcome"`
 	SpawnHostOutcomeID    string                     `bson:"spawn_host_outcome_id,omitempty" json:"-"`
+	// Digest is how often to send the notifications for the user's
+	// subscriptions that don't set their own digest frequency.
+	Digest string `bson:"digest,omitempty" json:"digest,omitempty"`
 }
 
 type UserSubscriptionPreference string
@@ -91,6 +91,10 @@ type Version struct {
 	// this field is only populated for push level triggers.
 	TriggerSHA string `bson:"trigger_sha,omitempty" json:"trigger_sha,omitempty"`
 
+	// PathFilteredTasks are the tasks that were not created because none of
+	// the changed files matched their changed-path filters.
+	PathFilteredTasks []patch.PathFilteredTask `bson:"path_filtered_tasks,omitempty" json:"path_filtered_tasks,omitempty"`
+
 	// this is only used for aggregations, and is not stored in the DB
 	Builds []build.Build `bson:"build_variants,omitempty" json:"build_variants,omitempty"`
 
@@ -345,6 +349,9 @@ type VersionMetadata struct {
 	PeriodicBuildID     string
 	RemotePath          string
 	GitTag              GitTag
+	// ChangedFiles are the files changed by the revision. If set, tasks
+	// whose changed-path filters don't match any of them are not created.
+	ChangedFiles []string
 }
 
 var (
@@ -37,6 +37,7 @@ func Admin() cli.Command {
 			updateServiceUser(),
 			getServiceUsers(),
 			deleteServiceUser(),
+			schedulerSimulation(),
 		},
 	}
 }
@@ -0,0 +1,288 @@
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cheynewallace/tabby"
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/scheduler"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/anser/bsonutil"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func schedulerSimulation() cli.Command {
+	return cli.Command{
+		Name:  "scheduler",
+		Usage: "replay the scheduler offline against alternative settings",
+		Subcommands: []cli.Command{
+			schedulerSnapshot(),
+			schedulerSimulate(),
+		},
+	}
+}
+
+func schedulerSnapshot() cli.Command {
+	const (
+		dbFlagName     = "db"
+		distroFlagName = "distro"
+		outputFlagName = "output"
+		urlFlagName    = "url"
+	)
+
+	return cli.Command{
+		Name:  "snapshot",
+		Usage: "save the task queues, distros, and hosts from a MongoDB database to a file for simulation",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  urlFlagName,
+				Usage: "specify the MongoDB URL",
+				Value: "mongodb://127.0.0.1:27017",
+			},
+			cli.StringFlag{
+				Name:  dbFlagName,
+				Usage: "read data from this database",
+				Value: "mci",
+			},
+			cli.StringSliceFlag{
+				Name:  joinFlagNames(distroFlagName, "d"),
+				Usage: "only snapshot these distros (defaults to all distros with a task queue)",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(outputFlagName, "o"),
+				Usage: "write the snapshot to this file",
+			},
+		},
+		Before: requireStringFlag(outputFlagName),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			client, err := mongo.Connect(ctx, options.Client().ApplyURI(c.String(urlFlagName)))
+			if err != nil {
+				return errors.Wrap(err, "creating MongoDB client")
+			}
+			defer func() {
+				grip.Error(errors.Wrap(client.Disconnect(ctx), "disconnecting MongoDB client"))
+			}()
+
+			snapshot, err := takeSchedulerSnapshot(ctx, client.Database(c.String(dbFlagName)), c.StringSlice(distroFlagName))
+			if err != nil {
+				return errors.Wrap(err, "taking scheduler snapshot")
+			}
+
+			if err = utility.WriteJSONFile(c.String(outputFlagName), snapshot); err != nil {
+				return errors.Wrap(err, "writing snapshot")
+			}
+			grip.Infof("wrote snapshot of %d distros to '%s'", len(snapshot.Distros), c.String(outputFlagName))
+
+			return nil
+		},
+	}
+}
+
+// takeSchedulerSnapshot reads the current scheduler inputs from the database.
+// It only reads from the database.
+func takeSchedulerSnapshot(ctx context.Context, db *mongo.Database, distroIDs []string) (*scheduler.SimulationSnapshot, error) {
+	snapshot := &scheduler.SimulationSnapshot{CapturedAt: time.Now()}
+
+	err := db.Collection(evergreen.ConfigCollection).FindOne(ctx, bson.M{"_id": snapshot.Scheduler.SectionId()}).Decode(&snapshot.Scheduler)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, errors.Wrap(err, "getting scheduler config")
+	}
+
+	queueFilter := bson.M{}
+	if len(distroIDs) > 0 {
+		queueFilter["distro"] = bson.M{"$in": distroIDs}
+	}
+	var queues []model.TaskQueue
+	if err = findAll(ctx, db.Collection(model.TaskQueuesCollection), queueFilter, &queues); err != nil {
+		return nil, errors.Wrap(err, "finding task queues")
+	}
+
+	for _, queue := range queues {
+		var d distro.Distro
+		if err = db.Collection(distro.Collection).FindOne(ctx, bson.M{distro.IdKey: queue.Distro}).Decode(&d); err != nil {
+			return nil, errors.Wrapf(err, "finding distro '%s'", queue.Distro)
+		}
+		if d.ContainerPool != "" {
+			grip.Infof("skipping container distro '%s'", d.Id)
+			continue
+		}
+
+		sd := scheduler.SimulationDistro{Distro: d}
+		hostFilter := bson.M{
+			bsonutil.GetDottedKeyName(host.DistroKey, distro.IdKey): d.Id,
+			host.StatusKey: bson.M{"$in": evergreen.UpHostStatus},
+		}
+		if err = findAll(ctx, db.Collection(host.Collection), hostFilter, &sd.Hosts); err != nil {
+			return nil, errors.Wrapf(err, "finding hosts for distro '%s'", d.Id)
+		}
+
+		runningTaskIDs := []string{}
+		for _, h := range sd.Hosts {
+			if h.RunningTask != "" {
+				runningTaskIDs = append(runningTaskIDs, h.RunningTask)
+			}
+		}
+		if err = findAll(ctx, db.Collection(task.Collection), bson.M{task.IdKey: bson.M{"$in": runningTaskIDs}}, &sd.RunningTasks); err != nil {
+			return nil, errors.Wrapf(err, "finding running tasks for distro '%s'", d.Id)
+		}
+
+		// Prefer the full task documents, but fall back to the task queue
+		// items for tasks that have since been dispatched.
+		queueTasks := scheduler.NewSimulationTasksFromQueue(queue)
+		queueTaskIDs := make([]string, 0, len(queueTasks))
+		for _, t := range queueTasks {
+			queueTaskIDs = append(queueTaskIDs, t.Id)
+		}
+		var dbTasks []task.Task
+		if err = findAll(ctx, db.Collection(task.Collection), bson.M{task.IdKey: bson.M{"$in": queueTaskIDs}}, &dbTasks); err != nil {
+			return nil, errors.Wrapf(err, "finding queued tasks for distro '%s'", d.Id)
+		}
+		dbTasksByID := make(map[string]task.Task, len(dbTasks))
+		for _, t := range dbTasks {
+			dbTasksByID[t.Id] = t
+		}
+		for _, t := range queueTasks {
+			if dbTask, ok := dbTasksByID[t.Id]; ok && dbTask.Status == evergreen.TaskUndispatched {
+				t = dbTask
+			}
+			sd.Tasks = append(sd.Tasks, t)
+		}
+
+		snapshot.Distros = append(snapshot.Distros, sd)
+	}
+
+	return snapshot, nil
+}
+
+func findAll(ctx context.Context, coll *mongo.Collection, filter bson.M, out any) error {
+	cur, err := coll.Find(ctx, filter)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(cur.All(ctx, out))
+}
+
+func readJSONFile(fn string, out any) error {
+	data, err := os.ReadFile(fn)
+	if err != nil {
+		return errors.Wrapf(err, "reading file '%s'", fn)
+	}
+	return errors.Wrapf(json.Unmarshal(data, out), "unmarshalling file '%s'", fn)
+}
+
+func schedulerSimulate() cli.Command {
+	const (
+		snapshotFlagName        = "snapshot"
+		scenarioFlagName        = "scenario"
+		hostStartupTimeFlagName = "host-startup-time"
+		hostHourlyCostFlagName  = "host-hourly-cost"
+		jsonFlagName            = "json"
+	)
+
+	return cli.Command{
+		Name:  "simulate",
+		Usage: "replay the task planner and host allocator against a snapshot and report queue wait times, host counts, and estimated cost",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  joinFlagNames(snapshotFlagName, "s"),
+				Usage: "the snapshot file to simulate",
+			},
+			cli.StringSliceFlag{
+				Name: scenarioFlagName,
+				Usage: "a JSON file describing a scenario to compare against the snapshot's settings, " +
+					"with the fields 'name', 'scheduler', 'ignore_distro_settings' and 'planner_strategy'. " +
+					"Scheduler settings that are not given keep their value from the snapshot",
+			},
+			cli.DurationFlag{
+				Name:  hostStartupTimeFlagName,
+				Usage: "how long new hosts take to start running tasks",
+				Value: 5 * time.Minute,
+			},
+			cli.Float64Flag{
+				Name:  hostHourlyCostFlagName,
+				Usage: "the hourly cost of a host for distros without one in the snapshot",
+			},
+			cli.BoolFlag{
+				Name:  jsonFlagName,
+				Usage: "print the full reports as JSON",
+			},
+		},
+		Before: mergeBeforeFuncs(
+			requireStringFlag(snapshotFlagName),
+			requireFileExists(snapshotFlagName),
+		),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			snapshot := scheduler.SimulationSnapshot{}
+			if err := readJSONFile(c.String(snapshotFlagName), &snapshot); err != nil {
+				return errors.Wrap(err, "reading snapshot")
+			}
+			for i := range snapshot.Distros {
+				if snapshot.Distros[i].HostHourlyCost == 0 {
+					snapshot.Distros[i].HostHourlyCost = c.Float64(hostHourlyCostFlagName)
+				}
+			}
+
+			scenarios := []scheduler.SimulationScenario{{Name: "snapshot", Scheduler: snapshot.Scheduler}}
+			for _, fn := range c.StringSlice(scenarioFlagName) {
+				// Decode on top of the snapshot's settings so that
+				// scenarios only need to specify what they change.
+				scenario := scheduler.SimulationScenario{Name: fn, Scheduler: snapshot.Scheduler}
+				if err := readJSONFile(fn, &scenario); err != nil {
+					return errors.Wrapf(err, "reading scenario '%s'", fn)
+				}
+				scenarios = append(scenarios, scenario)
+			}
+
+			var reports []scheduler.SimulationReport
+			for _, scenario := range scenarios {
+				scenario.HostStartupTime = c.Duration(hostStartupTimeFlagName)
+				report, err := scheduler.Simulate(ctx, snapshot, scenario)
+				if err != nil {
+					return errors.Wrapf(err, "simulating scenario '%s'", scenario.Name)
+				}
+				reports = append(reports, *report)
+			}
+
+			if c.Bool(jsonFlagName) {
+				out, err := json.MarshalIndent(reports, "", "  ")
+				if err != nil {
+					return errors.Wrap(err, "marshalling reports")
+				}
+				fmt.Println(string(out))
+				return nil
+			}
+
+			t := tabby.New()
+			t.AddHeader("Scenario", "Distro", "Queue", "Blocked", "Hosts", "New Hosts", "Mean Wait", "P90 Wait", "Max Wait", "Makespan", "Host Hours", "Cost")
+			for _, report := range reports {
+				for _, d := range report.Distros {
+					t.AddLine(report.Scenario, d.Distro, d.QueueInfo.Length, d.Blocked, d.ExistingHosts, d.NewHosts,
+						d.MeanQueueWait.Round(time.Second), d.P90QueueWait.Round(time.Second), d.MaxQueueWait.Round(time.Second),
+						d.Makespan.Round(time.Second), fmt.Sprintf("%.1f", d.HostHours), fmt.Sprintf("%.2f", d.EstimatedCost))
+				}
+			}
+			t.Print()
+
+			return nil
+		},
+	}
+}
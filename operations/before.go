@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/send"
 	"github.com/pkg/errors"
@@ -147,6 +148,16 @@ func requireStringFlag(name string) cli.BeforeFunc {
 	}
 }
 
+func requireStringValueChoices(name string, choices []string) cli.BeforeFunc {
+	return func(c *cli.Context) error {
+		if val := c.String(name); !utility.StringSliceContains(choices, val) {
+			return errors.Errorf("value of option '--%s' (%s) should be one of: %s",
+				name, val, strings.Join(choices, ", "))
+		}
+		return nil
+	}
+}
+
 func requireIntValueBetween(name string, min, max int) cli.BeforeFunc {
 	return func(c *cli.Context) error {
 		val := c.Int(name)
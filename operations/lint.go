@@ -0,0 +1,333 @@
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/rest/client"
+	"github.com/evergreen-ci/evergreen/validator"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+const (
+	lintFormatFlagName = "format"
+	lintFixFlagName    = "fix"
+
+	lintFormatText  = "text"
+	lintFormatJSON  = "json"
+	lintFormatSARIF = "sarif"
+)
+
+// lintResult is the outcome of linting a single project configuration file.
+type lintResult struct {
+	Path   string                     `json:"path"`
+	Issues validator.ValidationErrors `json:"issues"`
+	Fixes  []validator.LintFix        `json:"fixes,omitempty"`
+}
+
+func Lint() cli.Command {
+	return cli.Command{
+		Name:  "lint",
+		Usage: "check an evergreen project config for problems and optionally fix them",
+		Description: `Lint reports the same problems as validate, plus issues that are worth cleaning up such as
+functions that are never called and deprecated commands. Each issue has a rule ID. To ignore a
+rule for a file, add a comment anywhere in it:
+
+   # evergreen-lint-disable unused-functions, deprecated-commands`,
+		Flags: addPathFlag(cli.StringFlag{
+			Name:  lintFormatFlagName,
+			Usage: fmt.Sprintf("output format, one of: %s", strings.Join([]string{lintFormatText, lintFormatJSON, lintFormatSARIF}, ", ")),
+			Value: lintFormatText,
+		}, cli.BoolFlag{
+			Name:  lintFixFlagName,
+			Usage: "fix mechanical issues in place: unused functions, duplicate variant tasks and deprecated commands",
+		}, cli.BoolFlag{
+			Name:  joinFlagNames(errorOnWarningsFlagName, "w"),
+			Usage: "treat warnings as errors",
+		}, cli.StringSliceFlag{
+			Name:  joinFlagNames(localModulesFlagName, "lm"),
+			Usage: "specify local modules as MODULE_NAME=PATH pairs",
+		}, cli.StringFlag{
+			Name:  joinFlagNames(projectFlagName, "p"),
+			Usage: "specify project identifier in order to run validation requiring project settings",
+		}),
+		Before: mergeBeforeFuncs(autoUpdateCLI, setPlainLogger, requirePathFlag, requireStringValueChoices(lintFormatFlagName, []string{lintFormatText, lintFormatJSON, lintFormatSARIF})),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().String(confFlagName)
+			path := c.String(pathFlagName)
+			format := c.String(lintFormatFlagName)
+			fix := c.Bool(lintFixFlagName)
+			errorOnWarnings := c.Bool(errorOnWarningsFlagName)
+			projectID := c.String(projectFlagName)
+			localModuleMap, err := getLocalModulesFromInput(c.StringSlice(localModulesFlagName))
+			if err != nil {
+				return err
+			}
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+
+			if projectID == "" {
+				cwd, err := os.Getwd()
+				grip.Error(errors.Wrap(err, "getting current working directory"))
+				cwd, err = filepath.EvalSymlinks(cwd)
+				grip.Error(errors.Wrapf(err, "resolving symlinks for current working directory '%s'", cwd))
+				projectID = conf.FindDefaultProject(cwd, false)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			comm, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer comm.Close()
+
+			paths := []string{path}
+			fileInfo, err := os.Stat(path)
+			if err != nil {
+				return errors.Wrapf(err, "getting file info for path '%s'", path)
+			}
+			if fileInfo.IsDir() {
+				files, err := os.ReadDir(path)
+				if err != nil {
+					return errors.Wrapf(err, "reading directory '%s'", path)
+				}
+				paths = nil
+				for _, file := range files {
+					paths = append(paths, filepath.Join(path, file.Name()))
+				}
+			}
+
+			var results []lintResult
+			catcher := grip.NewSimpleCatcher()
+			for _, p := range paths {
+				result, err := lintFile(ctx, comm, p, fix, localModuleMap, projectID)
+				if err != nil {
+					catcher.Wrapf(err, "linting file '%s'", p)
+					continue
+				}
+				results = append(results, *result)
+				issues := result.Issues
+				catcher.ErrorfWhen(issues.Has(validator.Error) || (errorOnWarnings && issues.Has(validator.Warning)), "%s is an invalid configuration", p)
+			}
+
+			if err := printLintResults(results, format); err != nil {
+				catcher.Add(err)
+			}
+
+			return catcher.Resolve()
+		},
+	}
+}
+
+// lintFile checks the project configuration file for problems. If fix is
+// true, mechanical problems are first fixed in place.
+func lintFile(ctx context.Context, comm client.Communicator, path string, fix bool, localModuleMap map[string]string, projectID string) (*lintResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading file '%s'", path)
+	}
+	suppressed := validator.LintSuppressions(data)
+	result := &lintResult{Path: path}
+
+	project, pp, pc, loadErrs := loadProjectForLint(ctx, data, localModuleMap, projectID)
+	if !loadErrs.Has(validator.Error) && fix {
+		fixed, fixes, err := validator.FixProjectYAML(data, project, suppressed)
+		if err != nil {
+			return nil, errors.Wrap(err, "fixing project")
+		}
+		if len(fixes) > 0 {
+			if err = writeFilePreservingMode(path, fixed); err != nil {
+				return nil, err
+			}
+			result.Fixes = fixes
+			data = fixed
+			project, pp, pc, loadErrs = loadProjectForLint(ctx, data, localModuleMap, projectID)
+		}
+	}
+
+	result.Issues = loadErrs
+	if !loadErrs.Has(validator.Error) {
+		projectYaml, err := marshalProjectForValidation(pp, pc)
+		if err != nil {
+			return nil, err
+		}
+		serverErrs, err := comm.Validate(ctx, projectYaml, false, projectID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "validating project '%s'", projectID)
+		}
+		result.Issues = append(result.Issues, serverErrs...)
+		result.Issues = append(result.Issues, validator.CheckProjectLint(project, data)...)
+	}
+	result.Issues = result.Issues.WithoutRules(suppressed)
+
+	return result, nil
+}
+
+func loadProjectForLint(ctx context.Context, data []byte, localModuleMap map[string]string, projectID string) (*model.Project, *model.ParserProject, *model.ProjectConfig, validator.ValidationErrors) {
+	project := &model.Project{}
+	opts := &model.GetProjectOpts{
+		LocalModules:    localModuleMap,
+		ReadFileFrom:    model.ReadFromLocal,
+		UnmarshalStrict: true,
+	}
+	pp, pc, errs := loadProjectIntoWithValidation(ctx, data, opts, false, project, projectID)
+	return project, pp, pc, errs
+}
+
+func writeFilePreservingMode(path string, data []byte) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return errors.Wrapf(err, "getting file info for path '%s'", path)
+	}
+	return errors.Wrapf(os.WriteFile(path, data, info.Mode().Perm()), "writing file '%s'", path)
+}
+
+func printLintResults(results []lintResult, format string) error {
+	switch format {
+	case lintFormatJSON:
+		if results == nil {
+			results = []lintResult{}
+		}
+		out, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "marshalling lint results to JSON")
+		}
+		fmt.Println(string(out))
+	case lintFormatSARIF:
+		out, err := json.MarshalIndent(lintResultsToSARIF(results), "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "marshalling lint results to SARIF")
+		}
+		fmt.Println(string(out))
+	default:
+		for _, result := range results {
+			for _, fix := range result.Fixes {
+				grip.Infof("%s: fixed [%s]: %s", result.Path, fix.Rule, fix.Message)
+			}
+			for _, issue := range result.Issues {
+				rule := ""
+				if issue.Rule != "" {
+					rule = fmt.Sprintf(" [%s]", issue.Rule)
+				}
+				grip.Infof("%s: %s%s: %s", result.Path, issue.Level.String(), rule, issue.Message)
+			}
+			if len(result.Issues) == 0 {
+				grip.Infof("%s: no issues found", result.Path)
+			}
+		}
+	}
+
+	return nil
+}
+
+// The SARIF types are the subset of the SARIF 2.1.0 format needed to report
+// lint results to code scanning tools.
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId,omitempty"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+func lintResultsToSARIF(results []lintResult) sarifLog {
+	run := sarifRun{
+		Tool:    sarifTool{Driver: sarifDriver{Name: "evergreen lint", Rules: []sarifRule{}}},
+		Results: []sarifResult{},
+	}
+
+	rules := map[string]bool{}
+	for _, result := range results {
+		for _, issue := range result.Issues {
+			if issue.Rule != "" {
+				rules[issue.Rule] = true
+			}
+			run.Results = append(run.Results, sarifResult{
+				RuleID:  issue.Rule,
+				Level:   sarifLevel(issue.Level),
+				Message: sarifMessage{Text: issue.Message},
+				Locations: []sarifLocation{{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(result.Path)},
+					},
+				}},
+			})
+		}
+	}
+	ruleIDs := make([]string, 0, len(rules))
+	for rule := range rules {
+		ruleIDs = append(ruleIDs, rule)
+	}
+	sort.Strings(ruleIDs)
+	for _, rule := range ruleIDs {
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: rule})
+	}
+
+	return sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{run},
+	}
+}
+
+func sarifLevel(level validator.ValidationErrorLevel) string {
+	switch level {
+	case validator.Error:
+		return "error"
+	case validator.Warning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
@@ -0,0 +1,112 @@
+package operations
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen/agent"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func RunTask() cli.Command {
+	const (
+		taskFlagName           = "task"
+		variantFlagName        = "variant"
+		expansionFlagName      = "expansion"
+		expansionsFileFlagName = "expansions-file"
+		dryRunFlagName         = "dry-run"
+	)
+
+	return cli.Command{
+		Name:  "run-task",
+		Usage: "run a task's commands locally",
+		Description: `Run-task runs the commands for a task from a local project configuration in the same order
+as the agent, including pre and post or the task group's setup and teardown commands. It does not
+contact Evergreen, so commands that send data to Evergreen (such as attach.results) succeed without
+doing anything, and project variables must be given as expansions.`,
+		Flags: addPathFlag(
+			cli.StringFlag{
+				Name:  joinFlagNames(taskFlagName, "t"),
+				Usage: "the name of the task to run",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(variantFlagName, "v"),
+				Usage: "the name of the build variant to run the task on",
+			},
+			cli.StringFlag{
+				Name:  joinFlagNames(projectFlagName, "p"),
+				Usage: "the project identifier, which is used for the project expansions",
+			},
+			cli.StringFlag{
+				Name:  dirFlagName,
+				Usage: "the working directory to run the commands in",
+				Value: ".",
+			},
+			cli.StringSliceFlag{
+				Name:  joinFlagNames(expansionFlagName, "e"),
+				Usage: "specify an expansion as a KEY=VALUE pair",
+			},
+			cli.StringFlag{
+				Name:  expansionsFileFlagName,
+				Usage: "a YAML file of expansions, which are overridden by --expansion",
+			},
+			cli.BoolFlag{
+				Name:  dryRunFlagName,
+				Usage: "print the commands that would run with their parameters expanded instead of running them",
+			},
+		),
+		Before: mergeBeforeFuncs(setPlainLogger, requirePathFlag, requireStringFlag(taskFlagName), requireStringFlag(variantFlagName)),
+		Action: func(c *cli.Context) error {
+			path := c.String(pathFlagName)
+
+			expansions := util.Expansions{}
+			if expansionsFile := c.String(expansionsFileFlagName); expansionsFile != "" {
+				if _, err := expansions.UpdateFromYaml(expansionsFile); err != nil {
+					return errors.Wrapf(err, "reading expansions file '%s'", expansionsFile)
+				}
+			}
+			for _, pair := range c.StringSlice(expansionFlagName) {
+				key, val, ok := strings.Cut(pair, "=")
+				if !ok {
+					return errors.Errorf("could not parse expansion '%s' in KEY=VALUE format", pair)
+				}
+				expansions.Put(key, val)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			configBytes, err := os.ReadFile(path)
+			if err != nil {
+				return errors.Wrapf(err, "reading project config '%s'", path)
+			}
+			project := &model.Project{}
+			opts := &model.GetProjectOpts{
+				ReadFileFrom: model.ReadFromLocal,
+			}
+			if _, err = model.LoadProjectInto(ctx, configBytes, opts, c.String(projectFlagName), project); err != nil {
+				return errors.Wrap(err, "loading project")
+			}
+
+			localOpts := agent.LocalTaskOptions{
+				Project:      project,
+				ProjectID:    c.String(projectFlagName),
+				BuildVariant: c.String(variantFlagName),
+				TaskName:     c.String(taskFlagName),
+				WorkDir:      c.String(dirFlagName),
+				Expansions:   expansions,
+			}
+			if c.Bool(dryRunFlagName) {
+				return agent.PrintLocalTaskCommands(os.Stdout, localOpts)
+			}
+
+			grip.Infof("Running task '%s' on build variant '%s'.", localOpts.TaskName, localOpts.BuildVariant)
+			return agent.RunTaskLocally(ctx, localOpts)
+		},
+	}
+}
@@ -0,0 +1,208 @@
+package operations
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/user"
+	restmodel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func Tokens() cli.Command {
+	return cli.Command{
+		Name:    "tokens",
+		Aliases: []string{"token"},
+		Usage:   "manage your personal access tokens with the Evergreen service",
+		Subcommands: []cli.Command{
+			tokensCreate(),
+			tokensList(),
+			tokensRevoke(),
+		},
+	}
+}
+
+func tokensCreate() cli.Command {
+	const (
+		tokenNameFlagName    = "name"
+		tokenScopeFlagName   = "scope"
+		tokenProjectFlagName = "project"
+		tokenExpiresFlagName = "expires-in-days"
+	)
+
+	return cli.Command{
+		Name:  "create",
+		Usage: "create a personal access token, which can be used in place of your API key for REST v2 requests and patch submission",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  tokenNameFlagName,
+				Usage: "specify the name of the token",
+			},
+			cli.StringSliceFlag{
+				Name:  tokenScopeFlagName,
+				Usage: "restrict the token to a scope, one of: " + strings.Join(user.ValidAccessTokenScopes, ", ") + " (can be specified multiple times)",
+			},
+			cli.StringSliceFlag{
+				Name:  tokenProjectFlagName,
+				Usage: "restrict the token to a project (can be specified multiple times)",
+			},
+			cli.IntFlag{
+				Name:  tokenExpiresFlagName,
+				Usage: "specify the number of days until the token expires",
+				Value: 30,
+			},
+		},
+		Before: mergeBeforeFuncs(
+			setPlainLogger,
+			func(c *cli.Context) error {
+				if c.String(tokenNameFlagName) == "" {
+					return errors.New("token name cannot be empty")
+				}
+				if len(c.StringSlice(tokenScopeFlagName)) == 0 {
+					return errors.New("must specify at least one scope")
+				}
+				if c.Int(tokenExpiresFlagName) <= 0 {
+					return errors.New("token must expire in a positive number of days")
+				}
+				return nil
+			}),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+
+			client, err := conf.setupRestCommunicator(ctx, true)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer client.Close()
+
+			expiresAt := time.Now().Add(time.Duration(c.Int(tokenExpiresFlagName)) * 24 * time.Hour)
+			token, err := client.CreateAccessToken(ctx, restmodel.APIAccessToken{
+				Name:      utility.ToStringPtr(c.String(tokenNameFlagName)),
+				Scopes:    c.StringSlice(tokenScopeFlagName),
+				Projects:  c.StringSlice(tokenProjectFlagName),
+				ExpiresAt: &expiresAt,
+			})
+			if err != nil {
+				return errors.Wrap(err, "creating access token")
+			}
+
+			grip.Infof("Created access token '%s' (ID: '%s'), which expires at %s.\n", utility.FromStringPtr(token.Name), utility.FromStringPtr(token.Id), formatTokenTime(token.ExpiresAt))
+			grip.Info("Store the token now, since it cannot be shown again:")
+			grip.Info(utility.FromStringPtr(token.Token))
+
+			return nil
+		},
+	}
+}
+
+func tokensList() cli.Command {
+	return cli.Command{
+		Name:   "list",
+		Usage:  "list all personal access tokens for the current user",
+		Before: setPlainLogger,
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+
+			client, err := conf.setupRestCommunicator(ctx, false)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer client.Close()
+
+			tokens, err := client.GetAccessTokens(ctx)
+			if err != nil {
+				return errors.Wrap(err, "fetching access tokens")
+			}
+
+			if len(tokens) == 0 {
+				grip.Info("No access tokens found")
+				return nil
+			}
+
+			grip.Info("Access tokens stored in Evergreen:")
+			for _, token := range tokens {
+				projects := "all"
+				if len(token.Projects) > 0 {
+					projects = strings.Join(token.Projects, ", ")
+				}
+				grip.Infof("ID: '%s', Name: '%s', Scopes: '%s', Projects: '%s', Expires: %s, Last used: %s\n",
+					utility.FromStringPtr(token.Id), utility.FromStringPtr(token.Name), strings.Join(token.Scopes, ", "), projects,
+					formatTokenTime(token.ExpiresAt), formatTokenTime(token.LastUsedAt))
+			}
+
+			return nil
+		},
+	}
+}
+
+func tokensRevoke() cli.Command {
+	return cli.Command{
+		Name:  "revoke",
+		Usage: "revoke a personal access token by ID",
+		Before: mergeBeforeFuncs(
+			setPlainLogger,
+			func(c *cli.Context) error {
+				if c.NArg() != 1 {
+					return errors.New("must specify only one token to revoke at a time")
+				}
+
+				if c.Args().Get(0) == "" {
+					return errors.New("tokens revoke requires a token ID")
+				}
+				return nil
+			}),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "loading configuration")
+			}
+
+			client, err := conf.setupRestCommunicator(ctx, true)
+			if err != nil {
+				return errors.Wrap(err, "setting up REST communicator")
+			}
+			defer client.Close()
+
+			tokenID := c.Args().Get(0)
+			if err := client.RevokeAccessToken(ctx, tokenID); err != nil {
+				return errors.Wrap(err, "revoking access token")
+			}
+
+			grip.Infof("Successfully revoked access token: '%s'\n", tokenID)
+
+			return nil
+		},
+	}
+}
+
+func formatTokenTime(t *time.Time) string {
+	if t == nil {
+		return "never"
+	}
+	return t.Format(time.RFC3339)
+}
@@ -120,18 +120,9 @@ func validateFile(conf *ClientSettings, path string, ac *legacyClient, quiet, er
 		return errors.Errorf("%s is an invalid configuration", path)
 	}
 
-	projectYaml, err := yaml.Marshal(pp)
+	projectYaml, err := marshalProjectForValidation(pp, pc)
 	if err != nil {
-		return errors.Wrapf(err, "marshalling parser project into YAML")
-	}
-
-	if pc != nil {
-		projectConfigYaml, err := yaml.Marshal(pc.ProjectConfigFields)
-		if err != nil {
-			return errors.Wrapf(err, "marshalling project config into YAML")
-		}
-		projectBytes := [][]byte{projectYaml, projectConfigYaml}
-		projectYaml = bytes.Join(projectBytes, []byte("\n"))
+		return err
 	}
 
 	client, err := conf.setupRestCommunicator(ctx, false)
@@ -159,6 +150,26 @@ func validateFile(conf *ClientSettings, path string, ac *legacyClient, quiet, er
 	return nil
 }
 
+// marshalProjectForValidation returns the YAML to send to the server to
+// validate the loaded parser project and project config.
+func marshalProjectForValidation(pp *model.ParserProject, pc *model.ProjectConfig) ([]byte, error) {
+	projectYaml, err := yaml.Marshal(pp)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshalling parser project into YAML")
+	}
+
+	if pc != nil {
+		projectConfigYaml, err := yaml.Marshal(pc.ProjectConfigFields)
+		if err != nil {
+			return nil, errors.Wrapf(err, "marshalling project config into YAML")
+		}
+		projectBytes := [][]byte{projectYaml, projectConfigYaml}
+		projectYaml = bytes.Join(projectBytes, []byte("\n"))
+	}
+
+	return projectYaml, nil
+}
+
 // loadProjectIntoWithValidation returns a warning (instead of an error) if there's an error with unmarshalling strictly
 func loadProjectIntoWithValidation(ctx context.Context, data []byte, opts *model.GetProjectOpts, errorOnWarnings bool,
 	project *model.Project, projectID string) (*model.ParserProject, *model.ProjectConfig, validator.ValidationErrors) {
@@ -170,6 +181,7 @@ func loadProjectIntoWithValidation(ctx context.Context, data []byte, opts *model
 		errs = append(errs, validator.ValidationError{
 			Level:   validator.Error,
 			Message: err.Error(),
+			Rule:    validator.RuleProjectParse,
 		})
 	}
 	pp, err := model.LoadProjectInto(ctx, data, opts, projectID, project)
@@ -183,6 +195,7 @@ func loadProjectIntoWithValidation(ctx context.Context, data []byte, opts *model
 				errs = append(errs, validator.ValidationError{
 					Level:   validator.Warning,
 					Message: errors.Wrap(err, "strict unmarshalling YAML").Error(),
+					Rule:    validator.RuleStrictParse,
 				})
 				return pp, pc, errs
 			}
@@ -190,6 +203,7 @@ func loadProjectIntoWithValidation(ctx context.Context, data []byte, opts *model
 		errs = append(errs, validator.ValidationError{
 			Level:   validator.Error,
 			Message: err.Error(),
+			Rule:    validator.RuleProjectParse,
 		})
 	}
 	return pp, pc, errs
@@ -303,7 +303,8 @@ func (repoTracker *RepoTracker) StoreRevisions(ctx context.Context, revisions []
 
 		// "Ignore" a version if all changes are to ignored files
 		var ignore bool
-		if len(pInfo.Project.Ignore) > 0 {
+		var changedFiles []string
+		if len(pInfo.Project.Ignore) > 0 || pInfo.Project.HasPathFilters() {
 			var filenames []string
 			filenames, err = repoTracker.GetChangedFiles(ctx, revision)
 			if err != nil {
@@ -316,13 +317,18 @@ func (repoTracker *RepoTracker) StoreRevisions(ctx context.Context, revisions []
 				}))
 				continue
 			}
-			if pInfo.Project.IgnoresAllFiles(filenames) {
+			if pInfo.Project.IgnoresAllFiles(filenames) || pInfo.Project.PathFiltersSkipAllTasks(filenames, evergreen.RepotrackerVersionRequester) {
+				// If the changed-path filters would skip every task, the
+				// version would have no builds, so ignore it instead.
 				ignore = true
+			} else {
+				changedFiles = filenames
 			}
 		}
 
 		metadata := model.VersionMetadata{
-			Revision: revisions[i],
+			Revision:     revisions[i],
+			ChangedFiles: changedFiles,
 		}
 		projectInfo := &model.ProjectInfo{
 			Ref:                 ref,
@@ -882,6 +888,12 @@ func createVersionItems(ctx context.Context, v *model.Version, metadata model.Ve
 	}
 
 	taskIds := model.NewTaskIdConfigForRepotrackerVersion(ctx, projectInfo.Project, v, pairsToCreate, sourceRev, metadata.TriggerDefinitionID)
+	// Tasks skipped by their changed-path filters are not created, so they
+	// can't be depended on.
+	v.PathFilteredTasks = projectInfo.Project.PathFilteredTasks(metadata.ChangedFiles, v.Requester)
+	for _, filtered := range v.PathFilteredTasks {
+		delete(taskIds.ExecutionTasks, model.TVPair{Variant: filtered.Variant, TaskName: filtered.Task})
+	}
 
 	for _, buildvariant := range projectInfo.Project.BuildVariants {
 		taskNames := pairsToCreate.TaskNames(buildvariant.Name)
@@ -908,6 +920,7 @@ func createVersionItems(ctx context.Context, v *model.Version, metadata model.Ve
 			DistroAliases:       distroAliases,
 			TaskCreateTime:      v.CreateTime,
 			GithubChecksAliases: aliasesMatchingVariant,
+			ChangedFiles:        metadata.ChangedFiles,
 		}
 
 		b, tasks, err := model.CreateBuildFromVersionNoInsert(ctx, creationInfo)
@@ -82,6 +82,18 @@ type Communicator interface {
 	// Delete a key with specified name from the current authenticated user
 	DeletePublicKey(context.Context, string) error
 
+	// GetAccessTokens returns the current authenticated user's personal access
+	// tokens.
+	GetAccessTokens(context.Context) ([]restmodel.APIAccessToken, error)
+
+	// CreateAccessToken creates a personal access token for the current
+	// authenticated user. The returned access token includes the token itself.
+	CreateAccessToken(context.Context, restmodel.APIAccessToken) (*restmodel.APIAccessToken, error)
+
+	// RevokeAccessToken revokes the current authenticated user's personal
+	// access token with the given ID.
+	RevokeAccessToken(context.Context, string) error
+
 	// List variant/task aliases, with bool parameter to optionally include YAML-defined aliases.
 	ListAliases(context.Context, string, bool) ([]model.ProjectAlias, error)
 	ListPatchTriggerAliases(context.Context, string) ([]string, error)
@@ -901,6 +901,83 @@ func (c *communicatorImpl) DeletePublicKey(ctx context.Context, keyName string)
 	return nil
 }
 
+func (c *communicatorImpl) GetAccessTokens(ctx context.Context) ([]model.APIAccessToken, error) {
+	info := requestInfo{
+		method: http.MethodGet,
+		path:   "user/tokens",
+	}
+
+	resp, err := c.request(ctx, info, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to get access tokens for user '%s'", c.apiUser)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespError(resp, AuthError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "getting access tokens for user '%s'", c.apiUser)
+	}
+
+	tokens := []model.APIAccessToken{}
+	if err = utility.ReadJSON(resp.Body, &tokens); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return tokens, nil
+}
+
+func (c *communicatorImpl) CreateAccessToken(ctx context.Context, token model.APIAccessToken) (*model.APIAccessToken, error) {
+	info := requestInfo{
+		method: http.MethodPost,
+		path:   "user/tokens",
+	}
+
+	name := utility.FromStringPtr(token.Name)
+	resp, err := c.request(ctx, info, token)
+	if err != nil {
+		return nil, errors.Wrapf(err, "sending request to create access token '%s'", name)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, util.RespError(resp, AuthError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.RespErrorf(resp, "creating access token '%s'", name)
+	}
+
+	created := &model.APIAccessToken{}
+	if err = utility.ReadJSON(resp.Body, created); err != nil {
+		return nil, errors.Wrap(err, "reading JSON response body")
+	}
+
+	return created, nil
+}
+
+func (c *communicatorImpl) RevokeAccessToken(ctx context.Context, tokenID string) error {
+	info := requestInfo{
+		method: http.MethodDelete,
+		path:   "user/tokens/" + tokenID,
+	}
+
+	resp, err := c.request(ctx, info, "")
+	if err != nil {
+		return errors.Wrapf(err, "sending request to revoke access token '%s'", tokenID)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return util.RespError(resp, AuthError)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return util.RespErrorf(resp, "revoking access token '%s'", tokenID)
+	}
+
+	return nil
+}
+
 func (c *communicatorImpl) ListAliases(ctx context.Context, project string, includeProjectConfig bool) ([]serviceModel.ProjectAlias, error) {
 	path := fmt.Sprintf("alias/%s", project)
 	info := requestInfo{
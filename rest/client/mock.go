@@ -183,6 +183,18 @@ func (c *Mock) DeletePublicKey(ctx context.Context, keyName string) error {
 	return errors.New("(c *Mock) DeletePublicKey not implemented")
 }
 
+func (c *Mock) GetAccessTokens(ctx context.Context) ([]model.APIAccessToken, error) {
+	return nil, errors.New("(c *Mock) GetAccessTokens not implemented")
+}
+
+func (c *Mock) CreateAccessToken(ctx context.Context, token model.APIAccessToken) (*model.APIAccessToken, error) {
+	return nil, errors.New("(c *Mock) CreateAccessToken not implemented")
+}
+
+func (c *Mock) RevokeAccessToken(ctx context.Context, tokenID string) error {
+	return errors.New("(c *Mock) RevokeAccessToken not implemented")
+}
+
 func (c *Mock) ListAliases(ctx context.Context, keyName string) ([]serviceModel.ProjectAlias, error) {
 	return nil, errors.New("(c *Mock) ListAliases not implemented")
 }
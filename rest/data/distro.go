@@ -44,6 +44,10 @@ func UpdateDistro(ctx context.Context, old, new *distro.Distro) error {
 		}
 	}
 
+	// Whether the distro is in maintenance is managed by the maintenance job,
+	// so it can't be changed by updating the distro.
+	new.ActiveMaintenance = old.ActiveMaintenance
+
 	if err := new.ReplaceOne(ctx); err != nil {
 		return gimlet.ErrorResponse{
 			StatusCode: http.StatusInternalServerError,
@@ -536,6 +536,10 @@ func getUnredactedSubscriptions(unredactedPreviousSubscriptions []event.Subscrip
 		if err != nil {
 			return nil, errors.Wrapf(err, "unredacting webhook subscription")
 		}
+		err = unredactPagerDutySubscription(unredactedPreviousSubscriptions, &redactedSub)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unredacting PagerDuty subscription")
+		}
 		// Add to final subscription slice.
 		unredactedNewSubscriptions = append(unredactedNewSubscriptions, redactedSub)
 	}
@@ -576,6 +580,26 @@ func unredactWebhookSubscription(unredactedPreviousSubscriptions []event.Subscri
 	return nil
 }
 
+// unredactPagerDutySubscription unredacts the routing key for the subscription.
+// If the given subscription isn't a PagerDuty one, it no-ops.
+func unredactPagerDutySubscription(unredactedPreviousSubscriptions []event.Subscription, redactedNewSubscription *restModel.APISubscription) error {
+	redactedNewPagerDutySubscription := redactedNewSubscription.Subscriber.PagerDutySubscriber
+	if redactedNewPagerDutySubscription == nil {
+		return nil
+	}
+	if utility.FromStringPtr(redactedNewPagerDutySubscription.RoutingKey) != evergreen.RedactedValue {
+		return nil
+	}
+
+	unredactedPreviousPagerDutySubscription, err := getSubscription[event.PagerDutySubscriber](unredactedPreviousSubscriptions, utility.FromStringPtr(redactedNewSubscription.ID))
+	if err != nil || unredactedPreviousPagerDutySubscription == nil {
+		return err
+	}
+	redactedNewPagerDutySubscription.RoutingKey = utility.ToStringPtr(unredactedPreviousPagerDutySubscription.RoutingKey)
+
+	return nil
+}
+
 // getSubscription gets a subscription by id and asserts a type on it. If
 // the type does not match for that id, it returns an error.
 func getSubscription[T any](subscriptions []event.Subscription, id string) (*T, error) {
@@ -21,6 +21,7 @@ func NewConfigModel() *APIAdminSettings {
 		Buckets:             &APIBucketsConfig{},
 		Cedar:               &APICedarConfig{},
 		ContainerPools:      &APIContainerPoolsConfig{},
+		Cost:                &APICostConfig{},
 		Expansions:          map[string]string{},
 		HostInit:            &APIHostInitConfig{},
 		HostJasper:          &APIHostJasperConfig{},
@@ -66,6 +67,7 @@ type APIAdminSettings struct {
 	Cedar               *APICedarConfig               `json:"cedar,omitempty"`
 	ConfigDir           *string                       `json:"configdir,omitempty"`
 	ContainerPools      *APIContainerPoolsConfig      `json:"container_pools,omitempty"`
+	Cost                *APICostConfig                `json:"cost,omitempty"`
 	DomainName          *string                       `json:"domain_name,omitempty"`
 	Expansions          map[string]string             `json:"expansions,omitempty"`
 	GithubPRCreatorOrg  *string                       `json:"github_pr_creator_org,omitempty"`
@@ -489,6 +491,7 @@ type APIAuthConfig struct {
 	Github                  *APIGithubAuthConfig `json:"github"`
 	Multi                   *APIMultiAuthConfig  `json:"multi"`
 	Kanopy                  *APIKanopyAuthConfig `json:"kanopy"`
+	OIDC                    *APIOIDCAuthConfig   `json:"oidc"`
 	PreferredType           *string              `json:"preferred_type"`
 	BackgroundReauthMinutes int                  `json:"background_reauth_minutes"`
 	AllowServiceUsers       bool                 `json:"allow_service_users"`
@@ -527,6 +530,12 @@ func (a *APIAuthConfig) BuildFromService(h any) error {
 				return errors.Wrap(err, "converting Kanopy auth settings to API model")
 			}
 		}
+		if v.OIDC != nil {
+			a.OIDC = &APIOIDCAuthConfig{}
+			if err := a.OIDC.BuildFromService(v.OIDC); err != nil {
+				return errors.Wrap(err, "converting OIDC auth settings to API model")
+			}
+		}
 		a.PreferredType = utility.ToStringPtr(v.PreferredType)
 		a.BackgroundReauthMinutes = v.BackgroundReauthMinutes
 		a.AllowServiceUsers = v.AllowServiceUsers
@@ -542,6 +551,7 @@ func (a *APIAuthConfig) ToService() (any, error) {
 	var github *evergreen.GithubAuthConfig
 	var multi *evergreen.MultiAuthConfig
 	var kanopy *evergreen.KanopyAuthConfig
+	var oidc *evergreen.OIDCAuthConfig
 	var ok bool
 
 	i, err := a.Okta.ToService()
@@ -599,12 +609,24 @@ func (a *APIAuthConfig) ToService() (any, error) {
 		}
 	}
 
+	i, err = a.OIDC.ToService()
+	if err != nil {
+		return nil, errors.Wrap(err, "converting OIDC auth config to service model")
+	}
+	if i != nil {
+		oidc, ok = i.(*evergreen.OIDCAuthConfig)
+		if !ok {
+			return nil, errors.Errorf("programmatic error: expected OIDC auth config but got type %T", i)
+		}
+	}
+
 	return evergreen.AuthConfig{
 		Okta:                    okta,
 		Naive:                   naive,
 		Github:                  github,
 		Multi:                   multi,
 		Kanopy:                  kanopy,
+		OIDC:                    oidc,
 		PreferredType:           utility.FromStringPtr(a.PreferredType),
 		BackgroundReauthMinutes: a.BackgroundReauthMinutes,
 		AllowServiceUsers:       a.AllowServiceUsers,
@@ -615,6 +637,7 @@ type APIBucketsConfig struct {
 	LogBucket       APIBucketConfig  `json:"log_bucket"`
 	InternalBuckets []string         `json:"internal_buckets"`
 	Credentials     APIS3Credentials `json:"credentials"`
+	CompressedLogs  bool             `json:"compressed_logs"`
 }
 
 type APIBucketConfig struct {
@@ -640,6 +663,7 @@ func (a *APIBucketsConfig) BuildFromService(h any) error {
 		a.LogBucket.Name = utility.ToStringPtr(v.LogBucket.Name)
 		a.LogBucket.Type = utility.ToStringPtr(string(v.LogBucket.Type))
 		a.LogBucket.DBName = utility.ToStringPtr(v.LogBucket.DBName)
+		a.CompressedLogs = v.CompressedLogs
 
 		creds := APIS3Credentials{}
 		if err := creds.BuildFromService(v.Credentials); err != nil {
@@ -668,7 +692,8 @@ func (a *APIBucketsConfig) ToService() (any, error) {
 			Type:   evergreen.BucketType(utility.FromStringPtr(a.LogBucket.Type)),
 			DBName: utility.FromStringPtr(a.LogBucket.DBName),
 		},
-		Credentials: creds,
+		Credentials:    creds,
+		CompressedLogs: a.CompressedLogs,
 	}, nil
 }
 
@@ -935,6 +960,74 @@ func (a *APIKanopyAuthConfig) ToService() (any, error) {
 	}, nil
 }
 
+type APIOIDCAuthConfig struct {
+	Issuer             *string              `json:"issuer"`
+	ClientID           *string              `json:"client_id"`
+	ClientSecret       *string              `json:"client_secret"`
+	Scopes             []string             `json:"scopes"`
+	UsernameClaim      *string              `json:"username_claim"`
+	AllowedDomains     []string             `json:"allowed_domains"`
+	RolesClaim         *string              `json:"roles_claim"`
+	RoleMappings       []APIOIDCRoleMapping `json:"role_mappings"`
+	ExpireAfterMinutes int                  `json:"expire_after_minutes"`
+}
+
+type APIOIDCRoleMapping struct {
+	ClaimValue *string  `json:"claim_value"`
+	Roles      []string `json:"roles"`
+}
+
+func (a *APIOIDCAuthConfig) BuildFromService(h any) error {
+	switch v := h.(type) {
+	case *evergreen.OIDCAuthConfig:
+		if v == nil {
+			return nil
+		}
+		a.Issuer = utility.ToStringPtr(v.Issuer)
+		a.ClientID = utility.ToStringPtr(v.ClientID)
+		a.ClientSecret = utility.ToStringPtr(v.ClientSecret)
+		a.Scopes = v.Scopes
+		a.UsernameClaim = utility.ToStringPtr(v.UsernameClaim)
+		a.AllowedDomains = v.AllowedDomains
+		a.RolesClaim = utility.ToStringPtr(v.RolesClaim)
+		a.RoleMappings = nil
+		for _, mapping := range v.RoleMappings {
+			a.RoleMappings = append(a.RoleMappings, APIOIDCRoleMapping{
+				ClaimValue: utility.ToStringPtr(mapping.ClaimValue),
+				Roles:      mapping.Roles,
+			})
+		}
+		a.ExpireAfterMinutes = v.ExpireAfterMinutes
+	default:
+		return errors.Errorf("programmatic error: expected OIDC auth config but got type %T", h)
+	}
+	return nil
+}
+
+func (a *APIOIDCAuthConfig) ToService() (any, error) {
+	if a == nil {
+		return nil, nil
+	}
+	var mappings []evergreen.OIDCRoleMapping
+	for _, mapping := range a.RoleMappings {
+		mappings = append(mappings, evergreen.OIDCRoleMapping{
+			ClaimValue: utility.FromStringPtr(mapping.ClaimValue),
+			Roles:      mapping.Roles,
+		})
+	}
+	return &evergreen.OIDCAuthConfig{
+		Issuer:             utility.FromStringPtr(a.Issuer),
+		ClientID:           utility.FromStringPtr(a.ClientID),
+		ClientSecret:       utility.FromStringPtr(a.ClientSecret),
+		Scopes:             a.Scopes,
+		UsernameClaim:      utility.FromStringPtr(a.UsernameClaim),
+		AllowedDomains:     a.AllowedDomains,
+		RolesClaim:         utility.FromStringPtr(a.RolesClaim),
+		RoleMappings:       mappings,
+		ExpireAfterMinutes: a.ExpireAfterMinutes,
+	}, nil
+}
+
 // APIBanner is a public structure representing the banner part of the admin settings
 type APIBanner struct {
 	Text  *string `json:"banner"`
@@ -1361,8 +1454,9 @@ func (a *APIProjectCreationConfig) ToService() (any, error) {
 }
 
 type APICloudProviders struct {
-	AWS    *APIAWSConfig    `json:"aws"`
-	Docker *APIDockerConfig `json:"docker"`
+	AWS        *APIAWSConfig        `json:"aws"`
+	Docker     *APIDockerConfig     `json:"docker"`
+	Kubernetes *APIKubernetesConfig `json:"kubernetes"`
 }
 
 func (a *APICloudProviders) BuildFromService(h any) error {
@@ -1370,12 +1464,16 @@ func (a *APICloudProviders) BuildFromService(h any) error {
 	case evergreen.CloudProviders:
 		a.AWS = &APIAWSConfig{}
 		a.Docker = &APIDockerConfig{}
+		a.Kubernetes = &APIKubernetesConfig{}
 		if err := a.AWS.BuildFromService(v.AWS); err != nil {
 			return err
 		}
 		if err := a.Docker.BuildFromService(v.Docker); err != nil {
 			return err
 		}
+		if err := a.Kubernetes.BuildFromService(v.Kubernetes); err != nil {
+			return err
+		}
 	default:
 		return errors.Errorf("programmatic error: expected cloud provider config but got type %T", h)
 	}
@@ -1391,9 +1489,14 @@ func (a *APICloudProviders) ToService() (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	kubernetes, err := a.Kubernetes.ToService()
+	if err != nil {
+		return nil, err
+	}
 	return evergreen.CloudProviders{
-		AWS:    aws.(evergreen.AWSConfig),
-		Docker: docker.(evergreen.DockerConfig),
+		AWS:        aws.(evergreen.AWSConfig),
+		Docker:     docker.(evergreen.DockerConfig),
+		Kubernetes: kubernetes.(evergreen.KubernetesConfig),
 	}, nil
 }
 
@@ -2007,6 +2110,38 @@ func (a *APIDockerConfig) ToService() (any, error) {
 	}, nil
 }
 
+type APIKubernetesConfig struct {
+	APIServerURL *string `json:"api_server_url"`
+	BearerToken  *string `json:"bearer_token"`
+	CACert       *string `json:"ca_cert"`
+	Namespace    *string `json:"namespace"`
+}
+
+func (a *APIKubernetesConfig) BuildFromService(h any) error {
+	switch v := h.(type) {
+	case evergreen.KubernetesConfig:
+		a.APIServerURL = utility.ToStringPtr(v.APIServerURL)
+		a.BearerToken = utility.ToStringPtr(v.BearerToken)
+		a.CACert = utility.ToStringPtr(v.CACert)
+		a.Namespace = utility.ToStringPtr(v.Namespace)
+	default:
+		return errors.Errorf("programmatic error: expected Kubernetes config but got type %T", h)
+	}
+	return nil
+}
+
+func (a *APIKubernetesConfig) ToService() (any, error) {
+	if a == nil {
+		return evergreen.KubernetesConfig{}, nil
+	}
+	return evergreen.KubernetesConfig{
+		APIServerURL: utility.FromStringPtr(a.APIServerURL),
+		BearerToken:  utility.FromStringPtr(a.BearerToken),
+		CACert:       utility.FromStringPtr(a.CACert),
+		Namespace:    utility.FromStringPtr(a.Namespace),
+	}, nil
+}
+
 type APIRepoTrackerConfig struct {
 	NumNewRepoRevisionsToFetch int `json:"revs_to_fetch"`
 	MaxRepoRevisionsToSearch   int `json:"max_revs_to_search"`
@@ -2952,6 +3087,65 @@ func (c *APITestSelectionConfig) ToService() (any, error) {
 	}, nil
 }
 
+type APICostConfig struct {
+	DefaultHourlyCost *float64              `json:"default_hourly_cost"`
+	InstanceTypes     []APIInstanceTypeCost `json:"instance_types"`
+	Distros           []APIDistroCost       `json:"distros"`
+}
+
+type APIInstanceTypeCost struct {
+	InstanceType *string  `json:"instance_type"`
+	HourlyCost   *float64 `json:"hourly_cost"`
+}
+
+type APIDistroCost struct {
+	Distro     *string  `json:"distro"`
+	HourlyCost *float64 `json:"hourly_cost"`
+}
+
+func (c *APICostConfig) BuildFromService(h any) error {
+	switch v := h.(type) {
+	case evergreen.CostConfig:
+		c.DefaultHourlyCost = utility.ToFloat64Ptr(v.DefaultHourlyCost)
+		c.InstanceTypes = nil
+		for _, it := range v.InstanceTypes {
+			c.InstanceTypes = append(c.InstanceTypes, APIInstanceTypeCost{
+				InstanceType: utility.ToStringPtr(it.InstanceType),
+				HourlyCost:   utility.ToFloat64Ptr(it.HourlyCost),
+			})
+		}
+		c.Distros = nil
+		for _, d := range v.Distros {
+			c.Distros = append(c.Distros, APIDistroCost{
+				Distro:     utility.ToStringPtr(d.Distro),
+				HourlyCost: utility.ToFloat64Ptr(d.HourlyCost),
+			})
+		}
+		return nil
+	default:
+		return errors.Errorf("programmatic error: expected cost config but got type %T", h)
+	}
+}
+
+func (c *APICostConfig) ToService() (any, error) {
+	config := evergreen.CostConfig{
+		DefaultHourlyCost: utility.FromFloat64Ptr(c.DefaultHourlyCost),
+	}
+	for _, it := range c.InstanceTypes {
+		config.InstanceTypes = append(config.InstanceTypes, evergreen.InstanceTypeCost{
+			InstanceType: utility.FromStringPtr(it.InstanceType),
+			HourlyCost:   utility.FromFloat64Ptr(it.HourlyCost),
+		})
+	}
+	for _, d := range c.Distros {
+		config.Distros = append(config.Distros, evergreen.DistroCost{
+			Distro:     utility.FromStringPtr(d.Distro),
+			HourlyCost: utility.FromFloat64Ptr(d.HourlyCost),
+		})
+	}
+	return config, nil
+}
+
 type APIRuntimeEnvironmentsConfig struct {
 	BaseURL *string `json:"base_url"`
 	APIKey  *string `json:"api_key"`
@@ -103,6 +103,8 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.AuthConfig.Github.ClientId, utility.FromStringPtr(apiSettings.AuthConfig.Github.ClientId))
 	assert.EqualValues(testSettings.AuthConfig.Multi.ReadWrite[0], apiSettings.AuthConfig.Multi.ReadWrite[0])
 	assert.EqualValues(testSettings.AuthConfig.Kanopy.Issuer, utility.FromStringPtr(apiSettings.AuthConfig.Kanopy.Issuer))
+	assert.EqualValues(testSettings.AuthConfig.OIDC.Issuer, utility.FromStringPtr(apiSettings.AuthConfig.OIDC.Issuer))
+	assert.EqualValues(testSettings.AuthConfig.OIDC.RoleMappings[0].ClaimValue, utility.FromStringPtr(apiSettings.AuthConfig.OIDC.RoleMappings[0].ClaimValue))
 	assert.Equal(len(testSettings.AuthConfig.Github.Users), len(apiSettings.AuthConfig.Github.Users))
 	assert.Equal(testSettings.Buckets.LogBucket.Name, utility.FromStringPtr(apiSettings.Buckets.LogBucket.Name))
 	assert.EqualValues(testSettings.Buckets.LogBucket.Type, utility.FromStringPtr(apiSettings.Buckets.LogBucket.Type))
@@ -188,6 +190,7 @@ func TestModelConversion(t *testing.T) {
 		assert.Equal(ar.Role, utility.FromStringPtr(apiSettings.Providers.AWS.AccountRoles[i].Role))
 	}
 	assert.EqualValues(testSettings.Providers.Docker.APIVersion, utility.FromStringPtr(apiSettings.Providers.Docker.APIVersion))
+	assert.EqualValues(testSettings.Providers.Kubernetes.APIServerURL, utility.FromStringPtr(apiSettings.Providers.Kubernetes.APIServerURL))
 	assert.EqualValues(testSettings.RepoTracker.MaxConcurrentRequests, apiSettings.RepoTracker.MaxConcurrentRequests)
 	assert.EqualValues(testSettings.Scheduler.TaskFinder, utility.FromStringPtr(apiSettings.Scheduler.TaskFinder))
 	assert.EqualValues(testSettings.ServiceFlags.HostInitDisabled, apiSettings.ServiceFlags.HostInitDisabled)
@@ -252,6 +255,7 @@ func TestModelConversion(t *testing.T) {
 	assert.Equal(len(testSettings.AuthConfig.Github.Users), len(dbSettings.AuthConfig.Github.Users))
 	assert.EqualValues(testSettings.AuthConfig.Multi.ReadWrite[0], dbSettings.AuthConfig.Multi.ReadWrite[0])
 	assert.EqualValues(testSettings.AuthConfig.Kanopy.Issuer, dbSettings.AuthConfig.Kanopy.Issuer)
+	assert.EqualValues(testSettings.AuthConfig.OIDC.RoleMappings, dbSettings.AuthConfig.OIDC.RoleMappings)
 	assert.Equal(testSettings.Buckets.LogBucket.Name, utility.FromStringPtr(apiSettings.Buckets.LogBucket.Name))
 	assert.EqualValues(testSettings.Buckets.LogBucket.Type, utility.FromStringPtr(apiSettings.Buckets.LogBucket.Type))
 	assert.Equal(testSettings.Buckets.LogBucket.DBName, utility.FromStringPtr(apiSettings.Buckets.LogBucket.DBName))
@@ -297,6 +301,7 @@ func TestModelConversion(t *testing.T) {
 	assert.EqualValues(testSettings.Providers.AWS.PersistentDNS.HostedZoneID, dbSettings.Providers.AWS.PersistentDNS.HostedZoneID)
 	assert.EqualValues(testSettings.Providers.AWS.PersistentDNS.Domain, dbSettings.Providers.AWS.PersistentDNS.Domain)
 	assert.EqualValues(testSettings.Providers.Docker.APIVersion, dbSettings.Providers.Docker.APIVersion)
+	assert.EqualValues(testSettings.Providers.Kubernetes.Namespace, dbSettings.Providers.Kubernetes.Namespace)
 	assert.EqualValues(testSettings.RepoTracker.MaxConcurrentRequests, dbSettings.RepoTracker.MaxConcurrentRequests)
 	assert.EqualValues(testSettings.Scheduler.TaskFinder, dbSettings.Scheduler.TaskFinder)
 	assert.EqualValues(testSettings.ServiceFlags.HostInitDisabled, dbSettings.ServiceFlags.HostInitDisabled)
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// APICostSummary is the total estimated cost of a group of task executions.
+type APICostSummary struct {
+	// Project is the project the tasks belong to, if grouped by project.
+	Project *string `json:"project,omitempty"`
+	// BuildVariant is the build variant the tasks ran in, if grouped by
+	// build variant.
+	BuildVariant *string `json:"build_variant,omitempty"`
+	// Requester is what triggered the tasks, if grouped by requester.
+	Requester *string `json:"requester,omitempty"`
+	// Cost is the estimated cost of the tasks.
+	Cost float64 `json:"cost"`
+	// RunTimeSecs is the total time the tasks ran.
+	RunTimeSecs float64 `json:"run_time_secs"`
+	// OverheadTimeSecs is the total time the tasks' hosts spent being set up,
+	// idle or torn down that is attributed to the tasks.
+	OverheadTimeSecs float64 `json:"overhead_time_secs"`
+	// NumTasks is the number of task executions.
+	NumTasks int `json:"num_tasks"`
+}
+
+func (s *APICostSummary) BuildFromService(summary cost.Summary) {
+	if summary.Group.Project != "" {
+		s.Project = utility.ToStringPtr(summary.Group.Project)
+	}
+	if summary.Group.BuildVariant != "" {
+		s.BuildVariant = utility.ToStringPtr(summary.Group.BuildVariant)
+	}
+	if summary.Group.Requester != "" {
+		s.Requester = utility.ToStringPtr(summary.Group.Requester)
+	}
+	s.Cost = summary.Cost
+	s.RunTimeSecs = summary.RunTime.Seconds()
+	s.OverheadTimeSecs = summary.OverheadTime.Seconds()
+	s.NumTasks = summary.NumTasks
+}
+
+// APICostBudget is a project's monthly task cost budget.
+type APICostBudget struct {
+	// ProjectID is the project the budget applies to.
+	ProjectID *string `json:"project_id"`
+	// MonthlyLimit is the amount the project expects to spend on tasks in a
+	// calendar month.
+	MonthlyLimit float64 `json:"monthly_limit"`
+	// AlertThresholds are the percentages of the monthly limit that notify
+	// the subscribers when crossed. Defaults to notifying only when the limit
+	// is reached.
+	AlertThresholds []int `json:"alert_thresholds"`
+	// Subscribers are notified when an alert threshold is crossed. Only email
+	// and Slack subscribers are supported.
+	Subscribers []APISubscriber `json:"subscribers"`
+}
+
+func (b *APICostBudget) BuildFromService(budget cost.Budget) error {
+	b.ProjectID = utility.ToStringPtr(budget.ProjectID)
+	b.MonthlyLimit = budget.MonthlyLimit
+	b.AlertThresholds = budget.AlertThresholds
+	b.Subscribers = make([]APISubscriber, 0, len(budget.Subscribers))
+	for _, sub := range budget.Subscribers {
+		var apiSub APISubscriber
+		if err := apiSub.BuildFromService(sub); err != nil {
+			return errors.Wrapf(err, "converting subscriber '%s' to API model", sub.String())
+		}
+		b.Subscribers = append(b.Subscribers, apiSub)
+	}
+	return nil
+}
+
+func (b *APICostBudget) ToService() (*cost.Budget, error) {
+	budget := &cost.Budget{
+		ProjectID:       utility.FromStringPtr(b.ProjectID),
+		MonthlyLimit:    b.MonthlyLimit,
+		AlertThresholds: b.AlertThresholds,
+	}
+	for _, apiSub := range b.Subscribers {
+		sub, err := apiSub.ToService()
+		if err != nil {
+			return nil, errors.Wrap(err, "converting subscriber to service model")
+		}
+		budget.Subscribers = append(budget.Subscribers, sub)
+	}
+	return budget, nil
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+)
+
+// APICriticalPath is the critical path analysis of a version.
+type APICriticalPath struct {
+	// StartTime is the time the earliest task in the version was scheduled.
+	StartTime *time.Time `json:"start_time"`
+	// FinishTime is the time the last task in the version finished.
+	FinishTime *time.Time `json:"finish_time"`
+	// Makespan is the wall-clock time between StartTime and FinishTime.
+	Makespan APIDuration `json:"makespan_ms"`
+	// Path is the chain of tasks, in order of execution, that determined
+	// the version's makespan.
+	Path []APICriticalPathTask `json:"path"`
+	// Tasks contains every finished task in the version, with
+	// dependencies before the tasks that depend on them.
+	Tasks []APICriticalPathTask `json:"tasks"`
+}
+
+// APICriticalPathTask is the timing of a single task in a version's critical
+// path analysis.
+type APICriticalPathTask struct {
+	TaskID       *string `json:"task_id"`
+	DisplayName  *string `json:"display_name"`
+	BuildVariant *string `json:"build_variant"`
+	// ScheduledTime is the time the task was scheduled.
+	ScheduledTime *time.Time `json:"scheduled_time"`
+	// ReadyTime is the time the task was scheduled and all of its
+	// dependencies had finished.
+	ReadyTime  *time.Time `json:"ready_time"`
+	StartTime  *time.Time `json:"start_time"`
+	FinishTime *time.Time `json:"finish_time"`
+	// DependencyWait is the time spent waiting on dependencies to finish.
+	DependencyWait APIDuration `json:"dependency_wait_ms"`
+	// QueueWait is the time spent waiting in the task queue after becoming
+	// ready.
+	QueueWait APIDuration `json:"queue_wait_ms"`
+	// RunTime is the time spent running.
+	RunTime APIDuration `json:"run_time_ms"`
+	// Slack is how much later the task could have finished without
+	// delaying the version.
+	Slack APIDuration `json:"slack_ms"`
+	// OnCriticalPath is true if the task is in the critical path.
+	OnCriticalPath bool `json:"on_critical_path"`
+}
+
+func (cp *APICriticalPath) BuildFromService(path task.CriticalPath) {
+	cp.StartTime = ToTimePtr(path.StartTime)
+	cp.FinishTime = ToTimePtr(path.FinishTime)
+	cp.Makespan = NewAPIDuration(path.Makespan())
+	cp.Path = make([]APICriticalPathTask, 0, len(path.Path))
+	for _, t := range path.Path {
+		var apiTask APICriticalPathTask
+		apiTask.BuildFromService(t)
+		cp.Path = append(cp.Path, apiTask)
+	}
+	cp.Tasks = make([]APICriticalPathTask, 0, len(path.Tasks))
+	for _, t := range path.Tasks {
+		var apiTask APICriticalPathTask
+		apiTask.BuildFromService(t)
+		cp.Tasks = append(cp.Tasks, apiTask)
+	}
+}
+
+func (t *APICriticalPathTask) BuildFromService(cpTask task.CriticalPathTask) {
+	t.TaskID = utility.ToStringPtr(cpTask.ID)
+	t.DisplayName = utility.ToStringPtr(cpTask.Name)
+	t.BuildVariant = utility.ToStringPtr(cpTask.Variant)
+	t.ScheduledTime = ToTimePtr(cpTask.ScheduledTime)
+	t.ReadyTime = ToTimePtr(cpTask.ReadyTime)
+	t.StartTime = ToTimePtr(cpTask.StartTime)
+	t.FinishTime = ToTimePtr(cpTask.FinishTime)
+	t.DependencyWait = NewAPIDuration(cpTask.DependencyWait)
+	t.QueueWait = NewAPIDuration(cpTask.QueueWait)
+	t.RunTime = NewAPIDuration(cpTask.RunTime)
+	t.Slack = NewAPIDuration(cpTask.Slack)
+	t.OnCriticalPath = cpTask.OnCriticalPath
+}
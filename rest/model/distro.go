@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/evergreen-ci/birch"
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model/distro"
@@ -13,6 +15,7 @@ import (
 
 type APIPlannerSettings struct {
 	Version                   *string     `json:"version"`
+	Strategy                  *string     `json:"strategy"`
 	TargetTime                APIDuration `json:"target_time"`
 	GroupVersions             bool        `json:"group_versions"`
 	PatchFactor               int64       `json:"patch_factor"`
@@ -30,6 +33,10 @@ func (s *APIPlannerSettings) BuildFromService(settings distro.PlannerSettings) {
 	if settings.Version == "" {
 		s.Version = utility.ToStringPtr(evergreen.PlannerVersionTunable)
 	}
+	s.Strategy = utility.ToStringPtr(settings.Strategy)
+	if settings.Strategy == "" {
+		s.Strategy = utility.ToStringPtr(evergreen.PlannerStrategyDefault)
+	}
 	s.TargetTime = NewAPIDuration(settings.TargetTime)
 	s.GroupVersions = utility.FromBoolPtr(settings.GroupVersions)
 	s.PatchFactor = settings.PatchFactor
@@ -48,6 +55,7 @@ func (s *APIPlannerSettings) ToService() distro.PlannerSettings {
 	if settings.Version == "" {
 		settings.Version = evergreen.PlannerVersionTunable
 	}
+	settings.Strategy = utility.FromStringPtr(s.Strategy)
 	settings.TargetTime = s.TargetTime.ToDuration()
 	settings.GroupVersions = utility.ToBoolPtr(s.GroupVersions)
 	settings.PatchFactor = s.PatchFactor
@@ -369,6 +377,11 @@ type APIDistro struct {
 	SingleTaskDistro      bool                     `json:"single_task_distro"`
 	ImageID               *string                  `json:"image_id"`
 	ExecUser              *string                  `json:"exec_user"`
+	MaintenanceWindows    []APIMaintenanceWindow   `json:"maintenance_windows"`
+	// ActiveMaintenance and MaintenanceBanner are read-only and describe the
+	// maintenance window that the distro is currently in, if any.
+	ActiveMaintenance *APIActiveMaintenance `json:"active_maintenance,omitempty"`
+	MaintenanceBanner *string               `json:"maintenance_banner,omitempty"`
 }
 
 // BuildFromService converts from service level distro.Distro to an APIDistro
@@ -434,6 +447,23 @@ func (apiDistro *APIDistro) BuildFromService(d distro.Distro) {
 	bootstrapSettings := APIBootstrapSettings{}
 	bootstrapSettings.BuildFromService(d.BootstrapSettings)
 	apiDistro.BootstrapSettings = bootstrapSettings
+
+	if d.MaintenanceWindows != nil {
+		apiDistro.MaintenanceWindows = []APIMaintenanceWindow{}
+		for _, w := range d.MaintenanceWindows {
+			window := APIMaintenanceWindow{}
+			window.BuildFromService(w)
+			apiDistro.MaintenanceWindows = append(apiDistro.MaintenanceWindows, window)
+		}
+	}
+	if d.ActiveMaintenance != nil {
+		apiDistro.ActiveMaintenance = &APIActiveMaintenance{
+			Start:  ToTimePtr(d.ActiveMaintenance.Start),
+			End:    ToTimePtr(d.ActiveMaintenance.End),
+			Reason: utility.ToStringPtr(d.ActiveMaintenance.Reason),
+		}
+		apiDistro.MaintenanceBanner = utility.ToStringPtr(d.MaintenanceBanner())
+	}
 }
 
 // ToService returns a service layer distro using the data from APIDistro
@@ -479,9 +509,55 @@ func (apiDistro *APIDistro) ToService() *distro.Distro {
 	d.IsVirtualWorkstation = apiDistro.IsVirtualWorkstation
 	d.IsCluster = apiDistro.IsCluster
 
+	for _, w := range apiDistro.MaintenanceWindows {
+		d.MaintenanceWindows = append(d.MaintenanceWindows, w.ToService())
+	}
+
 	return &d
 }
 
+// APIMaintenanceWindow is derived from a service layer
+// distro.MaintenanceWindow.
+type APIMaintenanceWindow struct {
+	Start              *time.Time `json:"start,omitempty"`
+	End                *time.Time `json:"end,omitempty"`
+	Cron               *string    `json:"cron,omitempty"`
+	DurationSecs       int        `json:"duration_secs,omitempty"`
+	Reason             *string    `json:"reason,omitempty"`
+	TerminateIdleHosts bool       `json:"terminate_idle_hosts"`
+}
+
+// BuildFromService converts a service level distro.MaintenanceWindow to an
+// APIMaintenanceWindow.
+func (w *APIMaintenanceWindow) BuildFromService(window distro.MaintenanceWindow) {
+	w.Start = ToTimePtr(window.Start)
+	w.End = ToTimePtr(window.End)
+	w.Cron = utility.ToStringPtr(window.Cron)
+	w.DurationSecs = window.DurationSecs
+	w.Reason = utility.ToStringPtr(window.Reason)
+	w.TerminateIdleHosts = window.TerminateIdleHosts
+}
+
+// ToService returns a service layer distro.MaintenanceWindow using the data
+// from an APIMaintenanceWindow.
+func (w *APIMaintenanceWindow) ToService() distro.MaintenanceWindow {
+	return distro.MaintenanceWindow{
+		Start:              utility.FromTimePtr(w.Start),
+		End:                utility.FromTimePtr(w.End),
+		Cron:               utility.FromStringPtr(w.Cron),
+		DurationSecs:       w.DurationSecs,
+		Reason:             utility.FromStringPtr(w.Reason),
+		TerminateIdleHosts: w.TerminateIdleHosts,
+	}
+}
+
+// APIActiveMaintenance is the maintenance window that a distro is currently in.
+type APIActiveMaintenance struct {
+	Start  *time.Time `json:"start"`
+	End    *time.Time `json:"end"`
+	Reason *string    `json:"reason,omitempty"`
+}
+
 // APIExpansion is derived from a service layer distro.Expansion
 type APIExpansion struct {
 	Key   *string `json:"key"`
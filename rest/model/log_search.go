@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
+)
+
+// APILogSearchResult is a line returned by a search of a task's logs.
+type APILogSearchResult struct {
+	// Execution is the execution of the task whose logs contain the line.
+	Execution int `json:"execution"`
+	// LogName is the name of the log that contains the line.
+	LogName *string `json:"log_name"`
+	// LineNumber is the 0-based position of the line in the searched
+	// logs of the execution.
+	LineNumber int `json:"line_number"`
+	// Timestamp is the time the line was logged.
+	Timestamp *time.Time `json:"timestamp"`
+	// Priority is the priority of the line.
+	Priority int `json:"priority"`
+	// Data is the content of the line.
+	Data *string `json:"data"`
+	// IsContext is true if the line does not match the search pattern and
+	// is only returned as context for a nearby matching line.
+	IsContext bool `json:"is_context"`
+}
+
+func (r *APILogSearchResult) BuildFromService(result task.LogSearchResult) {
+	r.Execution = result.Execution
+	r.LogName = utility.ToStringPtr(result.LogName)
+	r.LineNumber = result.LineNumber
+	r.Timestamp = ToTimePtr(time.Unix(0, result.Timestamp))
+	r.Priority = int(result.Priority)
+	r.Data = utility.ToStringPtr(result.Data)
+	r.IsContext = result.IsContext
+}
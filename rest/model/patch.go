@@ -55,6 +55,9 @@ type APIPatch struct {
 	DownstreamTasks []DownstreamTasks `json:"downstream_tasks"`
 	// List of documents of available tasks and associated build variant
 	VariantsTasks []VariantTask `json:"variants_tasks"`
+	// Tasks that were not added to the patch because none of the changed
+	// files matched their changed-path filters
+	PathFilteredTasks []APIPathFilteredTask `json:"path_filtered_tasks,omitempty"`
 	// Whether the patch has been finalized and activated
 	Activated            bool                 `json:"activated"`
 	Alias                *string              `json:"alias,omitempty"`
@@ -87,6 +90,32 @@ type VariantTask struct {
 	Tasks []*string `json:"tasks"`
 }
 
+// APIPathFilteredTask is a task that was not created because none of the
+// changed files matched its changed-path filters.
+type APIPathFilteredTask struct {
+	// Name of build variant
+	BuildVariant *string `json:"build_variant"`
+	// Name of the task
+	Task *string `json:"task"`
+	// Explanation of which filters excluded the task
+	Reason *string `json:"reason"`
+}
+
+func buildAPIPathFilteredTasks(filtered []patch.PathFilteredTask) []APIPathFilteredTask {
+	if len(filtered) == 0 {
+		return nil
+	}
+	res := make([]APIPathFilteredTask, 0, len(filtered))
+	for _, t := range filtered {
+		res = append(res, APIPathFilteredTask{
+			BuildVariant: utility.ToStringPtr(t.Variant),
+			Task:         utility.ToStringPtr(t.Task),
+			Reason:       utility.ToStringPtr(t.Reason),
+		})
+	}
+	return res
+}
+
 type FileDiff struct {
 	FileName    *string `json:"file_name"`
 	Additions   int     `json:"additions"`
@@ -278,6 +307,7 @@ func (apiPatch *APIPatch) buildBasePatch(p patch.Patch) {
 		variantTasks[i].Tasks = tasks
 	}
 	apiPatch.VariantsTasks = variantTasks
+	apiPatch.PathFilteredTasks = buildAPIPathFilteredTasks(p.PathFilteredTasks)
 	apiPatch.Activated = p.Activated
 	apiPatch.Alias = utility.ToStringPtr(p.Alias)
 	apiPatch.GithubPatchData = githubPatch{}
@@ -295,6 +295,9 @@ type APIBuildBaronSettings struct {
 	BFSuggestionPassword    *string   `bson:"bf_suggestion_password" json:"bf_suggestion_password"`
 	BFSuggestionTimeoutSecs *int      `bson:"bf_suggestion_timeout_secs" json:"bf_suggestion_timeout_secs"`
 	BFSuggestionFeaturesURL *string   `bson:"bf_suggestion_features_url" json:"bf_suggestion_features_url"`
+	// Whether to suggest issues linked to past failures in the project that
+	// failed the same way.
+	FingerprintSuggestionsEnabled *bool `bson:"fingerprint_suggestions_enabled" json:"fingerprint_suggestions_enabled"`
 }
 
 func (bb *APIBuildBaronSettings) BuildFromService(def evergreen.BuildBaronSettings) {
@@ -306,6 +309,7 @@ func (bb *APIBuildBaronSettings) BuildFromService(def evergreen.BuildBaronSettin
 	bb.BFSuggestionPassword = utility.ToStringPtr(def.BFSuggestionPassword)
 	bb.BFSuggestionTimeoutSecs = utility.ToIntPtr(def.BFSuggestionTimeoutSecs)
 	bb.BFSuggestionFeaturesURL = utility.ToStringPtr(def.BFSuggestionFeaturesURL)
+	bb.FingerprintSuggestionsEnabled = utility.ToBoolPtr(def.FingerprintSuggestionsEnabled)
 }
 
 func (bb *APIBuildBaronSettings) ToService() evergreen.BuildBaronSettings {
@@ -318,6 +322,7 @@ func (bb *APIBuildBaronSettings) ToService() evergreen.BuildBaronSettings {
 	buildBaron.BFSuggestionPassword = utility.FromStringPtr(bb.BFSuggestionPassword)
 	buildBaron.BFSuggestionTimeoutSecs = utility.FromIntPtr(bb.BFSuggestionTimeoutSecs)
 	buildBaron.BFSuggestionFeaturesURL = utility.FromStringPtr(bb.BFSuggestionFeaturesURL)
+	buildBaron.FingerprintSuggestionsEnabled = utility.FromBoolPtr(bb.FingerprintSuggestionsEnabled)
 	return buildBaron
 }
 
@@ -653,6 +658,9 @@ type APIProjectRef struct {
 	DeleteGitTagAuthorizedTeams []*string `json:"delete_git_tag_authorized_teams,omitempty" bson:"delete_git_tag_authorized_teams,omitempty"`
 	// Notify original committer (or admins) when build fails.
 	NotifyOnBuildFailure *bool `json:"notify_on_failure"`
+	// How often to send notifications for the project's subscriptions that
+	// don't set their own digest frequency: immediate, hourly or daily.
+	NotificationDigest *string `json:"notification_digest,omitempty"`
 	// Prevent users from being able to view this project unless explicitly
 	// granted access.
 	Restricted *bool `json:"restricted"`
@@ -730,6 +738,7 @@ func (p *APIProjectRef) ToService() (*model.ProjectRef, error) {
 		VersionControlEnabled:            utility.BoolPtrCopy(p.VersionControlEnabled),
 		DisabledStatsCache:               utility.BoolPtrCopy(p.DisabledStatsCache),
 		NotifyOnBuildFailure:             utility.BoolPtrCopy(p.NotifyOnBuildFailure),
+		NotificationDigest:               utility.FromStringPtr(p.NotificationDigest),
 		SpawnHostScriptPath:              utility.FromStringPtr(p.SpawnHostScriptPath),
 		OldestAllowedMergeBase:           utility.FromStringPtr(p.OldestAllowedMergeBase),
 		Admins:                           utility.FromStringPtrSlice(p.Admins),
@@ -852,6 +861,9 @@ func (p *APIProjectRef) BuildPublicFields(ctx context.Context, projectRef model.
 	p.VersionControlEnabled = utility.BoolPtrCopy(projectRef.VersionControlEnabled)
 	p.DisabledStatsCache = utility.BoolPtrCopy(projectRef.DisabledStatsCache)
 	p.NotifyOnBuildFailure = utility.BoolPtrCopy(projectRef.NotifyOnBuildFailure)
+	if projectRef.NotificationDigest != "" {
+		p.NotificationDigest = utility.ToStringPtr(projectRef.NotificationDigest)
+	}
 	p.SpawnHostScriptPath = utility.ToStringPtr(projectRef.SpawnHostScriptPath)
 	p.OldestAllowedMergeBase = utility.ToStringPtr(projectRef.OldestAllowedMergeBase)
 	p.GitTagAuthorizedUsers = utility.ToStringPtrSlice(projectRef.GitTagAuthorizedUsers)
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/utility"
+)
+
+// APIProjectKeyVal is a key shared by the tasks in a project.
+type APIProjectKeyVal struct {
+	// Key is the name of the key.
+	Key *string `json:"key"`
+	// Namespace is the version ID of the patch whose tasks set the key, or
+	// empty for a key set by mainline tasks.
+	Namespace *string `json:"namespace,omitempty"`
+	// Value is the key's value. For a lock, it's the ID of the task holding
+	// the lock.
+	Value *string `json:"value"`
+	// UpdatedBy is the ID of the task that last set the value.
+	UpdatedBy *string `json:"updated_by"`
+	// UpdatedAt is when the value was last set.
+	UpdatedAt *time.Time `json:"updated_at"`
+	// ExpiresAt is when the key expires.
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func (kv *APIProjectKeyVal) BuildFromService(in model.ProjectKeyVal) {
+	kv.Key = utility.ToStringPtr(in.ID.Key)
+	if in.ID.Namespace != "" {
+		kv.Namespace = utility.ToStringPtr(in.ID.Namespace)
+	}
+	kv.Value = utility.ToStringPtr(in.Value)
+	kv.UpdatedBy = utility.ToStringPtr(in.UpdatedBy)
+	kv.UpdatedAt = ToTimePtr(in.UpdatedAt)
+	kv.ExpiresAt = ToTimePtr(in.ExpiresAt)
+}
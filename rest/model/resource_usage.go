@@ -0,0 +1,79 @@
+package model
+
+import (
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
+	"github.com/evergreen-ci/utility"
+)
+
+// APITaskResourceUsage is the resource usage of the processes that a task
+// execution ran.
+type APITaskResourceUsage struct {
+	TaskID    *string `json:"task_id"`
+	Execution int     `json:"execution"`
+	// SampleIntervalSecs is the number of seconds between samples.
+	SampleIntervalSecs float64 `json:"sample_interval_secs"`
+	// Total is the resource usage over the whole task.
+	Total APIResourceUsageSummary `json:"total"`
+	// Commands is the resource usage of each command, in the order that the
+	// commands first ran.
+	Commands []APIResourceUsageSummary `json:"commands"`
+	// Samples is the full time series, only included if requested.
+	Samples []resourceusage.Sample `json:"samples,omitempty"`
+}
+
+// APIResourceUsageSummary is the peak, average and total resource usage of a
+// task or one of its commands. CPU usage is a percentage where 100 is one
+// fully-used core. Network usage is for the whole host.
+type APIResourceUsageSummary struct {
+	Block           *string `json:"block,omitempty"`
+	Command         *string `json:"command,omitempty"`
+	NumSamples      int     `json:"num_samples"`
+	DurationSecs    float64 `json:"duration_secs"`
+	PeakCPUPercent  float64 `json:"peak_cpu_percent"`
+	AvgCPUPercent   float64 `json:"avg_cpu_percent"`
+	PeakMemoryBytes uint64  `json:"peak_memory_bytes"`
+	AvgMemoryBytes  uint64  `json:"avg_memory_bytes"`
+	PeakProcesses   int     `json:"peak_processes"`
+	DiskReadBytes   uint64  `json:"disk_read_bytes"`
+	DiskWriteBytes  uint64  `json:"disk_write_bytes"`
+	NetSentBytes    uint64  `json:"net_sent_bytes"`
+	NetRecvBytes    uint64  `json:"net_recv_bytes"`
+}
+
+// BuildFromService converts the task's resource usage to its REST model.
+// Samples are only included if includeSamples is true.
+func (u *APITaskResourceUsage) BuildFromService(usage *resourceusage.TaskResourceUsage, includeSamples bool) {
+	u.TaskID = utility.ToStringPtr(usage.TaskID)
+	u.Execution = usage.Execution
+	u.SampleIntervalSecs = usage.SampleIntervalSecs
+
+	total, byCommand := usage.Summarize()
+	u.Total.BuildFromService(total)
+	u.Commands = make([]APIResourceUsageSummary, len(byCommand))
+	for i := range byCommand {
+		u.Commands[i].BuildFromService(byCommand[i])
+	}
+	if includeSamples {
+		u.Samples = usage.Samples
+	}
+}
+
+func (s *APIResourceUsageSummary) BuildFromService(summary resourceusage.Summary) {
+	if summary.Block != "" {
+		s.Block = utility.ToStringPtr(summary.Block)
+	}
+	if summary.Command != "" {
+		s.Command = utility.ToStringPtr(summary.Command)
+	}
+	s.NumSamples = summary.NumSamples
+	s.DurationSecs = summary.DurationSecs
+	s.PeakCPUPercent = summary.PeakCPUPercent
+	s.AvgCPUPercent = summary.AvgCPUPercent
+	s.PeakMemoryBytes = summary.PeakMemoryBytes
+	s.AvgMemoryBytes = summary.AvgMemoryBytes
+	s.PeakProcesses = summary.PeakProcesses
+	s.DiskReadBytes = summary.DiskReadBytes
+	s.DiskWriteBytes = summary.DiskWriteBytes
+	s.NetSentBytes = summary.NetSentBytes
+	s.NetRecvBytes = summary.NetRecvBytes
+}
@@ -20,6 +20,7 @@ type APISubscriber struct {
 	Target              any                     `json:"target" swaggerignore:"true"`
 	WebhookSubscriber   *APIWebhookSubscriber   `json:"-"`
 	JiraIssueSubscriber *APIJIRAIssueSubscriber `json:"-"`
+	PagerDutySubscriber *APIPagerDutySubscriber `json:"-"`
 }
 
 type APIGithubPRSubscriber struct {
@@ -50,6 +51,20 @@ type APIWebhookSubscriber struct {
 	Headers    []APIWebhookHeader `json:"headers" mapstructure:"headers"`
 }
 
+type APITeamsSubscriber struct {
+	// URL is the incoming webhook URL of the chat channel.
+	URL *string `json:"url" mapstructure:"url"`
+}
+
+type APIPagerDutySubscriber struct {
+	// RoutingKey is the integration key of the service to page. It is
+	// redacted when returned.
+	RoutingKey *string `json:"routing_key" mapstructure:"routing_key"`
+	// URL is the events API endpoint. It defaults to the PagerDuty Events
+	// API v2.
+	URL *string `json:"url" mapstructure:"url"`
+}
+
 type APIWebhookHeader struct {
 	Key   *string `json:"key" mapstructure:"key"`
 	Value *string `json:"value" mapstructure:"value"`
@@ -103,6 +118,23 @@ func (s *APISubscriber) BuildFromService(in event.Subscriber) error {
 		target = sub
 		s.JiraIssueSubscriber = &sub
 
+	case event.TeamsSubscriberType:
+		sub := APITeamsSubscriber{}
+		err := sub.BuildFromService(in.Target)
+		if err != nil {
+			return err
+		}
+		target = sub
+
+	case event.PagerDutySubscriberType:
+		sub := APIPagerDutySubscriber{}
+		err := sub.BuildFromService(in.Target)
+		if err != nil {
+			return err
+		}
+		target = sub
+		s.PagerDutySubscriber = &sub
+
 	case event.JIRACommentSubscriberType, event.EmailSubscriberType,
 		event.SlackSubscriberType:
 		target = in.Target
@@ -180,6 +212,30 @@ func (s *APISubscriber) ToService() (event.Subscriber, error) {
 		}
 		target = apiModel.ToService()
 
+	case event.TeamsSubscriberType:
+		apiModel := APITeamsSubscriber{}
+		if err = mapstructure.Decode(s.Target, &apiModel); err != nil {
+			return event.Subscriber{}, gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    errors.Wrap(err, "Teams subscriber target is malformed").Error(),
+			}
+		}
+		target = apiModel.ToService()
+
+	case event.PagerDutySubscriberType:
+		apiModel := APIPagerDutySubscriber{}
+		if s.PagerDutySubscriber != nil {
+			apiModel = *s.PagerDutySubscriber
+		} else {
+			if err = mapstructure.Decode(s.Target, &apiModel); err != nil {
+				return event.Subscriber{}, gimlet.ErrorResponse{
+					StatusCode: http.StatusBadRequest,
+					Message:    errors.Wrap(err, "PagerDuty subscriber target is malformed").Error(),
+				}
+			}
+		}
+		target = apiModel.ToService()
+
 	case event.JIRACommentSubscriberType, event.EmailSubscriberType,
 		event.SlackSubscriberType:
 		target = s.Target
@@ -300,6 +356,44 @@ func (s *APIWebhookSubscriber) ToService() event.WebhookSubscriber {
 	return sub
 }
 
+func (s *APITeamsSubscriber) BuildFromService(h any) error {
+	switch v := h.(type) {
+	case *event.TeamsSubscriber:
+		s.URL = utility.ToStringPtr(v.URL)
+
+	default:
+		return errors.Errorf("programmatic error: expected Teams subscriber but got type %T", h)
+	}
+
+	return nil
+}
+
+func (s *APITeamsSubscriber) ToService() event.TeamsSubscriber {
+	return event.TeamsSubscriber{
+		URL: utility.FromStringPtr(s.URL),
+	}
+}
+
+func (s *APIPagerDutySubscriber) BuildFromService(h any) error {
+	switch v := h.(type) {
+	case *event.PagerDutySubscriber:
+		s.RoutingKey = utility.ToStringPtr(evergreen.RedactedValue)
+		s.URL = utility.ToStringPtr(v.URL)
+
+	default:
+		return errors.Errorf("programmatic error: expected PagerDuty subscriber but got type %T", h)
+	}
+
+	return nil
+}
+
+func (s *APIPagerDutySubscriber) ToService() event.PagerDutySubscriber {
+	return event.PagerDutySubscriber{
+		RoutingKey: utility.FromStringPtr(s.RoutingKey),
+		URL:        utility.FromStringPtr(s.URL),
+	}
+}
+
 func (s *APIWebhookHeader) BuildFromService(h event.WebhookHeader) {
 	s.Key = &h.Key
 	if h.Key == "Authorization" {
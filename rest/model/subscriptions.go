@@ -30,6 +30,10 @@ type APISubscription struct {
 	Owner *string `json:"owner"`
 	// Data for the particular condition that triggers the subscription.
 	TriggerData map[string]string `json:"trigger_data,omitempty"`
+	// How often to send the subscription's notifications: immediate, hourly
+	// or daily. Hourly and daily subscriptions send a single digest of the
+	// notifications in the window. Defaults to the owner's digest frequency.
+	Digest *string `json:"digest,omitempty"`
 }
 
 func (s *APISelector) BuildFromService(selector event.Selector) {
@@ -51,6 +55,9 @@ func (s *APISubscription) BuildFromService(sub event.Subscription) error {
 	s.Owner = utility.ToStringPtr(sub.Owner)
 	s.OwnerType = utility.ToStringPtr(string(sub.OwnerType))
 	s.TriggerData = sub.TriggerData
+	if sub.Digest != event.DigestDefault {
+		s.Digest = utility.ToStringPtr(sub.Digest)
+	}
 	err := s.Subscriber.BuildFromService(sub.Subscriber)
 	if err != nil {
 		return err
@@ -80,6 +87,7 @@ func (s *APISubscription) ToService() (event.Subscription, error) {
 		Selectors:      []event.Selector{},
 		RegexSelectors: []event.Selector{},
 		TriggerData:    s.TriggerData,
+		Digest:         utility.FromStringPtr(s.Digest),
 	}
 	subscriber, err := s.Subscriber.ToService()
 	if err != nil {
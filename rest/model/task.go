@@ -184,6 +184,8 @@ type ApiTaskEndDetail struct {
 	OOMTracker  APIOomTrackerInfo `json:"oom_tracker_info"`
 	TraceID     *string           `json:"trace_id"`
 	DiskDevices []string          `json:"disk_devices"`
+	// CacheResults are the results of the cache.restore commands that ran.
+	CacheResults []APICacheResult `json:"cache_results,omitempty"`
 }
 
 func (at *ApiTaskEndDetail) BuildFromService(t apimodels.TaskEndDetail) error {
@@ -211,6 +213,11 @@ func (at *ApiTaskEndDetail) BuildFromService(t apimodels.TaskEndDetail) error {
 	at.OOMTracker = apiOomTracker
 	at.TraceID = utility.ToStringPtr(t.TraceID)
 	at.DiskDevices = t.DiskDevices
+	for _, result := range t.CacheResults {
+		var apiResult APICacheResult
+		apiResult.BuildFromService(result)
+		at.CacheResults = append(at.CacheResults, apiResult)
+	}
 
 	return nil
 }
@@ -220,6 +227,10 @@ func (ad *ApiTaskEndDetail) ToService() apimodels.TaskEndDetail {
 	for _, failingCmd := range ad.OtherFailingCommands {
 		failingCmds = append(failingCmds, failingCmd.ToService())
 	}
+	var cacheResults []apimodels.CacheResult
+	for _, result := range ad.CacheResults {
+		cacheResults = append(cacheResults, result.ToService())
+	}
 	return apimodels.TaskEndDetail{
 		Status:               utility.FromStringPtr(ad.Status),
 		Type:                 utility.FromStringPtr(ad.Type),
@@ -233,6 +244,7 @@ func (ad *ApiTaskEndDetail) ToService() apimodels.TaskEndDetail {
 		OOMTracker:           ad.OOMTracker.ToService(),
 		TraceID:              utility.FromStringPtr(ad.TraceID),
 		DiskDevices:          ad.DiskDevices,
+		CacheResults:         cacheResults,
 	}
 }
 
@@ -257,6 +269,32 @@ func (afc *APIFailingCommand) ToService() apimodels.FailingCommand {
 	}
 }
 
+// APICacheResult is the result of restoring a cache in a task.
+type APICacheResult struct {
+	// Key is the name of the cache for the exact key.
+	Key *string `json:"key"`
+	// Hit is whether the cache for the exact key was restored.
+	Hit bool `json:"hit"`
+	// RestoredKey is the name of the cache that was restored, if any.
+	RestoredKey *string `json:"restored_key,omitempty"`
+}
+
+func (acr *APICacheResult) BuildFromService(cr apimodels.CacheResult) {
+	acr.Key = utility.ToStringPtr(cr.Key)
+	acr.Hit = cr.Hit
+	if cr.RestoredKey != "" {
+		acr.RestoredKey = utility.ToStringPtr(cr.RestoredKey)
+	}
+}
+
+func (acr *APICacheResult) ToService() apimodels.CacheResult {
+	return apimodels.CacheResult{
+		Key:         utility.FromStringPtr(acr.Key),
+		Hit:         acr.Hit,
+		RestoredKey: utility.FromStringPtr(acr.RestoredKey),
+	}
+}
+
 type APIOomTrackerInfo struct {
 	Detected bool  `json:"detected"`
 	Pids     []int `json:"pids"`
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"time"
+
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/utility"
+)
+
+// APITestFlakiness is the flakiness score and quarantine state of a single
+// test in a project's task.
+type APITestFlakiness struct {
+	// BuildVariant is the build variant of the task that runs the test.
+	BuildVariant *string `json:"build_variant"`
+	// TaskName is the display name of the task that runs the test.
+	TaskName *string `json:"task_name"`
+	// TestName is the display name of the test.
+	TestName *string `json:"test_name"`
+	// Score is the fraction of restarted tasks in which the test both
+	// passed and failed on the same revision.
+	Score float64 `json:"score"`
+	// NumRuns is the number of restarted tasks in which the test ran more
+	// than once.
+	NumRuns int `json:"num_runs"`
+	// NumFlips is the number of restarted tasks in which the test both
+	// passed and failed.
+	NumFlips int `json:"num_flips"`
+	// ScoredAt is the time the score was last computed.
+	ScoredAt *time.Time `json:"scored_at"`
+	// Quarantined is true if failures of the test do not fail the task.
+	Quarantined bool `json:"quarantined"`
+	// QuarantinedBy is the user who quarantined the test.
+	QuarantinedBy *string `json:"quarantined_by,omitempty"`
+	// QuarantinedAt is the time the test was quarantined.
+	QuarantinedAt *time.Time `json:"quarantined_at,omitempty"`
+	// QuarantineReason is the reason the test was quarantined.
+	QuarantineReason *string `json:"quarantine_reason,omitempty"`
+}
+
+func (f *APITestFlakiness) BuildFromService(flakiness testresult.TestFlakiness) {
+	f.BuildVariant = utility.ToStringPtr(flakiness.ID.BuildVariant)
+	f.TaskName = utility.ToStringPtr(flakiness.ID.TaskName)
+	f.TestName = utility.ToStringPtr(flakiness.ID.TestName)
+	f.Score = flakiness.Score
+	f.NumRuns = flakiness.NumRuns
+	f.NumFlips = flakiness.NumFlips
+	if !utility.IsZeroTime(flakiness.ScoredAt) {
+		f.ScoredAt = ToTimePtr(flakiness.ScoredAt)
+	}
+	f.Quarantined = flakiness.Quarantined
+	if flakiness.Quarantined {
+		f.QuarantinedBy = utility.ToStringPtr(flakiness.QuarantinedBy)
+		f.QuarantinedAt = ToTimePtr(flakiness.QuarantinedAt)
+		f.QuarantineReason = utility.ToStringPtr(flakiness.QuarantineReason)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/parsley"
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/thirdparty"
@@ -96,6 +97,30 @@ func (pk *APIPubKey) BuildFromService(in user.PubKey) {
 	pk.Key = utility.ToStringPtr(in.Key)
 }
 
+// APIAccessToken is a user's personal access token. Token is only set when
+// the access token is created.
+type APIAccessToken struct {
+	Id         *string    `json:"id"`
+	Name       *string    `json:"name"`
+	Token      *string    `json:"token,omitempty"`
+	Scopes     []string   `json:"scopes"`
+	Projects   []string   `json:"projects,omitempty"`
+	CreatedAt  *time.Time `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+// BuildFromService converts from service level structs to an APIAccessToken.
+func (t *APIAccessToken) BuildFromService(in user.AccessToken) {
+	t.Id = utility.ToStringPtr(in.Id)
+	t.Name = utility.ToStringPtr(in.Name)
+	t.Scopes = in.Scopes
+	t.Projects = in.Projects
+	t.CreatedAt = ToTimePtr(in.CreatedAt)
+	t.ExpiresAt = ToTimePtr(in.ExpiresAt)
+	t.LastUsedAt = ToTimePtr(in.LastUsedAt)
+}
+
 type APIUserSettings struct {
 	Timezone         *string                     `json:"timezone"`
 	Region           *string                     `json:"region"`
@@ -191,6 +216,7 @@ type APINotificationPreferences struct {
 	SpawnHostExpirationID *string `json:"spawn_host_expiration_id,omitempty"`
 	SpawnHostOutcome      *string `json:"spawn_host_outcome"`
 	SpawnHostOutcomeID    *string `json:"spawn_host_outcome_id,omitempty"`
+	Digest                *string `json:"digest,omitempty"`
 }
 
 func (n *APINotificationPreferences) BuildFromService(in user.NotificationPreferences) {
@@ -214,6 +240,9 @@ func (n *APINotificationPreferences) BuildFromService(in user.NotificationPrefer
 	if in.SpawnHostExpirationID != "" {
 		n.SpawnHostExpirationID = utility.ToStringPtr(in.SpawnHostExpirationID)
 	}
+	if in.Digest != "" {
+		n.Digest = utility.ToStringPtr(in.Digest)
+	}
 }
 
 func (n *APINotificationPreferences) ToService() (user.NotificationPreferences, error) {
@@ -240,12 +269,17 @@ func (n *APINotificationPreferences) ToService() (user.NotificationPreferences,
 	if !user.IsValidSubscriptionPreference(spawnHostOutcome) {
 		return user.NotificationPreferences{}, errors.Errorf("invalid spawn host outcome subscription preference '%s'", spawnHostOutcome)
 	}
+	digest := utility.FromStringPtr(n.Digest)
+	if !event.IsValidDigest(digest) {
+		return user.NotificationPreferences{}, errors.Errorf("invalid digest frequency '%s'", digest)
+	}
 	preferences := user.NotificationPreferences{
 		BuildBreak:          user.UserSubscriptionPreference(buildBreak),
 		PatchFinish:         user.UserSubscriptionPreference(patchFinish),
 		PatchFirstFailure:   user.UserSubscriptionPreference(patchFirstFailure),
 		SpawnHostOutcome:    user.UserSubscriptionPreference(spawnHostOutcome),
 		SpawnHostExpiration: user.UserSubscriptionPreference(spawnHostExpiration),
+		Digest:              digest,
 	}
 	preferences.BuildBreakID = utility.FromStringPtr(n.BuildBreakID)
 	preferences.PatchFinishID = utility.FromStringPtr(n.PatchFinishID)
@@ -53,6 +53,9 @@ type APIVersion struct {
 	GitTags []APIGitTag `json:"git_tags"`
 	// Indicates if the version was ignored due to only making changes to ignored files.
 	Ignored *bool `json:"ignored"`
+	// Tasks that were not created because none of the changed files matched
+	// their changed-path filters.
+	PathFilteredTasks []APIPathFilteredTask `json:"path_filtered_tasks,omitempty"`
 }
 
 type APIGitTag struct {
@@ -85,6 +88,7 @@ func (apiVersion *APIVersion) BuildFromService(ctx context.Context, v model.Vers
 	apiVersion.Activated = v.Activated
 	apiVersion.Aborted = utility.ToBoolPtr(v.Aborted)
 	apiVersion.Ignored = utility.ToBoolPtr(v.Ignored)
+	apiVersion.PathFilteredTasks = buildAPIPathFilteredTasks(v.PathFilteredTasks)
 
 	var bd buildDetail
 	for _, t := range v.BuildVariants {
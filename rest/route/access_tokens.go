@@ -0,0 +1,183 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+// accessTokenManagementForbidden returns an error responder if the request was
+// authenticated by a personal access token, since a token must not be able to
+// mint or revoke other tokens.
+func accessTokenManagementForbidden(ctx context.Context) gimlet.Responder {
+	if GetAccessToken(ctx) == nil {
+		return nil
+	}
+	return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+		StatusCode: http.StatusForbidden,
+		Message:    "access tokens cannot be used to manage access tokens",
+	})
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/user/tokens
+
+type accessTokensGetHandler struct{}
+
+func makeFetchAccessTokens() gimlet.RouteHandler {
+	return &accessTokensGetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get current user's personal access tokens
+//	@Description	Fetch the personal access tokens of the current user, including expired ones. The tokens themselves are not returned.
+//	@Tags			users
+//	@Router			/user/tokens [get]
+//	@Security		Api-User || Api-Key
+//	@Success		200	{array}	model.APIAccessToken
+func (h *accessTokensGetHandler) Factory() gimlet.RouteHandler                     { return &accessTokensGetHandler{} }
+func (h *accessTokensGetHandler) Parse(ctx context.Context, r *http.Request) error { return nil }
+
+func (h *accessTokensGetHandler) Run(ctx context.Context) gimlet.Responder {
+	u := MustHaveUser(ctx)
+	tokens, err := user.FindAccessTokensForUser(ctx, u.Id)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "finding access tokens"))
+	}
+
+	apiTokens := []model.APIAccessToken{}
+	for _, t := range tokens {
+		apiToken := model.APIAccessToken{}
+		apiToken.BuildFromService(t)
+		apiTokens = append(apiTokens, apiToken)
+	}
+
+	return gimlet.NewJSONResponse(apiTokens)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/user/tokens
+
+type accessTokenPostHandler struct {
+	opts user.AccessTokenOptions
+}
+
+func makeCreateAccessToken() gimlet.RouteHandler {
+	return &accessTokenPostHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Create a personal access token
+//	@Description	Create a named personal access token for the current user, which can be used in place of the user's API key in the Api-Key header for REST v2 routes and patch submission. The token expires at expires_at and is restricted to the given scopes ("all", "read" or "patches") and, if set, projects. The token is only included in this response.
+//	@Tags			users
+//	@Router			/user/tokens [post]
+//	@Security		Api-User || Api-Key
+//	@Param			{object}	body	model.APIAccessToken	true	"parameters"
+//	@Success		200			{object}	model.APIAccessToken
+func (h *accessTokenPostHandler) Factory() gimlet.RouteHandler {
+	return &accessTokenPostHandler{}
+}
+
+func (h *accessTokenPostHandler) Parse(ctx context.Context, r *http.Request) error {
+	body := utility.NewRequestReader(r)
+	defer body.Close()
+
+	input := model.APIAccessToken{}
+	if err := utility.ReadJSON(body, &input); err != nil {
+		return errors.Wrap(err, "reading access token from JSON request body")
+	}
+	if input.ExpiresAt == nil {
+		return errors.New("access token expiration must be specified")
+	}
+
+	h.opts = user.AccessTokenOptions{
+		Name:      utility.FromStringPtr(input.Name),
+		Scopes:    input.Scopes,
+		ExpiresAt: *input.ExpiresAt,
+	}
+	for _, project := range input.Projects {
+		projectId, err := dbModel.GetIdForProject(ctx, strings.TrimSpace(project))
+		if err != nil {
+			return errors.Wrapf(err, "finding project '%s'", project)
+		}
+		h.opts.Projects = append(h.opts.Projects, projectId)
+	}
+
+	return errors.Wrap(h.opts.Validate(), "invalid access token")
+}
+
+func (h *accessTokenPostHandler) Run(ctx context.Context) gimlet.Responder {
+	if resp := accessTokenManagementForbidden(ctx); resp != nil {
+		return resp
+	}
+	u := MustHaveUser(ctx)
+
+	created, token, err := user.CreateAccessToken(ctx, u.Id, h.opts)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "creating access token"))
+	}
+
+	apiToken := model.APIAccessToken{}
+	apiToken.BuildFromService(*created)
+	apiToken.Token = utility.ToStringPtr(token)
+
+	return gimlet.NewJSONResponse(apiToken)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// DELETE /rest/v2/user/tokens/{token_id}
+
+type accessTokenDeleteHandler struct {
+	tokenId string
+}
+
+func makeRevokeAccessToken() gimlet.RouteHandler {
+	return &accessTokenDeleteHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Revoke a personal access token
+//	@Description	Revoke the current user's personal access token with ID {token_id}, so that it can no longer be used.
+//	@Tags			users
+//	@Router			/user/tokens/{token_id} [delete]
+//	@Security		Api-User || Api-Key
+//	@Param			token_id	path	string	true	"the token ID"
+//	@Success		200
+func (h *accessTokenDeleteHandler) Factory() gimlet.RouteHandler {
+	return &accessTokenDeleteHandler{}
+}
+
+func (h *accessTokenDeleteHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.tokenId = gimlet.GetVars(r)["token_id"]
+	if strings.TrimSpace(h.tokenId) == "" {
+		return errors.New("access token ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (h *accessTokenDeleteHandler) Run(ctx context.Context) gimlet.Responder {
+	if resp := accessTokenManagementForbidden(ctx); resp != nil {
+		return resp
+	}
+	u := MustHaveUser(ctx)
+
+	if err := user.RevokeAccessToken(ctx, u.Id, h.tokenId); err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "revoking access token"))
+	}
+
+	return gimlet.NewJSONResponse(struct{}{})
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/manifest"
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/pod"
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testlog"
 	"github.com/evergreen-ci/evergreen/model/testresult"
@@ -714,6 +715,53 @@ func (h *setTaskResultsInfoHandler) Run(ctx context.Context) gimlet.Responder {
 	return gimlet.NewTextResponse("Results info set in task")
 }
 
+// POST /rest/v2/task/{task_id}/resource_usage
+type setTaskResourceUsageHandler struct {
+	taskID string
+	usage  resourceusage.TaskResourceUsage
+}
+
+func makeSetTaskResourceUsage() gimlet.RouteHandler {
+	return &setTaskResourceUsageHandler{}
+}
+
+func (h *setTaskResourceUsageHandler) Factory() gimlet.RouteHandler {
+	return &setTaskResourceUsageHandler{}
+}
+
+func (h *setTaskResourceUsageHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.taskID = gimlet.GetVars(r)["task_id"]
+
+	if err := gimlet.GetJSON(r.Body, &h.usage); err != nil {
+		return errors.Wrap(err, "reading resource usage from JSON request body")
+	}
+
+	return nil
+}
+
+func (h *setTaskResourceUsageHandler) Run(ctx context.Context) gimlet.Responder {
+	t, err := task.FindOneId(ctx, h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	// The task and execution come from the task rather than the request so
+	// that the agent can only set the usage of the task it's running.
+	h.usage.TaskID = t.Id
+	h.usage.Execution = t.Execution
+	if err = h.usage.Upsert(ctx); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	return gimlet.NewTextResponse("Resource usage set for task")
+}
+
 // POST /task/{task_id}/test_logs
 type attachTestLogHandler struct {
 	settings *evergreen.Settings
@@ -1254,6 +1302,98 @@ func (h *keyvalIncHandler) Run(ctx context.Context) gimlet.Responder {
 	return gimlet.NewJSONResponse(keyVal)
 }
 
+// POST /task/{task_id}/keyval/get
+// POST /task/{task_id}/keyval/set
+// POST /task/{task_id}/keyval/cas
+// POST /task/{task_id}/keyval/lock
+// POST /task/{task_id}/keyval/unlock
+type projectKeyvalHandler struct {
+	op      string
+	taskID  string
+	request apimodels.KeyValRequest
+}
+
+func makeProjectKeyval(op string) gimlet.RouteHandler {
+	return &projectKeyvalHandler{op: op}
+}
+
+func (h *projectKeyvalHandler) Factory() gimlet.RouteHandler {
+	return &projectKeyvalHandler{op: h.op}
+}
+
+func (h *projectKeyvalHandler) Parse(ctx context.Context, r *http.Request) error {
+	if h.taskID = gimlet.GetVars(r)["task_id"]; h.taskID == "" {
+		return errors.New("missing task ID")
+	}
+	if err := utility.ReadJSON(r.Body, &h.request); err != nil {
+		return errors.Wrap(err, "reading key-value request from JSON request body")
+	}
+	if err := h.request.Validate(); err != nil {
+		return errors.Wrap(err, "invalid key-value request")
+	}
+	if h.op == apimodels.KeyValSet || h.op == apimodels.KeyValCAS {
+		return errors.Wrap(model.ValidateProjectKeyVal(h.request.Key, h.request.Value), "invalid key-value pair")
+	}
+	return nil
+}
+
+func (h *projectKeyvalHandler) Run(ctx context.Context) gimlet.Responder {
+	t, err := task.FindOneId(ctx, h.taskID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+	}
+	if t == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+		})
+	}
+
+	// Patch tasks get their own namespace so that they can't read or
+	// overwrite the keys that mainline tasks rely on.
+	id := model.NewProjectKeyValID(t.Project, h.request.Key, t.Requester, t.Version)
+	ttl := time.Duration(h.request.TTLSecs) * time.Second
+	resp := apimodels.KeyValResponse{}
+	switch h.op {
+	case apimodels.KeyValGet:
+	case apimodels.KeyValSet:
+		_, err = model.SetProjectKeyVal(ctx, id, h.request.Value, t.Id, model.GetProjectKeyValTTL(ttl, model.DefaultProjectKeyValTTL))
+		resp.Succeeded = err == nil
+	case apimodels.KeyValCAS:
+		resp.Succeeded, err = model.CompareAndSwapProjectKeyVal(ctx, id, h.request.Expected, h.request.Value, t.Id, model.GetProjectKeyValTTL(ttl, model.DefaultProjectKeyValTTL))
+	case apimodels.KeyValLock:
+		resp.Succeeded, err = model.LockProjectKeyVal(ctx, id, t.Id, model.GetProjectKeyValTTL(ttl, model.DefaultProjectKeyValLockTTL))
+	case apimodels.KeyValUnlock:
+		resp.Succeeded, err = model.UnlockProjectKeyVal(ctx, id, t.Id)
+	default:
+		return gimlet.MakeJSONInternalErrorResponder(errors.Errorf("unrecognized key-value operation '%s'", h.op))
+	}
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "running key-value operation '%s' for task '%s'", h.op, t.Id))
+	}
+
+	kv, err := model.FindProjectKeyVal(ctx, id)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+	if kv != nil {
+		resp.Found = true
+		resp.Value = kv.Value
+	}
+
+	grip.InfoWhen(h.op != apimodels.KeyValGet, message.Fields{
+		"message":   "ran project key-value operation",
+		"operation": h.op,
+		"key":       h.request.Key,
+		"succeeded": resp.Succeeded,
+		"task":      t.Id,
+		"project":   t.Project,
+		"namespace": id.Namespace,
+	})
+
+	return gimlet.NewJSONResponse(resp)
+}
+
 // GET /task/{task_id}/manifest/load
 type manifestLoadHandler struct {
 	taskID   string
@@ -10,10 +10,13 @@ import (
 	"github.com/evergreen-ci/evergreen/model/annotations"
 	"github.com/evergreen-ci/evergreen/model/task"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/evergreen/units"
 	"github.com/evergreen-ci/evergreen/util"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/amboy"
 	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
 	"github.com/pkg/errors"
 )
 
@@ -343,10 +346,14 @@ func (h *annotationByTaskPutHandler) Parse(ctx context.Context, r *http.Request)
 }
 
 func (h *annotationByTaskPutHandler) Run(ctx context.Context) gimlet.Responder {
-	err := task.UpsertAnnotation(ctx, restModel.APITaskAnnotationToService(*h.annotation), h.user.DisplayName())
+	a := restModel.APITaskAnnotationToService(*h.annotation)
+	err := task.UpsertAnnotation(ctx, a, h.user.DisplayName())
 	if err != nil {
 		return gimlet.NewJSONInternalErrorResponse(errors.Wrap(err, "updating annotation"))
 	}
+	if len(a.Issues) > 0 || len(a.SuspectedIssues) > 0 {
+		enqueueFailureFingerprintJob(ctx, a.TaskId, a.TaskExecution)
+	}
 
 	return gimlet.NewJSONResponse(struct{}{})
 }
@@ -398,10 +405,14 @@ func (h *annotationByTaskPatchHandler) Parse(ctx context.Context, r *http.Reques
 }
 
 func (h *annotationByTaskPatchHandler) Run(ctx context.Context) gimlet.Responder {
-	err := task.PatchAnnotation(ctx, restModel.APITaskAnnotationToService(*h.annotation), h.user.DisplayName(), h.upsert)
+	a := restModel.APITaskAnnotationToService(*h.annotation)
+	err := task.PatchAnnotation(ctx, a, h.user.DisplayName(), h.upsert)
 	if err != nil {
 		gimlet.NewJSONInternalErrorResponse(err)
 	}
+	if err == nil && (len(a.Issues) > 0 || len(a.SuspectedIssues) > 0) {
+		enqueueFailureFingerprintJob(ctx, a.TaskId, a.TaskExecution)
+	}
 
 	return gimlet.NewJSONResponse(struct{}{})
 }
@@ -485,6 +496,19 @@ func (h *createdTicketByTaskPutHandler) Run(ctx context.Context) gimlet.Responde
 	if err != nil {
 		return gimlet.NewJSONInternalErrorResponse(err)
 	}
+	enqueueFailureFingerprintJob(ctx, h.taskId, h.execution)
 
 	return gimlet.NewJSONResponse(struct{}{})
 }
+
+// enqueueFailureFingerprintJob fingerprints the failed task execution so that
+// the issues linked to it can be suggested for later failures that match it,
+// and so that it can be matched against earlier failures.
+func enqueueFailureFingerprintJob(ctx context.Context, taskId string, execution int) {
+	j := units.NewFailureFingerprintJob(taskId, execution)
+	grip.Warning(message.WrapError(amboy.EnqueueUniqueJob(ctx, evergreen.GetEnvironment().RemoteQueue(), j), message.Fields{
+		"message":   "could not enqueue failure fingerprint job",
+		"task_id":   taskId,
+		"execution": execution,
+	}))
+}
@@ -0,0 +1,278 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/projects/{project_id}/costs
+
+type getProjectCostsHandler struct {
+	project string
+	opts    cost.SummaryOptions
+}
+
+func makeGetProjectCosts() gimlet.RouteHandler {
+	return &getProjectCostsHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get project task costs
+//	@Description	Returns the estimated cost of the project's tasks that finished in the given time range, sorted from most to least expensive. A task's cost covers the time it ran plus its share of the time its host spent being set up, sitting idle and being torn down. Costs are estimated once the task's host terminates.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/costs [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string	true	"the project ID"
+//	@Param			start_time	query		string	false	"only include tasks that finished at or after this time, in RFC3339 format. Defaults to the start of the current month."
+//	@Param			end_time	query		string	false	"only include tasks that finished before this time, in RFC3339 format. Defaults to now."
+//	@Param			group_by	query		string	false	"comma-separated fields to group costs by: build_variant, requester"
+//	@Success		200			{array}		model.APICostSummary
+func (h *getProjectCostsHandler) Factory() gimlet.RouteHandler {
+	return &getProjectCostsHandler{}
+}
+
+func (h *getProjectCostsHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.project = gimlet.GetVars(r)["project_id"]
+
+	vals := r.URL.Query()
+	h.opts.EndTime = time.Now()
+	if endTime := vals.Get("end_time"); endTime != "" {
+		t, err := time.Parse(time.RFC3339, endTime)
+		if err != nil {
+			return errors.Wrap(err, "parsing end time")
+		}
+		h.opts.EndTime = t
+	}
+	h.opts.StartTime = cost.MonthStart(h.opts.EndTime)
+	if startTime := vals.Get("start_time"); startTime != "" {
+		t, err := time.Parse(time.RFC3339, startTime)
+		if err != nil {
+			return errors.Wrap(err, "parsing start time")
+		}
+		h.opts.StartTime = t
+	}
+	if groupBy := vals.Get("group_by"); groupBy != "" {
+		for _, field := range strings.Split(groupBy, ",") {
+			field = strings.TrimSpace(field)
+			if field == cost.GroupByProject {
+				// Costs are already filtered to a single project.
+				continue
+			}
+			h.opts.GroupBy = append(h.opts.GroupBy, field)
+		}
+	}
+
+	return errors.Wrap(h.opts.Validate(), "invalid cost options")
+}
+
+func (h *getProjectCostsHandler) Run(ctx context.Context) gimlet.Responder {
+	projectID, err := dbModel.GetIdForProject(ctx, h.project)
+	if err != nil {
+		return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "getting ID for project '%s'", h.project).Error(),
+		})
+	}
+	h.opts.Project = projectID
+
+	summaries, err := cost.Summarize(ctx, h.opts)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "summarizing task costs for project '%s'", projectID))
+	}
+
+	apiSummaries := make([]model.APICostSummary, 0, len(summaries))
+	for _, s := range summaries {
+		var apiSummary model.APICostSummary
+		apiSummary.BuildFromService(s)
+		apiSummaries = append(apiSummaries, apiSummary)
+	}
+
+	return gimlet.NewJSONResponse(apiSummaries)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/projects/{project_id}/cost_budget
+
+type getCostBudgetHandler struct {
+	project string
+}
+
+func makeGetCostBudget() gimlet.RouteHandler {
+	return &getCostBudgetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a project's cost budget
+//	@Description	Returns the project's monthly task cost budget.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/cost_budget [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string	true	"the project ID"
+//	@Success		200			{object}	model.APICostBudget
+func (h *getCostBudgetHandler) Factory() gimlet.RouteHandler {
+	return &getCostBudgetHandler{}
+}
+
+func (h *getCostBudgetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.project = gimlet.GetVars(r)["project_id"]
+	return nil
+}
+
+func (h *getCostBudgetHandler) Run(ctx context.Context) gimlet.Responder {
+	projectID, err := dbModel.GetIdForProject(ctx, h.project)
+	if err != nil {
+		return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "getting ID for project '%s'", h.project).Error(),
+		})
+	}
+
+	budget, err := cost.FindBudget(ctx, projectID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+	if budget == nil {
+		return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("project '%s' has no cost budget", projectID),
+		})
+	}
+
+	var apiBudget model.APICostBudget
+	if err = apiBudget.BuildFromService(*budget); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting cost budget to API model"))
+	}
+
+	return gimlet.NewJSONResponse(apiBudget)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// PUT /rest/v2/projects/{project_id}/cost_budget
+
+type putCostBudgetHandler struct {
+	project string
+	budget  model.APICostBudget
+}
+
+func makePutCostBudget() gimlet.RouteHandler {
+	return &putCostBudgetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Set a project's cost budget
+//	@Description	Restricted to admins. Sets the project's monthly task cost budget. The subscribers are notified the first time in a calendar month that the project's estimated spend crosses each alert threshold.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/cost_budget [put]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path		string				true	"the project ID"
+//	@Param			{object}	body		model.APICostBudget	true	"the budget"
+//	@Success		200			{object}	model.APICostBudget
+func (h *putCostBudgetHandler) Factory() gimlet.RouteHandler {
+	return &putCostBudgetHandler{}
+}
+
+func (h *putCostBudgetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.project = gimlet.GetVars(r)["project_id"]
+	if err := utility.ReadJSON(r.Body, &h.budget); err != nil {
+		return errors.Wrap(err, "reading cost budget from JSON request body")
+	}
+	return nil
+}
+
+func (h *putCostBudgetHandler) Run(ctx context.Context) gimlet.Responder {
+	projectID, err := dbModel.GetIdForProject(ctx, h.project)
+	if err != nil {
+		return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "getting ID for project '%s'", h.project).Error(),
+		})
+	}
+
+	h.budget.ProjectID = utility.ToStringPtr(projectID)
+	budget, err := h.budget.ToService()
+	if err != nil {
+		return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "converting cost budget to service model").Error(),
+		})
+	}
+	if err = budget.Validate(); err != nil {
+		return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "invalid cost budget").Error(),
+		})
+	}
+	if err = budget.Upsert(ctx); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	var apiBudget model.APICostBudget
+	if err = apiBudget.BuildFromService(*budget); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting cost budget to API model"))
+	}
+
+	return gimlet.NewJSONResponse(apiBudget)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// DELETE /rest/v2/projects/{project_id}/cost_budget
+
+type deleteCostBudgetHandler struct {
+	project string
+}
+
+func makeDeleteCostBudget() gimlet.RouteHandler {
+	return &deleteCostBudgetHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Delete a project's cost budget
+//	@Description	Restricted to admins. Removes the project's monthly task cost budget.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/cost_budget [delete]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string	true	"the project ID"
+//	@Success		200
+func (h *deleteCostBudgetHandler) Factory() gimlet.RouteHandler {
+	return &deleteCostBudgetHandler{}
+}
+
+func (h *deleteCostBudgetHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.project = gimlet.GetVars(r)["project_id"]
+	return nil
+}
+
+func (h *deleteCostBudgetHandler) Run(ctx context.Context) gimlet.Responder {
+	projectID, err := dbModel.GetIdForProject(ctx, h.project)
+	if err != nil {
+		return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "getting ID for project '%s'", h.project).Error(),
+		})
+	}
+
+	if err = cost.RemoveBudget(ctx, projectID); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	return gimlet.NewJSONResponse(struct{}{})
+}
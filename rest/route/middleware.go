@@ -36,6 +36,7 @@ const (
 	RequestContext   requestContextKey = 0
 	githubPayloadKey requestContextKey = 3
 	snsPayloadKey    requestContextKey = 5
+	accessTokenKey   requestContextKey = 6
 )
 
 const alertmanagerUser = "alertmanager"
@@ -687,6 +688,157 @@ func getGitHubPayload(ctx context.Context) []byte {
 	return []byte{}
 }
 
+type accessTokenAuthMiddleware struct{}
+
+// NewAccessTokenAuthMiddleware returns a middleware that authenticates requests
+// that use a personal access token in place of the user's API key. It must run
+// before the user middleware, which would otherwise reject the token as an
+// invalid API key. Access tokens can only be used for REST v2 routes and for
+// the legacy route that the CLI uses to submit patches.
+func NewAccessTokenAuthMiddleware() gimlet.Middleware {
+	return &accessTokenAuthMiddleware{}
+}
+
+func (m *accessTokenAuthMiddleware) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	key := r.Header.Get(evergreen.APIKeyHeader)
+	if !strings.HasPrefix(key, user.AccessTokenPrefix) {
+		next(rw, r)
+		return
+	}
+
+	unauthorized := gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+		StatusCode: http.StatusUnauthorized,
+		Message:    "invalid access token",
+	})
+	isPatchSubmission := isLegacyPatchSubmissionRequest(r)
+	if !isRESTV2Path(r.URL.Path) && !isPatchSubmission {
+		gimlet.WriteResponse(rw, gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "access tokens can only be used for REST v2 routes and patch submission",
+		}))
+		return
+	}
+
+	ctx := r.Context()
+	token, err := user.FindAccessTokenByToken(ctx, key)
+	if err != nil {
+		gimlet.WriteResponse(rw, gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "finding access token")))
+		return
+	}
+	if token == nil || token.IsExpired() {
+		gimlet.WriteResponse(rw, unauthorized)
+		return
+	}
+	if username := r.Header.Get(evergreen.APIUserHeader); username != "" && username != token.UserId {
+		gimlet.WriteResponse(rw, unauthorized)
+		return
+	}
+	// The scope middleware only wraps REST v2 routes, so the scope for patch
+	// submission is checked here. The submission's project is in the request
+	// body, so the token's projects are checked when the patch is submitted.
+	if isPatchSubmission && !token.AllowsRequest(r.Method, true) {
+		gimlet.WriteResponse(rw, gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusForbidden,
+			Message:    fmt.Sprintf("access token '%s' does not have the scope for this request", token.Name),
+		}))
+		return
+	}
+
+	u, err := user.FindOneByIdContext(ctx, token.UserId)
+	if err != nil {
+		gimlet.WriteResponse(rw, gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding user '%s'", token.UserId)))
+		return
+	}
+	if u == nil {
+		gimlet.WriteResponse(rw, unauthorized)
+		return
+	}
+
+	grip.Warning(message.WrapError(token.UpdateLastUsed(ctx), message.Fields{
+		"message":  "could not update access token last used time",
+		"token_id": token.Id,
+		"user":     token.UserId,
+	}))
+
+	// The token has already been checked, so remove the headers to prevent
+	// the user middleware from comparing the token to the user's API key.
+	r.Header.Del(evergreen.APIKeyHeader)
+	r.Header.Del(evergreen.APIUserHeader)
+
+	ctx = gimlet.AttachUser(ctx, u)
+	ctx = context.WithValue(ctx, accessTokenKey, token)
+	next(rw, r.WithContext(ctx))
+}
+
+func isRESTV2Path(path string) bool {
+	restPrefix := "/" + evergreen.RestRoutePrefix + "/v2/"
+	return strings.HasPrefix(path, restPrefix) || strings.HasPrefix(path, "/"+evergreen.APIRoutePrefix+restPrefix)
+}
+
+// isLegacyPatchSubmissionRequest returns whether the request submits a new
+// patch using the legacy API route.
+func isLegacyPatchSubmissionRequest(r *http.Request) bool {
+	return r.Method == http.MethodPut && r.URL.Path == "/"+evergreen.APIRoutePrefix+"/patches/"
+}
+
+// GetAccessToken returns the personal access token that authenticated the
+// request, if any.
+func GetAccessToken(ctx context.Context) *user.AccessToken {
+	if rv := ctx.Value(accessTokenKey); rv != nil {
+		if t, ok := rv.(*user.AccessToken); ok {
+			return t
+		}
+	}
+
+	return nil
+}
+
+type accessTokenScopeMiddleware struct{}
+
+// NewAccessTokenScopeMiddleware returns a middleware that rejects requests
+// authenticated by a personal access token if the token's scopes or projects
+// do not allow them.
+func NewAccessTokenScopeMiddleware() gimlet.Middleware {
+	return &accessTokenScopeMiddleware{}
+}
+
+func (m *accessTokenScopeMiddleware) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	token := GetAccessToken(r.Context())
+	if token == nil {
+		next(rw, r)
+		return
+	}
+
+	forbidden := func(msg string) {
+		gimlet.WriteResponse(rw, gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusForbidden,
+			Message:    msg,
+		}))
+	}
+
+	_, isPatchRequest := gimlet.GetVars(r)["patch_id"]
+	if !token.AllowsRequest(r.Method, isPatchRequest) {
+		forbidden(fmt.Sprintf("access token '%s' does not have the scope for this request", token.Name))
+		return
+	}
+
+	if len(token.Projects) > 0 {
+		projectIds, _, err := urlVarsToProjectScopes(r)
+		if err != nil {
+			forbidden(fmt.Sprintf("access token '%s' is restricted to specific projects, but the request's project could not be determined", token.Name))
+			return
+		}
+		for _, projectId := range projectIds {
+			if !token.AllowsProject(projectId) {
+				forbidden(fmt.Sprintf("access token '%s' cannot be used for project '%s'", token.Name, projectId))
+				return
+			}
+		}
+	}
+
+	next(rw, r)
+}
+
 type snsAuthMiddleware struct{}
 
 // NewSNSAuthMiddleware returns a middleware that verifies the payload
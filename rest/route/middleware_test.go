@@ -20,6 +20,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/testutil"
 	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -762,3 +763,213 @@ func TestEventLogPermission(t *testing.T) {
 	assert.Equal(http.StatusOK, rw.Code)
 	assert.Equal(3, counter)
 }
+
+func TestAccessTokenAuthMiddleware(t *testing.T) {
+	for tName, tCase := range map[string]func(t *testing.T, token string){
+		"AttachesUserAndRemovesHeaders": func(t *testing.T, token string) {
+			r, err := http.NewRequest(http.MethodGet, "/rest/v2/hosts", nil)
+			require.NoError(t, err)
+			r.Header.Set(evergreen.APIUserHeader, "me")
+			r.Header.Set(evergreen.APIKeyHeader, token)
+
+			rw := httptest.NewRecorder()
+			called := false
+			NewAccessTokenAuthMiddleware().ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				called = true
+				u := gimlet.GetUser(r.Context())
+				require.NotNil(t, u)
+				assert.Equal(t, "me", u.Username())
+				require.NotNil(t, GetAccessToken(r.Context()))
+				assert.Empty(t, r.Header.Get(evergreen.APIKeyHeader))
+				assert.Empty(t, r.Header.Get(evergreen.APIUserHeader))
+			})
+			assert.True(t, called)
+			assert.Equal(t, http.StatusOK, rw.Code)
+
+			dbToken, err := user.FindAccessTokenByToken(t.Context(), token)
+			require.NoError(t, err)
+			require.NotNil(t, dbToken)
+			assert.False(t, utility.IsZeroTime(dbToken.LastUsedAt))
+		},
+		"AllowsAPIRESTPrefixWithoutUserHeader": func(t *testing.T, token string) {
+			r, err := http.NewRequest(http.MethodGet, "/api/rest/v2/hosts", nil)
+			require.NoError(t, err)
+			r.Header.Set(evergreen.APIKeyHeader, token)
+
+			rw := httptest.NewRecorder()
+			called := false
+			NewAccessTokenAuthMiddleware().ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) { called = true })
+			assert.True(t, called)
+		},
+		"IgnoresAPIKeys": func(t *testing.T, _ string) {
+			r, err := http.NewRequest(http.MethodGet, "/rest/v2/hosts", nil)
+			require.NoError(t, err)
+			r.Header.Set(evergreen.APIUserHeader, "me")
+			r.Header.Set(evergreen.APIKeyHeader, "api_key")
+
+			rw := httptest.NewRecorder()
+			called := false
+			NewAccessTokenAuthMiddleware().ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				called = true
+				assert.Nil(t, gimlet.GetUser(r.Context()))
+				assert.Equal(t, "api_key", r.Header.Get(evergreen.APIKeyHeader))
+			})
+			assert.True(t, called)
+		},
+		"AllowsLegacyPatchSubmission": func(t *testing.T, token string) {
+			r, err := http.NewRequest(http.MethodPut, "/api/patches/", nil)
+			require.NoError(t, err)
+			r.Header.Set(evergreen.APIKeyHeader, token)
+
+			rw := httptest.NewRecorder()
+			called := false
+			NewAccessTokenAuthMiddleware().ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				called = true
+				u := gimlet.GetUser(r.Context())
+				require.NotNil(t, u)
+				assert.Equal(t, "me", u.Username())
+			})
+			assert.True(t, called)
+		},
+		"AllowsLegacyPatchSubmissionWithPatchesScope": func(t *testing.T, _ string) {
+			_, token, err := user.CreateAccessToken(t.Context(), "me", user.AccessTokenOptions{
+				Name:      "patches",
+				Scopes:    []string{user.AccessTokenScopePatches},
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+			require.NoError(t, err)
+			r, err := http.NewRequest(http.MethodPut, "/api/patches/", nil)
+			require.NoError(t, err)
+			r.Header.Set(evergreen.APIKeyHeader, token)
+
+			rw := httptest.NewRecorder()
+			called := false
+			NewAccessTokenAuthMiddleware().ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) { called = true })
+			assert.True(t, called)
+		},
+		"RejectsLegacyPatchSubmissionWithoutPatchesScope": func(t *testing.T, _ string) {
+			_, token, err := user.CreateAccessToken(t.Context(), "me", user.AccessTokenOptions{
+				Name:      "read",
+				Scopes:    []string{user.AccessTokenScopeRead},
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+			require.NoError(t, err)
+			r, err := http.NewRequest(http.MethodPut, "/api/patches/", nil)
+			require.NoError(t, err)
+			r.Header.Set(evergreen.APIKeyHeader, token)
+
+			rw := httptest.NewRecorder()
+			NewAccessTokenAuthMiddleware().ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				assert.FailNow(t, "should not call next handler")
+			})
+			assert.Equal(t, http.StatusForbidden, rw.Code)
+		},
+		"RejectsNonRESTV2Routes": func(t *testing.T, token string) {
+			r, err := http.NewRequest(http.MethodGet, "/api/patches/mine", nil)
+			require.NoError(t, err)
+			r.Header.Set(evergreen.APIKeyHeader, token)
+
+			rw := httptest.NewRecorder()
+			NewAccessTokenAuthMiddleware().ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				assert.FailNow(t, "should not call next handler")
+			})
+			assert.Equal(t, http.StatusUnauthorized, rw.Code)
+		},
+		"RejectsUnknownTokens": func(t *testing.T, _ string) {
+			r, err := http.NewRequest(http.MethodGet, "/rest/v2/hosts", nil)
+			require.NoError(t, err)
+			r.Header.Set(evergreen.APIKeyHeader, user.AccessTokenPrefix+"unknown")
+
+			rw := httptest.NewRecorder()
+			NewAccessTokenAuthMiddleware().ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				assert.FailNow(t, "should not call next handler")
+			})
+			assert.Equal(t, http.StatusUnauthorized, rw.Code)
+		},
+		"RejectsMismatchedUser": func(t *testing.T, token string) {
+			r, err := http.NewRequest(http.MethodGet, "/rest/v2/hosts", nil)
+			require.NoError(t, err)
+			r.Header.Set(evergreen.APIUserHeader, "someone_else")
+			r.Header.Set(evergreen.APIKeyHeader, token)
+
+			rw := httptest.NewRecorder()
+			NewAccessTokenAuthMiddleware().ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				assert.FailNow(t, "should not call next handler")
+			})
+			assert.Equal(t, http.StatusUnauthorized, rw.Code)
+		},
+		"RejectsExpiredTokens": func(t *testing.T, token string) {
+			dbToken, err := user.FindAccessTokenByToken(t.Context(), token)
+			require.NoError(t, err)
+			require.NotNil(t, dbToken)
+			require.NoError(t, db.UpdateContext(t.Context(), user.AccessTokensCollection, bson.M{user.AccessTokenIdKey: dbToken.Id}, bson.M{
+				"$set": bson.M{user.AccessTokenExpiresAtKey: time.Now().Add(-time.Minute)},
+			}))
+
+			r, err := http.NewRequest(http.MethodGet, "/rest/v2/hosts", nil)
+			require.NoError(t, err)
+			r.Header.Set(evergreen.APIKeyHeader, token)
+
+			rw := httptest.NewRecorder()
+			NewAccessTokenAuthMiddleware().ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {
+				assert.FailNow(t, "should not call next handler")
+			})
+			assert.Equal(t, http.StatusUnauthorized, rw.Code)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			require.NoError(t, db.ClearCollections(user.Collection, user.AccessTokensCollection))
+			require.NoError(t, db.Insert(t.Context(), user.Collection, &user.DBUser{Id: "me", APIKey: "api_key"}))
+			_, token, err := user.CreateAccessToken(t.Context(), "me", user.AccessTokenOptions{
+				Name:      "ci",
+				Scopes:    []string{user.AccessTokenScopeAll},
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+			require.NoError(t, err)
+			tCase(t, token)
+		})
+	}
+}
+
+func TestAccessTokenScopeMiddleware(t *testing.T) {
+	require.NoError(t, db.ClearCollections(model.ProjectRefCollection))
+	require.NoError(t, (&model.ProjectRef{Id: "mci", Identifier: "evergreen"}).Insert(t.Context()))
+	require.NoError(t, (&model.ProjectRef{Id: "other", Identifier: "other"}).Insert(t.Context()))
+
+	serve := func(t *testing.T, token *user.AccessToken, method string, vars map[string]string) int {
+		r, err := http.NewRequest(method, "/rest/v2/route", nil)
+		require.NoError(t, err)
+		ctx := gimlet.AttachUser(t.Context(), &user.DBUser{Id: "me"})
+		if token != nil {
+			ctx = context.WithValue(ctx, accessTokenKey, token)
+		}
+		r = gimlet.SetURLVars(r.WithContext(ctx), vars)
+
+		rw := httptest.NewRecorder()
+		NewAccessTokenScopeMiddleware().ServeHTTP(rw, r, func(rw http.ResponseWriter, r *http.Request) {})
+		return rw.Code
+	}
+
+	t.Run("AllowsRequestsWithoutToken", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(t, nil, http.MethodPost, nil))
+	})
+	t.Run("ReadScopeAllowsReads", func(t *testing.T) {
+		token := &user.AccessToken{Scopes: []string{user.AccessTokenScopeRead}}
+		assert.Equal(t, http.StatusOK, serve(t, token, http.MethodGet, nil))
+		assert.Equal(t, http.StatusForbidden, serve(t, token, http.MethodPost, nil))
+	})
+	t.Run("PatchesScopeAllowsPatchRoutes", func(t *testing.T) {
+		token := &user.AccessToken{Scopes: []string{user.AccessTokenScopePatches}}
+		assert.Equal(t, http.StatusOK, serve(t, token, http.MethodPost, map[string]string{"patch_id": "p1"}))
+		assert.Equal(t, http.StatusForbidden, serve(t, token, http.MethodPost, map[string]string{"host_id": "h1"}))
+	})
+	t.Run("ProjectRestrictionAllowsOnlyGivenProjects", func(t *testing.T) {
+		token := &user.AccessToken{Scopes: []string{user.AccessTokenScopeAll}, Projects: []string{"mci"}}
+		assert.Equal(t, http.StatusOK, serve(t, token, http.MethodGet, map[string]string{"project_id": "evergreen"}))
+		assert.Equal(t, http.StatusForbidden, serve(t, token, http.MethodGet, map[string]string{"project_id": "other"}))
+	})
+	t.Run("ProjectRestrictionRejectsRequestsWithoutProject", func(t *testing.T) {
+		token := &user.AccessToken{Scopes: []string{user.AccessTokenScopeAll}, Projects: []string{"mci"}}
+		assert.Equal(t, http.StatusForbidden, serve(t, token, http.MethodGet, map[string]string{"host_id": "h1"}))
+	})
+}
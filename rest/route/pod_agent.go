@@ -503,6 +503,10 @@ func (h *podAgentEndTask) Run(ctx context.Context) gimlet.Responder {
 		}
 	}
 
+	if evergreen.IsFailedTaskStatus(h.details.Status) && !t.Aborted {
+		enqueueFailureFingerprintJob(ctx, t.Id, t.Execution)
+	}
+
 	// the task was aborted if it is still in undispatched.
 	// the active state should be inactive.
 	if h.details.Status == evergreen.TaskUndispatched {
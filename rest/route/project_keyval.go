@@ -0,0 +1,122 @@
+package route
+
+import (
+	"context"
+	"net/http"
+
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/projects/{project_id}/keyvals
+
+type getProjectKeyvalsHandler struct {
+	project string
+}
+
+func makeGetProjectKeyvals() gimlet.RouteHandler {
+	return &getProjectKeyvalsHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a project's shared keys
+//	@Description	Returns the unexpired keys that the project's tasks share using the keyval.set, keyval.cas and keyval.lock commands.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/keyvals [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string	true	"the project ID"
+//	@Success		200			{array}	model.APIProjectKeyVal
+func (h *getProjectKeyvalsHandler) Factory() gimlet.RouteHandler {
+	return &getProjectKeyvalsHandler{}
+}
+
+func (h *getProjectKeyvalsHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.project = gimlet.GetVars(r)["project_id"]
+	return nil
+}
+
+func (h *getProjectKeyvalsHandler) Run(ctx context.Context) gimlet.Responder {
+	projectID, err := dbModel.GetIdForProject(ctx, h.project)
+	if err != nil {
+		return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "getting ID for project '%s'", h.project).Error(),
+		})
+	}
+
+	kvs, err := dbModel.FindProjectKeyVals(ctx, projectID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	apiKVs := make([]model.APIProjectKeyVal, 0, len(kvs))
+	for _, kv := range kvs {
+		var apiKV model.APIProjectKeyVal
+		apiKV.BuildFromService(kv)
+		apiKVs = append(apiKVs, apiKV)
+	}
+
+	return gimlet.NewJSONResponse(apiKVs)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// DELETE /rest/v2/projects/{project_id}/keyvals
+// DELETE /rest/v2/projects/{project_id}/keyvals/{key}
+
+type deleteProjectKeyvalsHandler struct {
+	project string
+	key     string
+}
+
+func makeDeleteProjectKeyvals() gimlet.RouteHandler {
+	return &deleteProjectKeyvalsHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Clear a project's shared keys
+//	@Description	Deletes a key that the project's tasks share, or all of them if no key is given. Deleting a lock releases it.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/keyvals [delete]
+//	@Router			/projects/{project_id}/keyvals/{key} [delete]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string	true	"the project ID"
+//	@Param			key			path	string	false	"the key to delete"
+//	@Success		200
+func (h *deleteProjectKeyvalsHandler) Factory() gimlet.RouteHandler {
+	return &deleteProjectKeyvalsHandler{}
+}
+
+func (h *deleteProjectKeyvalsHandler) Parse(ctx context.Context, r *http.Request) error {
+	vars := gimlet.GetVars(r)
+	h.project = vars["project_id"]
+	h.key = vars["key"]
+	return nil
+}
+
+func (h *deleteProjectKeyvalsHandler) Run(ctx context.Context) gimlet.Responder {
+	projectID, err := dbModel.GetIdForProject(ctx, h.project)
+	if err != nil {
+		return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "getting ID for project '%s'", h.project).Error(),
+		})
+	}
+
+	if h.key != "" {
+		err = dbModel.DeleteProjectKeyVal(ctx, projectID, h.key)
+	} else {
+		err = dbModel.DeleteProjectKeyVals(ctx, projectID)
+	}
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	return gimlet.NewJSONResponse(struct{}{})
+}
@@ -3,17 +3,36 @@ package route
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
 	"github.com/evergreen-ci/gimlet"
 	testselection "github.com/evergreen-ci/test-selection-client"
 	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
 	"github.com/pkg/errors"
 )
 
+const (
+	// builtInTestSelectionWindow is how far back to look for past patch runs
+	// of a task when selecting tests without a test selection service.
+	builtInTestSelectionWindow = 28 * 24 * time.Hour
+	// builtInTestSelectionMaxRuns is the maximum number of past patch runs of
+	// a task whose test results are used to select tests. Their results are
+	// fetched while handling the request, so this is kept small.
+	builtInTestSelectionMaxRuns = 25
+	// builtInTestSelectionRecentFailureWindow is how far back a test failure
+	// counts as recent.
+	builtInTestSelectionRecentFailureWindow = 7 * 24 * time.Hour
+)
+
 type selectTestsHandler struct {
 	selectTests SelectTestsRequest
 	env         evergreen.Environment
@@ -37,6 +56,8 @@ type SelectTestsRequest struct {
 	// Tests is a list of test names.
 	Tests []string `json:"tests"`
 	// Strategies is the optional list of test selection strategies to use.
+	// If no test selection service is configured, the valid strategies are
+	// "co_failure", "recent_failure", and "new_tests".
 	Strategies []string `json:"strategies"`
 }
 
@@ -47,7 +68,7 @@ func makeSelectTestsHandler(env evergreen.Environment) gimlet.RouteHandler {
 // Factory creates an instance of the handler.
 //
 //	@Summary		Select tests
-//	@Description	Return a subset of tests to run for a given task. This endpoint is not yet ready. Please do not use it.
+//	@Description	Return a subset of tests to run for a given task. If no test selection service is configured, tests are selected using the task's test history and the files changed in its patch. This endpoint is not yet ready. Please do not use it.
 //	@Tags			select
 //	@Router			/select/tests [post]
 //	@Param			{object}	body	SelectTestsRequest	true	"Select tests request"
@@ -80,7 +101,7 @@ func (t *selectTestsHandler) Parse(ctx context.Context, r *http.Request) error {
 func (t *selectTestsHandler) Run(ctx context.Context) gimlet.Responder {
 	tssBaseURL := t.env.Settings().TestSelection.URL
 	if tssBaseURL == "" {
-		return gimlet.NewJSONResponse(t.selectTests)
+		return t.selectTestsBuiltIn(ctx)
 	}
 
 	httpClient := utility.GetHTTPClient()
@@ -116,3 +137,130 @@ func (t *selectTestsHandler) Run(ctx context.Context) gimlet.Responder {
 	rhResp.Tests = selectedTests
 	return gimlet.NewJSONResponse(rhResp)
 }
+
+// selectTestsBuiltIn selects tests using the test results of the task's past
+// patch runs and the files they changed. All the tests are returned if the
+// task is not a patch task or there is nothing to select with.
+func (t *selectTestsHandler) selectTestsBuiltIn(ctx context.Context) gimlet.Responder {
+	rhResp := t.selectTests
+	selector, err := testresult.NewTestSelector(testresult.TestSelectorOptions{
+		Strategies:          t.selectTests.Strategies,
+		RecentFailureWindow: builtInTestSelectionRecentFailureWindow,
+	})
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	if !evergreen.IsPatchRequester(t.selectTests.Requester) {
+		return gimlet.NewJSONResponse(rhResp)
+	}
+
+	tsk, err := task.FindOneIdWithFields(ctx, t.selectTests.TaskID, task.ProjectKey, task.BuildVariantKey, task.DisplayNameKey, task.VersionKey)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", t.selectTests.TaskID))
+	}
+	if tsk == nil {
+		return gimlet.NewJSONResponse(rhResp)
+	}
+	p, err := patch.FindOne(ctx, patch.ByVersion(tsk.Version).Project(patch.ExcludePatchDiff))
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding patch for task '%s'", tsk.Id))
+	}
+	if p == nil {
+		return gimlet.NewJSONResponse(rhResp)
+	}
+	filesChanged := p.FilesChanged()
+	if len(filesChanged) == 0 {
+		return gimlet.NewJSONResponse(rhResp)
+	}
+
+	now := time.Now()
+	if err = t.addPastRuns(ctx, selector, tsk, now); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+	rhResp.Tests = selector.Select(t.selectTests.Tests, filesChanged, now)
+
+	grip.Info(message.Fields{
+		"message":       "selected tests using built-in test selection",
+		"task_id":       tsk.Id,
+		"num_past_runs": selector.NumRuns(),
+		"num_tests":     len(t.selectTests.Tests),
+		"num_selected":  len(rhResp.Tests),
+		"num_files":     len(filesChanged),
+		"strategies":    t.selectTests.Strategies,
+		"project":       tsk.Project,
+		"build_variant": tsk.BuildVariant,
+		"task_name":     tsk.DisplayName,
+		"patch_id":      p.Id.Hex(),
+		"duration_secs": time.Since(now).Seconds(),
+	})
+
+	return gimlet.NewJSONResponse(rhResp)
+}
+
+// addPastRuns adds the test results and changed files of the task's recent
+// patch runs to the selector. The test results for all the runs are fetched
+// with a single request per test results service.
+func (t *selectTestsHandler) addPastRuns(ctx context.Context, selector *testresult.TestSelector, tsk *task.Task, now time.Time) error {
+	runs, err := task.FindRecentPatchRunsWithResults(ctx, tsk.Project, tsk.BuildVariant, tsk.DisplayName, now.Add(-builtInTestSelectionWindow), builtInTestSelectionMaxRuns)
+	if err != nil {
+		return errors.Wrap(err, "finding past patch runs")
+	}
+	if len(runs) == 0 {
+		return nil
+	}
+
+	versions := make([]string, 0, len(runs))
+	for _, run := range runs {
+		versions = append(versions, run.Version)
+	}
+	patches, err := patch.Find(ctx, patch.ByVersions(versions).Project(patch.ExcludePatchDiff))
+	if err != nil {
+		return errors.Wrap(err, "finding patches for past patch runs")
+	}
+	filesChanged := map[string][]string{}
+	for _, p := range patches {
+		filesChanged[p.Version] = p.FilesChanged()
+	}
+
+	var pastRuns []task.Task
+	taskOptsByService := map[string][]testresult.TaskOptions{}
+	for _, run := range runs {
+		if run.Id == tsk.Id {
+			continue
+		}
+		if _, ok := filesChanged[run.Version]; !ok {
+			continue
+		}
+		pastRuns = append(pastRuns, run)
+		taskOptsByService[run.ResultsService] = append(taskOptsByService[run.ResultsService], testresult.TaskOptions{
+			TaskID:         run.Id,
+			Execution:      run.Execution,
+			ResultsService: run.ResultsService,
+		})
+	}
+
+	resultsByRun := map[string][]testresult.TestResult{}
+	for service, taskOpts := range taskOptsByService {
+		results, err := testresult.GetMergedTaskTestResults(ctx, t.env, taskOpts, nil)
+		if err != nil {
+			return errors.Wrapf(err, "getting test results for %d past patch runs from service '%s'", len(taskOpts), service)
+		}
+		for _, result := range results.Results {
+			key := pastRunKey(result.TaskID, result.Execution)
+			resultsByRun[key] = append(resultsByRun[key], result)
+		}
+	}
+
+	for _, run := range pastRuns {
+		selector.AddRun(filesChanged[run.Version], resultsByRun[pastRunKey(run.Id, run.Execution)], run.FinishTime)
+	}
+
+	return nil
+}
+
+func pastRunKey(taskID string, execution int) string {
+	return fmt.Sprintf("%s_%d", taskID, execution)
+}
@@ -3,10 +3,22 @@ package route
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
 	"github.com/evergreen-ci/evergreen/mock"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/evergreen-ci/evergreen/thirdparty"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -109,3 +121,87 @@ func TestSelectTestsHandler(t *testing.T) {
 	sth = makeSelectTestsHandler(env)
 	require.Error(t, sth.Parse(ctx, req), "request should fail to parse when tests are empty")
 }
+
+func TestSelectTestsHandlerBuiltIn(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	env := testutil.NewEnvironment(ctx, t)
+	require.NoError(t, db.ClearCollections(task.Collection, patch.Collection, testresult.Collection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(task.Collection, patch.Collection, testresult.Collection))
+	}()
+
+	makePatch := func(files ...string) *patch.Patch {
+		var summaries []thirdparty.Summary
+		for _, f := range files {
+			summaries = append(summaries, thirdparty.Summary{Name: f})
+		}
+		p := &patch.Patch{
+			Id:      mgobson.NewObjectId(),
+			Patches: []patch.ModulePatch{{PatchSet: patch.PatchSet{Summary: summaries}}},
+		}
+		p.Version = p.Id.Hex()
+		require.NoError(t, p.Insert(ctx))
+		return p
+	}
+	makeTask := func(id string, p *patch.Patch, status string) *task.Task {
+		tsk := &task.Task{
+			Id:             id,
+			Project:        "project",
+			BuildVariant:   "bv",
+			DisplayName:    "test",
+			Version:        p.Version,
+			Requester:      evergreen.PatchVersionRequester,
+			Status:         status,
+			FinishTime:     time.Now().Add(-time.Hour),
+			ResultsService: testresult.TestResultsServiceLocal,
+		}
+		require.NoError(t, tsk.Insert(ctx))
+		return tsk
+	}
+
+	pastTask := makeTask("past", makePatch("auth/login.go"), evergreen.TaskFailed)
+	require.NoError(t, testresult.NewLocalService(env).AppendTestResults(ctx, []testresult.TestResult{
+		{TaskID: pastTask.Id, TestName: "auth", Status: evergreen.TestFailedStatus},
+		{TaskID: pastTask.Id, TestName: "billing", Status: evergreen.TestSucceededStatus},
+	}))
+	currentTask := makeTask("current", makePatch("auth/login.go"), evergreen.TaskStarted)
+
+	selectTests := func(t *testing.T, body SelectTestsRequest) gimlet.Responder {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodPost, "/select/tests", bytes.NewBuffer(b))
+		require.NoError(t, err)
+		rh := makeSelectTestsHandler(env)
+		require.NoError(t, rh.Parse(ctx, req))
+		return rh.Run(ctx)
+	}
+	body := SelectTestsRequest{
+		Project:      "project",
+		Requester:    evergreen.PatchVersionRequester,
+		BuildVariant: "bv",
+		TaskID:       currentTask.Id,
+		TaskName:     "test",
+		Tests:        []string{"auth", "billing", "new"},
+	}
+
+	t.Run("SelectsAffectedAndNewTests", func(t *testing.T) {
+		resp := selectTests(t, body)
+		require.Equal(t, http.StatusOK, resp.Status())
+		assert.Equal(t, []string{"new", "auth"}, resp.Data().(SelectTestsRequest).Tests)
+	})
+	t.Run("ReturnsAllTestsForMainlineRequester", func(t *testing.T) {
+		body := body
+		body.Requester = evergreen.RepotrackerVersionRequester
+		resp := selectTests(t, body)
+		require.Equal(t, http.StatusOK, resp.Status())
+		assert.Equal(t, body.Tests, resp.Data().(SelectTestsRequest).Tests)
+	})
+	t.Run("FailsWithInvalidStrategy", func(t *testing.T) {
+		body := body
+		body.Strategies = []string{"invalid"}
+		resp := selectTests(t, body)
+		assert.Equal(t, http.StatusBadRequest, resp.Status())
+	})
+}
@@ -61,6 +61,7 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	compress := gimlet.WrapperHandlerMiddleware(handlers.CompressHandler)
 
 	app.AddWrapper(gimlet.WrapperMiddleware(allowCORS))
+	app.AddWrapper(NewAccessTokenScopeMiddleware())
 
 	// Clients
 	stsManager := cloud.GetSTSManager(false)
@@ -93,6 +94,7 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/task/{task_id}/heartbeat").Version(2).Post().Wrap(requireTask, requirePodOrHost).RouteHandler(makeHeartbeat())
 	app.AddRoute("/task/{task_id}/parser_project").Version(2).Get().Wrap(requireTask).RouteHandler(makeGetParserProject(env))
 	app.AddRoute("/task/{task_id}/project_ref").Version(2).Get().Wrap(requireTask).RouteHandler(makeGetProjectRef())
+	app.AddRoute("/task/{task_id}/resource_usage").Version(2).Post().Wrap(requireTask, requirePodOrHost).RouteHandler(makeSetTaskResourceUsage())
 	app.AddRoute("/task/{task_id}/set_results_info").Version(2).Post().Wrap(requireTask).RouteHandler(makeSetTaskResultsInfoHandler())
 	app.AddRoute("/task/{task_id}/start").Version(2).Post().Wrap(requireTask, requirePodOrHost).RouteHandler(makeStartTask(env))
 	app.AddRoute("/task/{task_id}/test_logs").Version(2).Post().Wrap(requireTask, requirePodOrHost).RouteHandler(makeAttachTestLog(settings))
@@ -104,6 +106,11 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/task/{task_id}/installation_token/{owner}/{repo}").Version(2).Get().Wrap(requireTask).RouteHandler(makeCreateInstallationToken(env))
 	app.AddRoute("/task/{task_id}/github_dynamic_access_token/{owner}/{repo}").Version(2).Post().Wrap(requireTask).RouteHandler(makeCreateGitHubDynamicAccessToken(env))
 	app.AddRoute("/task/{task_id}/keyval/inc").Version(2).Post().Wrap(requireTask).RouteHandler(makeKeyvalPluginInc())
+	app.AddRoute("/task/{task_id}/keyval/get").Version(2).Post().Wrap(requireTask).RouteHandler(makeProjectKeyval(apimodels.KeyValGet))
+	app.AddRoute("/task/{task_id}/keyval/set").Version(2).Post().Wrap(requireTask).RouteHandler(makeProjectKeyval(apimodels.KeyValSet))
+	app.AddRoute("/task/{task_id}/keyval/cas").Version(2).Post().Wrap(requireTask).RouteHandler(makeProjectKeyval(apimodels.KeyValCAS))
+	app.AddRoute("/task/{task_id}/keyval/lock").Version(2).Post().Wrap(requireTask).RouteHandler(makeProjectKeyval(apimodels.KeyValLock))
+	app.AddRoute("/task/{task_id}/keyval/unlock").Version(2).Post().Wrap(requireTask).RouteHandler(makeProjectKeyval(apimodels.KeyValUnlock))
 	app.AddRoute("/task/{task_id}/manifest/load").Version(2).Get().Wrap(requireTask).RouteHandler(makeManifestLoad(settings))
 	app.AddRoute("/task/{task_id}/update_push_status").Version(2).Post().Wrap(requireTask).RouteHandler(makeUpdatePushStatus())
 	app.AddRoute("/task/{task_id}/restart").Version(2).Post().Wrap(requireTask).RouteHandler(makeMarkTaskForRestart())
@@ -197,11 +204,20 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/projects/{project_id}/copy").Version(2).Post().Wrap(requireUser, addProject, requireProjectAdmin, editProjectSettings).RouteHandler(makeCopyProject(env))
 	app.AddRoute("/projects/{project_id}/copy/variables").Version(2).Post().Wrap(requireUser, addProject, requireProjectAdmin, editProjectSettings).RouteHandler(makeCopyVariables())
 	app.AddRoute("/projects/{project_id}/events").Version(2).Get().Wrap(requireUser, addProject, requireProjectAdmin, viewProjectSettings).RouteHandler(makeFetchProjectEvents(opts.URL))
+	app.AddRoute("/projects/{project_id}/keyvals").Version(2).Get().Wrap(requireUser, viewProjectSettings).RouteHandler(makeGetProjectKeyvals())
+	app.AddRoute("/projects/{project_id}/keyvals").Version(2).Delete().Wrap(requireUser, addProject, editProjectSettings).RouteHandler(makeDeleteProjectKeyvals())
+	app.AddRoute("/projects/{project_id}/keyvals/{key}").Version(2).Delete().Wrap(requireUser, addProject, editProjectSettings).RouteHandler(makeDeleteProjectKeyvals())
 	app.AddRoute("/projects/{project_id}/patches").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makePatchesByProjectRoute(opts.URL))
 	app.AddRoute("/projects/{project_id}/recent_versions").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeFetchProjectVersionsLegacy())
 	app.AddRoute("/projects/{project_id}/revisions/{commit_hash}/tasks").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeTasksByProjectAndCommitHandler(parsleyURL, opts.URL))
 	app.AddRoute("/projects/{project_id}/task_reliability").Version(2).Get().Wrap(requireUser).RouteHandler(makeGetProjectTaskReliability(opts.URL))
 	app.AddRoute("/projects/{project_id}/task_stats").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetProjectTaskStats(opts.URL))
+	app.AddRoute("/projects/{project_id}/costs").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetProjectCosts())
+	app.AddRoute("/projects/{project_id}/cost_budget").Version(2).Get().Wrap(requireUser, viewProjectSettings).RouteHandler(makeGetCostBudget())
+	app.AddRoute("/projects/{project_id}/cost_budget").Version(2).Put().Wrap(requireUser, adminSettings).RouteHandler(makePutCostBudget())
+	app.AddRoute("/projects/{project_id}/cost_budget").Version(2).Delete().Wrap(requireUser, adminSettings).RouteHandler(makeDeleteCostBudget())
+	app.AddRoute("/projects/{project_id}/test_flakiness").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetTestFlakiness(env))
+	app.AddRoute("/projects/{project_id}/test_quarantine").Version(2).Post().Wrap(requireUser, addProject, requireProjectAdmin, editProjectSettings).RouteHandler(makeTestQuarantine(env))
 	app.AddRoute("/projects/{project_id}/versions").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetProjectVersionsHandler(opts.URL))
 	app.AddRoute("/projects/{project_id}/versions").Version(2).Patch().Wrap(requireUser, requireProjectAdmin).RouteHandler(makeModifyProjectVersionsHandler(opts.URL))
 	app.AddRoute("/projects/{project_id}/tasks/{task_name}").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetProjectTasksHandler(opts.URL))
@@ -229,15 +245,20 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/tasks/{task_id}/created_ticket").Version(2).Put().Wrap(requireUser, editAnnotations).RouteHandler(makeCreatedTicketByTask())
 	app.AddRoute("/tasks/{task_id}/abort").Version(2).Post().Wrap(requireUser, editTasks).RouteHandler(makeTaskAbortHandler())
 	app.AddRoute("/tasks/{task_id}/manifest").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetManifestHandler())
+	app.AddRoute("/tasks/{task_id}/resource_usage").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetTaskResourceUsage())
 	app.AddRoute("/tasks/{task_id}/restart").Version(2).Post().Wrap(requireUser, addProject, editTasks).RouteHandler(makeTaskRestartHandler())
 	app.AddRoute("/tasks/{task_id}/tests").Version(2).Get().Wrap(requireUser, addProject, viewTasks).RouteHandler(makeFetchTestsForTask(env, sc))
 	app.AddRoute("/tasks/{task_id}/tests/count").Version(2).Get().Wrap(requireUser, addProject, viewTasks).RouteHandler(makeFetchTestCountForTask())
 	app.AddRoute("/tasks/{task_id}/generated_tasks").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetGeneratedTasks())
 	app.AddRoute("/tasks/{task_id}/build/TaskLogs").Version(2).Get().Wrap(requireUser, viewTasks, compress).RouteHandler(makeGetTaskLogs(opts.URL))
 	app.AddRoute("/tasks/{task_id}/build/TestLogs/{path}").Version(2).Get().Wrap(requireUser, viewTasks, compress).RouteHandler(makeGetTestLogs(opts.URL))
+	app.AddRoute("/tasks/{task_id}/build/search_logs").Version(2).Get().Wrap(requireUser, viewTasks, compress).RouteHandler(makeSearchTaskOutputLogs())
 	app.AddRoute("/tasks/{task_id}/github_dynamic_access_tokens").Version(2).Delete().Wrap(requireUser, viewTasks).RouteHandler(makeDeleteGitHubDynamicAccessTokens())
 	app.AddRoute("/user/settings").Version(2).Get().Wrap(requireUser).RouteHandler(makeFetchUserConfig())
 	app.AddRoute("/user/settings").Version(2).Post().Wrap(requireUser).RouteHandler(makeSetUserConfig())
+	app.AddRoute("/user/tokens").Version(2).Get().Wrap(requireUser).RouteHandler(makeFetchAccessTokens())
+	app.AddRoute("/user/tokens").Version(2).Post().Wrap(requireUser).RouteHandler(makeCreateAccessToken())
+	app.AddRoute("/user/tokens/{token_id}").Version(2).Delete().Wrap(requireUser).RouteHandler(makeRevokeAccessToken())
 	app.AddRoute("/users/{user_id}").Version(2).Get().Wrap(requireUser).RouteHandler(makeGetUserHandler())
 	app.AddRoute("/users/{user_id}/hosts").Version(2).Get().Wrap(requireUser).RouteHandler(makeFetchHosts(opts.URL))
 	app.AddRoute("/users/{user_id}/patches").Version(2).Get().Wrap(requireUser).RouteHandler(makeUserPatchHandler(opts.URL))
@@ -252,6 +273,7 @@ func AttachHandler(app *gimlet.APIApp, opts HandlerOpts) {
 	app.AddRoute("/versions/{version_id}").Version(2).Patch().Wrap(requireUser, editTasks).RouteHandler(makePatchVersion())
 	app.AddRoute("/versions/{version_id}/abort").Version(2).Post().Wrap(requireUser, editTasks).RouteHandler(makeAbortVersion())
 	app.AddRoute("/versions/{version_id}/builds").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetVersionBuilds(env))
+	app.AddRoute("/versions/{version_id}/critical_path").Version(2).Get().Wrap(requireUser, viewTasks).RouteHandler(makeGetVersionCriticalPath())
 	app.AddRoute("/versions/{version_id}/restart").Version(2).Post().Wrap(requireUser, editTasks).RouteHandler(makeRestartVersion())
 	app.AddRoute("/versions/{version_id}/annotations").Version(2).Get().Wrap(requireUser, viewAnnotations).RouteHandler(makeFetchAnnotationsByVersion())
 
@@ -11,6 +11,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/log"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/taskoutput"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
@@ -289,6 +290,96 @@ func (h *getTestLogsHandler) Run(ctx context.Context) gimlet.Responder {
 	return h.createResponse(it)
 }
 
+// GET /tasks/{task_id}/build/search_logs
+type searchTaskOutputLogsHandler struct {
+	tsk  *task.Task
+	opts task.LogSearchOptions
+}
+
+func makeSearchTaskOutputLogs() gimlet.RouteHandler {
+	return &searchTaskOutputLogsHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Search the logs of a task.
+//	@Description	Search the task logs or test logs of every execution of a task for lines that match a substring or regular expression.
+//	@Tags			tasks
+//	@Router			/tasks/{task_id}/build/search_logs [get]
+//	@Security		Api-User || Api-Key
+//	@Param			task_id				path		string	true	"Task ID."
+//	@Param			pattern				query		string	true	"Substring or regular expression to search for."
+//	@Param			regex				query		bool	false	"If set to true, the pattern is a regular expression."
+//	@Param			case_insensitive	query		bool	false	"If set to true, the pattern is matched without regard to case."
+//	@Param			context_lines		query		int		false	"Number of lines before and after each matching line to also return."
+//	@Param			limit				query		int		false	"Maximum number of matching lines to return per execution. Defaults to 1000."
+//	@Param			type				query		string	false	"Task log type to search. Must be one of: `agent_log`, `system_log`, `task_log`, `all_logs`. Defaults to `all_logs`. Ignored if test_log_path is set."
+//	@Param			test_log_path		query		string	false	"Test log path, relative to the task's test log directory, to search instead of the task logs. Can be a prefix. Repeat the parameter key if more than one value."
+//	@Success		200					{object}	[]model.APILogSearchResult
+func (h *searchTaskOutputLogsHandler) Factory() gimlet.RouteHandler {
+	return &searchTaskOutputLogsHandler{}
+}
+
+func (h *searchTaskOutputLogsHandler) Parse(ctx context.Context, r *http.Request) error {
+	vals := r.URL.Query()
+
+	var err error
+	h.tsk, err = task.FindOneId(ctx, gimlet.GetVars(r)["task_id"])
+	if err != nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrap(err, "finding task").Error(),
+		}
+	}
+	if h.tsk == nil {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    "task not found",
+		}
+	}
+
+	if h.opts.TaskLogType = taskoutput.TaskLogType(vals.Get("type")); h.opts.TaskLogType == "" {
+		h.opts.TaskLogType = taskoutput.TaskLogTypeAll
+	} else if err = h.opts.TaskLogType.Validate(false); err != nil {
+		return err
+	}
+	h.opts.TestLogPaths = vals["test_log_path"]
+
+	h.opts.Search.Pattern = vals.Get("pattern")
+	h.opts.Search.Regex = strings.ToLower(vals.Get("regex")) == "true"
+	h.opts.Search.CaseInsensitive = strings.ToLower(vals.Get("case_insensitive")) == "true"
+	if contextLines := vals.Get("context_lines"); contextLines != "" {
+		h.opts.Search.ContextLines, err = strconv.Atoi(contextLines)
+		if err != nil {
+			return errors.Wrap(err, "parsing context lines")
+		}
+	}
+	if limit := vals.Get("limit"); limit != "" {
+		h.opts.Search.Limit, err = strconv.Atoi(limit)
+		if err != nil {
+			return errors.Wrap(err, "parsing limit")
+		}
+	}
+
+	return errors.Wrap(h.opts.Search.Validate(), "invalid search options")
+}
+
+func (h *searchTaskOutputLogsHandler) Run(ctx context.Context) gimlet.Responder {
+	results, err := h.tsk.SearchLogs(ctx, h.opts)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "searching task logs"))
+	}
+
+	apiResults := make([]model.APILogSearchResult, 0, len(results))
+	for _, result := range results {
+		var apiResult model.APILogSearchResult
+		apiResult.BuildFromService(result)
+		apiResults = append(apiResults, apiResult)
+	}
+
+	return gimlet.NewJSONResponse(apiResults)
+}
+
 // getUserTimeZone returns the time zone specified by the user settings.
 // Defaults to `America/New_York`.
 func getUserTimeZone(u *user.DBUser) *time.Location {
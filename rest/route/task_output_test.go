@@ -9,11 +9,14 @@ import (
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/log"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/taskoutput"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/level"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -362,3 +365,254 @@ func TestGetTestLogsHandlerParse(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchTaskOutputLogsHandlerParse(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := evergreen.GetEnvironment()
+
+	require.NoError(t, env.DB().Drop(ctx))
+	defer func() {
+		assert.NoError(t, env.DB().Drop(ctx))
+	}()
+
+	tsk := &task.Task{Id: "task"}
+	_, err := env.DB().Collection(task.Collection).InsertOne(ctx, tsk)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name     string
+		taskID   string
+		urlQuery string
+		expected task.LogSearchOptions
+		hasErr   bool
+		errCode  int
+	}{
+		{
+			name:     "TaskDNE",
+			taskID:   "DNE",
+			urlQuery: "pattern=error",
+			hasErr:   true,
+			errCode:  404,
+		},
+		{
+			name:    "MissingPattern",
+			taskID:  "task",
+			hasErr:  true,
+			errCode: 400,
+		},
+		{
+			name:     "InvalidRegex",
+			taskID:   "task",
+			urlQuery: "pattern=%28error&regex=true",
+			hasErr:   true,
+			errCode:  400,
+		},
+		{
+			name:     "InvalidTaskLogType",
+			taskID:   "task",
+			urlQuery: "pattern=error&type=invalid",
+			hasErr:   true,
+			errCode:  400,
+		},
+		{
+			name:     "InvalidContextLines",
+			taskID:   "task",
+			urlQuery: "pattern=error&context_lines=NaN",
+			hasErr:   true,
+			errCode:  400,
+		},
+		{
+			name:     "NegativeContextLines",
+			taskID:   "task",
+			urlQuery: "pattern=error&context_lines=-1",
+			hasErr:   true,
+			errCode:  400,
+		},
+		{
+			name:     "InvalidLimit",
+			taskID:   "task",
+			urlQuery: "pattern=error&limit=NaN",
+			hasErr:   true,
+			errCode:  400,
+		},
+		{
+			name:     "DefaultParameters",
+			taskID:   "task",
+			urlQuery: "pattern=%28error",
+			expected: task.LogSearchOptions{
+				TaskLogType: taskoutput.TaskLogTypeAll,
+				Search:      log.SearchOptions{Pattern: "(error", Limit: 1000},
+			},
+		},
+		{
+			name:     "ValidParameters",
+			taskID:   "task",
+			urlQuery: "pattern=err.r&regex=true&case_insensitive=true&context_lines=2&limit=10&type=task_log",
+			expected: task.LogSearchOptions{
+				TaskLogType: taskoutput.TaskLogTypeTask,
+				Search: log.SearchOptions{
+					Pattern:         "err.r",
+					Regex:           true,
+					CaseInsensitive: true,
+					ContextLines:    2,
+					Limit:           10,
+				},
+			},
+		},
+		{
+			name:     "TestLogPaths",
+			taskID:   "task",
+			urlQuery: "pattern=error&test_log_path=test0.log&test_log_path=test1",
+			expected: task.LogSearchOptions{
+				TaskLogType:  taskoutput.TaskLogTypeAll,
+				TestLogPaths: []string{"test0.log", "test1"},
+				Search:       log.SearchOptions{Pattern: "error", Limit: 1000},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			url, err := url.Parse(fmt.Sprintf("https://evergreen.mongodb.com/rest/v2/tasks/%s/build/search_logs?%s", test.taskID, test.urlQuery))
+			require.NoError(t, err)
+			req := &http.Request{Method: "GET"}
+			req.URL = url
+			req = gimlet.SetURLVars(req, map[string]string{"task_id": test.taskID})
+
+			rh := &searchTaskOutputLogsHandler{}
+			err = rh.Parse(ctx, req)
+			if test.hasErr {
+				require.Error(t, err)
+				errResp, ok := err.(gimlet.ErrorResponse)
+				if test.errCode == 400 {
+					assert.False(t, ok)
+				} else {
+					require.True(t, ok)
+					assert.Equal(t, test.errCode, errResp.StatusCode)
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tsk.Id, rh.tsk.Id)
+				assert.Equal(t, test.expected, rh.opts)
+			}
+		})
+	}
+}
+
+func TestSearchTaskOutputLogsHandlerRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	env := evergreen.GetEnvironment()
+
+	require.NoError(t, env.DB().Drop(ctx))
+	defer func() {
+		assert.NoError(t, env.DB().Drop(ctx))
+	}()
+
+	bucket := evergreen.BucketConfig{Type: evergreen.BucketTypeLocal, Name: t.TempDir()}
+	output := &taskoutput.TaskOutput{
+		TaskLogs: taskoutput.TaskLogOutput{Version: 1, BucketConfig: bucket},
+	}
+	task0 := &task.Task{
+		Id:             "task_0",
+		OldTaskId:      "task",
+		Project:        "project",
+		Archived:       true,
+		TaskOutputInfo: output,
+	}
+	_, err := env.DB().Collection(task.OldCollection).InsertOne(ctx, task0)
+	require.NoError(t, err)
+	task1 := &task.Task{
+		Id:             "task",
+		Project:        "project",
+		Execution:      1,
+		TaskOutputInfo: output,
+	}
+	_, err = env.DB().Collection(task.Collection).InsertOne(ctx, task1)
+	require.NoError(t, err)
+
+	ts := time.Now().UnixNano()
+	for execution, data := range [][]string{
+		{"starting", "ERROR: disk full", "retrying", "done"},
+		{"starting", "error 42 occurred", "done"},
+	} {
+		lines := make([]log.LogLine, len(data))
+		for i := range data {
+			lines[i] = log.LogLine{Priority: level.Info, Timestamp: ts + int64(i), Data: data[i]}
+		}
+		taskOpts := taskoutput.TaskOptions{ProjectID: "project", TaskID: "task", Execution: execution}
+		require.NoError(t, output.TaskLogs.Append(ctx, taskOpts, taskoutput.TaskLogTypeTask, lines))
+	}
+
+	type result struct {
+		execution  int
+		lineNumber int
+		data       string
+		isContext  bool
+	}
+	for _, test := range []struct {
+		name     string
+		urlQuery string
+		expected []result
+	}{
+		{
+			name:     "Substring",
+			urlQuery: "pattern=ERROR",
+			expected: []result{{execution: 0, lineNumber: 1, data: "ERROR: disk full"}},
+		},
+		{
+			name:     "SubstringDoesNotInterpretRegex",
+			urlQuery: "pattern=err.r",
+		},
+		{
+			name:     "Regex",
+			urlQuery: "pattern=%5Eerr.r&regex=true",
+			expected: []result{{execution: 1, lineNumber: 1, data: "error 42 occurred"}},
+		},
+		{
+			name:     "CaseInsensitiveAcrossExecutions",
+			urlQuery: "pattern=error&case_insensitive=true",
+			expected: []result{
+				{execution: 0, lineNumber: 1, data: "ERROR: disk full"},
+				{execution: 1, lineNumber: 1, data: "error 42 occurred"},
+			},
+		},
+		{
+			name:     "ContextLines",
+			urlQuery: "pattern=disk&context_lines=1",
+			expected: []result{
+				{execution: 0, lineNumber: 0, data: "starting", isContext: true},
+				{execution: 0, lineNumber: 1, data: "ERROR: disk full"},
+				{execution: 0, lineNumber: 2, data: "retrying", isContext: true},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			url, err := url.Parse(fmt.Sprintf("https://evergreen.mongodb.com/rest/v2/tasks/task/build/search_logs?%s", test.urlQuery))
+			require.NoError(t, err)
+			req := &http.Request{Method: "GET"}
+			req.URL = url
+			req = gimlet.SetURLVars(req, map[string]string{"task_id": "task"})
+
+			rh := makeSearchTaskOutputLogs()
+			require.NoError(t, rh.Parse(ctx, req))
+			resp := rh.Run(ctx)
+			require.Equal(t, http.StatusOK, resp.Status())
+
+			apiResults, ok := resp.Data().([]model.APILogSearchResult)
+			require.True(t, ok)
+			actual := make([]result, 0, len(apiResults))
+			for _, apiResult := range apiResults {
+				actual = append(actual, result{
+					execution:  apiResult.Execution,
+					lineNumber: apiResult.LineNumber,
+					data:       utility.FromStringPtr(apiResult.Data),
+					isContext:  apiResult.IsContext,
+				})
+			}
+			if test.expected == nil {
+				test.expected = []result{}
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen/model/resourceusage"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/pkg/errors"
+)
+
+// getTaskResourceUsageHandler implements the route
+// GET /tasks/{task_id}/resource_usage.
+type getTaskResourceUsageHandler struct {
+	taskID         string
+	execution      int
+	hasExecution   bool
+	includeSamples bool
+}
+
+func makeGetTaskResourceUsage() gimlet.RouteHandler {
+	return &getTaskResourceUsageHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get resource usage for task
+//	@Description	Returns the peak and average CPU, memory, disk and network usage of the processes that a task ran, overall and for each command. Usage is sampled by the agent while the task runs.
+//	@Tags			tasks
+//	@Router			/tasks/{task_id}/resource_usage [get]
+//	@Security		Api-User || Api-Key
+//	@Param			task_id			path		string	true	"task ID"
+//	@Param			execution		query		int		false	"The 0-based execution of the task. Defaults to the latest execution."
+//	@Param			include_samples	query		bool	false	"Include the full time series of samples."
+//	@Success		200				{object}	model.APITaskResourceUsage
+func (h *getTaskResourceUsageHandler) Factory() gimlet.RouteHandler {
+	return &getTaskResourceUsageHandler{}
+}
+
+func (h *getTaskResourceUsageHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.taskID = gimlet.GetVars(r)["task_id"]
+
+	vals := r.URL.Query()
+	if execution := vals.Get("execution"); execution != "" {
+		var err error
+		h.execution, err = strconv.Atoi(execution)
+		if err != nil {
+			return gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    fmt.Sprintf("invalid execution '%s'", execution),
+			}
+		}
+		h.hasExecution = true
+	}
+	h.includeSamples = vals.Get("include_samples") == "true"
+
+	return nil
+}
+
+func (h *getTaskResourceUsageHandler) Run(ctx context.Context) gimlet.Responder {
+	if !h.hasExecution {
+		t, err := task.FindOneId(ctx, h.taskID)
+		if err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding task '%s'", h.taskID))
+		}
+		if t == nil {
+			return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+				StatusCode: http.StatusNotFound,
+				Message:    fmt.Sprintf("task '%s' not found", h.taskID),
+			})
+		}
+		h.execution = t.Execution
+	}
+
+	usage, err := resourceusage.FindOne(ctx, h.taskID, h.execution)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+	if usage == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("no resource usage found for task '%s' execution %d", h.taskID, h.execution),
+		})
+	}
+
+	apiUsage := &model.APITaskResourceUsage{}
+	apiUsage.BuildFromService(usage, h.includeSamples)
+
+	return gimlet.NewJSONResponse(apiUsage)
+}
@@ -0,0 +1,184 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/evergreen-ci/evergreen"
+	dbModel "github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/evergreen-ci/evergreen/rest/model"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/projects/{project_id}/test_flakiness
+
+type getTestFlakinessHandler struct {
+	project string
+	opts    testresult.FindTestFlakinessOptions
+	env     evergreen.Environment
+}
+
+func makeGetTestFlakiness(env evergreen.Environment) gimlet.RouteHandler {
+	return &getTestFlakinessHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get test flakiness
+//	@Description	Returns the flakiness scores of the project's tests, sorted from most to least flaky. A test's score is the fraction of recently restarted tasks in which it both passed and failed on the same revision. Scores are recomputed daily.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/test_flakiness [get]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id		path		string	true	"the project ID"
+//	@Param			build_variant	query		string	false	"only return tests run by this build variant"
+//	@Param			task_name		query		string	false	"only return tests run by this task"
+//	@Param			min_score		query		number	false	"only return tests with at least this score, between 0 and 1"
+//	@Param			quarantined		query		boolean	false	"only return quarantined tests"
+//	@Param			limit			query		int		false	"the maximum number of tests to return"
+//	@Success		200				{array}		model.APITestFlakiness
+func (h *getTestFlakinessHandler) Factory() gimlet.RouteHandler {
+	return &getTestFlakinessHandler{env: h.env}
+}
+
+func (h *getTestFlakinessHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.project = gimlet.GetVars(r)["project_id"]
+
+	vals := r.URL.Query()
+	h.opts.BuildVariant = vals.Get("build_variant")
+	h.opts.TaskName = vals.Get("task_name")
+	if minScore := vals.Get("min_score"); minScore != "" {
+		score, err := strconv.ParseFloat(minScore, 64)
+		if err != nil {
+			return errors.Wrap(err, "parsing min score")
+		}
+		if score < 0 || score > 1 {
+			return errors.New("min score must be between 0 and 1")
+		}
+		h.opts.MinScore = score
+	}
+	if quarantined := vals.Get("quarantined"); quarantined != "" {
+		var err error
+		h.opts.QuarantinedOnly, err = strconv.ParseBool(quarantined)
+		if err != nil {
+			return errors.Wrap(err, "parsing quarantined")
+		}
+	}
+
+	var err error
+	h.opts.Limit, err = getLimit(vals)
+	return errors.WithStack(err)
+}
+
+func (h *getTestFlakinessHandler) Run(ctx context.Context) gimlet.Responder {
+	projectID, err := dbModel.GetIdForProject(ctx, h.project)
+	if err != nil {
+		return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "getting ID for project '%s'", h.project).Error(),
+		})
+	}
+	h.opts.Project = projectID
+
+	flakiness, err := testresult.FindTestFlakiness(ctx, h.env, h.opts)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding test flakiness for project '%s'", projectID))
+	}
+
+	apiFlakiness := make([]model.APITestFlakiness, 0, len(flakiness))
+	for _, f := range flakiness {
+		var apiF model.APITestFlakiness
+		apiF.BuildFromService(f)
+		apiFlakiness = append(apiFlakiness, apiF)
+	}
+
+	return gimlet.NewJSONResponse(apiFlakiness)
+}
+
+////////////////////////////////////////////////////////////////////////
+//
+// POST /rest/v2/projects/{project_id}/test_quarantine
+
+type testQuarantineHandler struct {
+	project string
+	opts    testQuarantineOptions
+	env     evergreen.Environment
+}
+
+type testQuarantineOptions struct {
+	// BuildVariant is the build variant of the task that runs the test.
+	// Required.
+	BuildVariant string `json:"build_variant"`
+	// TaskName is the display name of the task that runs the test.
+	// Required.
+	TaskName string `json:"task_name"`
+	// TestName is the display name of the test. Required.
+	TestName string `json:"test_name"`
+	// Quarantined is true to quarantine the test or false to unquarantine
+	// it.
+	Quarantined bool `json:"quarantined"`
+	// Reason is an optional explanation of why the test is quarantined.
+	Reason string `json:"reason"`
+}
+
+func makeTestQuarantine(env evergreen.Environment) gimlet.RouteHandler {
+	return &testQuarantineHandler{env: env}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Quarantine a test
+//	@Description	Restricted to project admins. Quarantines or unquarantines a test in a project's task. Failures of a quarantined test are still recorded, but do not fail the task if every failed test in the task is quarantined.
+//	@Tags			projects
+//	@Router			/projects/{project_id}/test_quarantine [post]
+//	@Security		Api-User || Api-Key
+//	@Param			project_id	path	string					true	"the project ID"
+//	@Param			{object}	body	testQuarantineOptions	true	"parameters"
+//	@Success		200
+func (h *testQuarantineHandler) Factory() gimlet.RouteHandler {
+	return &testQuarantineHandler{env: h.env}
+}
+
+func (h *testQuarantineHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.project = gimlet.GetVars(r)["project_id"]
+	if err := utility.ReadJSON(r.Body, &h.opts); err != nil {
+		return errors.Wrap(err, "reading test quarantine options from JSON request body")
+	}
+
+	return nil
+}
+
+func (h *testQuarantineHandler) Run(ctx context.Context) gimlet.Responder {
+	projectID, err := dbModel.GetIdForProject(ctx, h.project)
+	if err != nil {
+		return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "getting ID for project '%s'", h.project).Error(),
+		})
+	}
+
+	id := testresult.TestFlakinessID{
+		Project:      projectID,
+		BuildVariant: h.opts.BuildVariant,
+		TaskName:     h.opts.TaskName,
+		TestName:     h.opts.TestName,
+	}
+	if id.BuildVariant == "" || id.TaskName == "" || id.TestName == "" {
+		return gimlet.NewJSONErrorResponse(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "must specify a build variant, task name, and test name",
+		})
+	}
+
+	u := MustHaveUser(ctx)
+	if err = testresult.SetTestQuarantine(ctx, h.env, id, h.opts.Quarantined, u.Id, h.opts.Reason); err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(err)
+	}
+
+	return gimlet.NewJSONResponse(struct{}{})
+}
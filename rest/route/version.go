@@ -343,3 +343,61 @@ func (h *versionRestartHandler) Run(ctx context.Context) gimlet.Responder {
 	versionModel.BuildFromService(ctx, *foundVersion)
 	return gimlet.NewJSONResponse(versionModel)
 }
+
+////////////////////////////////////////////////////////////////////////
+//
+// GET /rest/v2/versions/{version_id}/critical_path
+
+type versionCriticalPathHandler struct {
+	versionID string
+}
+
+func makeGetVersionCriticalPath() gimlet.RouteHandler {
+	return &versionCriticalPathHandler{}
+}
+
+// Factory creates an instance of the handler.
+//
+//	@Summary		Get a version's critical path
+//	@Description	Analyzes the version's finished tasks using their dependencies and scheduled, start, and finish times. Returns the chain of tasks that determined the version's wall-clock makespan, along with each task's slack and the time it spent waiting on dependencies, waiting in the task queue, and running.
+//	@Tags			versions
+//	@Router			/versions/{version_id}/critical_path [get]
+//	@Security		Api-User || Api-Key
+//	@Param			version_id	path		string	true	"the version ID"
+//	@Success		200			{object}	model.APICriticalPath
+func (h *versionCriticalPathHandler) Factory() gimlet.RouteHandler {
+	return &versionCriticalPathHandler{}
+}
+
+func (h *versionCriticalPathHandler) Parse(ctx context.Context, r *http.Request) error {
+	h.versionID = gimlet.GetVars(r)["version_id"]
+	if h.versionID == "" {
+		return errors.New("missing version ID")
+	}
+	return nil
+}
+
+func (h *versionCriticalPathHandler) Run(ctx context.Context) gimlet.Responder {
+	v, err := dbModel.VersionFindOneId(ctx, h.versionID)
+	if err != nil {
+		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding version '%s'", h.versionID))
+	}
+	if v == nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("version '%s' not found", h.versionID),
+		})
+	}
+
+	criticalPath, err := task.VersionCriticalPath(ctx, h.versionID)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrapf(err, "computing critical path for version '%s'", h.versionID).Error(),
+		})
+	}
+
+	apiCriticalPath := &model.APICriticalPath{}
+	apiCriticalPath.BuildFromService(*criticalPath)
+	return gimlet.NewJSONResponse(apiCriticalPath)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
 )
 
 // HostAllocator is responsible for determining how many new hosts should be
@@ -20,6 +21,9 @@ type HostAllocatorData struct {
 	UsesContainers  bool
 	ContainerPool   *evergreen.ContainerPool
 	DistroQueueInfo model.DistroQueueInfo
+	// RunningTasks are the tasks running on ExistingHosts. If nil, the
+	// running tasks are looked up in the database.
+	RunningTasks []task.Task
 }
 
 func GetHostAllocator(name string) HostAllocator {
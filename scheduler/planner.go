@@ -194,6 +194,10 @@ type unitInfo struct {
 	ContainsGenerateTask bool `json:"contains_generate_task"`
 	// ContainsStepbackTask indicates if the unit contains task activated by stepback.
 	ContainsStepbackTask bool `json:"contains_stepback_task"`
+	// EarliestActivatedTime is the earliest time any task in the unit was activated.
+	EarliestActivatedTime time.Time `json:"earliest_activated_time"`
+	// Project is the project that the unit counts against for fair share scheduling.
+	Project string `json:"project"`
 }
 
 // value computes a full SortingValueBreakdown, containing the final value by which the unit
@@ -201,17 +205,58 @@ type unitInfo struct {
 // the unit's properties had on computing that final value. Currently, the formula for
 // computing this value is (custom_priority * custom_rankValue) + unit_length, where custom_priority
 // and custom_rankValue are both derived from specific properties of the unit and various
-// scheduler constants.
+// scheduler constants. The distro's planner strategy determines how the rank value is computed.
+// The fair-share strategy uses the default rank value here, which is then replaced once the
+// values of all the units in the plan are known.
 func (u *unitInfo) value() task.SortingValueBreakdown {
 	var breakdown task.SortingValueBreakdown
+	breakdown.Strategy = u.Settings.Strategy
+	if breakdown.Strategy == "" {
+		breakdown.Strategy = evergreen.PlannerStrategyDefault
+	}
 	unitLength := int64(len(u.TaskIDs))
 	breakdown.TaskGroupLength = unitLength
 	priority := u.computePriority(&breakdown)
-	rankValue := u.computeRankValue(&breakdown)
+
+	var rankValue int64
+	switch breakdown.Strategy {
+	case evergreen.PlannerStrategyShortestFirst:
+		rankValue = u.computeShortestFirstRankValue(&breakdown)
+	case evergreen.PlannerStrategyDeadline:
+		rankValue = u.computeDeadlineRankValue(&breakdown)
+	default:
+		rankValue = u.computeRankValue(&breakdown)
+	}
 	breakdown.TotalValue = priority*rankValue + breakdown.TaskGroupLength
 	return breakdown
 }
 
+// strategyMaxMinutes bounds the durations that the alternative planner
+// strategies use to compute rank values, so that all rank values are positive.
+const strategyMaxMinutes = 7 * 24 * 60
+
+// computeShortestFirstRankValue computes the rank value for the shortest-first strategy, which
+// is higher for units whose tasks are expected to take less time on average.
+func (u *unitInfo) computeShortestFirstRankValue(breakdown *task.SortingValueBreakdown) int64 {
+	avgRuntime := int64(math.Floor(u.ExpectedRuntime.Minutes() / float64(breakdown.TaskGroupLength)))
+	breakdown.StrategyBreakdown.ExpectedRuntimeMinutes = avgRuntime
+
+	return 1 + strategyMaxMinutes - min(avgRuntime, strategyMaxMinutes)
+}
+
+// computeDeadlineRankValue computes the rank value for the deadline strategy, which is higher
+// for units that have less time left before their tasks are expected to finish after their
+// deadline. A unit's deadline is the distro's target time after its earliest task was activated.
+func (u *unitInfo) computeDeadlineRankValue(breakdown *task.SortingValueBreakdown) int64 {
+	avgRuntime := u.ExpectedRuntime / time.Duration(breakdown.TaskGroupLength)
+	deadline := u.EarliestActivatedTime.Add(u.Settings.TargetTime)
+	slack := int64(math.Floor(time.Until(deadline.Add(-avgRuntime)).Minutes()))
+	breakdown.StrategyBreakdown.ExpectedRuntimeMinutes = int64(math.Floor(avgRuntime.Minutes()))
+	breakdown.StrategyBreakdown.DeadlineSlackMinutes = slack
+
+	return 1 + strategyMaxMinutes - max(-strategyMaxMinutes, min(slack, strategyMaxMinutes))
+}
+
 // computeRankValue computes the custom rank value for this unit, which will later be multiplied with the
 // computed priority to compute a final value by which the unit will be sorted on in the queue. It also
 // modifies the RankValueBreakdown field of the passed in SortingValueBreakdown struct, which is used for
@@ -307,10 +352,18 @@ func (unit *Unit) info(ctx context.Context) unitInfo {
 		info.ContainsGenerateTask = info.ContainsGenerateTask || t.GenerateTask
 		info.ContainsStepbackTask = info.ContainsStepbackTask || t.ActivatedBy == evergreen.StepbackTaskActivator
 
-		if !t.ActivatedTime.IsZero() {
-			info.TimeInQueue += time.Since(t.ActivatedTime)
-		} else if !t.IngestTime.IsZero() {
-			info.TimeInQueue += time.Since(t.IngestTime)
+		activatedTime := t.ActivatedTime
+		if activatedTime.IsZero() {
+			activatedTime = t.IngestTime
+		}
+		if !activatedTime.IsZero() {
+			info.TimeInQueue += time.Since(activatedTime)
+			if info.EarliestActivatedTime.IsZero() || activatedTime.Before(info.EarliestActivatedTime) {
+				info.EarliestActivatedTime = activatedTime
+			}
+		}
+		if info.Project == "" || t.Project < info.Project {
+			info.Project = t.Project
 		}
 
 		info.TotalPriority += t.Priority
@@ -446,7 +499,10 @@ func PrepareTasksForPlanning(ctx context.Context, distro *distro.Distro, tasks [
 
 // Export sorts the TaskPlan returning a unique list of tasks.
 func (tpl TaskPlan) Export(ctx context.Context) []task.Task {
-	sort.Sort(tpl)
+	if len(tpl.units) > 0 && tpl.units[0].distro.PlannerSettings.Strategy == evergreen.PlannerStrategyFairShare {
+		tpl.applyFairShare()
+	}
+	sort.Stable(tpl)
 
 	output := []task.Task{}
 	seen := StringSet{}
@@ -465,3 +521,28 @@ func (tpl TaskPlan) Export(ctx context.Context) []task.Task {
 
 	return output
 }
+
+// applyFairShare replaces the value of each unit in the plan so that each
+// project receives a share of the queue's expected runtime. Within a project,
+// units keep the order of their default values. Each unit's expected runtime
+// is weighted by its priority, so projects with higher priority tasks get a
+// larger share. Units are ordered by how much weighted runtime their project
+// has queued ahead of them, with ties broken by the default value.
+func (tpl TaskPlan) applyFairShare() {
+	sort.Stable(tpl)
+
+	queuedRuntime := map[string]float64{}
+	for _, unit := range tpl.units {
+		info := unit.info(tpl.ctx)
+		breakdown := unit.sortingValueBreakdown(tpl.ctx)
+		priority := max(1, breakdown.PriorityBreakdown.InitialPriorityImpact)
+
+		offset := queuedRuntime[info.Project]
+		queuedRuntime[info.Project] += max(1, info.ExpectedRuntime.Minutes()) / float64(priority)
+
+		breakdown.StrategyBreakdown.FairShareProject = info.Project
+		breakdown.StrategyBreakdown.FairShareOffsetMinutes = int64(math.Floor(offset))
+		breakdown.TotalValue = 1 + math.MaxInt32 - min(breakdown.StrategyBreakdown.FairShareOffsetMinutes, math.MaxInt32)
+		unit.cachedValue = breakdown
+	}
+}
@@ -341,6 +341,101 @@ func TestPlanner(t *testing.T) {
 				plan := buildPlan(NewUnit(task.Task{Id: "foo"}), NewUnit(task.Task{Id: "foo"}))
 				assert.Len(t, plan.Export(ctx), 1)
 			})
+			t.Run("Strategies", func(t *testing.T) {
+				withDuration := func(tsk task.Task, d time.Duration) task.Task {
+					tsk.DurationPrediction.Value = d
+					tsk.DurationPrediction.TTL = 24 * time.Hour
+					tsk.DurationPrediction.CollectedAt = time.Now()
+					return tsk
+				}
+				buildStrategyPlan := func(strategy string, targetTime time.Duration, tasks ...task.Task) TaskPlan {
+					d := &distro.Distro{PlannerSettings: distro.PlannerSettings{Strategy: strategy, TargetTime: targetTime}}
+					var units []*Unit
+					for _, tsk := range tasks {
+						u := NewUnit(tsk)
+						u.SetDistro(d)
+						units = append(units, u)
+					}
+					return TaskPlan{ctx: ctx, units: units}
+				}
+				exportedIDs := func(plan TaskPlan) []string {
+					var ids []string
+					for _, tsk := range plan.Export(ctx) {
+						ids = append(ids, tsk.Id)
+						assert.Equal(t, plan.units[0].distro.PlannerSettings.Strategy, tsk.SortingValueBreakdown.Strategy)
+					}
+					return ids
+				}
+
+				t.Run("ShortestFirst", func(t *testing.T) {
+					plan := buildStrategyPlan(evergreen.PlannerStrategyShortestFirst, 0,
+						withDuration(task.Task{Id: "long"}, time.Hour),
+						withDuration(task.Task{Id: "short"}, time.Minute),
+						withDuration(task.Task{Id: "medium"}, 10*time.Minute),
+					)
+					assert.Equal(t, []string{"short", "medium", "long"}, exportedIDs(plan))
+					assert.EqualValues(t, 60, plan.units[2].sortingValueBreakdown(ctx).StrategyBreakdown.ExpectedRuntimeMinutes)
+				})
+				t.Run("ShortestFirstRespectsPriority", func(t *testing.T) {
+					plan := buildStrategyPlan(evergreen.PlannerStrategyShortestFirst, 0,
+						withDuration(task.Task{Id: "short"}, time.Minute),
+						withDuration(task.Task{Id: "long", Priority: 10}, time.Hour),
+					)
+					assert.Equal(t, []string{"long", "short"}, exportedIDs(plan))
+				})
+				t.Run("Deadline", func(t *testing.T) {
+					now := time.Now()
+					plan := buildStrategyPlan(evergreen.PlannerStrategyDeadline, time.Hour,
+						withDuration(task.Task{Id: "new", ActivatedTime: now}, 10*time.Minute),
+						withDuration(task.Task{Id: "overdue", ActivatedTime: now.Add(-2 * time.Hour)}, 10*time.Minute),
+						withDuration(task.Task{Id: "old_and_long", ActivatedTime: now.Add(-20 * time.Minute)}, 30*time.Minute),
+					)
+					assert.Equal(t, []string{"overdue", "old_and_long", "new"}, exportedIDs(plan))
+					assert.Negative(t, plan.units[0].sortingValueBreakdown(ctx).StrategyBreakdown.DeadlineSlackMinutes)
+				})
+				t.Run("DeadlineWithInheritedTargetTime", func(t *testing.T) {
+					d := &distro.Distro{PlannerSettings: distro.PlannerSettings{Strategy: evergreen.PlannerStrategyDeadline}}
+					resolved, err := d.GetResolvedPlannerSettings(&evergreen.Settings{
+						Scheduler: evergreen.SchedulerConfig{TargetTimeSeconds: int((2 * time.Hour).Seconds())},
+					})
+					require.NoError(t, err)
+					require.Equal(t, 2*time.Hour, resolved.TargetTime)
+
+					now := time.Now()
+					plan := PrepareTasksForPlanning(ctx, distroForPlanning(d, &resolved), []task.Task{
+						withDuration(task.Task{Id: "new", ActivatedTime: now}, 10*time.Minute),
+						withDuration(task.Task{Id: "old", ActivatedTime: now.Add(-time.Hour)}, 10*time.Minute),
+					})
+					assert.Equal(t, []string{"old", "new"}, exportedIDs(plan))
+					for _, unit := range plan.units {
+						breakdown := unit.sortingValueBreakdown(ctx)
+						// The deadline is the inherited target time after
+						// activation rather than the activation time itself.
+						assert.Positive(t, breakdown.StrategyBreakdown.DeadlineSlackMinutes)
+					}
+					assert.InDelta(t, 110, plan.units[len(plan.units)-1].sortingValueBreakdown(ctx).StrategyBreakdown.DeadlineSlackMinutes, 1)
+				})
+				t.Run("FairShare", func(t *testing.T) {
+					plan := buildStrategyPlan(evergreen.PlannerStrategyFairShare, 0,
+						withDuration(task.Task{Id: "a1", Project: "a", NumDependents: 3}, 10*time.Minute),
+						withDuration(task.Task{Id: "a2", Project: "a", NumDependents: 2}, 10*time.Minute),
+						withDuration(task.Task{Id: "a3", Project: "a", NumDependents: 1}, 10*time.Minute),
+						withDuration(task.Task{Id: "b1", Project: "b"}, 10*time.Minute),
+					)
+					ids := exportedIDs(plan)
+					require.Len(t, ids, 4)
+					// Each project's first unit comes before any project's
+					// second unit, and project a keeps its own order.
+					assert.ElementsMatch(t, []string{"a1", "b1"}, ids[:2])
+					assert.Equal(t, []string{"a2", "a3"}, ids[2:])
+
+					for _, unit := range plan.units {
+						breakdown := unit.sortingValueBreakdown(ctx)
+						assert.NotEmpty(t, breakdown.StrategyBreakdown.FairShareProject)
+						assert.Positive(t, breakdown.TotalValue)
+					}
+				})
+			})
 		})
 		t.Run("TaskList", func(t *testing.T) {
 			t.Run("NoChange", func(t *testing.T) {
@@ -20,6 +20,9 @@ type TaskPlannerOptions struct {
 	IsSecondaryQueue     bool
 	IncludesDependencies bool
 	StartedAt            time.Time
+	// PlannerSettings are the distro's planner settings resolved against the
+	// admin defaults. If nil, the distro's own planner settings are used.
+	PlannerSettings *distro.PlannerSettings
 }
 
 type TaskPlanner func(*distro.Distro, []task.Task, TaskPlannerOptions) ([]task.Task, error)
@@ -31,6 +34,18 @@ func PrioritizeTasks(ctx context.Context, d *distro.Distro, tasks []task.Task, o
 	return runTunablePlanner(ctx, d, tasks, opts)
 }
 
+// distroForPlanning returns a copy of the distro that uses the given planner
+// settings, so that planning uses the settings it inherits from the admin
+// defaults. If settings is nil, the distro is returned unchanged.
+func distroForPlanning(d *distro.Distro, settings *distro.PlannerSettings) *distro.Distro {
+	if settings == nil {
+		return d
+	}
+	resolved := *d
+	resolved.PlannerSettings = *settings
+	return &resolved
+}
+
 func runTunablePlanner(ctx context.Context, d *distro.Distro, tasks []task.Task, opts TaskPlannerOptions) ([]task.Task, error) {
 	var err error
 
@@ -39,7 +54,7 @@ func runTunablePlanner(ctx context.Context, d *distro.Distro, tasks []task.Task,
 		return nil, errors.WithStack(err)
 	}
 
-	plan := PrepareTasksForPlanning(ctx, d, tasks).Export(ctx)
+	plan := PrepareTasksForPlanning(ctx, distroForPlanning(d, opts.PlannerSettings), tasks).Export(ctx)
 	info := GetDistroQueueInfo(ctx, d.Id, plan, d.GetTargetTime(), opts)
 	info.SecondaryQueue = opts.IsSecondaryQueue
 	info.PlanCreatedAt = opts.StartedAt
@@ -54,15 +69,24 @@ const RunnerName = "scheduler"
 
 // GetDistroQueueInfo returns the distroQueueInfo for the given set of tasks having set the task.ExpectedDuration for each task.
 func GetDistroQueueInfo(ctx context.Context, distroID string, tasks []task.Task, maxDurationThreshold time.Duration, opts TaskPlannerOptions) model.DistroQueueInfo {
-	var distroExpectedDuration, distroDurationOverThreshold time.Duration
-	var distroCountDurationOverThreshold, distroCountWaitOverThreshold, numTasksDepsMet int
-	var isSecondaryQueue bool
-	taskGroupInfosMap := make(map[string]*model.TaskGroupInfo)
 	depCache := make(map[string]task.Task, len(tasks))
 	for _, t := range tasks {
 		depCache[t.Id] = t
 	}
 
+	return getDistroQueueInfo(ctx, distroID, tasks, maxDurationThreshold, opts, func(t *task.Task) bool {
+		return checkDependenciesMet(ctx, t, depCache)
+	})
+}
+
+// getDistroQueueInfo returns the distroQueueInfo for the given set of tasks,
+// using dependenciesMet to determine whether each task's dependencies are met.
+func getDistroQueueInfo(ctx context.Context, distroID string, tasks []task.Task, maxDurationThreshold time.Duration, opts TaskPlannerOptions, dependenciesMet func(*task.Task) bool) model.DistroQueueInfo {
+	var distroExpectedDuration, distroDurationOverThreshold time.Duration
+	var distroCountDurationOverThreshold, distroCountWaitOverThreshold, numTasksDepsMet int
+	var isSecondaryQueue bool
+	taskGroupInfosMap := make(map[string]*model.TaskGroupInfo)
+
 	for i, task := range tasks {
 		group := task.TaskGroup
 		name := ""
@@ -79,7 +103,7 @@ func GetDistroQueueInfo(ctx context.Context, distroID string, tasks []task.Task,
 		var exists bool
 		var info *model.TaskGroupInfo
 		if info, exists = taskGroupInfosMap[name]; exists {
-			if !opts.IncludesDependencies || checkDependenciesMet(ctx, &task, depCache) {
+			if !opts.IncludesDependencies || dependenciesMet(&task) {
 				info.Count++
 				info.ExpectedDuration += duration
 			}
@@ -89,17 +113,17 @@ func GetDistroQueueInfo(ctx context.Context, distroID string, tasks []task.Task,
 				MaxHosts: task.TaskGroupMaxHosts,
 			}
 
-			if !opts.IncludesDependencies || checkDependenciesMet(ctx, &task, depCache) {
+			if !opts.IncludesDependencies || dependenciesMet(&task) {
 				info.Count++
 				info.ExpectedDuration += duration
 			}
 		}
 
-		dependenciesMet := checkDependenciesMet(ctx, &task, depCache)
-		if dependenciesMet {
+		depsMet := dependenciesMet(&task)
+		if depsMet {
 			numTasksDepsMet++
 		}
-		if !opts.IncludesDependencies || dependenciesMet {
+		if !opts.IncludesDependencies || depsMet {
 			task.ExpectedDuration = duration
 			distroExpectedDuration += duration
 			// duration is defined as expected runtime and does not include wait time
@@ -111,7 +135,7 @@ func GetDistroQueueInfo(ctx context.Context, distroID string, tasks []task.Task,
 				distroCountDurationOverThreshold++
 				distroDurationOverThreshold += duration
 			}
-			if dependenciesMet {
+			if depsMet {
 				startTime := task.ScheduledTime
 				if task.DependenciesMetTime.After(startTime) {
 					startTime = task.DependenciesMetTime
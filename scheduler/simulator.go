@@ -0,0 +1,494 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	// simulatedDefaultTaskDuration is the expected duration of tasks in a
+	// simulation that have no expected duration.
+	simulatedDefaultTaskDuration = 10 * time.Minute
+	// simulatedPredictionTTL is how long the expected durations of tasks in
+	// a simulation are treated as fresh, which prevents them from being
+	// refreshed from the database.
+	simulatedPredictionTTL = 7 * 24 * time.Hour
+)
+
+// SimulationSnapshot is the state of the scheduler inputs at a point in time,
+// which can be replayed offline against different scheduler settings.
+type SimulationSnapshot struct {
+	// CapturedAt is the time the snapshot was taken. All times in the
+	// snapshot are shifted by the time elapsed since then when the snapshot
+	// is simulated, so that times relative to the snapshot are preserved.
+	CapturedAt time.Time `json:"captured_at"`
+	// Scheduler is the scheduler configuration at the time the snapshot was
+	// taken.
+	Scheduler evergreen.SchedulerConfig `json:"scheduler"`
+	// Distros are the distros to simulate.
+	Distros []SimulationDistro `json:"distros"`
+}
+
+// SimulationDistro is the state of a single distro in a SimulationSnapshot.
+type SimulationDistro struct {
+	Distro distro.Distro `json:"distro"`
+	// HostHourlyCost is the cost of running one of the distro's hosts for an
+	// hour, which is used to estimate the cost of a simulation.
+	HostHourlyCost float64 `json:"host_hourly_cost,omitempty"`
+	// Tasks are the tasks to plan for the distro.
+	Tasks []task.Task `json:"tasks"`
+	// Hosts are the distro's up hosts.
+	Hosts []host.Host `json:"hosts"`
+	// RunningTasks are the tasks running on the distro's hosts.
+	RunningTasks []task.Task `json:"running_tasks"`
+}
+
+// NewSimulationTasksFromQueue returns the tasks in a persisted task queue in a
+// form that can be used in a SimulationDistro. Task queues do not record when
+// tasks were activated, so each task is treated as if it were activated when
+// the queue was generated.
+func NewSimulationTasksFromQueue(queue model.TaskQueue) []task.Task {
+	tasks := make([]task.Task, 0, len(queue.Queue))
+	for _, item := range queue.Queue {
+		t := task.Task{
+			Id:                  item.Id,
+			DisplayName:         item.DisplayName,
+			DistroId:            queue.Distro,
+			TaskGroup:           item.Group,
+			TaskGroupMaxHosts:   item.GroupMaxHosts,
+			TaskGroupOrder:      item.GroupIndex,
+			Version:             item.Version,
+			BuildVariant:        item.BuildVariant,
+			RevisionOrderNumber: item.RevisionOrderNumber,
+			Requester:           item.Requester,
+			Revision:            item.Revision,
+			Project:             item.Project,
+			ExpectedDuration:    item.ExpectedDuration,
+			Priority:            item.Priority,
+			ActivatedBy:         item.ActivatedBy,
+			ActivatedTime:       queue.GeneratedAt,
+			ScheduledTime:       queue.GeneratedAt,
+		}
+		for _, dep := range item.Dependencies {
+			t.DependsOn = append(t.DependsOn, task.Dependency{TaskId: dep})
+		}
+		if item.DependenciesMet && len(t.DependsOn) > 0 {
+			t.DependenciesMetTime = queue.GeneratedAt
+		}
+		tasks = append(tasks, t)
+	}
+
+	return tasks
+}
+
+// SimulationScenario describes the scheduler settings to simulate.
+type SimulationScenario struct {
+	// Name identifies the scenario in the report.
+	Name string `json:"name"`
+	// Scheduler is the scheduler configuration to simulate.
+	Scheduler evergreen.SchedulerConfig `json:"scheduler"`
+	// IgnoreDistroSettings, if true, ignores the planner and host allocator
+	// settings of individual distros, so the scheduler configuration applies
+	// to every distro. The distros' minimum and maximum hosts still apply.
+	IgnoreDistroSettings bool `json:"ignore_distro_settings"`
+	// PlannerStrategy, if set, overrides the planner strategy of every
+	// distro.
+	PlannerStrategy string `json:"planner_strategy"`
+	// HostStartupTime is how long new hosts take to start running tasks.
+	HostStartupTime time.Duration `json:"-"`
+}
+
+// SimulationReport is the result of simulating a snapshot.
+type SimulationReport struct {
+	Scenario string                   `json:"scenario"`
+	Distros  []DistroSimulationReport `json:"distros"`
+}
+
+// DistroSimulationReport is the result of simulating a single distro.
+type DistroSimulationReport struct {
+	Distro string `json:"distro"`
+	// QueueInfo is the queue information given to the host allocator.
+	QueueInfo model.DistroQueueInfo `json:"queue_info"`
+	// ExistingHosts is the number of hosts the distro already had.
+	ExistingHosts int `json:"existing_hosts"`
+	// FreeHosts is the host allocator's estimate of the number of existing
+	// hosts that are or will soon be free.
+	FreeHosts int `json:"free_hosts"`
+	// NewHosts is the number of new hosts the host allocator requested.
+	NewHosts int `json:"new_hosts"`
+	// MeanQueueWait, MedianQueueWait, P90QueueWait and MaxQueueWait
+	// summarize how long tasks waited between becoming ready and starting.
+	MeanQueueWait   time.Duration `json:"mean_queue_wait_ns"`
+	MedianQueueWait time.Duration `json:"median_queue_wait_ns"`
+	P90QueueWait    time.Duration `json:"p90_queue_wait_ns"`
+	MaxQueueWait    time.Duration `json:"max_queue_wait_ns"`
+	// Makespan is the time until the last task in the queue finishes.
+	Makespan time.Duration `json:"makespan_ns"`
+	// HostHours is the total time the distro's hosts spent starting up,
+	// running tasks, or waiting for queued tasks to become ready.
+	HostHours float64 `json:"host_hours"`
+	// EstimatedCost is HostHours multiplied by the distro's hourly host
+	// cost.
+	EstimatedCost float64 `json:"estimated_cost"`
+	// Blocked is the number of tasks that could not run because their
+	// dependencies never finished.
+	Blocked int `json:"blocked"`
+	// Tasks are the tasks in the order they were planned.
+	Tasks []SimulatedTask `json:"tasks"`
+}
+
+// SimulatedTask is the outcome of a single task in a simulation.
+type SimulatedTask struct {
+	ID                    string                     `json:"id"`
+	DisplayName           string                     `json:"display_name"`
+	Project               string                     `json:"project"`
+	SortingValueBreakdown task.SortingValueBreakdown `json:"sorting_value_breakdown"`
+	// StartTime is the simulated time the task started. It is zero if the
+	// task was blocked.
+	StartTime time.Time `json:"start_time"`
+	// QueueWait is the time between the task becoming ready and starting.
+	QueueWait time.Duration `json:"queue_wait_ns"`
+	// Host identifies the simulated host that ran the task.
+	Host string `json:"host"`
+}
+
+// Simulate replays the task planner and host allocator against the snapshot
+// using the scenario's settings and estimates how the queue would drain. It
+// does not read from or write to the database.
+//
+// Tasks in the queue run in planned order on the first available host once
+// the tasks they depend on in the queue have finished. Dependencies on tasks
+// that are not in the queue are assumed to be met.
+func Simulate(ctx context.Context, snapshot SimulationSnapshot, scenario SimulationScenario) (*SimulationReport, error) {
+	settings := &evergreen.Settings{Scheduler: scenario.Scheduler}
+	offset := time.Since(snapshot.CapturedAt)
+	report := &SimulationReport{Scenario: scenario.Name}
+	for _, sd := range snapshot.Distros {
+		distroReport, err := simulateDistro(ctx, sd, settings, scenario, offset)
+		if err != nil {
+			return nil, errors.Wrapf(err, "simulating distro '%s'", sd.Distro.Id)
+		}
+		report.Distros = append(report.Distros, *distroReport)
+	}
+
+	return report, nil
+}
+
+func simulateDistro(ctx context.Context, sd SimulationDistro, settings *evergreen.Settings, scenario SimulationScenario, offset time.Duration) (*DistroSimulationReport, error) {
+	d := sd.Distro
+	if d.ContainerPool != "" {
+		return nil, errors.New("container distros cannot be simulated")
+	}
+	if scenario.IgnoreDistroSettings {
+		d.PlannerSettings = distro.PlannerSettings{}
+		d.HostAllocatorSettings = distro.HostAllocatorSettings{
+			MinimumHosts: d.HostAllocatorSettings.MinimumHosts,
+			MaximumHosts: d.HostAllocatorSettings.MaximumHosts,
+		}
+	}
+	if scenario.PlannerStrategy != "" {
+		d.PlannerSettings.Strategy = scenario.PlannerStrategy
+	}
+	plannerSettings, err := d.GetResolvedPlannerSettings(settings)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if _, err = d.GetResolvedHostAllocatorSettings(settings); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	now := time.Now()
+	tasks := makeSimulationTasks(sd.Tasks, offset, now)
+	runningTasks := makeSimulationTasks(sd.RunningTasks, offset, now)
+	if runningTasks == nil {
+		runningTasks = []task.Task{}
+	}
+
+	plan := PrepareTasksForPlanning(ctx, distroForPlanning(&d, &plannerSettings), tasks).Export(ctx)
+	inQueue := make(map[string]bool, len(plan))
+	for _, t := range plan {
+		inQueue[t.Id] = true
+	}
+	readyAtStart := func(t *task.Task) bool {
+		if t.HasDependenciesMet() {
+			return true
+		}
+		for _, dep := range t.DependsOn {
+			if inQueue[dep.TaskId] {
+				return false
+			}
+		}
+		return true
+	}
+	opts := TaskPlannerOptions{
+		IncludesDependencies: d.DispatcherSettings.Version == evergreen.DispatcherVersionRevisedWithDependencies,
+	}
+	info := getDistroQueueInfo(ctx, d.Id, plan, d.GetTargetTime(), opts, readyAtStart)
+
+	report := &DistroSimulationReport{
+		Distro:        d.Id,
+		ExistingHosts: len(sd.Hosts),
+	}
+	allocatorData := HostAllocatorData{
+		Distro:          d,
+		ExistingHosts:   sd.Hosts,
+		DistroQueueInfo: info,
+		RunningTasks:    runningTasks,
+	}
+	if d.SingleTaskDistro {
+		report.NewHosts = max(0, info.LengthWithDependenciesMet-len(sd.Hosts))
+	} else {
+		report.NewHosts, report.FreeHosts, err = GetHostAllocator(d.HostAllocatorSettings.Version)(ctx, &allocatorData)
+		if err != nil {
+			return nil, errors.Wrap(err, "allocating hosts")
+		}
+	}
+	report.QueueInfo = allocatorData.DistroQueueInfo
+
+	sim := newQueueSimulation(now, plan, sd.Hosts, runningTasks, report.NewHosts, scenario.HostStartupTime, readyAtStart)
+	sim.run()
+	sim.summarize(report, sd.HostHourlyCost)
+
+	return report, nil
+}
+
+// makeSimulationTasks copies the tasks, shifting their times by offset and
+// fixing their expected durations so that they are never refreshed.
+func makeSimulationTasks(tasks []task.Task, offset time.Duration, now time.Time) []task.Task {
+	shift := func(t time.Time) time.Time {
+		if t.IsZero() {
+			return t
+		}
+		return t.Add(offset)
+	}
+
+	var simTasks []task.Task
+	for _, t := range tasks {
+		t.CreateTime = shift(t.CreateTime)
+		t.IngestTime = shift(t.IngestTime)
+		t.ActivatedTime = shift(t.ActivatedTime)
+		t.ScheduledTime = shift(t.ScheduledTime)
+		t.DependenciesMetTime = shift(t.DependenciesMetTime)
+		t.StartTime = shift(t.StartTime)
+
+		if t.ExpectedDuration == 0 {
+			t.ExpectedDuration = simulatedDefaultTaskDuration
+		}
+		t.DurationPrediction = util.CachedDurationValue{
+			Value:       t.ExpectedDuration,
+			StdDev:      t.ExpectedDurationStdDev,
+			TTL:         simulatedPredictionTTL,
+			CollectedAt: now,
+		}
+		simTasks = append(simTasks, t)
+	}
+
+	return simTasks
+}
+
+type simulatedHost struct {
+	id string
+	// availableAt is the time the host can start its next task.
+	availableAt time.Time
+	// billedFrom is the time the host started counting towards the cost of
+	// the simulation.
+	billedFrom time.Time
+}
+
+type queueSimulation struct {
+	start    time.Time
+	plan     []task.Task
+	hosts    []*simulatedHost
+	finished map[string]time.Time
+	inQueue  map[string]bool
+	results  []SimulatedTask
+	blocked  int
+	ready    func(*task.Task) bool
+}
+
+func newQueueSimulation(start time.Time, plan []task.Task, existingHosts []host.Host, runningTasks []task.Task, numNewHosts int, hostStartupTime time.Duration, readyAtStart func(*task.Task) bool) *queueSimulation {
+	sim := &queueSimulation{
+		start:    start,
+		plan:     plan,
+		finished: make(map[string]time.Time, len(plan)),
+		inQueue:  make(map[string]bool, len(plan)),
+		ready:    readyAtStart,
+	}
+	for _, t := range plan {
+		sim.inQueue[t.Id] = true
+	}
+
+	running := make(map[string]task.Task, len(runningTasks))
+	for _, t := range runningTasks {
+		running[t.Id] = t
+	}
+	for _, h := range existingHosts {
+		availableAt := start
+		if t, ok := running[h.RunningTask]; ok && !t.StartTime.IsZero() {
+			if finish := t.StartTime.Add(t.ExpectedDuration); finish.After(start) {
+				availableAt = finish
+			}
+		}
+		sim.hosts = append(sim.hosts, &simulatedHost{id: h.Id, availableAt: availableAt, billedFrom: start})
+	}
+	for i := 0; i < numNewHosts; i++ {
+		sim.hosts = append(sim.hosts, &simulatedHost{
+			id:          fmt.Sprintf("new-host-%d", i),
+			availableAt: start.Add(hostStartupTime),
+			billedFrom:  start,
+		})
+	}
+
+	return sim
+}
+
+// readyTime returns the time the task became ready to run, or false if it
+// depends on a task in the queue that has not been dispatched yet.
+func (sim *queueSimulation) readyTime(t *task.Task) (time.Time, bool) {
+	if sim.ready(t) {
+		readyAt := t.ScheduledTime
+		if readyAt.IsZero() {
+			readyAt = t.ActivatedTime
+		}
+		if t.DependenciesMetTime.After(readyAt) {
+			readyAt = t.DependenciesMetTime
+		}
+		if readyAt.IsZero() || readyAt.After(sim.start) {
+			readyAt = sim.start
+		}
+		return readyAt, true
+	}
+
+	readyAt := sim.start
+	for _, dep := range t.DependsOn {
+		if !sim.inQueue[dep.TaskId] {
+			continue
+		}
+		finishedAt, ok := sim.finished[dep.TaskId]
+		if !ok {
+			return time.Time{}, false
+		}
+		if finishedAt.After(readyAt) {
+			readyAt = finishedAt
+		}
+	}
+
+	return readyAt, true
+}
+
+// run dispatches the tasks in planned order. Each time a host becomes
+// available, it runs the first task in the plan that is ready.
+func (sim *queueSimulation) run() {
+	dispatched := make([]bool, len(sim.plan))
+	results := make([]SimulatedTask, len(sim.plan))
+	remaining := len(sim.plan)
+	for remaining > 0 && len(sim.hosts) > 0 {
+		h := sim.hosts[0]
+		for _, candidate := range sim.hosts[1:] {
+			if candidate.availableAt.Before(h.availableAt) {
+				h = candidate
+			}
+		}
+
+		next := -1
+		var nextReadyAt, earliestReadyAt time.Time
+		for i := range sim.plan {
+			if dispatched[i] {
+				continue
+			}
+			readyAt, ok := sim.readyTime(&sim.plan[i])
+			if !ok {
+				continue
+			}
+			if !readyAt.After(h.availableAt) {
+				next = i
+				nextReadyAt = readyAt
+				break
+			}
+			if earliestReadyAt.IsZero() || readyAt.Before(earliestReadyAt) {
+				earliestReadyAt = readyAt
+			}
+		}
+		if next == -1 {
+			if earliestReadyAt.IsZero() {
+				// The remaining tasks depend on tasks that can never run.
+				break
+			}
+			h.availableAt = earliestReadyAt
+			continue
+		}
+
+		t := sim.plan[next]
+		startAt := h.availableAt
+		h.availableAt = startAt.Add(t.ExpectedDuration)
+		sim.finished[t.Id] = h.availableAt
+		dispatched[next] = true
+		remaining--
+		results[next] = SimulatedTask{
+			ID:                    t.Id,
+			DisplayName:           t.DisplayName,
+			Project:               t.Project,
+			SortingValueBreakdown: t.SortingValueBreakdown,
+			StartTime:             startAt,
+			QueueWait:             startAt.Sub(nextReadyAt),
+			Host:                  h.id,
+		}
+	}
+
+	for i, t := range sim.plan {
+		if !dispatched[i] {
+			results[i] = SimulatedTask{
+				ID:                    t.Id,
+				DisplayName:           t.DisplayName,
+				Project:               t.Project,
+				SortingValueBreakdown: t.SortingValueBreakdown,
+			}
+			sim.blocked++
+		}
+	}
+	sim.results = results
+}
+
+// summarize adds the simulation's results to the report.
+func (sim *queueSimulation) summarize(report *DistroSimulationReport, hostHourlyCost float64) {
+	report.Tasks = sim.results
+	report.Blocked = sim.blocked
+
+	var waits []time.Duration
+	var totalWait time.Duration
+	for _, result := range sim.results {
+		if result.StartTime.IsZero() {
+			continue
+		}
+		waits = append(waits, result.QueueWait)
+		totalWait += result.QueueWait
+	}
+	if len(waits) > 0 {
+		sort.Slice(waits, func(i, j int) bool { return waits[i] < waits[j] })
+		report.MeanQueueWait = totalWait / time.Duration(len(waits))
+		report.MedianQueueWait = waits[len(waits)/2]
+		report.P90QueueWait = waits[len(waits)*9/10]
+		report.MaxQueueWait = waits[len(waits)-1]
+	}
+
+	for _, finishedAt := range sim.finished {
+		report.Makespan = max(report.Makespan, finishedAt.Sub(sim.start))
+	}
+
+	var hostTime time.Duration
+	for _, h := range sim.hosts {
+		hostTime += h.availableAt.Sub(h.billedFrom)
+	}
+	report.HostHours = hostTime.Hours()
+	report.EstimatedCost = report.HostHours * hostHourlyCost
+}
@@ -0,0 +1,156 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueSimulation(t *testing.T) {
+	start := time.Now()
+	readyAtStart := func(t *task.Task) bool { return t.HasDependenciesMet() }
+
+	t.Run("DispatchesInPlanOrder", func(t *testing.T) {
+		plan := []task.Task{
+			{Id: "a", ExpectedDuration: 30 * time.Minute},
+			{Id: "b", ExpectedDuration: 10 * time.Minute, DependsOn: []task.Dependency{{TaskId: "a"}}},
+			{Id: "c", ExpectedDuration: 20 * time.Minute},
+		}
+		sim := newQueueSimulation(start, plan, []host.Host{{Id: "h1"}}, nil, 1, 5*time.Minute, readyAtStart)
+		sim.run()
+		report := &DistroSimulationReport{}
+		sim.summarize(report, 6)
+
+		require.Len(t, report.Tasks, 3)
+		assert.Equal(t, "h1", report.Tasks[0].Host)
+		assert.Equal(t, start, report.Tasks[0].StartTime)
+		// b cannot start until a finishes, so c runs on the new host first.
+		assert.Equal(t, "h1", report.Tasks[1].Host)
+		assert.Equal(t, start.Add(30*time.Minute), report.Tasks[1].StartTime)
+		assert.Zero(t, report.Tasks[1].QueueWait)
+		assert.Equal(t, "new-host-0", report.Tasks[2].Host)
+		assert.Equal(t, 5*time.Minute, report.Tasks[2].QueueWait)
+
+		assert.Zero(t, report.Blocked)
+		assert.Equal(t, 40*time.Minute, report.Makespan)
+		assert.Zero(t, report.MedianQueueWait)
+		assert.Equal(t, 5*time.Minute, report.MaxQueueWait)
+		assert.InDelta(t, 70.0/60, report.HostHours, 0.0001)
+		assert.InDelta(t, 7.0, report.EstimatedCost, 0.0001)
+	})
+	t.Run("WaitsForRunningTasks", func(t *testing.T) {
+		running := task.Task{Id: "running", StartTime: start.Add(-10 * time.Minute), ExpectedDuration: 15 * time.Minute}
+		sim := newQueueSimulation(start, []task.Task{{Id: "a", ExpectedDuration: time.Minute}}, []host.Host{{Id: "h1", RunningTask: "running"}}, []task.Task{running}, 0, 0, readyAtStart)
+		sim.run()
+		report := &DistroSimulationReport{}
+		sim.summarize(report, 0)
+
+		require.Len(t, report.Tasks, 1)
+		assert.Equal(t, 5*time.Minute, report.Tasks[0].QueueWait)
+		assert.Equal(t, 6*time.Minute, report.Makespan)
+	})
+	t.Run("DependencyCycleIsBlocked", func(t *testing.T) {
+		plan := []task.Task{
+			{Id: "a", ExpectedDuration: time.Minute, DependsOn: []task.Dependency{{TaskId: "b"}}},
+			{Id: "b", ExpectedDuration: time.Minute, DependsOn: []task.Dependency{{TaskId: "a"}}},
+			{Id: "c", ExpectedDuration: time.Minute},
+		}
+		sim := newQueueSimulation(start, plan, []host.Host{{Id: "h1"}}, nil, 0, 0, readyAtStart)
+		sim.run()
+		report := &DistroSimulationReport{}
+		sim.summarize(report, 0)
+
+		assert.Equal(t, 2, report.Blocked)
+		assert.True(t, report.Tasks[0].StartTime.IsZero())
+		assert.Equal(t, "h1", report.Tasks[2].Host)
+	})
+}
+
+func TestSimulate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	capturedAt := time.Now().Add(-24 * time.Hour)
+	snapshot := SimulationSnapshot{
+		CapturedAt: capturedAt,
+		Distros: []SimulationDistro{
+			{
+				Distro: distro.Distro{
+					Id:       "d",
+					Provider: evergreen.ProviderNameEc2Fleet,
+					HostAllocatorSettings: distro.HostAllocatorSettings{
+						MaximumHosts: 10,
+					},
+				},
+				HostHourlyCost: 1,
+				Tasks: []task.Task{
+					{Id: "short", Project: "p1", ExpectedDuration: time.Minute, ActivatedTime: capturedAt.Add(-time.Hour)},
+					{Id: "long", Project: "p2", ExpectedDuration: time.Hour, ActivatedTime: capturedAt.Add(-time.Hour)},
+				},
+				Hosts: []host.Host{{Id: "h1", Status: evergreen.HostRunning}},
+			},
+		},
+	}
+
+	t.Run("ShortestFirst", func(t *testing.T) {
+		report, err := Simulate(ctx, snapshot, SimulationScenario{
+			Name:            "shortest-first",
+			PlannerStrategy: evergreen.PlannerStrategyShortestFirst,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "shortest-first", report.Scenario)
+		require.Len(t, report.Distros, 1)
+
+		distroReport := report.Distros[0]
+		assert.Equal(t, "d", distroReport.Distro)
+		assert.Equal(t, 1, distroReport.ExistingHosts)
+		assert.Equal(t, 2, distroReport.QueueInfo.LengthWithDependenciesMet)
+		assert.Zero(t, distroReport.Blocked)
+		require.Len(t, distroReport.Tasks, 2)
+		assert.Equal(t, "short", distroReport.Tasks[0].ID)
+		assert.Equal(t, evergreen.PlannerStrategyShortestFirst, distroReport.Tasks[0].SortingValueBreakdown.Strategy)
+		// Times are relative to the snapshot, so tasks have already waited
+		// an hour.
+		assert.True(t, distroReport.Tasks[0].QueueWait >= time.Hour)
+		assert.True(t, distroReport.Tasks[0].QueueWait < 2*time.Hour)
+	})
+	t.Run("InvalidStrategy", func(t *testing.T) {
+		_, err := Simulate(ctx, snapshot, SimulationScenario{PlannerStrategy: "random"})
+		assert.Error(t, err)
+	})
+	t.Run("ContainerDistro", func(t *testing.T) {
+		containerSnapshot := snapshot
+		containerSnapshot.Distros = []SimulationDistro{{Distro: distro.Distro{Id: "container", ContainerPool: "pool"}}}
+		_, err := Simulate(ctx, containerSnapshot, SimulationScenario{})
+		assert.Error(t, err)
+	})
+}
+
+func TestNewSimulationTasksFromQueue(t *testing.T) {
+	generatedAt := time.Now().Add(-time.Minute)
+	tasks := NewSimulationTasksFromQueue(model.TaskQueue{
+		Distro:      "d",
+		GeneratedAt: generatedAt,
+		Queue: []model.TaskQueueItem{
+			{Id: "t1", Project: "p", ExpectedDuration: time.Hour, Priority: 5},
+			{Id: "t2", Dependencies: []string{"t1"}},
+			{Id: "t3", Dependencies: []string{"t0"}, DependenciesMet: true},
+		},
+	})
+	require.Len(t, tasks, 3)
+	assert.Equal(t, "d", tasks[0].DistroId)
+	assert.Equal(t, time.Hour, tasks[0].ExpectedDuration)
+	assert.EqualValues(t, 5, tasks[0].Priority)
+	assert.Equal(t, generatedAt, tasks[0].ActivatedTime)
+	assert.True(t, tasks[0].HasDependenciesMet())
+	assert.False(t, tasks[1].HasDependenciesMet())
+	assert.True(t, tasks[2].HasDependenciesMet())
+}
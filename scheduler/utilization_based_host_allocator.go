@@ -12,6 +12,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
 	"github.com/mongodb/grip/recovery"
@@ -95,7 +96,8 @@ func UtilizationBasedHostAllocator(ctx context.Context, hostAllocatorData *HostA
 			distro.HostAllocatorSettings.FutureHostFraction,
 			hostAllocatorData.ContainerPool,
 			hostAllocatorData.DistroQueueInfo.MaxDurationThreshold,
-			maxHosts)
+			maxHosts,
+			hostAllocatorData.RunningTasks)
 
 		if err != nil {
 			return 0, len(freeHosts), errors.Wrapf(err, "error calculating hosts for distro %s", distro.Id)
@@ -132,7 +134,7 @@ func UtilizationBasedHostAllocator(ctx context.Context, hostAllocatorData *HostA
 // evalHostUtilization calculates the number of hosts needed by taking the total task scheduled task time
 // and dividing it by the target duration. Request however many hosts are needed to achieve that minus the
 // number of free hosts
-func evalHostUtilization(ctx context.Context, d distro.Distro, taskGroupData TaskGroupData, futureHostFraction float64, containerPool *evergreen.ContainerPool, maxDurationThreshold time.Duration, maxHosts int) (int, int, error) {
+func evalHostUtilization(ctx context.Context, d distro.Distro, taskGroupData TaskGroupData, futureHostFraction float64, containerPool *evergreen.ContainerPool, maxDurationThreshold time.Duration, maxHosts int, runningTasks []task.Task) (int, int, error) {
 	existingHosts := taskGroupData.Hosts
 	taskGroupInfo := taskGroupData.Info
 	numLongRunningTasks := taskGroupInfo.CountDurationOverThreshold
@@ -165,7 +167,7 @@ func evalHostUtilization(ctx context.Context, d distro.Distro, taskGroupData Tas
 	// summing their estimated time left to completion, and dividing that number by maxDurationThreshold.
 	// That estimate is then multiplied by the futureHostFraction coefficient, which is a fraction that allows us
 	// to tune the final estimate up or down.
-	expectedNumFreeHosts, err := calcExistingFreeHosts(ctx, existingHosts, futureHostFraction, maxDurationThreshold)
+	expectedNumFreeHosts, err := calcExistingFreeHosts(ctx, existingHosts, futureHostFraction, maxDurationThreshold, runningTasks)
 	if err != nil {
 		return numNewHosts, expectedNumFreeHosts, err
 	}
@@ -295,8 +297,9 @@ func calcNewHostsNeeded(totalShortRunningTasksExpectedDuration, maxDurationPerHo
 }
 
 // calcExistingFreeHosts returns the number of hosts that are not running a task,
-// plus hosts that will soon be free scaled by some fraction
-func calcExistingFreeHosts(ctx context.Context, existingHosts []host.Host, futureHostFactor float64, maxDurationPerHost time.Duration) (int, error) {
+// plus hosts that will soon be free scaled by some fraction. If runningTasks is
+// nil, the tasks running on the hosts are looked up in the database.
+func calcExistingFreeHosts(ctx context.Context, existingHosts []host.Host, futureHostFactor float64, maxDurationPerHost time.Duration, runningTasks []task.Task) (int, error) {
 	numFreeHosts := 0
 	if futureHostFactor > 1 {
 		return numFreeHosts, errors.New("future host factor cannot be greater than 1")
@@ -308,7 +311,7 @@ func calcExistingFreeHosts(ctx context.Context, existingHosts []host.Host, futur
 		}
 	}
 
-	soonToBeFree, err := getSoonToBeFreeHosts(ctx, existingHosts, futureHostFactor, maxDurationPerHost)
+	soonToBeFree, err := getSoonToBeFreeHosts(ctx, existingHosts, futureHostFactor, maxDurationPerHost, runningTasks)
 	if err != nil {
 		return 0, err
 	}
@@ -320,7 +323,7 @@ func calcExistingFreeHosts(ctx context.Context, existingHosts []host.Host, futur
 // to be free for some fraction of the next maxDurationPerHost interval
 // the final value is scaled by some fraction representing how confident we are that
 // the hosts will actually be free in the expected amount of time
-func getSoonToBeFreeHosts(ctx context.Context, existingHosts []host.Host, futureHostFraction float64, maxDurationPerHost time.Duration) (float64, error) {
+func getSoonToBeFreeHosts(ctx context.Context, existingHosts []host.Host, futureHostFraction float64, maxDurationPerHost time.Duration, knownRunningTasks []task.Task) (float64, error) {
 	runningTaskIds := []string{}
 
 	for _, existingDistroHost := range existingHosts {
@@ -333,9 +336,19 @@ func getSoonToBeFreeHosts(ctx context.Context, existingHosts []host.Host, future
 		return 0.0, nil
 	}
 
-	runningTasks, err := task.Find(ctx, task.ByIds(runningTaskIds))
-	if err != nil {
-		return 0.0, err
+	var runningTasks []task.Task
+	if knownRunningTasks != nil {
+		for _, t := range knownRunningTasks {
+			if utility.StringSliceContains(runningTaskIds, t.Id) {
+				runningTasks = append(runningTasks, t)
+			}
+		}
+	} else {
+		var err error
+		runningTasks, err = task.Find(ctx, task.ByIds(runningTaskIds))
+		if err != nil {
+			return 0.0, err
+		}
 	}
 
 	nums := make(chan float64, len(runningTasks))
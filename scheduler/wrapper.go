@@ -42,9 +42,10 @@ func PlanDistro(ctx context.Context, conf Configuration, s *evergreen.Settings)
 		return errors.Wrap(err, "problem unscheduling underwater tasks")
 	}
 
-	if distro.Disabled {
+	if distro.Disabled || distro.IsInMaintenance() {
 		// We can just clear these queues, the tasks will persist
-		// and get rescheduled once the distro is no longer disabled.
+		// and get rescheduled once the distro is no longer disabled or in
+		// maintenance.
 		var queueInfo model.DistroQueueInfo
 		queueInfo, err = model.GetDistroQueueInfo(ctx, distro.Id)
 		if err != nil {
@@ -76,7 +77,8 @@ func PlanDistro(ctx context.Context, conf Configuration, s *evergreen.Settings)
 		return nil
 	}
 
-	if _, err = distro.GetResolvedPlannerSettings(s); err != nil {
+	plannerSettings, err := distro.GetResolvedPlannerSettings(s)
+	if err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -108,6 +110,7 @@ func PlanDistro(ctx context.Context, conf Configuration, s *evergreen.Settings)
 		StartedAt:        taskFindingBegins,
 		ID:               schedulerInstanceID,
 		IsSecondaryQueue: false,
+		PlannerSettings:  &plannerSettings,
 	})
 	if err != nil {
 		return errors.WithStack(err)
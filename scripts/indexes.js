@@ -167,6 +167,13 @@ db.patches.ensureIndex({
 }, {
     "sparse": true
 })
+db.patches.createIndex({
+    "merge_queue_bisect.status": 1
+}, {
+    "partialFilterExpression": {
+        "merge_queue_bisect.status": "pending"
+    }
+})
 
 //======project_ref======//
 db.project_ref.ensureIndex({
@@ -306,6 +313,18 @@ db.tasks.createIndex({
     "branch": 1,
     "finish_time": 1
 })
+db.tasks.createIndex({
+    "host_id": 1,
+    "status": 1
+})
+db.tasks.createIndex({
+    "task_output_info.task_logs.version": 1,
+    "status": 1
+}, {
+    partialFilterExpression: {
+        "task_output_info.task_logs.version": 1
+    }
+})
 
 //======old_tasks======//
 db.old_tasks.ensureIndex({
@@ -334,6 +353,18 @@ db.old_tasks.ensureIndex({
 db.old_tasks.createIndex({
     "execution_tasks": 1
 })
+db.old_tasks.createIndex({
+    "host_id": 1,
+    "status": 1
+})
+db.old_tasks.createIndex({
+    "task_output_info.task_logs.version": 1,
+    "status": 1
+}, {
+    partialFilterExpression: {
+        "task_output_info.task_logs.version": 1
+    }
+})
 
 //======versions======//
 db.versions.ensureIndex({
@@ -465,10 +496,33 @@ db.users.createIndex({
     sparse: true
 })
 
+//======user_access_tokens======//
+db.user_access_tokens.ensureIndex({
+    "token_hash": 1
+}, {
+    unique: true
+})
+db.user_access_tokens.ensureIndex({
+    "user_id": 1,
+    "name": 1
+}, {
+    unique: true
+})
+
 //======notifications======//
 db.notifications.ensureIndex({
     "sent_at": 1
 })
+db.notifications.ensureIndex({
+    "digest": 1,
+    "created_at": 1
+}, {
+    partialFilterExpression: {
+        "sent_at": {
+            "$exists": false
+        }
+    }
+})
 
 //======hourly_test_stats======//
 db.hourly_test_stats.createIndex({
@@ -541,4 +595,20 @@ db.daily_task_stats.createIndex({
 db.manifest.createIndex({
     "project": 1,
     "revision": 1
-})
\ No newline at end of file
+})
+//======project_keyvals======//
+db.project_keyvals.createIndex({
+    "_id.project_id": 1,
+    "_id.key": 1
+})
+db.project_keyvals.createIndex({
+    "expires_at": 1
+}, {
+    expireAfterSeconds: 0
+})
+//======failure_fingerprints======//
+db.failure_fingerprints.createIndex({
+    "project": 1,
+    "fingerprint": 1,
+    "create_time": -1
+})
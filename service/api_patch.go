@@ -13,6 +13,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/evergreen/rest/route"
 	"github.com/evergreen-ci/evergreen/thirdparty"
 	"github.com/evergreen-ci/evergreen/units"
 	"github.com/evergreen-ci/evergreen/util"
@@ -143,6 +144,11 @@ func (as *APIServer) submitPatch(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if token := route.GetAccessToken(r.Context()); token != nil && !token.AllowsProject(pref.Id) {
+		as.LoggedError(w, r, http.StatusForbidden, errors.Errorf("access token '%s' cannot be used for project '%s'", token.Name, data.Project))
+		return
+	}
+
 	hasPermission := dbUser.HasPermission(gimlet.PermissionOpts{
 		Resource:      pref.Id,
 		ResourceType:  evergreen.ProjectResourceType,
@@ -4,15 +4,21 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model"
 	modelUtil "github.com/evergreen-ci/evergreen/model/testutil"
+	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/evergreen/testutil"
 	"github.com/evergreen-ci/utility"
 	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -105,3 +111,66 @@ func TestPatchListModulesEndPoints(t *testing.T) {
 		})
 	})
 }
+
+func TestSubmitPatchWithAccessToken(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testutil.DisablePermissionsForTests()
+	defer testutil.EnablePermissionsForTests()
+	testApiServer, err := CreateTestServer(ctx, testutil.TestConfig(), nil, false)
+	require.NoError(t, err, "failed to create new API server")
+	defer testApiServer.Close()
+
+	require.NoError(t, db.ClearCollections(user.Collection, user.AccessTokensCollection, model.ProjectRefCollection))
+	defer func() {
+		assert.NoError(t, db.ClearCollections(user.Collection, user.AccessTokensCollection, model.ProjectRefCollection))
+	}()
+	require.NoError(t, db.Insert(t.Context(), user.Collection, &user.DBUser{Id: "me", APIKey: "api_key"}))
+	// Patching is disabled for the project so that the submission stops
+	// before the patch is processed, which requires access to GitHub.
+	require.NoError(t, (&model.ProjectRef{Id: "mci", Identifier: "evergreen", Enabled: false}).Insert(t.Context()))
+
+	createToken := func(t *testing.T, scopes, projects []string) string {
+		_, token, err := user.CreateAccessToken(t.Context(), "me", user.AccessTokenOptions{
+			Name:      utility.RandomString(),
+			Scopes:    scopes,
+			Projects:  projects,
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+		return token
+	}
+	submitPatch := func(t *testing.T, token string) (int, string) {
+		request, err := http.NewRequest(http.MethodPut, testApiServer.URL+"/api/patches/", bytes.NewBufferString(`{"project": "mci", "desc": "patch"}`))
+		require.NoError(t, err)
+		request.Header.Set(evergreen.APIUserHeader, "me")
+		request.Header.Set(evergreen.APIKeyHeader, token)
+		resp, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(body)
+	}
+
+	t.Run("PatchesScopeCanSubmitPatches", func(t *testing.T) {
+		status, body := submitPatch(t, createToken(t, []string{user.AccessTokenScopePatches}, nil))
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Contains(t, body, "patching is disabled", "request should reach the patch submission")
+	})
+	t.Run("PatchesScopeForProjectCanSubmitPatches", func(t *testing.T) {
+		status, body := submitPatch(t, createToken(t, []string{user.AccessTokenScopePatches}, []string{"mci"}))
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Contains(t, body, "patching is disabled", "request should reach the patch submission")
+	})
+	t.Run("ReadScopeCannotSubmitPatches", func(t *testing.T) {
+		status, _ := submitPatch(t, createToken(t, []string{user.AccessTokenScopeRead}, nil))
+		assert.Equal(t, http.StatusForbidden, status)
+	})
+	t.Run("TokenForOtherProjectCannotSubmitPatches", func(t *testing.T) {
+		status, body := submitPatch(t, createToken(t, []string{user.AccessTokenScopePatches}, []string{"other"}))
+		assert.Equal(t, http.StatusForbidden, status)
+		assert.Contains(t, body, "cannot be used for project 'mci'")
+	})
+}
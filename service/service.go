@@ -44,6 +44,7 @@ func GetServer(addr string, n http.Handler) *http.Server {
 func GetRouter(ctx context.Context, as *APIServer, uis *UIServer) (http.Handler, error) {
 	app := gimlet.NewApp()
 	app.AddMiddleware(gimlet.MakeRecoveryLogger())
+	app.AddMiddleware(route.NewAccessTokenAuthMiddleware())
 	app.AddMiddleware(gimlet.UserMiddleware(ctx, uis.env.UserManager(), uis.umconf))
 	app.AddMiddleware(gimlet.NewAuthenticationHandler(gimlet.NewBasicAuthenticator(nil, nil), uis.env.UserManager()))
 	app.AddMiddleware(gimlet.NewStaticAuth("", http.Dir(filepath.Join(uis.Home, "public"))))
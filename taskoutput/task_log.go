@@ -128,6 +128,26 @@ func (o TaskLogOutput) Get(ctx context.Context, taskOpts TaskOptions, getOpts Ta
 	})
 }
 
+// Compress rewrites the task logs of the given task run in the compressed,
+// indexed log format and returns the task log output with its version updated
+// accordingly.
+func (o TaskLogOutput) Compress(ctx context.Context, taskOpts TaskOptions) (TaskLogOutput, error) {
+	if o.Version == 0 {
+		return o, errors.New("cannot compress Cedar Buildlogger task logs")
+	}
+
+	b, err := newBucket(ctx, o.BucketConfig, o.AWSCredentials)
+	if err != nil {
+		return o, err
+	}
+	if _, err = log.NewLogServiceV1(b).Convert(ctx, o.getLogName(taskOpts, TaskLogTypeAll)); err != nil {
+		return o, errors.Wrap(err, "converting task logs")
+	}
+
+	o.Version = max(o.Version, compressedLogsVersion)
+	return o, nil
+}
+
 func (o TaskLogOutput) getLogName(taskOpts TaskOptions, logType TaskLogType) string {
 	prefix := fmt.Sprintf("%s/%s/%d/%s", taskOpts.ProjectID, taskOpts.TaskID, taskOpts.Execution, o.ID())
 
@@ -152,7 +172,7 @@ func (o TaskLogOutput) getLogService(ctx context.Context) (log.LogService, error
 		return nil, err
 	}
 
-	return log.NewLogServiceV0(b), nil
+	return newLogService(o.Version, b), nil
 }
 
 // getBuildloggerLogs makes request to Cedar Buildlogger for logs.
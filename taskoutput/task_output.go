@@ -4,6 +4,10 @@ import (
 	"github.com/evergreen-ci/evergreen"
 )
 
+// compressedLogsVersion is the first task and test log output version whose
+// logs are written in the compressed, indexed log format.
+const compressedLogsVersion = 2
+
 // TaskOutput is the versioned entry point for coordinating persistent storage
 // of a task run's output data.
 type TaskOutput struct {
@@ -26,13 +30,18 @@ type TaskOptions struct {
 func InitializeTaskOutput(env evergreen.Environment) *TaskOutput {
 	settings := env.Settings()
 
+	logVersion := 1
+	if settings.Buckets.CompressedLogs {
+		logVersion = compressedLogsVersion
+	}
+
 	return &TaskOutput{
 		TaskLogs: TaskLogOutput{
-			Version:      1,
+			Version:      logVersion,
 			BucketConfig: settings.Buckets.LogBucket,
 		},
 		TestLogs: TestLogOutput{
-			Version:      1,
+			Version:      logVersion,
 			BucketConfig: settings.Buckets.LogBucket,
 		},
 	}
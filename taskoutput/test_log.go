@@ -92,8 +92,32 @@ func (o TestLogOutput) Get(ctx context.Context, taskOpts TaskOptions, getOpts Te
 	})
 }
 
+// Compress rewrites the test logs of the given task run in the compressed,
+// indexed log format and returns the test log output with its version updated
+// accordingly.
+func (o TestLogOutput) Compress(ctx context.Context, taskOpts TaskOptions) (TestLogOutput, error) {
+	if o.Version == 0 {
+		return o, errors.New("cannot compress Cedar Buildlogger test logs")
+	}
+
+	b, err := newBucket(ctx, o.BucketConfig, o.AWSCredentials)
+	if err != nil {
+		return o, err
+	}
+	if _, err = log.NewLogServiceV1(b).Convert(ctx, o.getPrefix(taskOpts)); err != nil {
+		return o, errors.Wrap(err, "converting test logs")
+	}
+
+	o.Version = max(o.Version, compressedLogsVersion)
+	return o, nil
+}
+
+func (o TestLogOutput) getPrefix(taskOpts TaskOptions) string {
+	return fmt.Sprintf("%s/%s/%d/%s", taskOpts.ProjectID, taskOpts.TaskID, taskOpts.Execution, o.ID())
+}
+
 func (o TestLogOutput) getLogNames(taskOpts TaskOptions, logPaths []string) []string {
-	prefix := fmt.Sprintf("%s/%s/%d/%s", taskOpts.ProjectID, taskOpts.TaskID, taskOpts.Execution, o.ID())
+	prefix := o.getPrefix(taskOpts)
 
 	logNames := make([]string, len(logPaths))
 	for i, path := range logPaths {
@@ -109,7 +133,7 @@ func (o TestLogOutput) getLogService(ctx context.Context) (log.LogService, error
 		return nil, err
 	}
 
-	return log.NewLogServiceV0(b), nil
+	return newLogService(o.Version, b), nil
 }
 
 // getBuildloggerLogs makes request to Cedar Buildlogger for logs.
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/log"
 	"github.com/evergreen-ci/pail"
 	"github.com/evergreen-ci/utility"
 	"github.com/pkg/errors"
@@ -41,3 +42,14 @@ func newBucket(ctx context.Context, config evergreen.BucketConfig, creds aws.Cre
 		return nil, errors.Errorf("unrecognized bucket type '%s'", config.Type)
 	}
 }
+
+// newLogService returns the log service for the given output version. Both
+// services can read logs written in either format, the version only
+// determines the format of newly written logs.
+func newLogService(version int, bucket pail.Bucket) log.LogService {
+	if version >= compressedLogsVersion {
+		return log.NewLogServiceV1(bucket)
+	}
+
+	return log.NewLogServiceV0(bucket)
+}
@@ -178,6 +178,16 @@ func MockConfig() *evergreen.Settings {
 				HeaderName: "auth_header",
 				KeysetURL:  "www.google.com",
 			},
+			OIDC: &evergreen.OIDCAuthConfig{
+				Issuer:         "https://idp.example.com",
+				ClientID:       "oidc_client",
+				ClientSecret:   "oidc_secret",
+				AllowedDomains: []string{"example.com"},
+				RolesClaim:     "groups",
+				RoleMappings: []evergreen.OIDCRoleMapping{
+					{ClaimValue: "admins", Roles: []string{"superuser"}},
+				},
+			},
 			BackgroundReauthMinutes: 60,
 		},
 		AWSInstanceRole: "role",
@@ -210,6 +220,11 @@ func MockConfig() *evergreen.Settings {
 				},
 			},
 		},
+		Cost: evergreen.CostConfig{
+			DefaultHourlyCost: 0.5,
+			InstanceTypes:     []evergreen.InstanceTypeCost{{InstanceType: "m5.xlarge", HourlyCost: 0.192}},
+			Distros:           []evergreen.DistroCost{{Distro: "ubuntu2204-large", HourlyCost: 1.5}},
+		},
 		DomainName:          "example.com",
 		Expansions:          map[string]string{"k2": "v2"},
 		GithubPRCreatorOrg:  "org",
@@ -354,6 +369,11 @@ func MockConfig() *evergreen.Settings {
 			Docker: evergreen.DockerConfig{
 				APIVersion: "docker_version",
 			},
+			Kubernetes: evergreen.KubernetesConfig{
+				APIServerURL: "https://kubernetes.example.com",
+				BearerToken:  "bearer_token",
+				Namespace:    "namespace",
+			},
 		},
 		RepoTracker: evergreen.RepoTrackerConfig{
 			NumNewRepoRevisionsToFetch: 10,
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -40,6 +41,9 @@ const (
 	GithubInvestigation        = "Github API Limit Investigation"
 	PRDiffTooLargeErrorMessage = "the diff exceeded the maximum"
 	GithubStatusDefaultContext = "evergreen"
+	// GithubStatusMergeQueueBisectContext is the context of the status that
+	// reports which PR caused a GitHub merge queue batch to fail.
+	GithubStatusMergeQueueBisectContext = "evergreen/merge-queue-bisect"
 )
 
 const (
@@ -185,6 +189,21 @@ type GithubMergeGroup struct {
 	// together, so there are as many commits as there are PRs in the merge
 	// group. This is only the title of the first commit in the merge group.
 	HeadCommit string `bson:"head_commit"`
+
+	// Commits are the commits in the merge group from oldest to newest, one
+	// per PR in the merge group. They're used to bisect the merge group if it
+	// fails. If the merge group contains a single PR, this is empty.
+	Commits []GithubMergeGroupCommit `bson:"commits,omitempty"`
+}
+
+// GithubMergeGroupCommit is the commit that merges a single PR into a GitHub
+// merge group.
+type GithubMergeGroupCommit struct {
+	SHA   string `bson:"sha"`
+	Title string `bson:"title"`
+	// PRNumber is the number of the PR merged by the commit, if it could be
+	// determined from the commit title.
+	PRNumber int `bson:"pr_number,omitempty"`
 }
 
 // SendGithubStatusInput is the input to the SendPendingStatusToGithub function and contains
@@ -621,6 +640,57 @@ func IsMergeBaseAllowed(ctx context.Context, owner, repo, oldestAllowedMergeBase
 	return status == "behind" || status == "identical", nil
 }
 
+// GetGithubMergeGroupCommits returns the commits in a GitHub merge group from
+// oldest to newest. GitHub creates one commit per PR in the merge group on top
+// of the base.
+func GetGithubMergeGroupCommits(ctx context.Context, owner, repo, baseSHA, headSHA string) ([]GithubMergeGroupCommit, error) {
+	caller := "GetGithubMergeGroupCommits"
+	ctx, span := tracer.Start(ctx, caller, trace.WithAttributes(
+		attribute.String(githubEndpointAttribute, caller),
+		attribute.String(githubOwnerAttribute, owner),
+		attribute.String(githubRepoAttribute, repo),
+		attribute.String(githubRefAttribute, headSHA),
+	))
+	defer span.End()
+
+	compare, err := getCommitComparison(ctx, owner, repo, baseSHA, headSHA, caller)
+	if err != nil {
+		return nil, errors.Wrapf(err, "comparing merge group base '%s' to head '%s'", baseSHA, headSHA)
+	}
+
+	commits := make([]GithubMergeGroupCommit, 0, len(compare.Commits))
+	for _, c := range compare.Commits {
+		title, _, _ := strings.Cut(c.GetCommit().GetMessage(), "\n")
+		commits = append(commits, GithubMergeGroupCommit{
+			SHA:      c.GetSHA(),
+			Title:    title,
+			PRNumber: ParsePRNumberFromCommitTitle(title),
+		})
+	}
+
+	return commits, nil
+}
+
+// mergeGroupPRNumberRegex matches the PR number in the title of a commit that
+// GitHub creates for a merge group, which is either the squashed PR title
+// followed by "(#123)" or "Merge pull request #123 from ...".
+var mergeGroupPRNumberRegex = regexp.MustCompile(`(?:\(#(\d+)\)\s*$)|(?:^Merge pull request #(\d+))`)
+
+// ParsePRNumberFromCommitTitle returns the number of the PR that a merge
+// group commit merges, or 0 if it can't be determined.
+func ParsePRNumberFromCommitTitle(title string) int {
+	matches := mergeGroupPRNumberRegex.FindStringSubmatch(title)
+	if matches == nil {
+		return 0
+	}
+	for _, match := range matches[1:] {
+		if prNumber, err := strconv.Atoi(match); err == nil {
+			return prNumber
+		}
+	}
+	return 0
+}
+
 func getCommitComparison(ctx context.Context, owner, repo, baseRevision, currentCommitHash, caller string) (*github.CommitsComparison, error) {
 	span := trace.SpanFromContext(ctx)
 
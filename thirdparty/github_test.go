@@ -492,3 +492,10 @@ func TestValidateCheckRunOutput(t *testing.T) {
 		"checkRun output 'This is my report' specifies an annotation 'Error Detector' with no annotation level"
 	assert.Equal(t, expectedError, err.Error())
 }
+
+func TestParsePRNumberFromCommitTitle(t *testing.T) {
+	assert.Equal(t, 515, ParsePRNumberFromCommitTitle("Fix the thing (#515)"))
+	assert.Equal(t, 42, ParsePRNumberFromCommitTitle("Merge pull request #42 from user/branch"))
+	assert.Equal(t, 7, ParsePRNumberFromCommitTitle("Mention #3 but merge (#7)"))
+	assert.Zero(t, ParsePRNumberFromCommitTitle("Commit without a PR number"))
+}
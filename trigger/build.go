@@ -200,15 +200,16 @@ func (t *buildTriggers) makeData(ctx context.Context, sub *event.Subscription, p
 	}
 
 	data := commonTemplateData{
-		ID:              t.build.Id,
-		EventID:         t.event.ID,
-		SubscriptionID:  sub.ID,
-		DisplayName:     t.build.DisplayName,
-		Object:          event.ObjectBuild,
-		Project:         projectName,
-		URL:             t.build.GetURL(t.uiConfig.Url),
-		PastTenseStatus: t.data.Status,
-		apiModel:        &api,
+		ID:                t.build.Id,
+		EventID:           t.event.ID,
+		SubscriptionID:    sub.ID,
+		DisplayName:       t.build.DisplayName,
+		Object:            event.ObjectBuild,
+		Project:           projectName,
+		URL:               t.build.GetURL(t.uiConfig.Url),
+		PastTenseStatus:   t.data.Status,
+		apiModel:          &api,
+		pagerDutyDedupKey: makePagerDutyDedupKey("build", t.build.Requester, t.build.Version, t.build.Project, t.build.Requester, t.build.BuildVariant),
 	}
 
 	if t.data.GithubCheckStatus != "" {
@@ -34,13 +34,14 @@ const patchAllOutcomes = "*"
 func makePatchTriggers() eventHandler {
 	t := &patchTriggers{}
 	t.base.triggers = map[string]trigger{
-		event.TriggerFamilyOutcome: t.patchFamilyOutcome,
-		event.TriggerFamilyFailure: t.patchFamilyFailure,
-		event.TriggerFamilySuccess: t.patchFamilySuccess,
-		event.TriggerOutcome:       t.patchOutcome,
-		event.TriggerFailure:       t.patchFailure,
-		event.TriggerSuccess:       t.patchSuccess,
-		event.TriggerPatchStarted:  t.patchStarted,
+		event.TriggerFamilyOutcome:      t.patchFamilyOutcome,
+		event.TriggerFamilyFailure:      t.patchFamilyFailure,
+		event.TriggerFamilySuccess:      t.patchFamilySuccess,
+		event.TriggerOutcome:            t.patchOutcome,
+		event.TriggerFailure:            t.patchFailure,
+		event.TriggerSuccess:            t.patchSuccess,
+		event.TriggerPatchStarted:       t.patchStarted,
+		event.TriggerMergeQueueBisected: t.patchMergeQueueBisected,
 	}
 	return t
 }
@@ -205,6 +206,31 @@ func (t *patchTriggers) patchStarted(ctx context.Context, sub *event.Subscriptio
 	return t.generate(ctx, sub)
 }
 
+// patchMergeQueueBisected reports which PR caused a GitHub merge queue batch
+// to fail once the batch has been bisected.
+func (t *patchTriggers) patchMergeQueueBisected(ctx context.Context, sub *event.Subscription) (*notification.Notification, error) {
+	if t.event.EventType != event.PatchMergeQueueBisected || t.patch.MergeQueueBisect == nil {
+		return nil, nil
+	}
+
+	data, err := t.makeData(ctx, sub)
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting patch data")
+	}
+	data.githubContext = thirdparty.GithubStatusMergeQueueBisectContext
+	data.githubState = message.GithubStateFailure
+	if t.patch.MergeQueueBisect.Status != patch.MergeQueueBisectFound {
+		data.githubState = message.GithubStateError
+	}
+	data.githubDescription = t.patch.MergeQueueBisectDescription()
+
+	payload, err := makeCommonPayload(sub, t.Attributes(), data)
+	if err != nil {
+		return nil, errors.Wrap(err, "building notification")
+	}
+	return notification.New(t.event.ID, sub.Trigger, &sub.Subscriber, payload)
+}
+
 func (t *patchTriggers) makeData(ctx context.Context, sub *event.Subscription) (*commonTemplateData, error) {
 	api := restModel.APIPatch{}
 	if err := api.BuildFromService(ctx, *t.patch, &restModel.APIPatchArgs{
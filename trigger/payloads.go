@@ -7,6 +7,7 @@ import (
 	"html/template"
 	"net/http"
 	"net/url"
+	"strings"
 	ttemplate "text/template"
 
 	"github.com/evergreen-ci/evergreen"
@@ -34,6 +35,11 @@ const (
 	// or the link back to Github Pull Requests.
 	// This number MUST NOT exceed 100, and Slack recommends a limit of 10
 	slackAttachmentsLimit = 10
+
+	// pagerDutySummaryLimit is the maximum length of a PagerDuty incident
+	// summary.
+	pagerDutySummaryLimit = 1024
+	pagerDutySeverity     = "error"
 )
 
 type commonTemplateData struct {
@@ -60,6 +66,11 @@ type commonTemplateData struct {
 	githubState       message.GithubState
 	githubDescription string
 
+	// pagerDutyDedupKey identifies the incident that the notification
+	// triggers or resolves. It should be the same for a failure and the
+	// later success that fixes it.
+	pagerDutyDedupKey string
+
 	emailContent *template.Template
 }
 
@@ -242,6 +253,8 @@ const jiraIssueTitle string = "Evergreen {{ .Object }} '{{ .DisplayName }}' in '
 
 const slackTemplate string = `The {{ .Object }} <{{ .URL }}|{{ .DisplayName }}> in '{{ .Project }}' has {{ .PastTenseStatus }}!`
 
+const teamsTemplate string = `The {{ .Object }} [{{ .DisplayName }}]({{ .URL }}) in '{{ .Project }}' has {{ .PastTenseStatus }}!`
+
 func makeHeaders(headerMap map[string][]string) http.Header {
 	headers := http.Header{}
 	for headerField, headerData := range headerMap {
@@ -377,6 +390,83 @@ func slack(t *commonTemplateData) (*notification.SlackPayload, error) {
 	}, nil
 }
 
+func teams(t *commonTemplateData) (*notification.TeamsPayload, error) {
+	title, err := executeTextTemplate("teams-title", jiraIssueTitle, t)
+	if err != nil {
+		return nil, err
+	}
+	text, err := executeTextTemplate("teams", teamsTemplate, t)
+	if err != nil {
+		return nil, err
+	}
+
+	color := evergreenFailColor
+	if t.PastTenseStatus == "succeeded" {
+		color = evergreenSuccessColor
+	}
+
+	return &notification.TeamsPayload{
+		Title: title,
+		Text:  text,
+		URL:   t.URL,
+		Color: color,
+	}, nil
+}
+
+// makePagerDutyDedupKey returns the key for the incident of an object with the
+// given requester and version. Mainline objects share the key across versions
+// so that a later success resolves the incident that a failure opened, while
+// each patch gets its own key so that incidents from different patches aren't
+// merged together.
+func makePagerDutyDedupKey(object, requester, versionID string, parts ...string) string {
+	key := append([]string{"evergreen", object}, parts...)
+	if evergreen.IsPatchRequester(requester) {
+		key = append(key, versionID)
+	}
+	return strings.Join(key, "-")
+}
+
+// pagerDuty resolves the incident for the object when it succeeds and
+// triggers it otherwise.
+func pagerDuty(t *commonTemplateData) (*notification.PagerDutyPayload, error) {
+	summary, err := executeTextTemplate("pagerduty", jiraIssueTitle, t)
+	if err != nil {
+		return nil, err
+	}
+	summary, _ = truncateString(summary, pagerDutySummaryLimit)
+
+	action := notification.PagerDutyActionTrigger
+	if t.PastTenseStatus == "succeeded" {
+		action = notification.PagerDutyActionResolve
+	}
+	dedupKey := t.pagerDutyDedupKey
+	if dedupKey == "" {
+		dedupKey = fmt.Sprintf("evergreen-%s-%s", t.Object, t.ID)
+	}
+
+	return &notification.PagerDutyPayload{
+		Action:   action,
+		DedupKey: dedupKey,
+		Summary:  summary,
+		Source:   t.Project,
+		Severity: pagerDutySeverity,
+		URL:      t.URL,
+	}, nil
+}
+
+func executeTextTemplate(name, tmpl string, t *commonTemplateData) (string, error) {
+	parsed, err := ttemplate.New(name).Parse(tmpl)
+	if err != nil {
+		return "", errors.Wrapf(err, "parsing %s template", name)
+	}
+
+	buf := &bytes.Buffer{}
+	if err = parsed.Execute(buf, t); err != nil {
+		return "", errors.Wrapf(err, "executing %s template", name)
+	}
+	return buf.String(), nil
+}
+
 // truncateString splits a string into two parts, with the following behavior:
 // If the entire string is <= capacity, it's returned unchanged.
 // Otherwise, the string is split at the (capacity-3)'th byte. The first string
@@ -441,6 +531,12 @@ func makeCommonPayload(sub *event.Subscription, eventAttributes event.Attributes
 
 	case event.SlackSubscriberType:
 		return slack(data)
+
+	case event.TeamsSubscriberType:
+		return teams(data)
+
+	case event.PagerDutySubscriberType:
+		return pagerDuty(data)
 	}
 
 	return nil, errors.Errorf("unknown subscriber type '%s'", sub.Subscriber.Type)
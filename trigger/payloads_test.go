@@ -10,6 +10,7 @@ import (
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/build"
+	"github.com/evergreen-ci/evergreen/model/notification"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/testresult"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
@@ -162,6 +163,49 @@ func (s *payloadSuite) TestSlack() {
 	s.Empty(m.Attachments)
 }
 
+func (s *payloadSuite) TestTeams() {
+	m, err := teams(&s.t)
+	s.NoError(err)
+	s.Require().NotNil(m)
+
+	s.Equal("Evergreen patch 'display-1234' in 'test' has failed", m.Title)
+	s.Equal("The patch [display-1234](https://example.com/patch/1234) in 'test' has failed!", m.Text)
+	s.Equal(s.url, m.URL)
+	s.Equal(evergreenFailColor, m.Color)
+
+	s.t.PastTenseStatus = "succeeded"
+	m, err = teams(&s.t)
+	s.NoError(err)
+	s.Require().NotNil(m)
+	s.Equal(evergreenSuccessColor, m.Color)
+}
+
+func (s *payloadSuite) TestPagerDuty() {
+	s.t.pagerDutyDedupKey = "evergreen-task-project-variant-task"
+	m, err := pagerDuty(&s.t)
+	s.NoError(err)
+	s.Require().NotNil(m)
+
+	s.Equal(notification.PagerDutyActionTrigger, m.Action)
+	s.Equal("evergreen-task-project-variant-task", m.DedupKey)
+	s.Equal("Evergreen patch 'display-1234' in 'test' has failed", m.Summary)
+	s.Equal("test", m.Source)
+	s.Equal(s.url, m.URL)
+
+	s.t.PastTenseStatus = "succeeded"
+	m, err = pagerDuty(&s.t)
+	s.NoError(err)
+	s.Require().NotNil(m)
+	s.Equal(notification.PagerDutyActionResolve, m.Action)
+	s.Equal("evergreen-task-project-variant-task", m.DedupKey, "resolve should use the same key as the trigger")
+
+	s.t.pagerDutyDedupKey = ""
+	m, err = pagerDuty(&s.t)
+	s.NoError(err)
+	s.Require().NotNil(m)
+	s.Equal("evergreen-patch-1234", m.DedupKey)
+}
+
 func (s *payloadSuite) TestGetFailedTestsFromTemplate() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -234,3 +278,19 @@ func TestTruncateString(t *testing.T) {
 	assert.Empty(head)
 	assert.Equal("12345", tail)
 }
+
+func TestMakePagerDutyDedupKey(t *testing.T) {
+	assert.Equal(t, "evergreen-task-project-gitter_request-variant-task",
+		makePagerDutyDedupKey("task", evergreen.RepotrackerVersionRequester, "v1", "project", evergreen.RepotrackerVersionRequester, "variant", "task"))
+	assert.Equal(t,
+		makePagerDutyDedupKey("task", evergreen.RepotrackerVersionRequester, "v1", "project", evergreen.RepotrackerVersionRequester, "variant", "task"),
+		makePagerDutyDedupKey("task", evergreen.RepotrackerVersionRequester, "v2", "project", evergreen.RepotrackerVersionRequester, "variant", "task"),
+		"mainline versions should share a key")
+
+	for _, requester := range []string{evergreen.PatchVersionRequester, evergreen.GithubPRRequester} {
+		patch1 := makePagerDutyDedupKey("task", requester, "patch1", "project", requester, "variant", "task")
+		patch2 := makePagerDutyDedupKey("task", requester, "patch2", "project", requester, "variant", "task")
+		assert.Equal(t, "evergreen-task-project-"+requester+"-variant-task-patch1", patch1)
+		assert.NotEqual(t, patch1, patch2, "patches should not share a key")
+	}
+}
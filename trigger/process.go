@@ -11,6 +11,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/notification"
 	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/user"
 	"github.com/evergreen-ci/utility"
 	"github.com/google/go-github/v70/github"
 	"github.com/mongodb/grip"
@@ -62,6 +63,7 @@ func NotificationsFromEvent(ctx context.Context, e *event.EventLogEntry) ([]noti
 
 	notifications := make([]notification.Notification, 0, len(subscriptions))
 
+	digests := digestResolver{}
 	catcher := grip.NewSimpleCatcher()
 	for i := range subscriptions {
 		n, err := h.Process(ctx, &subscriptions[i])
@@ -84,12 +86,72 @@ func NotificationsFromEvent(ctx context.Context, e *event.EventLogEntry) ([]noti
 		}
 		grip.Info(msg)
 
+		n.CreatedAt = time.Now().Truncate(time.Millisecond)
+		n.Digest = digests.resolve(ctx, &subscriptions[i])
+
 		notifications = append(notifications, *n)
 	}
 
 	return notifications, catcher.Resolve()
 }
 
+// digestResolver determines how often a subscription's notifications should
+// be sent, caching each owner's default digest frequency.
+type digestResolver map[string]string
+
+// resolve returns the digest frequency for the subscription's notifications,
+// or an empty string if they should be sent immediately. A frequency set on
+// the subscription takes precedence over its owner's default.
+func (r digestResolver) resolve(ctx context.Context, sub *event.Subscription) string {
+	if !event.SupportsDigest(sub.Subscriber.Type) {
+		return ""
+	}
+
+	digest := sub.Digest
+	if digest == event.DigestDefault {
+		digest = r.ownerDefault(ctx, sub)
+	}
+	if event.DigestWindow(digest) <= 0 {
+		return ""
+	}
+
+	return digest
+}
+
+func (r digestResolver) ownerDefault(ctx context.Context, sub *event.Subscription) string {
+	key := string(sub.OwnerType) + "/" + sub.Owner
+	if digest, ok := r[key]; ok {
+		return digest
+	}
+
+	var digest string
+	var err error
+	switch sub.OwnerType {
+	case event.OwnerTypePerson:
+		var u *user.DBUser
+		u, err = user.FindOneByIdContext(ctx, sub.Owner)
+		if u != nil {
+			digest = u.Settings.Notifications.Digest
+		}
+	case event.OwnerTypeProject:
+		var pRef *model.ProjectRef
+		pRef, err = model.FindMergedProjectRef(ctx, sub.Owner, "", false)
+		if pRef != nil {
+			digest = pRef.NotificationDigest
+		}
+	}
+	grip.Warning(message.WrapError(err, message.Fields{
+		"source":          "events-processing",
+		"message":         "could not find subscription owner's default digest, sending immediately",
+		"subscription_id": sub.ID,
+		"owner_type":      sub.OwnerType,
+		"owner":           sub.Owner,
+	}))
+
+	r[key] = digest
+	return digest
+}
+
 type projectProcessor func(context.Context, ProcessorArgs) (*model.Version, error)
 
 type ProcessorArgs struct {
@@ -268,6 +268,9 @@ func (t *taskTriggers) makeData(ctx context.Context, sub *event.Subscription, pa
 		Task:            t.task,
 		ProjectRef:      projectRef,
 		Build:           buildDoc,
+		// Use the same key for every mainline version's run of the task so
+		// that a later success resolves the incident that a failure opened.
+		pagerDutyDedupKey: makePagerDutyDedupKey("task", t.task.Requester, t.task.Version, t.task.Project, t.task.Requester, t.task.BuildVariant, t.task.DisplayName),
 	}
 	slackColor := evergreenFailColor
 
@@ -154,6 +154,7 @@ func (t *versionTriggers) makeData(ctx context.Context, sub *event.Subscription,
 		githubState:       message.GithubStatePending,
 		githubContext:     thirdparty.GithubStatusDefaultContext,
 		githubDescription: evergreen.PRTasksRunningDescription,
+		pagerDutyDedupKey: makePagerDutyDedupKey("version", t.version.Requester, t.version.Id, t.version.Identifier, t.version.Requester),
 	}
 	if t.data.GithubCheckStatus != "" {
 		data.PastTenseStatus = t.data.GithubCheckStatus
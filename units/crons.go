@@ -12,6 +12,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/event"
 	"github.com/evergreen-ci/evergreen/model/host"
 	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/pod"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/user"
@@ -151,6 +152,34 @@ func sendNotificationJobs(ctx context.Context, _ evergreen.Environment, ts time.
 	return notificationJobs(ctx, unprocessedNotifications, flags, ts)
 }
 
+// notificationDigestJobs returns the job to send the notification digests that
+// are due. Digests are sent at most every 15 minutes, which is frequent enough
+// for the hourly and daily digest windows.
+func notificationDigestJobs(ctx context.Context, env evergreen.Environment, _ time.Time) ([]amboy.Job, error) {
+	return []amboy.Job{NewNotificationDigestJob(env, utility.RoundPartOfHour(15).Format(TSFormat))}, nil
+}
+
+// distroMaintenanceJobs returns the job to move distros into and out of their
+// scheduled maintenance windows.
+func distroMaintenanceJobs(ctx context.Context, env evergreen.Environment, ts time.Time) ([]amboy.Job, error) {
+	return []amboy.Job{NewDistroMaintenanceJob(env, ts.Format(TSFormat))}, nil
+}
+
+// mergeQueueBisectJobs returns the jobs to create the patches for the next
+// step of bisecting failed GitHub merge queue batches.
+func mergeQueueBisectJobs(ctx context.Context, env evergreen.Environment, _ time.Time) ([]amboy.Job, error) {
+	batches, err := patch.FindMergeQueuePatchesPendingBisect(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding merge queue patches pending bisect")
+	}
+
+	jobs := make([]amboy.Job, 0, len(batches))
+	for _, batch := range batches {
+		jobs = append(jobs, NewMergeQueueBisectJob(env, batch.Id.Hex(), batch.MergeQueueBisect.NextIndex))
+	}
+	return jobs, nil
+}
+
 func eventNotifierJobs(ctx context.Context, env evergreen.Environment, ts time.Time) ([]amboy.Job, error) {
 	flags, err := evergreen.GetServiceFlags(ctx)
 	if err != nil {
@@ -458,6 +487,33 @@ func PopulateDuplicateTaskCheckJobs() amboy.QueueOperation {
 	}
 }
 
+// PopulateLogCompressionJobs enqueues jobs to convert the plain-text logs of
+// finished tasks to the compressed, indexed log format. Conversion only runs
+// once new task logs are written in the compressed format.
+func PopulateLogCompressionJobs(env evergreen.Environment) amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		if !env.Settings().Buckets.CompressedLogs {
+			return nil
+		}
+
+		tasks, err := task.FindWithUncompressedLogs(ctx, logCompressionBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "finding tasks with uncompressed logs")
+		}
+
+		catcher := grip.NewBasicCatcher()
+		for _, t := range tasks {
+			taskID := t.Id
+			if t.Archived {
+				taskID = t.OldTaskId
+			}
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewLogCompressionJob(taskID, t.Execution)), "enqueueing log compression job for task '%s' execution %d", taskID, t.Execution)
+		}
+
+		return catcher.Resolve()
+	}
+}
+
 // PopulateHostStatJobs adds host stats jobs.
 func PopulateHostStatJobs(parts int) amboy.QueueOperation {
 	return func(ctx context.Context, queue amboy.Queue) error {
@@ -859,6 +915,48 @@ func PopulateCacheHistoricalTaskDataJob(part int) amboy.QueueOperation {
 	}
 }
 
+// PopulateTestFlakinessJobs enqueues the jobs to score the test flakiness of
+// each enabled project once per day.
+func PopulateTestFlakinessJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		projects, err := model.FindAllMergedTrackedProjectRefs(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		// Although we don't run this hourly, we still queue hourly to improve resiliency.
+		ts := utility.RoundPartOfDay(1).Format(TSFormat)
+
+		catcher := grip.NewBasicCatcher()
+		for _, project := range projects {
+			if !project.Enabled {
+				continue
+			}
+
+			catcher.Wrapf(amboy.EnqueueUniqueJob(ctx, queue, NewTestFlakinessJob(ts, project.Id)), "enqueueing test flakiness job for project '%s'", project.Identifier)
+		}
+
+		return catcher.Resolve()
+	}
+}
+
+// PopulateTaskCostJobs enqueues the job to estimate the cost of the tasks
+// that ran on recently terminated hosts.
+func PopulateTaskCostJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		ts := utility.RoundPartOfHour(0)
+		return errors.Wrap(amboy.EnqueueUniqueJob(ctx, queue, NewTaskCostJob(ts)), "enqueueing task cost job")
+	}
+}
+
+// PopulateCostBudgetCheckJobs enqueues the job to check project spend against
+// the project budgets.
+func PopulateCostBudgetCheckJobs() amboy.QueueOperation {
+	return func(ctx context.Context, queue amboy.Queue) error {
+		ts := utility.RoundPartOfHour(0).Format(TSFormat)
+		return errors.Wrap(amboy.EnqueueUniqueJob(ctx, queue, NewCostBudgetCheckJob(ts)), "enqueueing cost budget check job")
+	}
+}
+
 func PopulateSpawnhostExpirationCheckJob() amboy.QueueOperation {
 	return func(ctx context.Context, queue amboy.Queue) error {
 		hosts, err := host.FindSpawnhostsWithNoExpirationToExtend(ctx)
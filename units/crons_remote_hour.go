@@ -55,6 +55,10 @@ func (j *cronsRemoteHourJob) Run(ctx context.Context) {
 		PopulateDuplicateTaskCheckJobs(),
 		PopulatePodResourceCleanupJobs(),
 		PopulateUnexpirableSpawnHostStatsJob(),
+		PopulateLogCompressionJobs(j.env),
+		PopulateTestFlakinessJobs(),
+		PopulateTaskCostJobs(),
+		PopulateCostBudgetCheckJobs(),
 	}
 
 	queue := j.env.RemoteQueue()
@@ -49,9 +49,12 @@ func (j *cronsRemoteMinuteJob) Run(ctx context.Context) {
 		"host ready":                 hostReadyJob,
 		"background stats":           backgroundStatsJobs,
 		"container state":            containerStateJobs,
+		"distro maintenance":         distroMaintenanceJobs,
 		"event send":                 sendNotificationJobs,
 		"host monitoring":            hostMonitoringJobs,
 		"last container finish time": lastContainerFinishTimeJobs,
+		"merge queue bisect":         mergeQueueBisectJobs,
+		"notification digest":        notificationDigestJobs,
 		"oldest image removal":       oldestImageRemovalJobs,
 		"parent decommission":        parentDecommissionJobs,
 		"periodic notification":      periodicNotificationJobs,
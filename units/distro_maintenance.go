@@ -0,0 +1,152 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	distroMaintenanceJobName = "distro-maintenance"
+
+	distroMaintenanceTerminationReason = "distro is in a maintenance window"
+)
+
+func init() {
+	registry.AddJobType(distroMaintenanceJobName, func() amboy.Job { return makeDistroMaintenanceJob() })
+}
+
+type distroMaintenanceJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+	env      evergreen.Environment
+}
+
+func makeDistroMaintenanceJob() *distroMaintenanceJob {
+	j := &distroMaintenanceJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    distroMaintenanceJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewDistroMaintenanceJob creates a job that moves distros into and out of
+// their scheduled maintenance windows.
+func NewDistroMaintenanceJob(env evergreen.Environment, ts string) amboy.Job {
+	j := makeDistroMaintenanceJob()
+	j.env = env
+	j.SetID(fmt.Sprintf("%s.%s", distroMaintenanceJobName, ts))
+	return j
+}
+
+func (j *distroMaintenanceJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	distros, err := distro.FindWithMaintenance(ctx)
+	if err != nil {
+		j.AddError(errors.Wrap(err, "finding distros with maintenance windows"))
+		return
+	}
+
+	now := time.Now()
+	for i := range distros {
+		if ctx.Err() != nil {
+			j.AddError(ctx.Err())
+			return
+		}
+		j.AddError(errors.Wrapf(j.updateMaintenance(ctx, &distros[i], now), "updating maintenance for distro '%s'", distros[i].Id))
+	}
+}
+
+// updateMaintenance starts or ends the distro's maintenance depending on
+// whether one of its maintenance windows is active.
+func (j *distroMaintenanceJob) updateMaintenance(ctx context.Context, d *distro.Distro, now time.Time) error {
+	window, active := d.CurrentMaintenanceWindow(now)
+	previous := d.ActiveMaintenance
+
+	switch {
+	case active == nil && previous == nil:
+		return nil
+	case active == nil:
+		if err := d.SetActiveMaintenance(ctx, nil); err != nil {
+			return err
+		}
+		event.LogDistroMaintenanceEnded(ctx, d.Id, previous.Start, previous.End, previous.Reason)
+		grip.Info(message.Fields{
+			"message": "distro maintenance window ended",
+			"distro":  d.Id,
+			"reason":  previous.Reason,
+			"job":     j.ID(),
+		})
+		return nil
+	case previous != nil && previous.Start.Equal(active.Start) && previous.End.Equal(active.End) && previous.Reason == active.Reason:
+		return nil
+	}
+
+	if err := d.SetActiveMaintenance(ctx, active); err != nil {
+		return err
+	}
+	if previous != nil {
+		// The distro is already in maintenance but the window changed, for
+		// example because it was extended, so only the window needs to be
+		// updated.
+		return nil
+	}
+
+	event.LogDistroMaintenanceStarted(ctx, d.Id, active.Start, active.End, active.Reason)
+	grip.Info(message.Fields{
+		"message":              "distro maintenance window started",
+		"distro":               d.Id,
+		"end":                  active.End,
+		"reason":               active.Reason,
+		"terminate_idle_hosts": window.TerminateIdleHosts,
+		"job":                  j.ID(),
+	})
+
+	catcher := grip.NewBasicCatcher()
+	// Clear the queue so that no more tasks are dispatched. Tasks that are
+	// already running are allowed to finish, and the cleared tasks remain
+	// scheduled until the distro leaves maintenance.
+	catcher.Wrapf(model.ClearTaskQueue(ctx, d.Id), "clearing task queue for distro '%s'", d.Id)
+	if window.TerminateIdleHosts {
+		catcher.Add(j.terminateIdleHosts(ctx, d))
+	}
+	return catcher.Resolve()
+}
+
+// terminateIdleHosts terminates the distro's hosts that are not running a
+// task.
+func (j *distroMaintenanceJob) terminateIdleHosts(ctx context.Context, d *distro.Distro) error {
+	idleHosts, err := host.IdleHostsWithDistroID(ctx, d.Id)
+	if err != nil {
+		return errors.Wrapf(err, "finding idle hosts for distro '%s'", d.Id)
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for i := range idleHosts {
+		h := idleHosts[i]
+		catcher.Wrapf(EnqueueTerminateHostJob(ctx, j.env, NewHostTerminationJob(j.env, &h, HostTerminationOptions{
+			TerminationReason: distroMaintenanceTerminationReason,
+		})), "enqueueing termination job for idle host '%s'", h.Id)
+	}
+	return catcher.Resolve()
+}
@@ -0,0 +1,219 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/cloud"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/mock"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/distro"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/testutil"
+	"github.com/mongodb/amboy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistroMaintenanceJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Round to the millisecond so that times survive a round trip to the DB.
+	now := time.Now().Round(time.Millisecond)
+
+	runJob := func(ctx context.Context, t *testing.T, env *mock.Environment) {
+		j := NewDistroMaintenanceJob(env, now.Format(TSFormat))
+		j.Run(ctx)
+		require.NoError(t, j.Error())
+	}
+	checkQueueLength := func(ctx context.Context, t *testing.T, distroID string, expected int) {
+		tq, err := model.LoadTaskQueue(ctx, distroID)
+		require.NoError(t, err)
+		require.NotNil(t, tq)
+		assert.Equal(t, expected, tq.Length())
+	}
+	checkEvents := func(ctx context.Context, t *testing.T, distroID string, expected ...string) {
+		events, err := event.FindLatestPrimaryDistroEvents(ctx, distroID, 10, time.Time{})
+		require.NoError(t, err)
+		require.Len(t, events, len(expected))
+		for i := range expected {
+			assert.Equal(t, expected[i], events[i].EventType)
+		}
+	}
+	insertHost := func(ctx context.Context, t *testing.T, h *host.Host) {
+		require.NoError(t, h.Insert(ctx))
+		cloud.GetMockProvider().Set(h.Id, cloud.MockInstance{Status: cloud.StatusRunning})
+	}
+	checkHostStatus := func(ctx context.Context, t *testing.T, hostID, expected string) {
+		dbHost, err := host.FindOneId(ctx, hostID)
+		require.NoError(t, err)
+		require.NotZero(t, dbHost)
+		assert.Equal(t, expected, dbHost.Status)
+	}
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T, env *mock.Environment, d *distro.Distro, idleHost *host.Host){
+		"StartsMaintenance": func(ctx context.Context, t *testing.T, env *mock.Environment, d *distro.Distro, idleHost *host.Host) {
+			d.MaintenanceWindows = []distro.MaintenanceWindow{{
+				Start:  now.Add(-time.Minute),
+				End:    now.Add(time.Hour),
+				Reason: "upgrade",
+			}}
+			require.NoError(t, d.Insert(ctx))
+
+			runJob(ctx, t, env)
+
+			dbDistro, err := distro.FindOneId(ctx, d.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbDistro)
+			require.NotZero(t, dbDistro.ActiveMaintenance)
+			assert.True(t, dbDistro.ActiveMaintenance.Start.Equal(d.MaintenanceWindows[0].Start))
+			assert.True(t, dbDistro.ActiveMaintenance.End.Equal(d.MaintenanceWindows[0].End))
+			assert.Equal(t, "upgrade", dbDistro.ActiveMaintenance.Reason)
+
+			checkQueueLength(ctx, t, d.Id, 0)
+			checkEvents(ctx, t, d.Id, event.EventDistroMaintenanceStarted)
+			checkHostStatus(ctx, t, idleHost.Id, evergreen.HostRunning)
+		},
+		"ExtendsMaintenance": func(ctx context.Context, t *testing.T, env *mock.Environment, d *distro.Distro, idleHost *host.Host) {
+			d.MaintenanceWindows = []distro.MaintenanceWindow{{
+				Start:  now.Add(-time.Minute),
+				End:    now.Add(2 * time.Hour),
+				Reason: "upgrade",
+			}}
+			d.ActiveMaintenance = &distro.ActiveMaintenance{
+				Start:  now.Add(-time.Minute),
+				End:    now.Add(time.Hour),
+				Reason: "upgrade",
+			}
+			require.NoError(t, d.Insert(ctx))
+
+			runJob(ctx, t, env)
+
+			dbDistro, err := distro.FindOneId(ctx, d.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbDistro)
+			require.NotZero(t, dbDistro.ActiveMaintenance)
+			assert.True(t, dbDistro.ActiveMaintenance.End.Equal(now.Add(2*time.Hour)))
+
+			checkQueueLength(ctx, t, d.Id, 1)
+			checkEvents(ctx, t, d.Id)
+		},
+		"KeepsUnchangedMaintenance": func(ctx context.Context, t *testing.T, env *mock.Environment, d *distro.Distro, idleHost *host.Host) {
+			d.MaintenanceWindows = []distro.MaintenanceWindow{{
+				Start:  now.Add(-time.Minute),
+				End:    now.Add(time.Hour),
+				Reason: "upgrade",
+			}}
+			d.ActiveMaintenance = &distro.ActiveMaintenance{
+				Start:  now.Add(-time.Minute),
+				End:    now.Add(time.Hour),
+				Reason: "upgrade",
+			}
+			require.NoError(t, d.Insert(ctx))
+
+			runJob(ctx, t, env)
+
+			dbDistro, err := distro.FindOneId(ctx, d.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbDistro)
+			assert.NotZero(t, dbDistro.ActiveMaintenance)
+
+			checkQueueLength(ctx, t, d.Id, 1)
+			checkEvents(ctx, t, d.Id)
+		},
+		"EndsMaintenance": func(ctx context.Context, t *testing.T, env *mock.Environment, d *distro.Distro, idleHost *host.Host) {
+			d.MaintenanceWindows = []distro.MaintenanceWindow{{
+				Start:  now.Add(-2 * time.Hour),
+				End:    now.Add(-time.Minute),
+				Reason: "upgrade",
+			}}
+			d.ActiveMaintenance = &distro.ActiveMaintenance{
+				Start:  now.Add(-2 * time.Hour),
+				End:    now.Add(-time.Minute),
+				Reason: "upgrade",
+			}
+			require.NoError(t, d.Insert(ctx))
+
+			runJob(ctx, t, env)
+
+			dbDistro, err := distro.FindOneId(ctx, d.Id)
+			require.NoError(t, err)
+			require.NotZero(t, dbDistro)
+			assert.Zero(t, dbDistro.ActiveMaintenance)
+
+			checkEvents(ctx, t, d.Id, event.EventDistroMaintenanceEnded)
+		},
+		"TerminatesIdleHostsWhenMaintenanceStarts": func(ctx context.Context, t *testing.T, env *mock.Environment, d *distro.Distro, idleHost *host.Host) {
+			d.MaintenanceWindows = []distro.MaintenanceWindow{{
+				Start:              now.Add(-time.Minute),
+				End:                now.Add(time.Hour),
+				TerminateIdleHosts: true,
+			}}
+			require.NoError(t, d.Insert(ctx))
+
+			busyHost := &host.Host{
+				Id:          "busy",
+				Distro:      *d,
+				Provider:    evergreen.ProviderNameMock,
+				Status:      evergreen.HostRunning,
+				StartedBy:   evergreen.User,
+				RunningTask: "task",
+				Provisioned: true,
+			}
+			insertHost(ctx, t, busyHost)
+
+			runJob(ctx, t, env)
+
+			queue, err := env.RemoteQueueGroup().Get(ctx, terminateHostQueueGroup)
+			require.NoError(t, err)
+			require.True(t, amboy.WaitInterval(ctx, queue, 100*time.Millisecond),
+				"failed while waiting for host termination job to complete")
+
+			checkHostStatus(ctx, t, idleHost.Id, evergreen.HostTerminated)
+			checkHostStatus(ctx, t, busyHost.Id, evergreen.HostRunning)
+			checkQueueLength(ctx, t, d.Id, 0)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			tctx, tcancel := context.WithTimeout(ctx, 10*time.Second)
+			defer tcancel()
+			tctx = testutil.TestSpan(tctx, t)
+
+			require.NoError(t, db.ClearCollections(distro.Collection, host.Collection, event.EventCollection, model.TaskQueuesCollection, model.TaskSecondaryQueuesCollection))
+			defer func() {
+				assert.NoError(t, db.ClearCollections(distro.Collection, host.Collection, event.EventCollection, model.TaskQueuesCollection, model.TaskSecondaryQueuesCollection))
+			}()
+
+			provider := cloud.GetMockProvider()
+			provider.Reset()
+			defer provider.Reset()
+
+			env := &mock.Environment{}
+			require.NoError(t, env.Configure(tctx))
+
+			d := &distro.Distro{
+				Id:       "d",
+				Provider: evergreen.ProviderNameMock,
+			}
+			tq := model.NewTaskQueue(d.Id, []model.TaskQueueItem{{Id: "queued_task"}}, model.DistroQueueInfo{Length: 1})
+			require.NoError(t, tq.Save(tctx))
+
+			idleHost := &host.Host{
+				Id:          "idle",
+				Distro:      *d,
+				Provider:    evergreen.ProviderNameMock,
+				Status:      evergreen.HostRunning,
+				StartedBy:   evergreen.User,
+				Provisioned: true,
+			}
+			insertHost(tctx, t, idleHost)
+
+			tCase(tctx, t, env, d, idleHost)
+		})
+	}
+}
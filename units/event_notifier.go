@@ -228,6 +228,11 @@ func notificationJobs(ctx context.Context, notifications []notification.Notifica
 	catcher := grip.NewBasicCatcher()
 	var jobs []amboy.Job
 	for i := range notifications {
+		if notifications[i].Digest != "" {
+			// Digest notifications are sent later, grouped together by the
+			// notification digest job.
+			continue
+		}
 		if notificationIsEnabled(flags, &notifications[i]) {
 			jobs = append(jobs, NewEventSendJob(notifications[i].ID, ts.Format(TSFormat)))
 		} else {
@@ -245,7 +250,7 @@ func notificationIsEnabled(flags *evergreen.ServiceFlags, n *notification.Notifi
 	case event.JIRAIssueSubscriberType, event.JIRACommentSubscriberType:
 		return !flags.JIRANotificationsDisabled
 
-	case event.EvergreenWebhookSubscriberType:
+	case event.EvergreenWebhookSubscriberType, event.TeamsSubscriberType, event.PagerDutySubscriberType:
 		return !flags.WebhookNotificationsDisabled
 
 	case event.EmailSubscriberType:
@@ -165,7 +165,7 @@ func (j *eventSendJob) checkDegradedMode(n *notification.Notification) error {
 	case event.JIRACommentSubscriberType:
 		return checkFlag(j.flags.JIRANotificationsDisabled)
 
-	case event.EvergreenWebhookSubscriberType:
+	case event.EvergreenWebhookSubscriberType, event.TeamsSubscriberType, event.PagerDutySubscriberType:
 		return checkFlag(j.flags.WebhookNotificationsDisabled)
 
 	case event.EmailSubscriberType:
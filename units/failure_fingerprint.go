@@ -0,0 +1,70 @@
+package units
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/pkg/errors"
+)
+
+const failureFingerprintJobName = "failure-fingerprint"
+
+func init() {
+	registry.AddJobType(failureFingerprintJobName, func() amboy.Job { return makeFailureFingerprintJob() })
+}
+
+type failureFingerprintJob struct {
+	job.Base  `bson:"job_base" json:"job_base" yaml:"job_base"`
+	TaskID    string `bson:"task_id" json:"task_id" yaml:"task_id"`
+	Execution int    `bson:"execution" json:"execution" yaml:"execution"`
+}
+
+func makeFailureFingerprintJob() *failureFingerprintJob {
+	j := &failureFingerprintJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    failureFingerprintJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewFailureFingerprintJob creates a job that fingerprints a failed task
+// execution so that the issues linked to it can be suggested for later
+// failures with the same fingerprint.
+func NewFailureFingerprintJob(taskID string, execution int) amboy.Job {
+	j := makeFailureFingerprintJob()
+	j.TaskID = taskID
+	j.Execution = execution
+	j.SetID(fmt.Sprintf("%s.%s.%d", failureFingerprintJobName, taskID, execution))
+	return j
+}
+
+func (j *failureFingerprintJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	t, err := task.FindOneIdOldOrNew(ctx, j.TaskID, j.Execution)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding task '%s' execution %d", j.TaskID, j.Execution))
+		return
+	}
+	if t == nil {
+		j.AddError(errors.Errorf("task '%s' execution %d not found", j.TaskID, j.Execution))
+		return
+	}
+
+	bbProj, ok := model.GetBuildBaronSettings(ctx, t.Project, t.Version)
+	if !ok || !bbProj.FingerprintSuggestionsEnabled {
+		return
+	}
+
+	_, err = model.UpsertFailureFingerprint(ctx, t)
+	j.AddError(errors.Wrapf(err, "fingerprinting failure for task '%s' execution %d", j.TaskID, j.Execution))
+}
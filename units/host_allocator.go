@@ -138,6 +138,16 @@ func (j *hostAllocatorJob) Run(ctx context.Context) {
 	if distro.Disabled {
 		return
 	}
+	if distro.IsInMaintenance() {
+		grip.Info(message.Fields{
+			"message":            "not allocating hosts for distro in maintenance",
+			"distro":             distro.Id,
+			"maintenance_end":    distro.ActiveMaintenance.End,
+			"maintenance_reason": distro.ActiveMaintenance.Reason,
+			"job":                j.ID(),
+		})
+		return
+	}
 
 	////////////////////////
 	// host-allocation phase
@@ -222,6 +222,12 @@ func (j *cloudHostReadyJob) setCloudHostStatus(ctx context.Context, h host.Host,
 }
 
 func (j *cloudHostReadyJob) setNextState(ctx context.Context, h *host.Host) error {
+	if h.Distro.Provider == evergreen.ProviderNameKubernetes {
+		// Kubernetes pods download and start the agent themselves once their
+		// container is running, so there's nothing left to provision.
+		return errors.Wrap(h.MarkAsProvisioned(ctx), "marking host as running")
+	}
+
 	switch h.Distro.BootstrapSettings.Method {
 	case distro.BootstrapMethodUserData:
 		// From the app server's perspective, it is done provisioning a user
@@ -0,0 +1,81 @@
+package units
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	logCompressionJobName = "log-compression"
+
+	// logCompressionBatchSize is the maximum number of tasks whose logs
+	// are converted to the compressed log format per cron interval.
+	logCompressionBatchSize = 500
+)
+
+func init() {
+	registry.AddJobType(logCompressionJobName, func() amboy.Job { return makeLogCompressionJob() })
+}
+
+type logCompressionJob struct {
+	job.Base  `bson:"job_base" json:"job_base" yaml:"job_base"`
+	TaskID    string `bson:"task_id" json:"task_id" yaml:"task_id"`
+	Execution int    `bson:"execution" json:"execution" yaml:"execution"`
+}
+
+func makeLogCompressionJob() *logCompressionJob {
+	j := &logCompressionJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    logCompressionJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewLogCompressionJob creates a job that converts the plain-text task and
+// test logs of a task execution to the compressed, indexed log format.
+func NewLogCompressionJob(taskID string, execution int) amboy.Job {
+	j := makeLogCompressionJob()
+	j.TaskID = taskID
+	j.Execution = execution
+	j.SetID(fmt.Sprintf("%s.%s.%d", logCompressionJobName, taskID, execution))
+	return j
+}
+
+func (j *logCompressionJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	t, err := task.FindOneIdAndExecution(ctx, j.TaskID, j.Execution)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding task '%s' execution %d", j.TaskID, j.Execution))
+		return
+	}
+	if t == nil {
+		j.AddError(errors.Errorf("task '%s' execution %d not found", j.TaskID, j.Execution))
+		return
+	}
+
+	if err = t.CompressTaskOutput(ctx); err != nil {
+		j.AddError(errors.Wrapf(err, "compressing logs for task '%s' execution %d", j.TaskID, j.Execution))
+		return
+	}
+
+	grip.Debug(message.Fields{
+		"message":   "compressed task logs",
+		"task_id":   j.TaskID,
+		"execution": j.Execution,
+		"job":       j.ID(),
+		"job_type":  j.Type().Name,
+	})
+}
@@ -0,0 +1,111 @@
+package units
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/mock"
+	"github.com/evergreen-ci/evergreen/model/log"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/taskoutput"
+	"github.com/mongodb/amboy/queue"
+	"github.com/mongodb/grip/level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogCompressionJob(t *testing.T) {
+	makeTask := func(t *testing.T) task.Task {
+		bucket := evergreen.BucketConfig{Type: evergreen.BucketTypeLocal, Name: t.TempDir()}
+		return task.Task{
+			Id:        "t1",
+			Project:   "project",
+			Execution: 1,
+			Status:    evergreen.TaskSucceeded,
+			TaskOutputInfo: &taskoutput.TaskOutput{
+				TaskLogs: taskoutput.TaskLogOutput{Version: 1, BucketConfig: bucket},
+				TestLogs: taskoutput.TestLogOutput{Version: 1, BucketConfig: bucket},
+			},
+		}
+	}
+
+	for tName, tCase := range map[string]func(ctx context.Context, t *testing.T){
+		"CompressesTaskLogs": func(ctx context.Context, t *testing.T) {
+			tsk := makeTask(t)
+			require.NoError(t, tsk.Insert(ctx))
+			taskOpts := taskoutput.TaskOptions{ProjectID: tsk.Project, TaskID: tsk.Id, Execution: tsk.Execution}
+			require.NoError(t, tsk.TaskOutputInfo.TaskLogs.Append(ctx, taskOpts, taskoutput.TaskLogTypeTask, []log.LogLine{
+				{Priority: level.Info, Timestamp: time.Now().UnixNano(), Data: "line"},
+			}))
+
+			j := NewLogCompressionJob(tsk.Id, tsk.Execution)
+			j.Run(ctx)
+			require.NoError(t, j.Error())
+
+			dbTask, err := task.FindOneIdAndExecution(ctx, tsk.Id, tsk.Execution)
+			require.NoError(t, err)
+			require.NotNil(t, dbTask)
+			assert.Equal(t, 2, dbTask.TaskOutputInfo.TaskLogs.Version)
+			assert.Equal(t, 2, dbTask.TaskOutputInfo.TestLogs.Version)
+
+			it, err := dbTask.GetTaskLogs(ctx, taskoutput.TaskLogGetOptions{LogType: taskoutput.TaskLogTypeTask})
+			require.NoError(t, err)
+			require.True(t, it.Next())
+			assert.Equal(t, "line", it.Item().Data)
+		},
+		"FailsForNonexistentTask": func(ctx context.Context, t *testing.T) {
+			j := NewLogCompressionJob("nonexistent", 0)
+			j.Run(ctx)
+			assert.Error(t, j.Error())
+		},
+		"PopulateEnqueuesCurrentAndArchivedExecutions": func(ctx context.Context, t *testing.T) {
+			env := &mock.Environment{}
+			require.NoError(t, env.Configure(ctx))
+			env.EvergreenSettings.Buckets.CompressedLogs = true
+
+			tsk := makeTask(t)
+			require.NoError(t, tsk.Insert(ctx))
+			oldTsk := makeTask(t)
+			oldTsk.Id = "t1_0"
+			oldTsk.OldTaskId = "t1"
+			oldTsk.Execution = 0
+			oldTsk.Archived = true
+			require.NoError(t, db.Insert(ctx, task.OldCollection, oldTsk))
+
+			q := queue.NewLocalLimitedSize(1, 16)
+			require.NoError(t, q.Start(ctx))
+			defer q.Close(ctx)
+			require.NoError(t, PopulateLogCompressionJobs(env)(ctx, q))
+
+			_, ok := q.Get(ctx, NewLogCompressionJob("t1", 1).ID())
+			assert.True(t, ok)
+			_, ok = q.Get(ctx, NewLogCompressionJob("t1", 0).ID())
+			assert.True(t, ok)
+		},
+		"PopulateNoopsWhenCompressedLogsAreDisabled": func(ctx context.Context, t *testing.T) {
+			env := &mock.Environment{}
+			require.NoError(t, env.Configure(ctx))
+			env.EvergreenSettings.Buckets.CompressedLogs = false
+
+			tsk := makeTask(t)
+			require.NoError(t, tsk.Insert(ctx))
+
+			q := queue.NewLocalLimitedSize(1, 16)
+			require.NoError(t, q.Start(ctx))
+			defer q.Close(ctx)
+			require.NoError(t, PopulateLogCompressionJobs(env)(ctx, q))
+			assert.Zero(t, q.Stats(ctx).Total)
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			require.NoError(t, db.ClearCollections(task.Collection, task.OldCollection))
+			tCase(ctx, t)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package units
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evergreen-ci/evergreen"
+	mgobson "github.com/evergreen-ci/evergreen/db/mgo/bson"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const mergeQueueBisectJobName = "merge-queue-bisect"
+
+func init() {
+	registry.AddJobType(mergeQueueBisectJobName, func() amboy.Job { return makeMergeQueueBisectJob() })
+}
+
+type mergeQueueBisectJob struct {
+	job.Base     `bson:"job_base" json:"job_base" yaml:"job_base"`
+	BatchPatchID string `bson:"batch_patch_id" json:"batch_patch_id" yaml:"batch_patch_id"`
+	CommitIndex  int    `bson:"commit_index" json:"commit_index" yaml:"commit_index"`
+
+	env evergreen.Environment
+}
+
+func makeMergeQueueBisectJob() *mergeQueueBisectJob {
+	j := &mergeQueueBisectJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    mergeQueueBisectJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewMergeQueueBisectJob creates a job that creates the patch for the next
+// step of the bisection of a failed GitHub merge queue batch, which tests the
+// commits of the batch up to and including the commit at the given index.
+func NewMergeQueueBisectJob(env evergreen.Environment, batchPatchID string, commitIndex int) amboy.Job {
+	j := makeMergeQueueBisectJob()
+	j.env = env
+	j.BatchPatchID = batchPatchID
+	j.CommitIndex = commitIndex
+	j.SetID(fmt.Sprintf("%s.%s.%d", mergeQueueBisectJobName, batchPatchID, commitIndex))
+	return j
+}
+
+func (j *mergeQueueBisectJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	batch, err := patch.FindOneId(ctx, j.BatchPatchID)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding merge queue batch patch '%s'", j.BatchPatchID))
+		return
+	}
+	if batch == nil {
+		j.AddError(errors.Errorf("merge queue batch patch '%s' not found", j.BatchPatchID))
+		return
+	}
+	if batch.MergeQueueBisect == nil || batch.MergeQueueBisect.Status != patch.MergeQueueBisectPending || batch.MergeQueueBisect.NextIndex != j.CommitIndex {
+		return
+	}
+
+	stepPatchID, err := j.createStepPatch(ctx, batch)
+	if err != nil {
+		j.AddError(err)
+		j.AddError(batch.SetMergeQueueBisectFailed(ctx))
+		event.LogPatchMergeQueueBisectedEvent(ctx, batch.Id.Hex())
+		return
+	}
+	if err = batch.SetMergeQueueBisectRunning(ctx, j.CommitIndex, stepPatchID.Hex()); err != nil {
+		j.AddError(err)
+		return
+	}
+
+	grip.Info(message.Fields{
+		"message":       "created merge queue bisect patch",
+		"patch_id":      j.BatchPatchID,
+		"step_patch_id": stepPatchID.Hex(),
+		"commit_index":  j.CommitIndex,
+		"job":           j.ID(),
+	})
+}
+
+// createStepPatch creates and finalizes the patch that tests the commits of
+// the batch up to the job's commit index.
+func (j *mergeQueueBisectJob) createStepPatch(ctx context.Context, batch *patch.Patch) (mgobson.ObjectId, error) {
+	intent, err := patch.NewGithubMergeBisectIntent(batch, j.CommitIndex)
+	if err != nil {
+		return "", errors.Wrap(err, "creating merge queue bisect intent")
+	}
+	if err = intent.Insert(ctx); err != nil && !mongo.IsDuplicateKeyError(err) {
+		return "", errors.Wrap(err, "inserting merge queue bisect intent")
+	}
+
+	stepPatchID := mgobson.NewObjectId()
+	processor := NewPatchIntentProcessor(j.env, stepPatchID, intent)
+	processor.Run(ctx)
+	if err = processor.Error(); err != nil {
+		return "", errors.Wrapf(err, "processing merge queue bisect intent for commit index %d", j.CommitIndex)
+	}
+
+	return stepPatchID, nil
+}
@@ -0,0 +1,128 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const notificationDigestJobName = "notification-digest"
+
+func init() {
+	registry.AddJobType(notificationDigestJobName, func() amboy.Job { return makeNotificationDigestJob() })
+}
+
+type notificationDigestJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+	env      evergreen.Environment
+	flags    *evergreen.ServiceFlags
+}
+
+func makeNotificationDigestJob() *notificationDigestJob {
+	j := &notificationDigestJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    notificationDigestJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewNotificationDigestJob creates a job that combines the notifications
+// waiting in due digests into a single notification per subscriber and sends
+// them.
+func NewNotificationDigestJob(env evergreen.Environment, ts string) amboy.Job {
+	j := makeNotificationDigestJob()
+	j.env = env
+	j.SetID(fmt.Sprintf("%s.%s", notificationDigestJobName, ts))
+	return j
+}
+
+func (j *notificationDigestJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+	if j.flags == nil {
+		flags, err := evergreen.GetServiceFlags(ctx)
+		if err != nil {
+			j.AddError(errors.Wrap(err, "getting service flags"))
+			return
+		}
+		j.flags = flags
+	}
+	if j.flags.EventProcessingDisabled {
+		return
+	}
+
+	unsent, err := notification.FindUnsentDigestNotifications(ctx)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+
+	now := time.Now()
+	for _, d := range notification.GroupDueDigests(unsent, now) {
+		if ctx.Err() != nil {
+			j.AddError(ctx.Err())
+			return
+		}
+		j.AddError(j.sendDigest(ctx, &d, now))
+	}
+}
+
+// sendDigest creates the combined notification for the digest, marks the
+// notifications in the digest as sent, and enqueues a job to send the combined
+// notification.
+func (j *notificationDigestJob) sendDigest(ctx context.Context, d *notification.Digest, now time.Time) error {
+	n, err := d.Notification()
+	if err != nil {
+		return errors.Wrapf(err, "creating %s digest for subscriber '%s'", d.Frequency, d.Subscriber.String())
+	}
+	n.CreatedAt = now.Truncate(time.Millisecond)
+
+	// If a previous attempt already created this digest, it may not have been
+	// sent yet, so continue to mark the notifications and enqueue the send.
+	// The digest's ID is derived from all of its notifications, so an existing
+	// digest with the same ID contains exactly these notifications.
+	if err = notification.InsertMany(ctx, *n); err != nil && !db.IsDuplicateKey(err) {
+		return errors.Wrapf(err, "inserting digest notification '%s'", n.ID)
+	}
+
+	ids := make([]string, 0, len(d.Notifications))
+	for _, digested := range d.Notifications {
+		ids = append(ids, digested.ID)
+	}
+	if err = notification.MarkDigested(ctx, ids, n.ID); err != nil {
+		return err
+	}
+
+	grip.Info(message.Fields{
+		"message":           "sending notification digest",
+		"job_id":            j.ID(),
+		"source":            "events-processing",
+		"notification_id":   n.ID,
+		"notification_type": d.Subscriber.Type,
+		"frequency":         d.Frequency,
+		"num_notifications": len(ids),
+	})
+
+	if !notificationIsEnabled(j.flags, n) {
+		return errors.Wrapf(n.MarkError(ctx, errors.New("notification is disabled")), "setting error for notification '%s'", n.ID)
+	}
+
+	return errors.Wrapf(amboy.EnqueueUniqueJob(ctx, j.env.RemoteQueue(), NewEventSendJob(n.ID, now.Format(TSFormat))), "enqueueing send job for digest notification '%s'", n.ID)
+}
@@ -141,7 +141,7 @@ func (j *patchIntentProcessor) Run(ctx context.Context) {
 	}
 
 	if err = j.finishPatch(ctx, patchDoc); err != nil {
-		if j.IntentType == patch.GithubIntentType || j.IntentType == patch.GithubMergeIntentType {
+		if (j.IntentType == patch.GithubIntentType || j.IntentType == patch.GithubMergeIntentType) && patchDoc.MergeQueueBisectStep == nil {
 			if j.gitHubError == "" {
 				j.gitHubError = OtherErrors
 			}
@@ -351,6 +351,12 @@ func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.
 	if err = j.buildTasksAndVariants(ctx, patchDoc, patchedProject); err != nil {
 		return errors.Wrap(err, BuildTasksAndVariantsError)
 	}
+	// Don't create patches for github PRs if the changed-path filters skipped
+	// every task.
+	if patchDoc.IsGithubPRPatch() && len(patchDoc.VariantsTasks) == 0 && len(patchDoc.PathFilteredTasks) > 0 {
+		j.sendGitHubSuccessMessages(ctx, patchDoc, pref, pathFilteredTasks)
+		return nil
+	}
 
 	if (j.intent.ShouldFinalizePatch() || patchDoc.IsMergeQueuePatch()) &&
 		len(patchDoc.VariantsTasks) == 0 {
@@ -410,7 +416,9 @@ func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.
 		catcher.Wrap(j.createGitHubSubscriptions(ctx, patchDoc), "creating GitHub PR patch subscriptions")
 	}
 
-	if patchDoc.IsMergeQueuePatch() {
+	// Patches that bisect a failed merge queue batch report their results
+	// through the batch's patch rather than to GitHub directly.
+	if patchDoc.IsMergeQueuePatch() && patchDoc.MergeQueueBisectStep == nil {
 		catcher.Wrap(j.createGitHubMergeSubscription(ctx, patchDoc), "creating GitHub merge queue subscriptions")
 	}
 
@@ -998,6 +1006,24 @@ func (j *patchIntentProcessor) buildGithubMergeDoc(ctx context.Context, patchDoc
 	patchDoc.Author = j.user.Id
 	patchDoc.Project = projectRef.Id
 	patchDoc.Description = makeMergeQueueDescription(patchDoc.GithubMergeData)
+
+	if patchDoc.MergeQueueBisectStep == nil {
+		// The merge group's commits are only needed to bisect the merge
+		// group if it fails, so the patch can be created without them.
+		commits, err := thirdparty.GetGithubMergeGroupCommits(ctx, patchDoc.GithubMergeData.Org, patchDoc.GithubMergeData.Repo, patchDoc.Githash, patchDoc.GithubMergeData.HeadSHA)
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message":  "could not get merge group commits, merge group will not be bisected if it fails",
+			"job":      j.ID(),
+			"patch_id": j.PatchID,
+			"owner":    patchDoc.GithubMergeData.Org,
+			"repo":     patchDoc.GithubMergeData.Repo,
+			"head_sha": patchDoc.GithubMergeData.HeadSHA,
+		}))
+		if len(commits) > 1 {
+			patchDoc.GithubMergeData.Commits = commits
+		}
+	}
+
 	return nil
 }
 
@@ -83,10 +83,27 @@ func (j *distroAliasSchedulerJob) Run(ctx context.Context) {
 	if d == nil {
 		return
 	}
+	if d.IsInMaintenance() {
+		// The distro scheduler clears both of the distro's queues while it's
+		// in maintenance, so the secondary queue must not be rebuilt either.
+		grip.Debug(message.Fields{
+			"message":  "skipping secondary queue planning for distro in maintenance",
+			"distro":   j.DistroID,
+			"job":      j.ID(),
+			"job_type": j.Type().Name,
+		})
+		return
+	}
+	plannerSettings, err := d.GetResolvedPlannerSettings(evergreen.GetEnvironment().Settings())
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "resolving planner settings for distro '%s'", j.DistroID))
+		return
+	}
 	plan, err := scheduler.PrioritizeTasks(ctx, d, tasks, scheduler.TaskPlannerOptions{
 		StartedAt:        startAt,
 		ID:               j.ID(),
 		IsSecondaryQueue: true,
+		PlannerSettings:  &plannerSettings,
 	})
 	if err != nil {
 		j.AddError(err)
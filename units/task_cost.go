@@ -0,0 +1,202 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/cost"
+	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/model/notification"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	taskCostJobName        = "task-cost"
+	costBudgetCheckJobName = "cost-budget-check"
+
+	// taskCostLookback is how far before the job's timestamp to look for
+	// terminated hosts. It overlaps with the previous job's range so that a
+	// host is not missed if a job doesn't run.
+	taskCostLookback = 2 * time.Hour
+
+	// costBudgetTrigger is the notification trigger for budget alerts.
+	costBudgetTrigger = "cost-budget-exceeded"
+)
+
+func init() {
+	registry.AddJobType(taskCostJobName, func() amboy.Job { return makeTaskCostJob() })
+	registry.AddJobType(costBudgetCheckJobName, func() amboy.Job { return makeCostBudgetCheckJob() })
+}
+
+type taskCostJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+	EndTime  time.Time `bson:"end_time" json:"end_time" yaml:"end_time"`
+
+	env evergreen.Environment
+}
+
+func makeTaskCostJob() *taskCostJob {
+	j := &taskCostJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    taskCostJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewTaskCostJob creates a job that estimates the cost of the tasks that ran
+// on hosts that terminated shortly before the given time.
+func NewTaskCostJob(ts time.Time) amboy.Job {
+	j := makeTaskCostJob()
+	j.EndTime = ts
+	j.SetID(fmt.Sprintf("%s.%s", taskCostJobName, ts.Format(TSFormat)))
+	return j
+}
+
+func (j *taskCostJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	hosts, err := host.FindTerminatedTaskHostsBetween(ctx, j.EndTime.Add(-taskCostLookback), j.EndTime)
+	if err != nil {
+		j.AddError(errors.Wrap(err, "finding terminated hosts"))
+		return
+	}
+
+	config := j.env.Settings().Cost
+	for i := range hosts {
+		if ctx.Err() != nil {
+			j.AddError(ctx.Err())
+			return
+		}
+		j.AddError(errors.Wrapf(cost.RecordHostTaskCosts(ctx, &hosts[i], config), "recording task costs for host '%s'", hosts[i].Id))
+	}
+
+	grip.Info(message.Fields{
+		"message":   "recorded task costs",
+		"num_hosts": len(hosts),
+		"end_time":  j.EndTime,
+		"job":       j.ID(),
+		"job_type":  j.Type().Name,
+	})
+}
+
+type costBudgetCheckJob struct {
+	job.Base `bson:"job_base" json:"job_base" yaml:"job_base"`
+
+	env evergreen.Environment
+}
+
+func makeCostBudgetCheckJob() *costBudgetCheckJob {
+	j := &costBudgetCheckJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    costBudgetCheckJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewCostBudgetCheckJob creates a job that notifies the subscribers of each
+// project budget whose month-to-date spend has crossed an alert threshold.
+func NewCostBudgetCheckJob(ts string) amboy.Job {
+	j := makeCostBudgetCheckJob()
+	j.SetID(fmt.Sprintf("%s.%s", costBudgetCheckJobName, ts))
+	return j
+}
+
+func (j *costBudgetCheckJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	budgets, err := cost.FindAllBudgets(ctx)
+	if err != nil {
+		j.AddError(err)
+		return
+	}
+
+	now := time.Now()
+	month := cost.BudgetMonth(now)
+	for i := range budgets {
+		if ctx.Err() != nil {
+			j.AddError(ctx.Err())
+			return
+		}
+		j.AddError(errors.Wrapf(j.checkBudget(ctx, &budgets[i], now, month), "checking budget for project '%s'", budgets[i].ProjectID))
+	}
+}
+
+func (j *costBudgetCheckJob) checkBudget(ctx context.Context, b *cost.Budget, now time.Time, month string) error {
+	spend, err := cost.ProjectSpend(ctx, b.ProjectID, cost.MonthStart(now), now)
+	if err != nil {
+		return errors.Wrap(err, "getting month-to-date spend")
+	}
+
+	thresholds := b.CrossedThresholds(spend, month)
+	if len(thresholds) == 0 {
+		return nil
+	}
+
+	// Only notify about the highest threshold crossed, since subscribers
+	// don't need to hear about the lower ones separately.
+	threshold := thresholds[len(thresholds)-1]
+	eventID := fmt.Sprintf("cost-budget-%s-%s-%d", b.ProjectID, month, threshold)
+	var notifications []notification.Notification
+	for i := range b.Subscribers {
+		n, err := notification.New(eventID, costBudgetTrigger, &b.Subscribers[i], costBudgetPayload(b, &b.Subscribers[i], spend, threshold, month))
+		if err != nil {
+			return errors.Wrapf(err, "creating notification for subscriber '%s'", b.Subscribers[i].String())
+		}
+		notifications = append(notifications, *n)
+	}
+
+	// Notification IDs are deterministic, so if this budget was already
+	// partly notified about, the duplicates are not sent again.
+	if err = notification.InsertMany(ctx, notifications...); err != nil && !db.IsDuplicateKey(err) {
+		return errors.Wrap(err, "inserting notifications")
+	}
+
+	grip.Info(message.Fields{
+		"message":       "project crossed cost budget alert threshold",
+		"project":       b.ProjectID,
+		"month":         month,
+		"spend":         spend,
+		"monthly_limit": b.MonthlyLimit,
+		"threshold":     threshold,
+		"job":           j.ID(),
+		"job_type":      j.Type().Name,
+	})
+
+	return b.MarkNotified(ctx, month, thresholds)
+}
+
+func costBudgetPayload(b *cost.Budget, sub *event.Subscriber, spend float64, threshold int, month string) any {
+	summary := fmt.Sprintf("Project '%s' has spent an estimated $%.2f on tasks in %s, which is over %d%% of its monthly budget of $%.2f.",
+		b.ProjectID, spend, month, threshold, b.MonthlyLimit)
+	if sub.Type == event.SlackSubscriberType {
+		return &notification.SlackPayload{Body: summary}
+	}
+	return &message.Email{
+		Subject:           fmt.Sprintf("Evergreen: project '%s' is at %d%% of its monthly budget", b.ProjectID, threshold),
+		Body:              summary,
+		PlainTextContents: true,
+	}
+}
@@ -0,0 +1,148 @@
+package units
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/model/testresult"
+	"github.com/mongodb/amboy"
+	"github.com/mongodb/amboy/job"
+	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/pkg/errors"
+)
+
+const (
+	testFlakinessJobName = "test-flakiness"
+
+	// testFlakinessWindow is how far back to look for restarted tasks when
+	// scoring test flakiness.
+	testFlakinessWindow = 14 * 24 * time.Hour
+	// testFlakinessMaxTasks is the maximum number of restarted tasks per
+	// project whose test results are mined when scoring test flakiness.
+	testFlakinessMaxTasks = 1000
+)
+
+func init() {
+	registry.AddJobType(testFlakinessJobName, func() amboy.Job { return makeTestFlakinessJob() })
+}
+
+type testFlakinessJob struct {
+	job.Base  `bson:"job_base" json:"job_base" yaml:"job_base"`
+	ProjectID string `bson:"project_id" json:"project_id" yaml:"project_id"`
+
+	env evergreen.Environment
+}
+
+func makeTestFlakinessJob() *testFlakinessJob {
+	j := &testFlakinessJob{
+		Base: job.Base{
+			JobType: amboy.JobType{
+				Name:    testFlakinessJobName,
+				Version: 0,
+			},
+		},
+	}
+	return j
+}
+
+// NewTestFlakinessJob creates a job that scores the flakiness of the project's
+// tests by comparing the test results across the executions of its recently
+// restarted tasks.
+func NewTestFlakinessJob(id, projectID string) amboy.Job {
+	j := makeTestFlakinessJob()
+	j.ProjectID = projectID
+	j.SetID(fmt.Sprintf("%s.%s.%s", testFlakinessJobName, projectID, id))
+	return j
+}
+
+func (j *testFlakinessJob) Run(ctx context.Context) {
+	defer j.MarkComplete()
+	if j.env == nil {
+		j.env = evergreen.GetEnvironment()
+	}
+
+	startAt := time.Now()
+	tasks, err := task.FindRestartedByProjectSinceFinishTime(ctx, j.ProjectID, startAt.Add(-testFlakinessWindow), testFlakinessMaxTasks)
+	if err != nil {
+		j.AddError(errors.Wrapf(err, "finding restarted tasks for project '%s'", j.ProjectID))
+		return
+	}
+
+	// A single task's test results failing to load should not prevent the
+	// rest of the project's tests from being scored.
+	taskCatcher := grip.NewBasicCatcher()
+	scorer := testresult.NewTestFlakinessScorer(j.ProjectID)
+	for _, t := range tasks {
+		if ctx.Err() != nil {
+			j.AddError(ctx.Err())
+			return
+		}
+
+		executions, err := j.getExecutionResults(ctx, t)
+		if err != nil {
+			taskCatcher.Wrapf(err, "getting test results for task '%s'", t.Id)
+			continue
+		}
+		scorer.AddTask(t.BuildVariant, t.DisplayName, executions)
+	}
+
+	scores := scorer.Scores(startAt)
+	if err = testresult.UpsertTestFlakinessScores(ctx, j.env, scores); err != nil {
+		j.AddError(err)
+		return
+	}
+	// Scores are only considered stale if every task was scored, since a
+	// test may only run in the tasks whose results could not be loaded.
+	if !taskCatcher.HasErrors() {
+		if err = testresult.RemoveStaleTestFlakinessScores(ctx, j.env, j.ProjectID, startAt); err != nil {
+			j.AddError(err)
+			return
+		}
+	}
+
+	grip.Info(message.Fields{
+		"message":          "scored test flakiness",
+		"project":          j.ProjectID,
+		"num_tasks":        len(tasks),
+		"num_failed_tasks": taskCatcher.Len(),
+		"num_tests":        len(scores),
+		"duration_ms":      time.Since(startAt).Milliseconds(),
+		"job":              j.ID(),
+		"job_type":         j.Type().Name,
+	})
+	j.AddError(taskCatcher.Resolve())
+}
+
+// getExecutionResults returns the test results of every execution of the
+// task that has test results.
+func (j *testFlakinessJob) getExecutionResults(ctx context.Context, t task.Task) ([][]testresult.TestResult, error) {
+	oldExecutions, err := task.FindOldExecutionsWithResults(ctx, t.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "finding archived task executions")
+	}
+
+	taskOpts := []testresult.TaskOptions{{TaskID: t.Id, Execution: t.Execution, ResultsService: t.ResultsService}}
+	for _, execution := range oldExecutions {
+		taskOpts = append(taskOpts, testresult.TaskOptions{
+			TaskID:         execution.OldTaskId,
+			Execution:      execution.Execution,
+			ResultsService: execution.ResultsService,
+		})
+	}
+
+	var executions [][]testresult.TestResult
+	for _, opts := range taskOpts {
+		results, err := testresult.GetMergedTaskTestResults(ctx, j.env, []testresult.TaskOptions{opts}, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting test results for execution %d", opts.Execution)
+		}
+		executions = append(executions, results.Results)
+	}
+
+	return executions, nil
+}
@@ -31,6 +31,9 @@ type EvergreenWebhook struct {
 	Retries        int         `bson:"retries"`
 	MinDelayMS     int         `bson:"min_delay_ms"`
 	TimeoutMS      int         `bson:"timeout_ms"`
+	// Unsigned webhooks are sent to third-party services that don't verify
+	// Evergreen's signature, so they don't need a secret.
+	Unsigned bool `bson:"unsigned,omitempty"`
 }
 
 type evergreenWebhookMessage struct {
@@ -49,7 +52,7 @@ func (w *evergreenWebhookMessage) Loggable() bool {
 	if len(w.raw.NotificationID) == 0 {
 		return false
 	}
-	if len(w.raw.Secret) == 0 {
+	if len(w.raw.Secret) == 0 && !w.raw.Unsigned {
 		return false
 	}
 	if len(w.raw.Body) == 0 {
@@ -87,11 +90,6 @@ func (w *EvergreenWebhook) request() (*http.Request, error) {
 		return nil, errors.Wrap(err, "creating webhook HTTP request")
 	}
 
-	hash, err := CalculateHMACHash(w.Secret, w.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "calculating HMAC hash")
-	}
-
 	for k := range w.Headers {
 		for i := range w.Headers[k] {
 			req.Header.Add(k, w.Headers[k][i])
@@ -102,7 +100,13 @@ func (w *EvergreenWebhook) request() (*http.Request, error) {
 	req.Header.Del(evergreenHMACHeader)
 	req.Header.Del(evergreenNotificationIDHeader)
 
-	req.Header.Add(evergreenHMACHeader, hash)
+	if !w.Unsigned {
+		hash, err := CalculateHMACHash(w.Secret, w.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "calculating HMAC hash")
+		}
+		req.Header.Add(evergreenHMACHeader, hash)
+	}
 	req.Header.Add(evergreenNotificationIDHeader, w.NotificationID)
 
 	return req, nil
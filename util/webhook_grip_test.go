@@ -63,6 +63,27 @@ func TestEvergreenWebhookComposer(t *testing.T) {
 		Headers:        nil,
 	})
 	assert.True(m.Loggable())
+
+	m = NewWebhookMessage(EvergreenWebhook{
+		NotificationID: "evergreen",
+		URL:            url,
+		Body:           []byte("something important"),
+	})
+	assert.False(m.Loggable(), "signed webhook should require a secret")
+
+	m = NewWebhookMessage(EvergreenWebhook{
+		NotificationID: "evergreen",
+		URL:            url,
+		Body:           []byte("something important"),
+		Unsigned:       true,
+	})
+	assert.True(m.Loggable())
+	m2, ok = m.(*evergreenWebhookMessage)
+	assert.True(ok)
+	req, err := m2.raw.request()
+	assert.NoError(err)
+	assert.Empty(req.Header.Get(evergreenHMACHeader))
+	assert.Equal("evergreen", req.Header.Get(evergreenNotificationIDHeader))
 }
 
 func TestEvergreenWebhookSender(t *testing.T) {
@@ -37,6 +37,7 @@ var distroSyntaxValidators = []distroValidator{
 	ensureHasValidFinderSettings,
 	ensureHasValidDispatcherSettings,
 	ensureHasValidVirtualWorkstationSettings,
+	ensureValidMaintenanceWindows,
 }
 
 // CheckDistro checks if the distro configuration syntax is valid. Returns
@@ -262,6 +263,21 @@ func ensureValidArch(ctx context.Context, d *distro.Distro, s *evergreen.Setting
 	return nil
 }
 
+// ensureValidMaintenanceWindows checks that each maintenance window is either
+// a valid one-off window or a valid recurring window.
+func ensureValidMaintenanceWindows(ctx context.Context, d *distro.Distro, s *evergreen.Settings) ValidationErrors {
+	var errs ValidationErrors
+	for i, w := range d.MaintenanceWindows {
+		if err := w.Validate(); err != nil {
+			errs = append(errs, ValidationError{
+				Message: errors.Wrapf(err, "invalid maintenance window at index %d", i).Error(),
+				Level:   Error,
+			})
+		}
+	}
+	return errs
+}
+
 // ensureValidBootstrapSettings checks that the bootstrap method
 // is one of the supported methods, the communication method is one of the
 // supported methods, and the two together form a valid combination.
@@ -406,6 +422,12 @@ func ensureHasValidPlannerSettings(ctx context.Context, d *distro.Distro, s *eve
 			Level:   Error,
 		})
 	}
+	if settings.Strategy != "" && !utility.StringSliceContains(evergreen.ValidTaskPlannerStrategies, settings.Strategy) {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("invalid planner_settings.strategy '%s' for distro '%s'", settings.Strategy, d.Id),
+			Level:   Error,
+		})
+	}
 	if settings.TargetTime < 0 {
 		ms := settings.TargetTime / time.Millisecond
 		errs = append(errs, ValidationError{
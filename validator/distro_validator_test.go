@@ -3,6 +3,7 @@ package validator
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/evergreen-ci/birch"
 	"github.com/evergreen-ci/evergreen"
@@ -697,6 +698,56 @@ func TestEnsureHasValidVirtualWorkstationSettings(t *testing.T) {
 	}, settings))
 }
 
+func TestEnsureValidMaintenanceWindows(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	settings := &evergreen.Settings{}
+	now := time.Now()
+	for tName, tCase := range map[string]struct {
+		window  distro.MaintenanceWindow
+		isValid bool
+	}{
+		"OneOffWindow": {
+			window:  distro.MaintenanceWindow{Start: now, End: now.Add(time.Hour)},
+			isValid: true,
+		},
+		"RecurringWindow": {
+			window:  distro.MaintenanceWindow{Cron: "0 2 * * *", DurationSecs: 7200},
+			isValid: true,
+		},
+		"EmptyWindow": {
+			window: distro.MaintenanceWindow{Reason: "reason"},
+		},
+		"OneOffWindowEndingBeforeStart": {
+			window: distro.MaintenanceWindow{Start: now, End: now.Add(-time.Hour)},
+		},
+		"OneOffWindowWithoutEnd": {
+			window: distro.MaintenanceWindow{Start: now},
+		},
+		"RecurringWindowWithoutDuration": {
+			window: distro.MaintenanceWindow{Cron: "0 2 * * *"},
+		},
+		"RecurringWindowWithInvalidCron": {
+			window: distro.MaintenanceWindow{Cron: "not a cron", DurationSecs: 7200},
+		},
+		"WindowWithBothOneOffAndRecurringFields": {
+			window: distro.MaintenanceWindow{Start: now, End: now.Add(time.Hour), Cron: "0 2 * * *", DurationSecs: 7200},
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			errs := ensureValidMaintenanceWindows(ctx, &distro.Distro{
+				MaintenanceWindows: []distro.MaintenanceWindow{tCase.window},
+			}, settings)
+			if tCase.isValid {
+				assert.Empty(t, errs)
+			} else {
+				assert.NotEmpty(t, errs)
+			}
+		})
+	}
+}
+
 func TestValidateAliases(t *testing.T) {
 	assert.NotNil(t, validateAliases(&distro.Distro{
 		Id:            "distro",
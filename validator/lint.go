@@ -0,0 +1,243 @@
+package validator
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/agent/command"
+	"github.com/evergreen-ci/evergreen/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Rule IDs identify the check that produced a validation error. They are
+// stable so that they can be referenced by suppression comments and by tools
+// that consume lint output.
+const (
+	RuleBuildVariantFields    = "build-variant-fields"
+	RuleDependencyGraph       = "dependency-graph"
+	RulePluginCommands        = "plugin-commands"
+	RuleProjectFields         = "project-fields"
+	RuleDependencyStatuses    = "dependency-statuses"
+	RuleTaskNames             = "task-names"
+	RuleBuildVariantNames     = "build-variant-names"
+	RuleBatchTimes            = "batch-times"
+	RuleDisplayTaskNames      = "display-task-names"
+	RuleBuildVariantTaskNames = "build-variant-task-names"
+	RuleAllDependencies       = "all-dependencies"
+	RuleDuplicateTaskNames    = "duplicate-task-names"
+	RuleTaskIDsAndTags        = "task-ids-and-tags"
+	RuleParameters            = "parameters"
+	RuleTaskGroups            = "task-groups"
+	RuleHostCreate            = "host-create"
+	RuleDuplicateVariantTasks = "duplicate-variant-tasks"
+	RuleGenerateTasks         = "generate-tasks"
+	RuleDuplicateContainers   = "duplicate-containers"
+	RuleReferentialIntegrity  = "referential-integrity"
+
+	RuleConfigAliases    = "config-aliases"
+	RuleConfigPlugins    = "config-plugins"
+	RuleConfigContainers = "config-containers"
+
+	RuleTaskGroupUsage       = "task-group-usage"
+	RuleTaskRuns             = "task-runs"
+	RuleModules              = "modules"
+	RuleTasks                = "tasks"
+	RuleDependencyReferences = "dependency-references"
+	RuleDependencyRequesters = "dependency-requesters"
+	RuleDependencyPaths      = "dependency-paths"
+	RuleBuildVariants        = "build-variants"
+	RuleUnusedTasks          = "unused-tasks"
+
+	RuleAliasCoverage = "alias-coverage"
+	RuleCheckRuns     = "check-runs"
+
+	RuleProjectSettings = "project-settings"
+	RuleVersionControl  = "version-control"
+	RuleContainers      = "containers"
+	RuleProjectLimits   = "project-limits"
+	RuleIncludeLimits   = "include-limits"
+	RuleTimeoutLimits   = "timeout-limits"
+
+	// RuleProjectParse and RuleStrictParse are for errors loading the YAML
+	// itself, before any of the other checks run.
+	RuleProjectParse = "project-parse"
+	RuleStrictParse  = "strict-parse"
+
+	// RuleUnusedFunctions and RuleDeprecatedCommands are only checked when
+	// linting. RuleDuplicateVariantTasks is also checked when linting for
+	// identical duplicates, which are otherwise ignored.
+	RuleUnusedFunctions    = "unused-functions"
+	RuleDeprecatedCommands = "deprecated-commands"
+)
+
+// withRule sets the rule of each validation error that doesn't already have
+// one.
+func withRule(rule string, errs ValidationErrors) ValidationErrors {
+	for i := range errs {
+		if errs[i].Rule == "" {
+			errs[i].Rule = rule
+		}
+	}
+	return errs
+}
+
+// lintSuppressionRegexp matches comments of the form
+// "# evergreen-lint-disable rule-one, rule-two".
+var lintSuppressionRegexp = regexp.MustCompile(`#\s*evergreen-lint-disable\s+(.+)$`)
+
+// LintSuppressions returns the rules that are disabled by suppression comments
+// in the project YAML. Suppressions apply to the whole file regardless of
+// where the comment is.
+func LintSuppressions(projectYAML []byte) map[string]bool {
+	suppressed := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(projectYAML))
+	for scanner.Scan() {
+		match := lintSuppressionRegexp.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		for _, rule := range strings.FieldsFunc(match[1], func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }) {
+			suppressed[rule] = true
+		}
+	}
+	return suppressed
+}
+
+// WithoutRules returns the validation errors that are not produced by any of
+// the given rules.
+func (v ValidationErrors) WithoutRules(rules map[string]bool) ValidationErrors {
+	errs := ValidationErrors{}
+	for _, err := range v {
+		if err.Rule != "" && rules[err.Rule] {
+			continue
+		}
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// CheckProjectLint returns issues in the project configuration that are not
+// problems on their own but are worth cleaning up. These are not checked when
+// validating a project. The project must be the result of loading the YAML.
+func CheckProjectLint(project *model.Project, projectYAML []byte) ValidationErrors {
+	errs := ValidationErrors{}
+	errs = append(errs, withRule(RuleUnusedFunctions, checkFunctionUsage(project))...)
+	errs = append(errs, withRule(RuleDuplicateVariantTasks, checkRedundantVariantTasks(projectYAML))...)
+	errs = append(errs, withRule(RuleDeprecatedCommands, checkDeprecatedCommands(project))...)
+	return errs
+}
+
+// checkRedundantVariantTasks returns a notice for each task that is listed
+// more than once in a build variant with an identical definition. These are
+// ignored when the project is loaded, so they can't be found in the project.
+func checkRedundantVariantTasks(projectYAML []byte) ValidationErrors {
+	errs := ValidationErrors{}
+	var root yaml.Node
+	if err := yaml.Unmarshal(projectYAML, &root); err != nil || len(root.Content) == 0 {
+		return errs
+	}
+	for _, dup := range removeDuplicateVariantTasks(root.Content[0]) {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("task '%s' is listed more than once in build variant '%s'", dup.task, dup.variant),
+			Level:   Notice,
+		})
+	}
+	return errs
+}
+
+// checkFunctionUsage returns a notice for each function that is defined but
+// never called.
+func checkFunctionUsage(project *model.Project) ValidationErrors {
+	errs := ValidationErrors{}
+	for _, name := range UnusedFunctions(project) {
+		errs = append(errs, ValidationError{
+			Message: fmt.Sprintf("function '%s' is defined but never called", name),
+			Level:   Notice,
+		})
+	}
+	return errs
+}
+
+// checkDeprecatedCommands returns a warning for each block that calls a
+// deprecated command.
+func checkDeprecatedCommands(project *model.Project) ValidationErrors {
+	errs := ValidationErrors{}
+	for _, block := range projectCommandBlocks(project) {
+		for _, cmd := range block.commands {
+			if command.IsDeprecated(cmd.Command) {
+				errs = append(errs, ValidationError{
+					Message: fmt.Sprintf("%s calls deprecated command '%s', which no longer does anything", block.name, cmd.Command),
+					Level:   Warning,
+				})
+			}
+		}
+	}
+	return errs
+}
+
+// UnusedFunctions returns the names of the project's functions that are never
+// called, sorted by name. Functions may be called by tasks that the project
+// generates, so no functions are considered unused if the project generates
+// tasks.
+func UnusedFunctions(project *model.Project) []string {
+	called := map[string]bool{}
+	for _, block := range projectCommandBlocks(project) {
+		for _, cmd := range block.commands {
+			if cmd.Command == evergreen.GenerateTasksCommandName {
+				return nil
+			}
+			if cmd.Function != "" {
+				called[cmd.Function] = true
+			}
+		}
+	}
+
+	var unused []string
+	for name := range project.Functions {
+		if !called[name] {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+	return unused
+}
+
+type commandBlock struct {
+	name     string
+	commands []model.PluginCommandConf
+}
+
+// projectCommandBlocks returns every block of commands in the project.
+func projectCommandBlocks(project *model.Project) []commandBlock {
+	var blocks []commandBlock
+	addSet := func(name string, set *model.YAMLCommandSet) {
+		if set != nil {
+			blocks = append(blocks, commandBlock{name: name, commands: set.List()})
+		}
+	}
+
+	addSet("pre", project.Pre)
+	addSet("post", project.Post)
+	addSet("timeout", project.Timeout)
+	for name, set := range project.Functions {
+		addSet(fmt.Sprintf("function '%s'", name), set)
+	}
+	for _, t := range project.Tasks {
+		blocks = append(blocks, commandBlock{name: fmt.Sprintf("task '%s'", t.Name), commands: t.Commands})
+	}
+	for _, tg := range project.TaskGroups {
+		addSet(fmt.Sprintf("task group '%s' setup_group", tg.Name), tg.SetupGroup)
+		addSet(fmt.Sprintf("task group '%s' setup_task", tg.Name), tg.SetupTask)
+		addSet(fmt.Sprintf("task group '%s' teardown_task", tg.Name), tg.TeardownTask)
+		addSet(fmt.Sprintf("task group '%s' teardown_group", tg.Name), tg.TeardownGroup)
+		addSet(fmt.Sprintf("task group '%s' timeout", tg.Name), tg.Timeout)
+	}
+
+	sort.SliceStable(blocks, func(i, j int) bool { return blocks[i].name < blocks[j].name })
+	return blocks
+}
@@ -0,0 +1,212 @@
+package validator
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/evergreen-ci/evergreen/agent/command"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// LintFix describes a change made to a project's YAML to fix a lint issue.
+type LintFix struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// FixProjectYAML fixes the mechanical lint issues in a project's YAML:
+//   - Functions that are never called are removed.
+//   - Tasks that are listed more than once in a build variant are removed if
+//     the duplicates are identical.
+//   - Calls to deprecated commands are removed, unless that would leave a
+//     block with no commands.
+//
+// The project must be the result of loading the YAML, including any
+// includes, so that functions called from included files are not removed.
+// Rules in suppressed are not fixed. If nothing is fixed, the YAML is returned
+// unchanged; otherwise, comments are kept but the YAML may be reformatted.
+func FixProjectYAML(projectYAML []byte, project *model.Project, suppressed map[string]bool) ([]byte, []LintFix, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(projectYAML, &root); err != nil {
+		return nil, nil, errors.Wrap(err, "parsing project YAML")
+	}
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return projectYAML, nil, nil
+	}
+	doc := root.Content[0]
+
+	var fixes []LintFix
+	if !suppressed[RuleUnusedFunctions] {
+		fixes = append(fixes, fixUnusedFunctions(doc, project)...)
+	}
+	if !suppressed[RuleDuplicateVariantTasks] {
+		fixes = append(fixes, fixDuplicateVariantTasks(doc)...)
+	}
+	if !suppressed[RuleDeprecatedCommands] {
+		fixes = append(fixes, fixDeprecatedCommands(doc)...)
+	}
+	if len(fixes) == 0 {
+		return projectYAML, nil, nil
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&root); err != nil {
+		return nil, nil, errors.Wrap(err, "encoding fixed project YAML")
+	}
+	if err := enc.Close(); err != nil {
+		return nil, nil, errors.Wrap(err, "encoding fixed project YAML")
+	}
+
+	return buf.Bytes(), fixes, nil
+}
+
+func fixUnusedFunctions(doc *yaml.Node, project *model.Project) []LintFix {
+	functions := mappingValue(doc, "functions")
+	if functions == nil || functions.Kind != yaml.MappingNode {
+		return nil
+	}
+	unused := map[string]bool{}
+	for _, name := range UnusedFunctions(project) {
+		unused[name] = true
+	}
+
+	var fixes []LintFix
+	var kept []*yaml.Node
+	for i := 0; i+1 < len(functions.Content); i += 2 {
+		name := functions.Content[i].Value
+		if unused[name] {
+			fixes = append(fixes, LintFix{
+				Rule:    RuleUnusedFunctions,
+				Message: fmt.Sprintf("removed unused function '%s'", name),
+			})
+			continue
+		}
+		kept = append(kept, functions.Content[i], functions.Content[i+1])
+	}
+	functions.Content = kept
+	return fixes
+}
+
+func fixDuplicateVariantTasks(doc *yaml.Node) []LintFix {
+	var fixes []LintFix
+	for _, dup := range removeDuplicateVariantTasks(doc) {
+		fixes = append(fixes, LintFix{
+			Rule:    RuleDuplicateVariantTasks,
+			Message: fmt.Sprintf("removed duplicate task '%s' from build variant '%s'", dup.task, dup.variant),
+		})
+	}
+	return fixes
+}
+
+type duplicateVariantTask struct {
+	variant string
+	task    string
+}
+
+// removeDuplicateVariantTasks removes tasks that are listed more than once in
+// a build variant with identical definitions and returns the removed tasks.
+func removeDuplicateVariantTasks(doc *yaml.Node) []duplicateVariantTask {
+	variants := mappingValue(doc, "buildvariants")
+	if variants == nil || variants.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	var removed []duplicateVariantTask
+	for _, bv := range variants.Content {
+		tasks := mappingValue(bv, "tasks")
+		if tasks == nil || tasks.Kind != yaml.SequenceNode {
+			continue
+		}
+		bvName := ""
+		if name := mappingValue(bv, "name"); name != nil {
+			bvName = name.Value
+		}
+
+		seen := map[string]any{}
+		var kept []*yaml.Node
+		for _, t := range tasks.Content {
+			name, spec := variantTaskSpec(t)
+			if prev, ok := seen[name]; ok && name != "" && reflect.DeepEqual(prev, spec) {
+				removed = append(removed, duplicateVariantTask{variant: bvName, task: name})
+				continue
+			}
+			if _, ok := seen[name]; !ok {
+				seen[name] = spec
+			}
+			kept = append(kept, t)
+		}
+		tasks.Content = kept
+	}
+	return removed
+}
+
+// variantTaskSpec returns the name of a task listed in a build variant and
+// its decoded definition. A task listed by name alone is equivalent to one
+// listed as a mapping with only a name.
+func variantTaskSpec(node *yaml.Node) (string, any) {
+	if node.Kind == yaml.ScalarNode {
+		return node.Value, map[string]any{"name": node.Value}
+	}
+	name := mappingValue(node, "name")
+	if name == nil {
+		return "", nil
+	}
+	var spec any
+	if err := node.Decode(&spec); err != nil {
+		return "", nil
+	}
+	return name.Value, spec
+}
+
+// fixDeprecatedCommands removes deprecated commands from every list of
+// commands in the YAML.
+func fixDeprecatedCommands(node *yaml.Node) []LintFix {
+	var fixes []LintFix
+	for _, child := range node.Content {
+		fixes = append(fixes, fixDeprecatedCommands(child)...)
+	}
+	if node.Kind != yaml.SequenceNode {
+		return fixes
+	}
+
+	var kept []*yaml.Node
+	var removed []string
+	for _, item := range node.Content {
+		if cmd := mappingValue(item, "command"); cmd != nil && command.IsDeprecated(cmd.Value) {
+			removed = append(removed, cmd.Value)
+			continue
+		}
+		kept = append(kept, item)
+	}
+	if len(removed) == 0 || len(kept) == 0 {
+		return fixes
+	}
+
+	node.Content = kept
+	for _, name := range removed {
+		fixes = append(fixes, LintFix{
+			Rule:    RuleDeprecatedCommands,
+			Message: fmt.Sprintf("removed deprecated command '%s'", name),
+		})
+	}
+	return fixes
+}
+
+// mappingValue returns the value for the key in a YAML mapping, or nil if the
+// node isn't a mapping or doesn't have the key.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
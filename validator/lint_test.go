@@ -0,0 +1,139 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const lintProjectYAML = `# evergreen-lint-disable task-runs
+functions:
+  used:
+    - command: shell.exec
+      params:
+        script: echo used
+  unused:
+    - command: shell.exec
+      params:
+        script: echo unused
+
+tasks:
+  - name: t1
+    commands:
+      - command: manifest.load
+      - func: used
+  - name: t2
+    commands:
+      - command: git.apply_patch
+
+buildvariants:
+  - name: bv
+    display_name: bv
+    run_on: d1
+    tasks:
+      - name: t1
+      - t1
+      - name: t2
+`
+
+func TestLintSuppressions(t *testing.T) {
+	suppressed := LintSuppressions([]byte(`
+# evergreen-lint-disable unused-functions, deprecated-commands
+tasks:
+  - name: t1 # evergreen-lint-disable modules
+`))
+	assert.Equal(t, map[string]bool{
+		RuleUnusedFunctions:    true,
+		RuleDeprecatedCommands: true,
+		RuleModules:            true,
+	}, suppressed)
+
+	errs := ValidationErrors{
+		{Message: "unused", Rule: RuleUnusedFunctions},
+		{Message: "tasks", Rule: RuleTasks},
+		{Message: "no rule"},
+	}
+	filtered := errs.WithoutRules(suppressed)
+	require.Len(t, filtered, 2)
+	assert.Equal(t, "tasks", filtered[0].Message)
+	assert.Equal(t, "no rule", filtered[1].Message)
+}
+
+func TestCheckProjectLint(t *testing.T) {
+	project := &model.Project{}
+	_, err := model.LoadProjectInto(t.Context(), []byte(lintProjectYAML), nil, "", project)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"unused"}, UnusedFunctions(project))
+
+	errs := CheckProjectLint(project, []byte(lintProjectYAML))
+	require.Len(t, errs, 4)
+	assert.Equal(t, RuleUnusedFunctions, errs[0].Rule)
+	assert.Equal(t, Notice, errs[0].Level)
+	assert.Contains(t, errs[0].Message, "'unused'")
+	assert.Equal(t, RuleDuplicateVariantTasks, errs[1].Rule)
+	assert.Equal(t, Notice, errs[1].Level)
+	assert.Contains(t, errs[1].Message, "'t1'")
+	for _, err := range errs[2:] {
+		assert.Equal(t, RuleDeprecatedCommands, err.Rule)
+		assert.Equal(t, Warning, err.Level)
+	}
+
+	t.Run("NoUnusedFunctionsWithGenerateTasks", func(t *testing.T) {
+		project.Tasks = append(project.Tasks, model.ProjectTask{
+			Name:     "generator",
+			Commands: []model.PluginCommandConf{{Command: "generate.tasks"}},
+		})
+		assert.Empty(t, UnusedFunctions(project))
+	})
+}
+
+func TestFixProjectYAML(t *testing.T) {
+	project := &model.Project{}
+	_, err := model.LoadProjectInto(t.Context(), []byte(lintProjectYAML), nil, "", project)
+	require.NoError(t, err)
+
+	t.Run("FixesMechanicalIssues", func(t *testing.T) {
+		fixed, fixes, err := FixProjectYAML([]byte(lintProjectYAML), project, nil)
+		require.NoError(t, err)
+		require.Len(t, fixes, 3)
+		assert.Equal(t, RuleUnusedFunctions, fixes[0].Rule)
+		assert.Equal(t, RuleDuplicateVariantTasks, fixes[1].Rule)
+		assert.Equal(t, RuleDeprecatedCommands, fixes[2].Rule)
+
+		fixedProject := &model.Project{}
+		_, err = model.LoadProjectInto(t.Context(), fixed, nil, "", fixedProject)
+		require.NoError(t, err)
+		assert.Len(t, fixedProject.Functions, 1)
+		assert.NotNil(t, fixedProject.Functions["used"])
+		require.Len(t, fixedProject.Tasks, 2)
+		assert.Len(t, fixedProject.Tasks[0].Commands, 1, "deprecated command should be removed")
+		assert.Len(t, fixedProject.Tasks[1].Commands, 1, "task's only command should be kept")
+		assert.Contains(t, string(fixed), "# evergreen-lint-disable task-runs")
+
+		errs := CheckProjectLint(fixedProject, fixed)
+		require.Len(t, errs, 1, "only the deprecated command that can't be removed should remain")
+		assert.Equal(t, RuleDeprecatedCommands, errs[0].Rule)
+	})
+	t.Run("SkipsSuppressedRules", func(t *testing.T) {
+		_, fixes, err := FixProjectYAML([]byte(lintProjectYAML), project, map[string]bool{
+			RuleUnusedFunctions:       true,
+			RuleDuplicateVariantTasks: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, fixes, 1)
+		assert.Equal(t, RuleDeprecatedCommands, fixes[0].Rule)
+	})
+	t.Run("ReturnsUnchangedYAMLWithoutFixes", func(t *testing.T) {
+		fixed, fixes, err := FixProjectYAML([]byte(lintProjectYAML), project, map[string]bool{
+			RuleUnusedFunctions:       true,
+			RuleDuplicateVariantTasks: true,
+			RuleDeprecatedCommands:    true,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, fixes)
+		assert.Equal(t, lintProjectYAML, string(fixed))
+	})
+}
@@ -36,6 +36,29 @@ type projectSettingsValidator func(context.Context, *evergreen.Settings, *model.
 
 type projectAliasValidator func(config *model.Project, aliases model.ProjectAliases) ValidationErrors
 
+// projectValidatorRule associates a project validator with the ID of the rule
+// it checks. The other *ValidatorRule types do the same for the other kinds
+// of validators.
+type projectValidatorRule struct {
+	rule     string
+	validate projectValidator
+}
+
+type projectConfigValidatorRule struct {
+	rule     string
+	validate projectConfigValidator
+}
+
+type projectSettingsValidatorRule struct {
+	rule     string
+	validate projectSettingsValidator
+}
+
+type projectAliasValidatorRule struct {
+	rule     string
+	validate projectAliasValidator
+}
+
 type ValidationErrorLevel int64
 
 const (
@@ -67,6 +90,9 @@ func (vel ValidationErrorLevel) String() string {
 type ValidationError struct {
 	Level   ValidationErrorLevel `json:"level"`
 	Message string               `json:"message"`
+	// Rule is the ID of the check that produced the error. See lint.go for
+	// the list of rules.
+	Rule string `json:"rule,omitempty"`
 }
 
 type ValidationErrors []ValidationError
@@ -124,61 +150,62 @@ type ValidationInput struct {
 // These are expected to only return ValidationError's with
 // a level of Error ValidationLevel. They must also explicitly return
 // Error as opposed to leaving the field blank.
-var projectErrorValidators = []projectValidator{
-	validateBVFields,
-	validateDependencyGraph,
-	validatePluginCommands,
-	validateProjectFields,
-	validateStatusesForTaskDependencies,
-	validateTaskNames,
-	validateBVNames,
-	validateBVBatchTimes,
-	validateDisplayTaskNames,
-	validateBVTaskNames,
-	validateAllDependenciesSpec,
-	validateProjectTaskNames,
-	validateProjectTaskIdsAndTags,
-	validateParameters,
-	validateTaskGroups,
-	validateHostCreates,
-	validateDuplicateBVTasks,
-	validateGenerateTasks,
+var projectErrorValidators = []projectValidatorRule{
+	{RuleBuildVariantFields, validateBVFields},
+	{RuleDependencyGraph, validateDependencyGraph},
+	{RulePluginCommands, validatePluginCommands},
+	{RuleProjectFields, validateProjectFields},
+	{RuleDependencyStatuses, validateStatusesForTaskDependencies},
+	{RuleTaskNames, validateTaskNames},
+	{RuleBuildVariantNames, validateBVNames},
+	{RuleBatchTimes, validateBVBatchTimes},
+	{RuleDisplayTaskNames, validateDisplayTaskNames},
+	{RuleBuildVariantTaskNames, validateBVTaskNames},
+	{RuleAllDependencies, validateAllDependenciesSpec},
+	{RuleDuplicateTaskNames, validateProjectTaskNames},
+	{RuleTaskIDsAndTags, validateProjectTaskIdsAndTags},
+	{RuleParameters, validateParameters},
+	{RuleTaskGroups, validateTaskGroups},
+	{RuleHostCreate, validateHostCreates},
+	{RuleDuplicateVariantTasks, validateDuplicateBVTasks},
+	{RuleGenerateTasks, validateGenerateTasks},
 }
 
 // Functions used to validate the syntax of project configs representing properties found on the project page.
-var projectConfigErrorValidators = []projectConfigValidator{
-	validateProjectConfigAliases,
-	validateProjectConfigPlugins,
-	validateProjectConfigContainers,
+var projectConfigErrorValidators = []projectConfigValidatorRule{
+	{RuleConfigAliases, validateProjectConfigAliases},
+	{RuleConfigPlugins, validateProjectConfigPlugins},
+	{RuleConfigContainers, validateProjectConfigContainers},
 }
 
 // Functions used to validate the semantics of a project configuration file.
 // These are expected to only return ValidationError's with
 // a level of Warning ValidationLevel or Notice ValidationLevel.
-var projectWarningValidators = []projectValidator{
-	checkTaskGroups,
-	checkTaskRuns,
-	checkModules,
-	checkTasks,
-	checkReferencesForTaskDependencies,
-	checkRequestersForTaskDependencies,
-	checkBuildVariants,
-	checkTaskUsage,
+var projectWarningValidators = []projectValidatorRule{
+	{RuleTaskGroupUsage, checkTaskGroups},
+	{RuleTaskRuns, checkTaskRuns},
+	{RuleModules, checkModules},
+	{RuleTasks, checkTasks},
+	{RuleDependencyReferences, checkReferencesForTaskDependencies},
+	{RuleDependencyRequesters, checkRequestersForTaskDependencies},
+	{RuleDependencyPaths, checkPathFiltersForTaskDependencies},
+	{RuleBuildVariants, checkBuildVariants},
+	{RuleUnusedTasks, checkTaskUsage},
 }
 
-var projectAliasWarningValidators = []projectAliasValidator{
-	validateAliasCoverage,
-	validateCheckRuns,
+var projectAliasWarningValidators = []projectAliasValidatorRule{
+	{RuleAliasCoverage, validateAliasCoverage},
+	{RuleCheckRuns, validateCheckRuns},
 }
 
 // Functions used to validate a project configuration that requires additional
 // info such as admin settings and project settings.
-var projectSettingsValidators = []projectSettingsValidator{
-	validateVersionControl,
-	validateContainers,
-	validateProjectLimits,
-	validateIncludeLimits,
-	validateTimeoutLimits,
+var projectSettingsValidators = []projectSettingsValidatorRule{
+	{RuleVersionControl, validateVersionControl},
+	{RuleContainers, validateContainers},
+	{RuleProjectLimits, validateProjectLimits},
+	{RuleIncludeLimits, validateIncludeLimits},
+	{RuleTimeoutLimits, validateTimeoutLimits},
 }
 
 func (vr ValidationError) Error() string {
@@ -263,6 +290,7 @@ func CheckProject(ctx context.Context, project *model.Project, config *model.Pro
 		verrs = append(verrs, ValidationError{
 			Message: "no project specified; validation will proceed without checking project settings and alias coverage",
 			Level:   Warning,
+			Rule:    RuleProjectSettings,
 		})
 		return verrs
 	}
@@ -271,11 +299,13 @@ func CheckProject(ctx context.Context, project *model.Project, config *model.Pro
 			return append(verrs, ValidationError{
 				Message: "error finding project; validation will proceed without checking project settings and alias coverage",
 				Level:   Warning,
+				Rule:    RuleProjectSettings,
 			})
 		}
 		return append(verrs, ValidationError{
 			Message: "project does not exist; validation will proceed without checking project settings and alias coverage",
 			Level:   Warning,
+			Rule:    RuleProjectSettings,
 		})
 	}
 	verrs = append(verrs, CheckProjectSettings(ctx, evergreen.GetEnvironment().Settings(), project, ref, isConfigDefined)...)
@@ -285,6 +315,7 @@ func CheckProject(ctx context.Context, project *model.Project, config *model.Pro
 		return append(verrs, ValidationError{
 			Message: "problem finding aliases; validation will not check alias coverage",
 			Level:   Warning,
+			Rule:    RuleAliasCoverage,
 		})
 	}
 	return append(verrs, CheckAliasWarnings(project, aliases)...)
@@ -295,7 +326,7 @@ func CheckProjectWarnings(project *model.Project) ValidationErrors {
 	validationErrs := ValidationErrors{}
 	for _, projectWarningValidator := range projectWarningValidators {
 		validationErrs = append(validationErrs,
-			projectWarningValidator(project)...)
+			withRule(projectWarningValidator.rule, projectWarningValidator.validate(project))...)
 	}
 	return validationErrs
 }
@@ -305,7 +336,7 @@ func CheckAliasWarnings(project *model.Project, aliases model.ProjectAliases) Va
 	validationErrs := ValidationErrors{}
 	for _, validator := range projectAliasWarningValidators {
 		validationErrs = append(validationErrs,
-			validator(project, aliases)...)
+			withRule(validator.rule, validator.validate(project, aliases))...)
 	}
 
 	return validationErrs
@@ -317,7 +348,7 @@ func CheckProjectErrors(ctx context.Context, project *model.Project) ValidationE
 
 	for _, projectErrorValidator := range projectErrorValidators {
 		validationErrs = append(validationErrs,
-			projectErrorValidator(project)...)
+			withRule(projectErrorValidator.rule, projectErrorValidator.validate(project))...)
 	}
 
 	singleTaskDistroWhitelist, err := GetAllowedSingleTaskDistroTasksForProject(ctx, project.Identifier)
@@ -328,16 +359,16 @@ func CheckProjectErrors(ctx context.Context, project *model.Project) ValidationE
 	// get distro IDs and aliases for ensureReferentialIntegrity validation
 	distroIDs, distroAliases, singleTaskDistroIDs, distroWarnings, err := getDistrosForProject(ctx, project.Identifier)
 	if err != nil {
-		validationErrs = append(validationErrs, ValidationError{Message: "can't get distros from database"})
+		validationErrs = append(validationErrs, ValidationError{Message: "can't get distros from database", Rule: RuleReferentialIntegrity})
 	}
 	containerNameMap := map[string]bool{}
 	for _, container := range project.Containers {
 		if containerNameMap[container.Name] {
-			validationErrs = append(validationErrs, ValidationError{Message: fmt.Sprintf("container '%s' is defined multiple times", container.Name)})
+			validationErrs = append(validationErrs, ValidationError{Message: fmt.Sprintf("container '%s' is defined multiple times", container.Name), Rule: RuleDuplicateContainers})
 		}
 		containerNameMap[container.Name] = true
 	}
-	validationErrs = append(validationErrs, ensureReferentialIntegrity(project, containerNameMap, distroIDs, distroAliases, singleTaskDistroIDs, singleTaskDistroWhitelist, distroWarnings)...)
+	validationErrs = append(validationErrs, withRule(RuleReferentialIntegrity, ensureReferentialIntegrity(project, containerNameMap, distroIDs, distroAliases, singleTaskDistroIDs, singleTaskDistroWhitelist, distroWarnings))...)
 	return validationErrs
 }
 
@@ -365,7 +396,7 @@ func CheckProjectConfigErrors(ctx context.Context, projectConfig *model.ProjectC
 	}
 	for _, projectConfigErrorValidator := range projectConfigErrorValidators {
 		validationErrs = append(validationErrs,
-			projectConfigErrorValidator(ctx, projectConfig)...)
+			withRule(projectConfigErrorValidator.rule, projectConfigErrorValidator.validate(ctx, projectConfig))...)
 	}
 
 	return validationErrs
@@ -376,7 +407,7 @@ func CheckProjectConfigErrors(ctx context.Context, projectConfig *model.ProjectC
 func CheckProjectSettings(ctx context.Context, settings *evergreen.Settings, p *model.Project, ref *model.ProjectRef, isConfigDefined bool) ValidationErrors {
 	var errs ValidationErrors
 	for _, validateSettings := range projectSettingsValidators {
-		errs = append(errs, validateSettings(ctx, settings, p, ref, isConfigDefined)...)
+		errs = append(errs, withRule(validateSettings.rule, validateSettings.validate(ctx, settings, p, ref, isConfigDefined))...)
 	}
 	return errs
 }
@@ -1861,6 +1892,40 @@ func checkRequestersForTaskDependencies(project *model.Project) ValidationErrors
 	return errs
 }
 
+// checkPathFiltersForTaskDependencies checks for tasks that depend on a task
+// that could be skipped by its changed-path filters when the dependent task is
+// not.
+func checkPathFiltersForTaskDependencies(project *model.Project) ValidationErrors {
+	var errs ValidationErrors
+	for _, bvtu := range project.FindAllBuildVariantTasks() {
+		for _, d := range bvtu.DependsOn {
+			depVariant := d.Variant
+			if depVariant == "" {
+				depVariant = bvtu.Variant
+			}
+			dependency := project.FindTaskForVariant(d.Name, depVariant)
+			if dependency == nil || !dependency.HasPathFilters() {
+				continue
+			}
+			if samePathFilters(bvtu, *dependency) {
+				continue
+			}
+			errs = append(errs, ValidationError{
+				Level: Warning,
+				Message: fmt.Sprintf("task '%s' in build variant '%s' depends on task '%s' in build variant '%s', which has different changed-path filters; "+
+					"the dependency will not be created when none of the changed files match its filters", bvtu.Name, bvtu.Variant, d.Name, depVariant),
+			})
+		}
+	}
+	return errs
+}
+
+func samePathFilters(a, b model.BuildVariantTaskUnit) bool {
+	pathsDiffA, pathsDiffB := utility.StringSliceSymmetricDifference(a.Paths, b.Paths)
+	ignoreDiffA, ignoreDiffB := utility.StringSliceSymmetricDifference(a.IgnorePaths, b.IgnorePaths)
+	return len(pathsDiffA)+len(pathsDiffB)+len(ignoreDiffA)+len(ignoreDiffB) == 0
+}
+
 func validateParameters(p *model.Project) ValidationErrors {
 	errs := ValidationErrors{}
 
@@ -46,7 +46,7 @@ func TestProjectWarningValidators(t *testing.T) {
 }
 
 // testProjectValidatorsFunctions parses through all the given project validators and runs the given test function on each one.
-func testProjectValidatorsFunctions(t *testing.T, projectValidators []projectValidator, test func(t *testing.T, funcBodies map[string]*ast.BlockStmt, funcName string)) {
+func testProjectValidatorsFunctions(t *testing.T, projectValidators []projectValidatorRule, test func(t *testing.T, funcBodies map[string]*ast.BlockStmt, funcName string)) {
 	node, err := parser.ParseFile(token.NewFileSet(), "project_validator.go", nil, parser.AllErrors)
 	require.NoError(t, err)
 	funcBodies := make(map[string]*ast.BlockStmt)
@@ -61,7 +61,7 @@ func testProjectValidatorsFunctions(t *testing.T, projectValidators []projectVal
 	// 1. They must return an error explicitly.
 	// 2. They must not return any other type of ValidationError level.
 	for _, validator := range projectValidators {
-		funcPtr := runtime.FuncForPC(reflect.ValueOf(validator).Pointer())
+		funcPtr := runtime.FuncForPC(reflect.ValueOf(validator.validate).Pointer())
 		funcName := funcPtr.Name()[strings.LastIndex(funcPtr.Name(), ".")+1:]
 
 		t.Run(funcName, func(t *testing.T) {
@@ -1004,6 +1004,128 @@ buildvariants:
 	})
 }
 
+func TestCheckPathFiltersForTaskDependencies(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for tName, tCase := range map[string]struct {
+		projYAML    string
+		expectedErr bool
+	}{
+		"SucceedsWithoutPathFilters": {
+			projYAML: `
+tasks:
+  - name: dep
+  - name: task
+    depends_on:
+      - name: dep
+buildvariants:
+  - name: bv
+    run_on:
+      - localhost
+    tasks:
+      - name: task
+      - name: dep
+`,
+		},
+		"SucceedsWithVariantLevelPathFilters": {
+			projYAML: `
+tasks:
+  - name: dep
+  - name: task
+    depends_on:
+      - name: dep
+buildvariants:
+  - name: bv
+    run_on:
+      - localhost
+    paths:
+      - "src/**"
+    tasks:
+      - name: task
+      - name: dep
+`,
+		},
+		"SucceedsWhenOnlyDependentTaskHasPathFilters": {
+			projYAML: `
+tasks:
+  - name: dep
+  - name: task
+    paths:
+      - "src/**"
+    depends_on:
+      - name: dep
+buildvariants:
+  - name: bv
+    run_on:
+      - localhost
+    tasks:
+      - name: task
+      - name: dep
+`,
+		},
+		"WarnsWhenDependencyHasDifferentPathFilters": {
+			projYAML: `
+tasks:
+  - name: dep
+    paths:
+      - "lib/**"
+  - name: task
+    ignore_paths:
+      - "*.md"
+    depends_on:
+      - name: dep
+buildvariants:
+  - name: bv
+    run_on:
+      - localhost
+    tasks:
+      - name: task
+      - name: dep
+`,
+			expectedErr: true,
+		},
+		"WarnsWhenDependencyInOtherVariantHasPathFilters": {
+			projYAML: `
+tasks:
+  - name: dep
+  - name: task
+    depends_on:
+      - name: dep
+        variant: other
+buildvariants:
+  - name: bv
+    run_on:
+      - localhost
+    tasks:
+      - name: task
+  - name: other
+    run_on:
+      - localhost
+    tasks:
+      - name: dep
+        paths:
+          - "lib/**"
+`,
+			expectedErr: true,
+		},
+	} {
+		t.Run(tName, func(t *testing.T) {
+			var p model.Project
+			_, err := model.LoadProjectInto(ctx, []byte(tCase.projYAML), nil, "", &p)
+			require.NoError(t, err)
+			errs := checkPathFiltersForTaskDependencies(&p)
+			if tCase.expectedErr {
+				require.Len(t, errs, 1)
+				assert.Equal(t, Warning, errs[0].Level)
+				assert.Contains(t, errs[0].Message, "changed-path filters")
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
+
 func TestCheckRequestersForTaskDependencies(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()